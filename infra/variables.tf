@@ -34,7 +34,7 @@ variable "container_secrets" {
 variable "health_check_path" {
   description = "Caminho de verificação de integridade do serviço"
   type        = string
-  default     = "/health"
+  default     = "/health/ready"
 }
 
 variable "task_role_policy_arns" {
@@ -1,6 +1,7 @@
 package steps
 
 import (
+	"context"
 	"fmt"
 	"tech_challenge/internal/daos"
 	mock_interfaces "tech_challenge/internal/interfaces/mocks"
@@ -72,9 +73,9 @@ func (koh *KitchenOrderHelper) ISendARequestToCreateANewKitchenOrder() error {
 		CreatedAt:  time.Now(),
 	}
 
-	koh.MockDS.EXPECT().Insert(gomock.Any()).Return(nil)
+	koh.MockDS.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
 
-	err := koh.MockDS.Insert(kitchenOrder)
+	err := koh.MockDS.Insert(context.Background(), kitchenOrder)
 	if err != nil {
 		return err
 	}
@@ -115,9 +116,9 @@ func (koh *KitchenOrderHelper) AKitchenOrderExistsWithID(id string) {
 }
 
 func (koh *KitchenOrderHelper) ISendARequestToFindTheKitchenOrderByID() error {
-	koh.MockDS.EXPECT().FindByID(koh.existingID).Return(*koh.foundOrder, nil)
+	koh.MockDS.EXPECT().FindByID(gomock.Any(), koh.existingID).Return(*koh.foundOrder, nil)
 
-	order, err := koh.MockDS.FindByID(koh.existingID)
+	order, err := koh.MockDS.FindByID(context.Background(), koh.existingID)
 	if err != nil {
 		return err
 	}
@@ -149,9 +150,9 @@ func (koh *KitchenOrderHelper) ISendARequestToUpdateTheKitchenOrderStatus() erro
 	now := time.Now()
 	updatedOrder.UpdatedAt = &now
 
-	koh.MockDS.EXPECT().Update(gomock.Any()).Return(nil)
+	koh.MockDS.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
 
-	err := koh.MockDS.Update(updatedOrder)
+	err := koh.MockDS.Update(context.Background(), updatedOrder)
 	if err != nil {
 		return err
 	}
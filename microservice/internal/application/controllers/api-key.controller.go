@@ -0,0 +1,83 @@
+package controllers
+
+import (
+	"time"
+
+	"tech_challenge/internal/application/dtos"
+	"tech_challenge/internal/application/gateways"
+	"tech_challenge/internal/application/presenters"
+	"tech_challenge/internal/interfaces"
+	"tech_challenge/internal/use_cases"
+)
+
+type APIKeyController struct {
+	apiKeyGateway    gateways.APIKeyGateway
+	lastUsedInterval time.Duration
+}
+
+func NewAPIKeyController(apiKeyDataSource interfaces.IAPIKeyDataSource, lastUsedInterval time.Duration) *APIKeyController {
+	return &APIKeyController{
+		apiKeyGateway:    *gateways.NewAPIKeyGateway(apiKeyDataSource),
+		lastUsedInterval: lastUsedInterval,
+	}
+}
+
+func (c *APIKeyController) Create(apiKeyDTO dtos.CreateAPIKeyDTO) (dtos.APIKeyResponseDTO, error) {
+	useCase := use_cases.NewCreateAPIKeyUseCase(c.apiKeyGateway)
+
+	apiKey, key, err := useCase.Execute(apiKeyDTO)
+
+	if err != nil {
+		return dtos.APIKeyResponseDTO{}, err
+	}
+
+	return presenters.ToResponseCreatedAPIKey(apiKey, key), nil
+}
+
+func (c *APIKeyController) FindAll() ([]dtos.APIKeyResponseDTO, error) {
+	useCase := use_cases.NewFindAllAPIKeysUseCase(c.apiKeyGateway)
+
+	apiKeys, err := useCase.Execute()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenters.ToResponseListAPIKey(apiKeys), nil
+}
+
+func (c *APIKeyController) FindByID(id string) (dtos.APIKeyResponseDTO, error) {
+	useCase := use_cases.NewFindAPIKeyByIDUseCase(c.apiKeyGateway)
+
+	apiKey, err := useCase.Execute(id)
+
+	if err != nil {
+		return dtos.APIKeyResponseDTO{}, err
+	}
+
+	return presenters.ToResponseAPIKey(apiKey), nil
+}
+
+func (c *APIKeyController) Revoke(id string) (dtos.APIKeyResponseDTO, error) {
+	useCase := use_cases.NewRevokeAPIKeyUseCase(c.apiKeyGateway)
+
+	apiKey, err := useCase.Execute(id)
+
+	if err != nil {
+		return dtos.APIKeyResponseDTO{}, err
+	}
+
+	return presenters.ToResponseAPIKey(apiKey), nil
+}
+
+func (c *APIKeyController) Authenticate(key string) (dtos.APIKeyResponseDTO, error) {
+	useCase := use_cases.NewAuthenticateAPIKeyUseCase(c.apiKeyGateway, c.lastUsedInterval)
+
+	apiKey, err := useCase.Execute(key)
+
+	if err != nil {
+		return dtos.APIKeyResponseDTO{}, err
+	}
+
+	return presenters.ToResponseAPIKey(apiKey), nil
+}
@@ -0,0 +1,35 @@
+package controllers
+
+import (
+	"context"
+	"time"
+
+	"tech_challenge/internal/application/dtos"
+	"tech_challenge/internal/application/gateways"
+	presenter "tech_challenge/internal/application/presenters"
+	"tech_challenge/internal/interfaces"
+	"tech_challenge/internal/use_cases"
+)
+
+type DisplayBoardController struct {
+	kitchenOrderGateway gateways.KitchenOrderGateway
+	readyTTL            time.Duration
+}
+
+func NewDisplayBoardController(kitchenOrderDataSource interfaces.IKitchenOrderDataSource, readyTTL time.Duration) *DisplayBoardController {
+	return &DisplayBoardController{
+		kitchenOrderGateway: *gateways.NewKitchenOrderGateway(kitchenOrderDataSource),
+		readyTTL:            readyTTL,
+	}
+}
+
+func (c *DisplayBoardController) Get(ctx context.Context) (dtos.DisplayBoardDTO, error) {
+	useCase := use_cases.NewGetDisplayBoardUseCase(c.kitchenOrderGateway, c.readyTTL)
+
+	board, err := useCase.Execute(ctx)
+	if err != nil {
+		return dtos.DisplayBoardDTO{}, err
+	}
+
+	return presenter.ToResponseDisplayBoard(board), nil
+}
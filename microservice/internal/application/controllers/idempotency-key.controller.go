@@ -0,0 +1,54 @@
+package controllers
+
+import (
+	"time"
+
+	"tech_challenge/internal/application/dtos"
+	"tech_challenge/internal/application/gateways"
+	"tech_challenge/internal/interfaces"
+	"tech_challenge/internal/use_cases"
+)
+
+type IdempotencyKeyController struct {
+	idempotencyKeyGateway gateways.IdempotencyKeyGateway
+	ttl                   time.Duration
+	sweeper               *use_cases.SweepIdempotencyKeysUseCase
+}
+
+func NewIdempotencyKeyController(idempotencyKeyDataSource interfaces.IIdempotencyKeyDataSource, ttl time.Duration) *IdempotencyKeyController {
+	idempotencyKeyGateway := *gateways.NewIdempotencyKeyGateway(idempotencyKeyDataSource)
+
+	return &IdempotencyKeyController{
+		idempotencyKeyGateway: idempotencyKeyGateway,
+		ttl:                   ttl,
+		sweeper:               use_cases.NewSweepIdempotencyKeysUseCase(idempotencyKeyGateway, ttl),
+	}
+}
+
+func (c *IdempotencyKeyController) Reserve(idempotencyKeyDTO dtos.IdempotencyKeyDTO) (dtos.IdempotencyKeyReservationDTO, error) {
+	useCase := use_cases.NewReserveIdempotencyKeyUseCase(c.idempotencyKeyGateway, c.ttl)
+
+	idempotencyKey, err := useCase.Execute(idempotencyKeyDTO)
+	c.sweeper.Execute()
+	if err != nil {
+		return dtos.IdempotencyKeyReservationDTO{}, err
+	}
+
+	if idempotencyKey.IsCompleted() {
+		return dtos.IdempotencyKeyReservationDTO{Replayed: true, ResourceID: *idempotencyKey.ResourceID}, nil
+	}
+
+	return dtos.IdempotencyKeyReservationDTO{}, nil
+}
+
+func (c *IdempotencyKeyController) Complete(scope, key, resourceID string) error {
+	useCase := use_cases.NewCompleteIdempotencyKeyUseCase(c.idempotencyKeyGateway)
+
+	return useCase.Execute(scope, key, resourceID)
+}
+
+func (c *IdempotencyKeyController) Release(scope, key string) error {
+	useCase := use_cases.NewReleaseIdempotencyKeyUseCase(c.idempotencyKeyGateway)
+
+	return useCase.Execute(scope, key)
+}
@@ -0,0 +1,55 @@
+package controllers
+
+import (
+	"time"
+
+	"tech_challenge/internal/application/dtos"
+	"tech_challenge/internal/application/gateways"
+	presenter "tech_challenge/internal/application/presenters"
+	"tech_challenge/internal/interfaces"
+	"tech_challenge/internal/use_cases"
+)
+
+type KitchenOrderEventController struct {
+	kitchenOrderEventGateway gateways.KitchenOrderEventGateway
+}
+
+func NewKitchenOrderEventController(kitchenOrderEventDataSource interfaces.IKitchenOrderEventDataSource) *KitchenOrderEventController {
+	return &KitchenOrderEventController{
+		kitchenOrderEventGateway: *gateways.NewKitchenOrderEventGateway(kitchenOrderEventDataSource),
+	}
+}
+
+func (c *KitchenOrderEventController) FindAfter(afterID uint64, limit int) ([]dtos.KitchenOrderEventDTO, error) {
+	useCase := use_cases.NewFindKitchenOrderEventsUseCase(c.kitchenOrderEventGateway)
+
+	events, err := useCase.Execute(afterID, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.ToResponseKitchenOrderEventList(events), nil
+}
+
+func (c *KitchenOrderEventController) FindByIDs(ids []uint64) ([]dtos.KitchenOrderEventDTO, error) {
+	useCase := use_cases.NewFindKitchenOrderEventsByIDsUseCase(c.kitchenOrderEventGateway)
+
+	events, err := useCase.Execute(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	return presenter.ToResponseKitchenOrderEventList(events), nil
+}
+
+func (c *KitchenOrderEventController) LastID() (uint64, error) {
+	useCase := use_cases.NewFindLastKitchenOrderEventIDUseCase(c.kitchenOrderEventGateway)
+
+	return useCase.Execute()
+}
+
+func (c *KitchenOrderEventController) Prune(retention time.Duration) (int64, error) {
+	useCase := use_cases.NewPruneKitchenOrderEventsUseCase(c.kitchenOrderEventGateway)
+
+	return useCase.Execute(retention)
+}
@@ -1,9 +1,12 @@
 package controllers
 
 import (
+	"context"
+
 	"tech_challenge/internal/application/dtos"
 	"tech_challenge/internal/application/gateways"
 	presenter "tech_challenge/internal/application/presenters"
+	"tech_challenge/internal/domain/entities"
 	"tech_challenge/internal/interfaces"
 	shared_interfaces "tech_challenge/internal/shared/interfaces"
 	"tech_challenge/internal/use_cases"
@@ -15,12 +18,16 @@ type KitchenOrderController struct {
 	kitchenOrderGateway    gateways.KitchenOrderGateway
 	orderStatusGateway     gateways.OrderStatusGateway
 	messageBroker          shared_interfaces.MessageBroker
+	webhookDispatcher      interfaces.IWebhookDispatcher
+	printDispatcher        interfaces.IPrintDispatcher
 }
 
 func NewKitchenOrderController(
 	kitchenOrderDataSource interfaces.IKitchenOrderDataSource, 
 	orderStatusDataSource interfaces.IOrderStatusDataSource,
 	messageBroker shared_interfaces.MessageBroker,
+	webhookDispatcher interfaces.IWebhookDispatcher,
+	printDispatcher interfaces.IPrintDispatcher,
 ) *KitchenOrderController {
 	return &KitchenOrderController{
 		kitchenOrderDataSource: kitchenOrderDataSource,
@@ -28,13 +35,15 @@ func NewKitchenOrderController(
 		kitchenOrderGateway:    *gateways.NewKitchenOrderGateway(kitchenOrderDataSource),
 		orderStatusGateway:     *gateways.NewOrderStatusGateway(orderStatusDataSource),
 		messageBroker:          messageBroker,
+		webhookDispatcher:      webhookDispatcher,
+		printDispatcher:        printDispatcher,
 	}
 }
 
-func (c *KitchenOrderController) Create(kitchenOrderDTO dtos.CreateKitchenOrderDTO) (dtos.KitchenOrderResponseDTO, error) {
-	kitchenOrderUseCase := use_cases.NewCreateKitchenOrderUseCase(c.kitchenOrderGateway, c.orderStatusGateway)
+func (c *KitchenOrderController) Create(ctx context.Context, kitchenOrderDTO dtos.CreateKitchenOrderDTO) (dtos.KitchenOrderResponseDTO, error) {
+	kitchenOrderUseCase := use_cases.NewCreateKitchenOrderUseCase(c.kitchenOrderGateway, c.orderStatusGateway, c.printDispatcher)
 
-	kitchenOrder, err := kitchenOrderUseCase.Execute(kitchenOrderDTO.OrderID)
+	kitchenOrder, err := kitchenOrderUseCase.Execute(ctx, kitchenOrderDTO)
 
 	if err != nil {
 		return dtos.KitchenOrderResponseDTO{}, err
@@ -43,22 +52,36 @@ func (c *KitchenOrderController) Create(kitchenOrderDTO dtos.CreateKitchenOrderD
 	return presenter.ToResponse(kitchenOrder), nil
 }
 
-func (c *KitchenOrderController) FindAll(filter dtos.KitchenOrderFilter) ([]dtos.KitchenOrderResponseDTO, error) {
+func (c *KitchenOrderController) FindAll(ctx context.Context, filter dtos.KitchenOrderFilter) (dtos.KitchenOrderListResponseDTO, error) {
 	kitchenOrderUseCase := use_cases.NewFindAllKitchenOrderUseCase(c.kitchenOrderGateway)
 
-	kitchenOrders, err := kitchenOrderUseCase.Execute(filter)
+	kitchenOrders, pageInfo, err := kitchenOrderUseCase.Execute(ctx, filter)
 
 	if err != nil {
-		return nil, err
+		return dtos.KitchenOrderListResponseDTO{}, err
+	}
+
+	return presenter.ToResponsePage(kitchenOrders, pageInfo), nil
+}
+
+func (c *KitchenOrderController) Export(ctx context.Context, filter dtos.KitchenOrderFilter, writer interfaces.IKitchenOrderExportWriter) error {
+	kitchenOrderUseCase := use_cases.NewExportKitchenOrdersUseCase(c.kitchenOrderGateway)
+
+	err := kitchenOrderUseCase.Execute(ctx, filter, func(kitchenOrder entities.KitchenOrder) error {
+		return writer.Write(presenter.ToExportRow(kitchenOrder))
+	})
+
+	if err != nil {
+		return err
 	}
 
-	return presenter.ToResponseList(kitchenOrders), nil
+	return writer.Close()
 }
 
-func (c *KitchenOrderController) FindByID(id string) (dtos.KitchenOrderResponseDTO, error) {
+func (c *KitchenOrderController) FindByID(ctx context.Context, id string) (dtos.KitchenOrderResponseDTO, error) {
 	kitchenOrderUseCase := use_cases.NewFindKitchenOrderByIDUseCase(c.kitchenOrderGateway)
 
-	kitchenOrder, err := kitchenOrderUseCase.Execute(id)
+	kitchenOrder, err := kitchenOrderUseCase.Execute(ctx, id)
 
 	if err != nil {
 		return dtos.KitchenOrderResponseDTO{}, err
@@ -67,10 +90,22 @@ func (c *KitchenOrderController) FindByID(id string) (dtos.KitchenOrderResponseD
 	return presenter.ToResponse(kitchenOrder), nil
 }
 
-func (c *KitchenOrderController) Update(kitchenOrderDTO dtos.UpdateKitchenOrderDTO) (dtos.KitchenOrderResponseDTO, error) {
-	kitchenOrderUseCase := use_cases.NewUpdateKitchenOrderUseCase(c.kitchenOrderGateway, c.orderStatusGateway, c.messageBroker)
+func (c *KitchenOrderController) FindTicket(ctx context.Context, id string) (dtos.KitchenOrderTicketDTO, error) {
+	kitchenOrderUseCase := use_cases.NewFindKitchenOrderByIDUseCase(c.kitchenOrderGateway)
 
-	kitchenOrder, err := kitchenOrderUseCase.Execute(kitchenOrderDTO)
+	kitchenOrder, err := kitchenOrderUseCase.Execute(ctx, id)
+
+	if err != nil {
+		return dtos.KitchenOrderTicketDTO{}, err
+	}
+
+	return presenter.ToTicket(kitchenOrder), nil
+}
+
+func (c *KitchenOrderController) Update(ctx context.Context, kitchenOrderDTO dtos.UpdateKitchenOrderDTO) (dtos.KitchenOrderResponseDTO, error) {
+	kitchenOrderUseCase := use_cases.NewUpdateKitchenOrderUseCase(c.kitchenOrderGateway, c.orderStatusGateway, c.messageBroker, c.webhookDispatcher)
+
+	kitchenOrder, err := kitchenOrderUseCase.Execute(ctx, kitchenOrderDTO)
 
 	if err != nil {
 		return dtos.KitchenOrderResponseDTO{}, err
@@ -78,3 +113,39 @@ func (c *KitchenOrderController) Update(kitchenOrderDTO dtos.UpdateKitchenOrderD
 
 	return presenter.ToResponse(kitchenOrder), nil
 }
+
+func (c *KitchenOrderController) ExecuteCommand(ctx context.Context, commandDTO dtos.KitchenOrderCommandDTO) (dtos.KitchenOrderResponseDTO, error) {
+	updateUseCase := use_cases.NewUpdateKitchenOrderUseCase(c.kitchenOrderGateway, c.orderStatusGateway, c.messageBroker, c.webhookDispatcher)
+	kitchenOrderUseCase := use_cases.NewExecuteKitchenOrderCommandUseCase(c.kitchenOrderGateway, updateUseCase)
+
+	kitchenOrder, err := kitchenOrderUseCase.Execute(ctx, commandDTO)
+
+	if err != nil {
+		return dtos.KitchenOrderResponseDTO{}, err
+	}
+
+	return presenter.ToResponse(kitchenOrder), nil
+}
+
+func (c *KitchenOrderController) BulkUpdateStatus(ctx context.Context, bulkDTO dtos.BulkUpdateKitchenOrderStatusDTO) ([]dtos.BulkUpdateKitchenOrderStatusItemDTO, error) {
+	updateUseCase := use_cases.NewUpdateKitchenOrderUseCase(c.kitchenOrderGateway, c.orderStatusGateway, c.messageBroker, c.webhookDispatcher)
+	kitchenOrderUseCase := use_cases.NewBulkUpdateKitchenOrderStatusUseCase(c.kitchenOrderGateway, c.orderStatusGateway, updateUseCase)
+
+	results, err := kitchenOrderUseCase.Execute(ctx, bulkDTO)
+
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]dtos.BulkUpdateKitchenOrderStatusItemDTO, len(results))
+	for i, result := range results {
+		items[i] = dtos.BulkUpdateKitchenOrderStatusItemDTO{ID: result.ID, Skipped: result.Skipped, Error: result.Err}
+
+		if result.Err == nil {
+			kitchenOrder := presenter.ToResponse(result.KitchenOrder)
+			items[i].KitchenOrder = &kitchenOrder
+		}
+	}
+
+	return items, nil
+}
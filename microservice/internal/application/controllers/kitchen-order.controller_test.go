@@ -17,16 +17,23 @@ type MockKitchenOrderDataSource struct {
 	kitchenOrders []daos.KitchenOrderDAO
 }
 
-func (m *MockKitchenOrderDataSource) Insert(kitchenOrder daos.KitchenOrderDAO) error {
+func (m *MockKitchenOrderDataSource) Insert(ctx context.Context, kitchenOrder daos.KitchenOrderDAO) error {
 	m.kitchenOrders = append(m.kitchenOrders, kitchenOrder)
 	return nil
 }
 
-func (m *MockKitchenOrderDataSource) FindAll(filter dtos.KitchenOrderFilter) ([]daos.KitchenOrderDAO, error) {
-	return m.kitchenOrders, nil
+func (m *MockKitchenOrderDataSource) FindAll(ctx context.Context, filter dtos.KitchenOrderFilter) ([]daos.KitchenOrderDAO, dtos.PageInfo, error) {
+	return m.kitchenOrders, dtos.PageInfo{Total: int64(len(m.kitchenOrders))}, nil
 }
 
-func (m *MockKitchenOrderDataSource) FindByID(id string) (daos.KitchenOrderDAO, error) {
+func (m *MockKitchenOrderDataSource) FindAllInBatches(ctx context.Context, filter dtos.KitchenOrderFilter, batchSize int, handle func([]daos.KitchenOrderDAO) error) error {
+	if len(m.kitchenOrders) == 0 {
+		return nil
+	}
+	return handle(m.kitchenOrders)
+}
+
+func (m *MockKitchenOrderDataSource) FindByID(ctx context.Context, id string) (daos.KitchenOrderDAO, error) {
 	for _, order := range m.kitchenOrders {
 		if order.ID == id {
 			return order, nil
@@ -35,7 +42,7 @@ func (m *MockKitchenOrderDataSource) FindByID(id string) (daos.KitchenOrderDAO,
 	return daos.KitchenOrderDAO{}, nil
 }
 
-func (m *MockKitchenOrderDataSource) Update(kitchenOrder daos.KitchenOrderDAO) error {
+func (m *MockKitchenOrderDataSource) Update(ctx context.Context, kitchenOrder daos.KitchenOrderDAO) error {
 	for i, order := range m.kitchenOrders {
 		if order.ID == kitchenOrder.ID {
 			m.kitchenOrders[i] = kitchenOrder
@@ -45,7 +52,11 @@ func (m *MockKitchenOrderDataSource) Update(kitchenOrder daos.KitchenOrderDAO) e
 	return nil
 }
 
-func (m *MockKitchenOrderDataSource) Delete(id string) error {
+func (m *MockKitchenOrderDataSource) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func (m *MockKitchenOrderDataSource) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
@@ -57,11 +68,11 @@ func (m *MockOrderStatusDataSource) Insert(orderStatus daos.OrderStatusDAO) erro
 	return nil
 }
 
-func (m *MockOrderStatusDataSource) FindAll() ([]daos.OrderStatusDAO, error) {
+func (m *MockOrderStatusDataSource) FindAll(ctx context.Context) ([]daos.OrderStatusDAO, error) {
 	return m.orderStatuses, nil
 }
 
-func (m *MockOrderStatusDataSource) FindByID(id string) (daos.OrderStatusDAO, error) {
+func (m *MockOrderStatusDataSource) FindByID(ctx context.Context, id string) (daos.OrderStatusDAO, error) {
 	for _, status := range m.orderStatuses {
 		if status.ID == id {
 			return status, nil
@@ -107,7 +118,7 @@ func createTestController() (*KitchenOrderController, *MockKitchenOrderDataSourc
 		},
 	}
 	mockMessageBroker := &MockMessageBroker{}
-	controller := NewKitchenOrderController(mockKitchenOrderDS, mockOrderStatusDS, mockMessageBroker)
+	controller := NewKitchenOrderController(mockKitchenOrderDS, mockOrderStatusDS, mockMessageBroker, nil, nil)
 	return controller, mockKitchenOrderDS, mockOrderStatusDS
 }
 
@@ -146,7 +157,7 @@ func TestKitchenOrderController_Create(t *testing.T) {
 		OrderID: "order-123",
 	}
 
-	result, err := controller.Create(createDTO)
+	result, err := controller.Create(context.Background(), createDTO)
 
 	if err != nil {
 		t.Fatalf("Expected no error, got %v", err)
@@ -165,16 +176,19 @@ func TestKitchenOrderController_FindAll(t *testing.T) {
 	testOrder := createTestKitchenOrder("550e8400-e29b-41d4-a716-446655440000")
 	mockKitchenOrderDS.kitchenOrders = []daos.KitchenOrderDAO{testOrder}
 
-	result, err := controller.FindAll(dtos.KitchenOrderFilter{})
+	result, err := controller.FindAll(context.Background(), dtos.KitchenOrderFilter{})
 
 	if err != nil {
 		t.Fatalf("Expected no error, got %v", err)
 	}
-	if len(result) != 1 {
-		t.Errorf("Expected 1 result, got %d", len(result))
+	if len(result.Items) != 1 {
+		t.Errorf("Expected 1 result, got %d", len(result.Items))
+	}
+	if result.Items[0].ID != testOrder.ID {
+		t.Errorf("Expected ID '%s', got %s", testOrder.ID, result.Items[0].ID)
 	}
-	if result[0].ID != testOrder.ID {
-		t.Errorf("Expected ID '%s', got %s", testOrder.ID, result[0].ID)
+	if result.Total != 1 || result.NextCursor != nil {
+		t.Errorf("Expected total 1 without next cursor, got %d / %v", result.Total, result.NextCursor)
 	}
 }
 
@@ -184,7 +198,7 @@ func TestKitchenOrderController_FindByID(t *testing.T) {
 	testOrder := createTestKitchenOrder("550e8400-e29b-41d4-a716-446655440000")
 	mockKitchenOrderDS.kitchenOrders = []daos.KitchenOrderDAO{testOrder}
 
-	result, err := controller.FindByID(testOrder.ID)
+	result, err := controller.FindByID(context.Background(), testOrder.ID)
 
 	if err != nil {
 		t.Fatalf("Expected no error, got %v", err)
@@ -208,7 +222,7 @@ func TestKitchenOrderController_Update(t *testing.T) {
 		StatusID: constants.KITCHEN_ORDER_STATUS_PREPARING_ID,
 	}
 
-	result, err := controller.Update(updateDTO)
+	result, err := controller.Update(context.Background(), updateDTO)
 
 	if err != nil {
 		t.Fatalf("Expected no error, got %v", err)
@@ -219,4 +233,25 @@ func TestKitchenOrderController_Update(t *testing.T) {
 	if result.Status.ID != constants.KITCHEN_ORDER_STATUS_PREPARING_ID {
 		t.Errorf("Expected Status ID %s, got %s", constants.KITCHEN_ORDER_STATUS_PREPARING_ID, result.Status.ID)
 	}
-}
\ No newline at end of file
+}
+func TestKitchenOrderController_ExecuteCommand(t *testing.T) {
+	internal.SetupTestEnv()
+	defer internal.CleanupTestEnv()
+
+	controller, mockKitchenOrderDS, _ := createTestController()
+
+	testOrder := createTestKitchenOrder("550e8400-e29b-41d4-a716-446655440000")
+	mockKitchenOrderDS.kitchenOrders = []daos.KitchenOrderDAO{testOrder}
+
+	result, err := controller.ExecuteCommand(context.Background(), dtos.KitchenOrderCommandDTO{
+		ID:      testOrder.ID,
+		Command: constants.KITCHEN_ORDER_COMMAND_START,
+	})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if result.Status.ID != constants.KITCHEN_ORDER_STATUS_PREPARING_ID {
+		t.Errorf("Expected Status ID %s, got %s", constants.KITCHEN_ORDER_STATUS_PREPARING_ID, result.Status.ID)
+	}
+}
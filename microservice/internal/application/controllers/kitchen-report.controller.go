@@ -0,0 +1,54 @@
+package controllers
+
+import (
+	"context"
+
+	"tech_challenge/internal/application/dtos"
+	"tech_challenge/internal/application/gateways"
+	"tech_challenge/internal/application/presenters"
+	"tech_challenge/internal/interfaces"
+	"tech_challenge/internal/use_cases"
+)
+
+type KitchenReportController struct {
+	kitchenReportGateway gateways.KitchenReportGateway
+}
+
+func NewKitchenReportController(kitchenReportDataSource interfaces.IKitchenReportDataSource) *KitchenReportController {
+	return &KitchenReportController{
+		kitchenReportGateway: *gateways.NewKitchenReportGateway(kitchenReportDataSource),
+	}
+}
+
+func (c *KitchenReportController) Throughput(ctx context.Context, filter dtos.KitchenReportFilter) (dtos.ThroughputReportDTO, error) {
+	useCase := use_cases.NewGetThroughputReportUseCase(c.kitchenReportGateway)
+
+	report, err := useCase.Execute(ctx, filter)
+	if err != nil {
+		return dtos.ThroughputReportDTO{}, err
+	}
+
+	return presenters.ToResponseThroughputReport(report), nil
+}
+
+func (c *KitchenReportController) PrepTimes(ctx context.Context, filter dtos.KitchenReportFilter) (dtos.PrepTimeReportDTO, error) {
+	useCase := use_cases.NewGetPrepTimesReportUseCase(c.kitchenReportGateway)
+
+	report, err := useCase.Execute(ctx, filter)
+	if err != nil {
+		return dtos.PrepTimeReportDTO{}, err
+	}
+
+	return presenters.ToResponsePrepTimeReport(report), nil
+}
+
+func (c *KitchenReportController) BoardStats(ctx context.Context) (dtos.KitchenBoardStatsDTO, error) {
+	useCase := use_cases.NewGetKitchenBoardStatsUseCase(c.kitchenReportGateway)
+
+	stats, err := useCase.Execute(ctx)
+	if err != nil {
+		return dtos.KitchenBoardStatsDTO{}, err
+	}
+
+	return presenters.ToResponseKitchenBoardStats(stats), nil
+}
@@ -1,6 +1,8 @@
 package controllers
 
 import (
+	"context"
+
 	"tech_challenge/internal/application/dtos"
 	"tech_challenge/internal/application/gateways"
 	"tech_challenge/internal/application/presenters"
@@ -20,10 +22,10 @@ func NewOrderStatusController(dataSource interfaces.IOrderStatusDataSource) *Ord
 	}
 }
 
-func (c *OrderStatusController) FindAll() ([]dtos.OrderStatusResponseDTO, error) {
+func (c *OrderStatusController) FindAll(ctx context.Context) ([]dtos.OrderStatusResponseDTO, error) {
 	orderStatusUseCase := use_cases.NewFindAllOrdersStatusUseCase(c.gateway)
 
-	orderStatus, err := orderStatusUseCase.Execute()
+	orderStatus, err := orderStatusUseCase.Execute(ctx)
 
 	if err != nil {
 		return nil, err
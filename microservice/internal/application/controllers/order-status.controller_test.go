@@ -1,6 +1,7 @@
 package controllers
 
 import (
+	"context"
 	"testing"
 
 	"tech_challenge/internal/daos"
@@ -38,7 +39,7 @@ func TestOrderStatusController_FindAll(t *testing.T) {
 
 	controller := NewOrderStatusController(mockOrderStatusDS)
 
-	result, err := controller.FindAll()
+	result, err := controller.FindAll(context.Background())
 
 	if err != nil {
 		t.Errorf("Expected no error, got %v", err)
@@ -64,7 +65,7 @@ func TestOrderStatusController_FindAll_Empty(t *testing.T) {
 
 	controller := NewOrderStatusController(mockOrderStatusDS)
 
-	result, err := controller.FindAll()
+	result, err := controller.FindAll(context.Background())
 
 	if err != nil {
 		t.Errorf("Expected no error, got %v", err)
@@ -0,0 +1,150 @@
+package controllers
+
+import (
+	"context"
+
+	"tech_challenge/internal/application/dtos"
+	"tech_challenge/internal/application/gateways"
+	"tech_challenge/internal/application/presenters"
+	"tech_challenge/internal/domain/entities"
+	"tech_challenge/internal/interfaces"
+	"tech_challenge/internal/use_cases"
+)
+
+type PrinterController struct {
+	printerGateway      gateways.PrinterGateway
+	printRouteGateway   gateways.PrintRouteGateway
+	printJobGateway     gateways.PrintJobGateway
+	kitchenOrderGateway gateways.KitchenOrderGateway
+	sender              interfaces.IPrinterSender
+	retryPolicy         dtos.PrintRetryPolicyDTO
+}
+
+func NewPrinterController(
+	printerDataSource interfaces.IPrinterDataSource,
+	printRouteDataSource interfaces.IPrintRouteDataSource,
+	printJobDataSource interfaces.IPrintJobDataSource,
+	kitchenOrderDataSource interfaces.IKitchenOrderDataSource,
+	sender interfaces.IPrinterSender,
+	retryPolicy dtos.PrintRetryPolicyDTO,
+) *PrinterController {
+	return &PrinterController{
+		printerGateway:      *gateways.NewPrinterGateway(printerDataSource),
+		printRouteGateway:   *gateways.NewPrintRouteGateway(printRouteDataSource),
+		printJobGateway:     *gateways.NewPrintJobGateway(printJobDataSource),
+		kitchenOrderGateway: *gateways.NewKitchenOrderGateway(kitchenOrderDataSource),
+		sender:              sender,
+		retryPolicy:         retryPolicy,
+	}
+}
+
+func (c *PrinterController) Create(printerDTO dtos.CreatePrinterDTO) (dtos.PrinterResponseDTO, error) {
+	useCase := use_cases.NewCreatePrinterUseCase(c.printerGateway)
+
+	printer, err := useCase.Execute(printerDTO)
+
+	if err != nil {
+		return dtos.PrinterResponseDTO{}, err
+	}
+
+	return presenters.ToResponsePrinter(printer), nil
+}
+
+func (c *PrinterController) FindAll() ([]dtos.PrinterResponseDTO, error) {
+	useCase := use_cases.NewFindAllPrintersUseCase(c.printerGateway)
+
+	printers, err := useCase.Execute()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenters.ToResponseListPrinter(printers), nil
+}
+
+func (c *PrinterController) FindByID(id string) (dtos.PrinterResponseDTO, error) {
+	useCase := use_cases.NewFindPrinterByIDUseCase(c.printerGateway)
+
+	printer, err := useCase.Execute(id)
+
+	if err != nil {
+		return dtos.PrinterResponseDTO{}, err
+	}
+
+	return presenters.ToResponsePrinter(printer), nil
+}
+
+func (c *PrinterController) Update(printerDTO dtos.UpdatePrinterDTO) (dtos.PrinterResponseDTO, error) {
+	useCase := use_cases.NewUpdatePrinterUseCase(c.printerGateway)
+
+	printer, err := useCase.Execute(printerDTO)
+
+	if err != nil {
+		return dtos.PrinterResponseDTO{}, err
+	}
+
+	return presenters.ToResponsePrinter(printer), nil
+}
+
+func (c *PrinterController) Delete(id string) error {
+	useCase := use_cases.NewDeletePrinterUseCase(c.printerGateway)
+
+	return useCase.Execute(id)
+}
+
+func (c *PrinterController) FindRoutes(ctx context.Context) ([]dtos.PrintRouteResponseDTO, error) {
+	useCase := use_cases.NewFindPrintRoutesUseCase(c.printRouteGateway)
+
+	routes, err := useCase.Execute(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenters.ToResponseListPrintRoute(routes), nil
+}
+
+func (c *PrinterController) ReplaceRoutes(routeDTOs []dtos.PrintRouteDTO) ([]dtos.PrintRouteResponseDTO, error) {
+	useCase := use_cases.NewReplacePrintRoutesUseCase(c.printRouteGateway)
+
+	routes, err := useCase.Execute(routeDTOs)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenters.ToResponseListPrintRoute(routes), nil
+}
+
+func (c *PrinterController) FindJobs(filter dtos.PrintJobFilter) ([]dtos.PrintJobResponseDTO, error) {
+	useCase := use_cases.NewFindPrintJobsUseCase(c.printJobGateway)
+
+	jobs, err := useCase.Execute(filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenters.ToResponseListPrintJob(jobs), nil
+}
+
+func (c *PrinterController) Enqueue(ctx context.Context, printDTO dtos.PrintKitchenOrderDTO) ([]dtos.PrintJobResponseDTO, error) {
+	useCase := use_cases.NewEnqueueKitchenOrderTicketsUseCase(c.kitchenOrderGateway, c.printerGateway, c.printRouteGateway, c.printJobGateway)
+
+	jobs, err := useCase.Execute(ctx, printDTO)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenters.ToResponseListPrintJob(jobs), nil
+}
+
+// ProcessDueJobs imprime os trabalhos vencidos da fila e devolve quantos foram processados
+func (c *PrinterController) ProcessDueJobs(ctx context.Context) (int, error) {
+	useCase := use_cases.NewProcessPrintJobsUseCase(c.printJobGateway, c.printerGateway, c.kitchenOrderGateway, c.retryPolicy)
+
+	return useCase.Execute(ctx, func(printer entities.Printer, kitchenOrder entities.KitchenOrder) error {
+		return c.sender.Print(presenters.ToPrinterTarget(printer), presenters.ToTicket(kitchenOrder))
+	})
+}
@@ -0,0 +1,120 @@
+package controllers
+
+import (
+	"context"
+
+	"tech_challenge/internal/application/dtos"
+	"tech_challenge/internal/application/gateways"
+	"tech_challenge/internal/application/presenters"
+	"tech_challenge/internal/interfaces"
+	"tech_challenge/internal/use_cases"
+)
+
+type WebhookController struct {
+	subscriptionGateway gateways.WebhookSubscriptionGateway
+	deliveryGateway     gateways.WebhookDeliveryGateway
+	outboxGateway       gateways.WebhookOutboxGateway
+	sender              interfaces.IWebhookSender
+	urlValidator        interfaces.IWebhookURLValidator
+	retryPolicy         dtos.WebhookRetryPolicyDTO
+}
+
+func NewWebhookController(
+	subscriptionDataSource interfaces.IWebhookSubscriptionDataSource,
+	deliveryDataSource interfaces.IWebhookDeliveryDataSource,
+	outboxDataSource interfaces.IWebhookOutboxDataSource,
+	sender interfaces.IWebhookSender,
+	urlValidator interfaces.IWebhookURLValidator,
+	retryPolicy dtos.WebhookRetryPolicyDTO,
+) *WebhookController {
+	return &WebhookController{
+		subscriptionGateway: *gateways.NewWebhookSubscriptionGateway(subscriptionDataSource),
+		deliveryGateway:     *gateways.NewWebhookDeliveryGateway(deliveryDataSource),
+		outboxGateway:       *gateways.NewWebhookOutboxGateway(outboxDataSource),
+		sender:              sender,
+		urlValidator:        urlValidator,
+		retryPolicy:         retryPolicy,
+	}
+}
+
+func (c *WebhookController) Create(subscriptionDTO dtos.CreateWebhookSubscriptionDTO) (dtos.WebhookSubscriptionResponseDTO, error) {
+	useCase := use_cases.NewCreateWebhookSubscriptionUseCase(c.subscriptionGateway, c.urlValidator)
+
+	subscription, err := useCase.Execute(subscriptionDTO)
+
+	if err != nil {
+		return dtos.WebhookSubscriptionResponseDTO{}, err
+	}
+
+	return presenters.ToResponseCreatedWebhookSubscription(subscription), nil
+}
+
+func (c *WebhookController) FindAll() ([]dtos.WebhookSubscriptionResponseDTO, error) {
+	useCase := use_cases.NewFindAllWebhookSubscriptionsUseCase(c.subscriptionGateway)
+
+	subscriptions, err := useCase.Execute()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenters.ToResponseListWebhookSubscription(subscriptions), nil
+}
+
+func (c *WebhookController) FindByID(id string) (dtos.WebhookSubscriptionResponseDTO, error) {
+	useCase := use_cases.NewFindWebhookSubscriptionByIDUseCase(c.subscriptionGateway)
+
+	subscription, err := useCase.Execute(id)
+
+	if err != nil {
+		return dtos.WebhookSubscriptionResponseDTO{}, err
+	}
+
+	return presenters.ToResponseWebhookSubscription(subscription), nil
+}
+
+func (c *WebhookController) Update(subscriptionDTO dtos.UpdateWebhookSubscriptionDTO) (dtos.WebhookSubscriptionResponseDTO, error) {
+	useCase := use_cases.NewUpdateWebhookSubscriptionUseCase(c.subscriptionGateway, c.urlValidator)
+
+	subscription, err := useCase.Execute(subscriptionDTO)
+
+	if err != nil {
+		return dtos.WebhookSubscriptionResponseDTO{}, err
+	}
+
+	return presenters.ToResponseWebhookSubscription(subscription), nil
+}
+
+func (c *WebhookController) Delete(id string) error {
+	useCase := use_cases.NewDeleteWebhookSubscriptionUseCase(c.subscriptionGateway)
+
+	return useCase.Execute(id)
+}
+
+func (c *WebhookController) FindDeliveries(subscriptionID string) ([]dtos.WebhookDeliveryResponseDTO, error) {
+	useCase := use_cases.NewFindWebhookDeliveriesUseCase(c.subscriptionGateway, c.deliveryGateway)
+
+	deliveries, err := useCase.Execute(subscriptionID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return presenters.ToResponseListWebhookDelivery(deliveries), nil
+}
+
+// Enqueue grava as entregas do evento e devolve quantas foram criadas
+func (c *WebhookController) Enqueue(ctx context.Context, event dtos.WebhookEventDTO) (int, error) {
+	useCase := use_cases.NewEnqueueWebhookEventUseCase(c.subscriptionGateway, c.outboxGateway)
+
+	entries, err := useCase.Execute(ctx, event)
+
+	return len(entries), err
+}
+
+// ProcessDueDeliveries envia as entregas vencidas da fila e devolve quantas foram processadas
+func (c *WebhookController) ProcessDueDeliveries(ctx context.Context) (int, error) {
+	useCase := use_cases.NewProcessWebhookOutboxUseCase(c.subscriptionGateway, c.deliveryGateway, c.outboxGateway, c.sender, c.retryPolicy)
+
+	return useCase.Execute(ctx)
+}
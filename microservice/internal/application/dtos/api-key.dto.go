@@ -0,0 +1,21 @@
+package dtos
+
+import "time"
+
+type CreateAPIKeyDTO struct {
+	Name      string
+	Scopes    []string
+	CreatedBy *string
+}
+
+type APIKeyResponseDTO struct {
+	ID         string
+	Name       string
+	Prefix     string
+	Key        string
+	Scopes     []string
+	CreatedBy  *string
+	CreatedAt  time.Time
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+}
@@ -0,0 +1,13 @@
+package dtos
+
+import "time"
+
+type DisplayBoardEntryDTO struct {
+	Slug  string
+	Since time.Time
+}
+
+type DisplayBoardDTO struct {
+	Preparing []DisplayBoardEntryDTO
+	Ready     []DisplayBoardEntryDTO
+}
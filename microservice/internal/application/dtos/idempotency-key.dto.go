@@ -0,0 +1,13 @@
+package dtos
+
+type IdempotencyKeyDTO struct {
+	Scope       string
+	Key         string
+	Fingerprint string
+}
+
+// IdempotencyKeyReservationDTO indica se a requisição deve ser executada ou se já existe um recurso criado com a mesma chave
+type IdempotencyKeyReservationDTO struct {
+	Replayed   bool
+	ResourceID string
+}
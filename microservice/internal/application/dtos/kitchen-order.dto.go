@@ -21,25 +21,121 @@ type OrderItemDTO struct {
 }
 
 type CreateKitchenOrderDTO struct {
-	OrderID string
+	OrderID    string
+	CustomerID *string
+	Items      []CreateOrderItemDTO
+}
+
+type CreateOrderItemDTO struct {
+	ProductID string
+	Quantity  int
+	UnitPrice float64
 }
 
 type UpdateKitchenOrderDTO struct {
-	ID       string
-	StatusID string
+	ID              string
+	StatusID        string
+	Reason          *string
+	ChangedBy       *string
+	ExpectedVersion *uint64
+}
+
+type BulkUpdateKitchenOrderStatusDTO struct {
+	IDs       []string
+	StatusID  string
+	ChangedBy *string
+}
+
+type BulkUpdateKitchenOrderStatusItemDTO struct {
+	ID           string
+	KitchenOrder *KitchenOrderResponseDTO
+	Skipped      bool
+	Error        error
+}
+
+type KitchenOrderCommandDTO struct {
+	ID              string
+	Command         string
+	Reason          string
+	ChangedBy       *string
+	ExpectedVersion *uint64
 }
 
 type KitchenOrderFilter struct {
-	CreatedAtFrom *time.Time
-	CreatedAtTo   *time.Time
-	StatusID      *uint
+	CreatedAtFrom   *time.Time
+	CreatedAtTo     *time.Time
+	StatusIDs       []string
+	OrderID         *string
+	Slug            *string
+	CustomerID      *string
+	IncludeFinished bool
+	Sort            string
+	Limit           int
+	Cursor          string
+}
+
+type PageInfo struct {
+	NextCursor *string
+	Total      int64
 }
 
 type KitchenOrderResponseDTO struct {
-	ID        string
-	OrderID   string
-	Slug      string
-	Status    OrderStatusDTO
-	CreatedAt time.Time
-	UpdatedAt *time.Time
+	ID           string
+	OrderID      string
+	Slug         string
+	Status       OrderStatusDTO
+	Version      uint64
+	StatusReason    *string
+	StatusChangedBy *string
+	CreatedAt       time.Time
+	UpdatedAt       *time.Time
+}
+
+type KitchenOrderListResponseDTO struct {
+	Items      []KitchenOrderResponseDTO
+	NextCursor *string
+	Total      int64
+}
+
+type KitchenOrderExportDTO struct {
+	ID                  string
+	OrderID             string
+	CustomerID          *string
+	Slug                string
+	Status              OrderStatusDTO
+	Amount              float64
+	Items               []OrderItemDTO
+	StatusReason        *string
+	CreatedAt           time.Time
+	PreparingAt         *time.Time
+	ReadyAt             *time.Time
+	FinishedAt          *time.Time
+	WaitingDuration     *time.Duration
+	PreparationDuration *time.Duration
+	TotalDuration       *time.Duration
+}
+
+type KitchenOrderTicketDTO struct {
+	ID          string
+	OrderID     string
+	Slug        string
+	Status      OrderStatusDTO
+	Items       []OrderItemDTO
+	Notes       *string
+	CreatedAt   time.Time
+	PreparingAt *time.Time
+	ReadyAt     *time.Time
+	FinishedAt  *time.Time
+}
+
+type KitchenOrderEventDTO struct {
+	ID             uint64
+	Type           string
+	KitchenOrderID string
+	OrderID        string
+	Slug           string
+	Status         OrderStatusDTO
+	Reason         *string
+	Actor          *string
+	OccurredAt     time.Time
 }
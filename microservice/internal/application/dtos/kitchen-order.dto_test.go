@@ -75,12 +75,12 @@ func TestKitchenOrderFilter_Structure(t *testing.T) {
 	now := time.Now()
 	from := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
 	to := now
-	statusID := uint(1)
+	statusID := "3f9a1c98-7b2f-4f3b-8a96-c0b7c761a123"
 
 	filter := KitchenOrderFilter{
 		CreatedAtFrom: &from,
 		CreatedAtTo:   &to,
-		StatusID:      &statusID,
+		StatusIDs:     []string{statusID},
 	}
 
 	// Assert
@@ -92,12 +92,12 @@ func TestKitchenOrderFilter_Structure(t *testing.T) {
 		t.Error("Expected CreatedAtTo to be set, got nil")
 	}
 
-	if filter.StatusID == nil {
-		t.Error("Expected StatusID to be set, got nil")
+	if len(filter.StatusIDs) != 1 {
+		t.Fatalf("Expected 1 StatusID, got %d", len(filter.StatusIDs))
 	}
 
-	if *filter.StatusID != statusID {
-		t.Errorf("Expected StatusID %d, got %d", statusID, *filter.StatusID)
+	if filter.StatusIDs[0] != statusID {
+		t.Errorf("Expected StatusID %s, got %s", statusID, filter.StatusIDs[0])
 	}
 }
 
@@ -106,7 +106,7 @@ func TestKitchenOrderFilter_NilValues(t *testing.T) {
 	filter := KitchenOrderFilter{
 		CreatedAtFrom: nil,
 		CreatedAtTo:   nil,
-		StatusID:      nil,
+		StatusIDs:     nil,
 	}
 
 	// Assert
@@ -118,8 +118,12 @@ func TestKitchenOrderFilter_NilValues(t *testing.T) {
 		t.Error("Expected CreatedAtTo to be nil, got value")
 	}
 
-	if filter.StatusID != nil {
-		t.Error("Expected StatusID to be nil, got value")
+	if filter.StatusIDs != nil {
+		t.Error("Expected StatusIDs to be nil, got value")
+	}
+
+	if filter.IncludeFinished {
+		t.Error("Expected IncludeFinished to default to false")
 	}
 }
 
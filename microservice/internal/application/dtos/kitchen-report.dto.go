@@ -0,0 +1,70 @@
+package dtos
+
+import "time"
+
+type KitchenReportFilter struct {
+	From    time.Time
+	To      time.Time
+	GroupBy string
+	// ProductsLimit limita a lista de produtos mais lentos
+	ProductsLimit int
+}
+
+type ThroughputBucketDTO struct {
+	PeriodStart     time.Time
+	Received        int
+	Finished        int
+	ReceivedPerHour float64
+	FinishedPerHour float64
+}
+
+type ThroughputReportDTO struct {
+	From            time.Time
+	To              time.Time
+	GroupBy         string
+	Received        int
+	Finished        int
+	ReceivedPerHour float64
+	FinishedPerHour float64
+	Buckets         []ThroughputBucketDTO
+}
+
+type StageDurationDTO struct {
+	StatusID       string
+	StatusName     string
+	Orders         int
+	AverageSeconds float64
+	P90Seconds     float64
+}
+
+type PrepTimeBucketDTO struct {
+	PeriodStart time.Time
+	Stages      []StageDurationDTO
+}
+
+type ProductPrepTimeDTO struct {
+	ProductID      string
+	Orders         int
+	AverageSeconds float64
+	P90Seconds     float64
+}
+
+type PrepTimeReportDTO struct {
+	From            time.Time
+	To              time.Time
+	GroupBy         string
+	Stages          []StageDurationDTO
+	Buckets         []PrepTimeBucketDTO
+	SlowestProducts []ProductPrepTimeDTO
+}
+
+type BoardStatusCountDTO struct {
+	StatusID   string
+	StatusName string
+	Orders     int
+}
+
+type KitchenBoardStatsDTO struct {
+	Statuses                 []BoardStatusCountDTO
+	OldestReceivedAgeSeconds float64
+}
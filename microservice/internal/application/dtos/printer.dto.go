@@ -0,0 +1,90 @@
+package dtos
+
+import "time"
+
+type CreatePrinterDTO struct {
+	Name    string
+	Host    string
+	Port    int
+	Station string
+	Width   int
+}
+
+type UpdatePrinterDTO struct {
+	ID      string
+	Name    string
+	Host    string
+	Port    int
+	Station string
+	Width   int
+	Active  bool
+}
+
+type PrinterResponseDTO struct {
+	ID        string
+	Name      string
+	Host      string
+	Port      int
+	Station   string
+	Width     int
+	Active    bool
+	CreatedAt time.Time
+	UpdatedAt *time.Time
+}
+
+type PrintRouteDTO struct {
+	ProductPattern string
+	Station        string
+}
+
+type PrintRouteResponseDTO struct {
+	ID             string
+	Position       int
+	ProductPattern string
+	Station        string
+}
+
+type PrintKitchenOrderDTO struct {
+	KitchenOrderID string
+	// PrinterID envia a comanda a uma única impressora; vazio distribui os itens pelas praças
+	PrinterID   string
+	Reprint     bool
+	RequestedBy *string
+}
+
+type PrintJobFilter struct {
+	KitchenOrderID string
+	PrinterID      string
+	Status         string
+	Limit          int
+}
+
+type PrintJobResponseDTO struct {
+	ID             string
+	KitchenOrderID string
+	PrinterID      string
+	Station        string
+	ItemIDs        []string
+	Status         string
+	Attempts       int
+	LastError      string
+	NextAttemptAt  time.Time
+	PrintedAt      *time.Time
+	Reprint        bool
+	RequestedBy    *string
+	CreatedAt      time.Time
+	UpdatedAt      *time.Time
+}
+
+// PrinterTargetDTO traz o necessário para enviar uma comanda a uma impressora de rede
+type PrinterTargetDTO struct {
+	ID      string
+	Address string
+	Width   int
+}
+
+type PrintRetryPolicyDTO struct {
+	MaxAttempts int
+	BaseDelay   time.Duration
+	Lease       time.Duration
+}
@@ -0,0 +1,62 @@
+package dtos
+
+import "time"
+
+type CreateWebhookSubscriptionDTO struct {
+	URL    string
+	Secret string
+	Events []string
+}
+
+type UpdateWebhookSubscriptionDTO struct {
+	ID     string
+	URL    string
+	Events []string
+	Active bool
+}
+
+type WebhookSubscriptionResponseDTO struct {
+	ID                  string
+	URL                 string
+	Secret              string
+	Events              []string
+	Active              bool
+	ConsecutiveFailures int
+	CreatedAt           time.Time
+	UpdatedAt           *time.Time
+}
+
+type WebhookDeliveryResponseDTO struct {
+	ID             string
+	SubscriptionID string
+	EventID        string
+	Event          string
+	Attempt        int
+	StatusCode     int
+	Success        bool
+	Error          string
+	DurationMs     int64
+	CreatedAt      time.Time
+}
+
+type WebhookEventDTO struct {
+	ID         string
+	Event      string
+	OccurredAt time.Time
+	Data       WebhookKitchenOrderDataDTO
+}
+
+type WebhookKitchenOrderDataDTO struct {
+	ID       string
+	OrderID  string
+	Slug     string
+	StatusID string
+	Status   string
+}
+
+type WebhookRetryPolicyDTO struct {
+	MaxAttempts          int
+	BaseDelay            time.Duration
+	DisableAfterFailures int
+	Lease                time.Duration
+}
@@ -0,0 +1,102 @@
+package gateways
+
+import (
+	"time"
+
+	"tech_challenge/internal/daos"
+	"tech_challenge/internal/domain/entities"
+	"tech_challenge/internal/interfaces"
+)
+
+type APIKeyGateway struct {
+	dataSource interfaces.IAPIKeyDataSource
+}
+
+func NewAPIKeyGateway(dataSource interfaces.IAPIKeyDataSource) *APIKeyGateway {
+	return &APIKeyGateway{
+		dataSource: dataSource,
+	}
+}
+
+func (g *APIKeyGateway) Insert(apiKey entities.APIKey) error {
+	return g.dataSource.Insert(toAPIKeyDAO(apiKey))
+}
+
+func (g *APIKeyGateway) FindByID(id string) (entities.APIKey, error) {
+	apiKeyDAO, err := g.dataSource.FindByID(id)
+	if err != nil {
+		return entities.APIKey{}, err
+	}
+
+	return toAPIKeyEntity(apiKeyDAO)
+}
+
+func (g *APIKeyGateway) FindByPrefix(prefix string) (entities.APIKey, error) {
+	apiKeyDAO, err := g.dataSource.FindByPrefix(prefix)
+	if err != nil {
+		return entities.APIKey{}, err
+	}
+
+	return toAPIKeyEntity(apiKeyDAO)
+}
+
+func (g *APIKeyGateway) FindAll() ([]entities.APIKey, error) {
+	apiKeyDAOs, err := g.dataSource.FindAll()
+	if err != nil {
+		return nil, err
+	}
+
+	apiKeys := make([]entities.APIKey, 0, len(apiKeyDAOs))
+
+	for _, apiKeyDAO := range apiKeyDAOs {
+		apiKey, err := toAPIKeyEntity(apiKeyDAO)
+		if err != nil {
+			return nil, err
+		}
+
+		apiKeys = append(apiKeys, apiKey)
+	}
+
+	return apiKeys, nil
+}
+
+func (g *APIKeyGateway) Revoke(id string, revokedAt time.Time) error {
+	return g.dataSource.Revoke(id, revokedAt)
+}
+
+func (g *APIKeyGateway) TouchLastUsed(id string, usedAt time.Time) error {
+	return g.dataSource.TouchLastUsed(id, usedAt)
+}
+
+func toAPIKeyDAO(apiKey entities.APIKey) daos.APIKeyDAO {
+	return daos.APIKeyDAO{
+		ID:         apiKey.ID,
+		Name:       apiKey.Name,
+		Prefix:     apiKey.Prefix,
+		KeyHash:    apiKey.KeyHash,
+		Scopes:     apiKey.Scopes,
+		CreatedBy:  apiKey.CreatedBy,
+		CreatedAt:  apiKey.CreatedAt,
+		LastUsedAt: apiKey.LastUsedAt,
+		RevokedAt:  apiKey.RevokedAt,
+	}
+}
+
+func toAPIKeyEntity(apiKeyDAO daos.APIKeyDAO) (entities.APIKey, error) {
+	apiKey, err := entities.NewAPIKey(
+		apiKeyDAO.ID,
+		apiKeyDAO.Name,
+		apiKeyDAO.Prefix,
+		apiKeyDAO.KeyHash,
+		apiKeyDAO.Scopes,
+		apiKeyDAO.CreatedBy,
+		apiKeyDAO.CreatedAt,
+		apiKeyDAO.LastUsedAt,
+		apiKeyDAO.RevokedAt,
+	)
+	if err != nil {
+		return entities.APIKey{}, err
+	}
+
+	return *apiKey, nil
+}
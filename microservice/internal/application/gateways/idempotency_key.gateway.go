@@ -0,0 +1,73 @@
+package gateways
+
+import (
+	"time"
+
+	"tech_challenge/internal/daos"
+	"tech_challenge/internal/domain/entities"
+	"tech_challenge/internal/interfaces"
+)
+
+type IdempotencyKeyGateway struct {
+	dataSource interfaces.IIdempotencyKeyDataSource
+}
+
+func NewIdempotencyKeyGateway(dataSource interfaces.IIdempotencyKeyDataSource) *IdempotencyKeyGateway {
+	return &IdempotencyKeyGateway{
+		dataSource: dataSource,
+	}
+}
+
+func (g *IdempotencyKeyGateway) toDAO(idempotencyKey entities.IdempotencyKey) daos.IdempotencyKeyDAO {
+	return daos.IdempotencyKeyDAO{
+		Scope:       idempotencyKey.Scope,
+		Key:         idempotencyKey.Key,
+		Fingerprint: idempotencyKey.Fingerprint,
+		ResourceID:  idempotencyKey.ResourceID,
+		CreatedAt:   idempotencyKey.CreatedAt,
+	}
+}
+
+func (g *IdempotencyKeyGateway) Reserve(idempotencyKey entities.IdempotencyKey) (bool, error) {
+	return g.dataSource.Reserve(g.toDAO(idempotencyKey))
+}
+
+func (g *IdempotencyKeyGateway) Reclaim(idempotencyKey entities.IdempotencyKey, staleBefore time.Time) (bool, error) {
+	return g.dataSource.Reclaim(g.toDAO(idempotencyKey), staleBefore)
+}
+
+func (g *IdempotencyKeyGateway) ReclaimExpired(idempotencyKey entities.IdempotencyKey, expiredBefore time.Time) (bool, error) {
+	return g.dataSource.ReclaimExpired(g.toDAO(idempotencyKey), expiredBefore)
+}
+
+func (g *IdempotencyKeyGateway) FindByKey(scope, key string) (entities.IdempotencyKey, error) {
+	idempotencyKeyDAO, err := g.dataSource.FindByKey(scope, key)
+	if err != nil {
+		return entities.IdempotencyKey{}, err
+	}
+
+	idempotencyKey, err := entities.NewIdempotencyKey(
+		idempotencyKeyDAO.Scope,
+		idempotencyKeyDAO.Key,
+		idempotencyKeyDAO.Fingerprint,
+		idempotencyKeyDAO.ResourceID,
+		idempotencyKeyDAO.CreatedAt,
+	)
+	if err != nil {
+		return entities.IdempotencyKey{}, err
+	}
+
+	return *idempotencyKey, nil
+}
+
+func (g *IdempotencyKeyGateway) Complete(scope, key, resourceID string) error {
+	return g.dataSource.Complete(scope, key, resourceID)
+}
+
+func (g *IdempotencyKeyGateway) Release(scope, key string) error {
+	return g.dataSource.Release(scope, key)
+}
+
+func (g *IdempotencyKeyGateway) DeleteOlderThan(before time.Time) (int64, error) {
+	return g.dataSource.DeleteOlderThan(before)
+}
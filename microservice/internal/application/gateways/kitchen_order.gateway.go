@@ -1,6 +1,8 @@
 package gateways
 
 import (
+	"context"
+
 	"tech_challenge/internal/application/dtos"
 	"tech_challenge/internal/daos"
 	"tech_challenge/internal/domain/entities"
@@ -17,7 +19,7 @@ func NewKitchenOrderGateway(dataSource interfaces.IKitchenOrderDataSource) *Kitc
 	}
 }
 
-func (g *KitchenOrderGateway) Insert(order entities.KitchenOrder) error {
+func (g *KitchenOrderGateway) Insert(ctx context.Context, order entities.KitchenOrder) error {
 
 	status := daos.OrderStatusDAO{
 		ID:   order.Status.ID,
@@ -35,25 +37,71 @@ func (g *KitchenOrderGateway) Insert(order entities.KitchenOrder) error {
 		}
 	}
 
-	return g.dataSource.Insert(daos.KitchenOrderDAO{
-		ID:         order.ID,
-		OrderID:    order.OrderID,
-		CustomerID: order.CustomerID,
-		Amount:     order.Amount,
-		Slug:       order.Slug.Value(),
-		Status:     status,
-		Items:      items,
-		CreatedAt:  order.CreatedAt,
-		UpdatedAt:  order.UpdatedAt,
+	return g.dataSource.Insert(ctx, daos.KitchenOrderDAO{
+		ID:              order.ID,
+		OrderID:         order.OrderID,
+		CustomerID:      order.CustomerID,
+		Amount:          order.Amount,
+		Slug:            order.Slug.Value(),
+		Status:          status,
+		Items:           items,
+		Version:         order.Version,
+		StatusReason:    order.StatusReason,
+		StatusChangedBy: order.StatusChangedBy,
+		PreparingAt:     order.PreparingAt,
+		ReadyAt:         order.ReadyAt,
+		FinishedAt:      order.FinishedAt,
+		CreatedAt:       order.CreatedAt,
+		UpdatedAt:       order.UpdatedAt,
 	})
 }
 
-func (g *KitchenOrderGateway) FindByID(id string) (entities.KitchenOrder, error) {
-	orderDAO, err := g.dataSource.FindByID(id)
+func (g *KitchenOrderGateway) FindByID(ctx context.Context, id string) (entities.KitchenOrder, error) {
+	orderDAO, err := g.dataSource.FindByID(ctx, id)
 	if err != nil {
 		return entities.KitchenOrder{}, err
 	}
 
+	return toKitchenOrderEntity(orderDAO)
+}
+
+func (g *KitchenOrderGateway) FindAll(ctx context.Context, filter dtos.KitchenOrderFilter) ([]entities.KitchenOrder, dtos.PageInfo, error) {
+	orderDAOs, pageInfo, err := g.dataSource.FindAll(ctx, filter)
+	if err != nil {
+		return nil, dtos.PageInfo{}, err
+	}
+
+	orders := make([]entities.KitchenOrder, 0, len(orderDAOs))
+	for _, orderDAO := range orderDAOs {
+		order, err := toKitchenOrderEntity(orderDAO)
+		if err != nil {
+			return nil, dtos.PageInfo{}, err
+		}
+
+		orders = append(orders, order)
+	}
+
+	return orders, pageInfo, nil
+}
+
+// FindAllInBatches entrega os pedidos do filtro em lotes, para exportações que não cabem em uma página
+func (g *KitchenOrderGateway) FindAllInBatches(ctx context.Context, filter dtos.KitchenOrderFilter, batchSize int, handle func([]entities.KitchenOrder) error) error {
+	return g.dataSource.FindAllInBatches(ctx, filter, batchSize, func(orderDAOs []daos.KitchenOrderDAO) error {
+		orders := make([]entities.KitchenOrder, 0, len(orderDAOs))
+		for _, orderDAO := range orderDAOs {
+			order, err := toKitchenOrderEntity(orderDAO)
+			if err != nil {
+				return err
+			}
+
+			orders = append(orders, order)
+		}
+
+		return handle(orders)
+	})
+}
+
+func toKitchenOrderEntity(orderDAO daos.KitchenOrderDAO) (entities.KitchenOrder, error) {
 	status, err := entities.NewOrderStatus(
 		orderDAO.Status.ID,
 		orderDAO.Status.Name,
@@ -91,65 +139,18 @@ func (g *KitchenOrderGateway) FindByID(id string) (entities.KitchenOrder, error)
 	if err != nil {
 		return entities.KitchenOrder{}, err
 	}
+	order.Version = orderDAO.Version
+	order.StatusReason = orderDAO.StatusReason
+	order.StatusChangedBy = orderDAO.StatusChangedBy
+	order.PreparingAt = orderDAO.PreparingAt
+	order.ReadyAt = orderDAO.ReadyAt
+	order.FinishedAt = orderDAO.FinishedAt
 
 	return *order, nil
 }
 
-func (g *KitchenOrderGateway) FindAll(filter dtos.KitchenOrderFilter) ([]entities.KitchenOrder, error) {
-	orderDAOs, err := g.dataSource.FindAll(filter)
-	if err != nil {
-		return nil, err
-	}
-
-	orders := make([]entities.KitchenOrder, 0, len(orderDAOs))
-	for _, orderDAO := range orderDAOs {
-
-		status, err := entities.NewOrderStatus(
-			orderDAO.Status.ID,
-			orderDAO.Status.Name,
-		)
-		if err != nil {
-			return nil, err
-		}
-
-		items := make([]entities.OrderItem, len(orderDAO.Items))
-		for i, itemDAO := range orderDAO.Items {
-			item, err := entities.NewOrderItem(
-				itemDAO.ID,
-				itemDAO.OrderID,
-				itemDAO.ProductID,
-				itemDAO.Quantity,
-				itemDAO.UnitPrice,
-			)
-			if err != nil {
-				return nil, err
-			}
-			items[i] = *item
-		}
-
-		order, err := entities.NewKitchenOrderWithOrderData(
-			orderDAO.ID,
-			orderDAO.OrderID,
-			orderDAO.Slug,
-			orderDAO.CustomerID,
-			orderDAO.Amount,
-			items,
-			*status,
-			orderDAO.CreatedAt,
-			orderDAO.UpdatedAt,
-		)
-		if err != nil {
-			return nil, err
-		}
-
-		orders = append(orders, *order)
-	}
-
-	return orders, nil
-}
-
-func (g *KitchenOrderGateway) Update(kitchenOrder entities.KitchenOrder) error {
-	return g.dataSource.Update(daos.KitchenOrderDAO{
+func (g *KitchenOrderGateway) Update(ctx context.Context, kitchenOrder entities.KitchenOrder) error {
+	return g.dataSource.Update(ctx, daos.KitchenOrderDAO{
 		ID:      kitchenOrder.ID,
 		OrderID: kitchenOrder.OrderID,
 		Slug:    kitchenOrder.Slug.Value(),
@@ -157,7 +158,15 @@ func (g *KitchenOrderGateway) Update(kitchenOrder entities.KitchenOrder) error {
 			ID:   kitchenOrder.Status.ID,
 			Name: kitchenOrder.Status.Name.Value(),
 		},
-		CreatedAt: kitchenOrder.CreatedAt,
-		UpdatedAt: kitchenOrder.UpdatedAt,
+		Version:         kitchenOrder.Version,
+		StatusReason:    kitchenOrder.StatusReason,
+		StatusChangedBy: kitchenOrder.StatusChangedBy,
+		CreatedAt:       kitchenOrder.CreatedAt,
+		UpdatedAt:       kitchenOrder.UpdatedAt,
 	})
 }
+
+// Transaction agrupa a gravação do pedido com as entregas que dependem dela: ou todas são confirmadas, ou nenhuma
+func (g *KitchenOrderGateway) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return g.dataSource.Transaction(ctx, fn)
+}
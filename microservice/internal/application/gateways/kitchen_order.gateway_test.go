@@ -1,6 +1,7 @@
 package gateways
 
 import (
+	"context"
 	"errors"
 	"testing"
 	"time"
@@ -15,38 +16,56 @@ import (
 type MockKitchenOrderDataSource struct {
 	insertFunc   func(daos.KitchenOrderDAO) error
 	findByIDFunc func(string) (daos.KitchenOrderDAO, error)
-	findAllFunc  func(dtos.KitchenOrderFilter) ([]daos.KitchenOrderDAO, error)
+	findAllFunc  func(dtos.KitchenOrderFilter) ([]daos.KitchenOrderDAO, dtos.PageInfo, error)
 	updateFunc   func(daos.KitchenOrderDAO) error
 }
 
-func (m *MockKitchenOrderDataSource) Insert(order daos.KitchenOrderDAO) error {
+func (m *MockKitchenOrderDataSource) Insert(ctx context.Context, order daos.KitchenOrderDAO) error {
 	if m.insertFunc != nil {
 		return m.insertFunc(order)
 	}
 	return nil
 }
 
-func (m *MockKitchenOrderDataSource) FindByID(id string) (daos.KitchenOrderDAO, error) {
+func (m *MockKitchenOrderDataSource) FindByID(ctx context.Context, id string) (daos.KitchenOrderDAO, error) {
 	if m.findByIDFunc != nil {
 		return m.findByIDFunc(id)
 	}
 	return daos.KitchenOrderDAO{}, nil
 }
 
-func (m *MockKitchenOrderDataSource) FindAll(filter dtos.KitchenOrderFilter) ([]daos.KitchenOrderDAO, error) {
+func (m *MockKitchenOrderDataSource) FindAll(ctx context.Context, filter dtos.KitchenOrderFilter) ([]daos.KitchenOrderDAO, dtos.PageInfo, error) {
 	if m.findAllFunc != nil {
 		return m.findAllFunc(filter)
 	}
-	return []daos.KitchenOrderDAO{}, nil
+	return []daos.KitchenOrderDAO{}, dtos.PageInfo{}, nil
 }
 
-func (m *MockKitchenOrderDataSource) Update(order daos.KitchenOrderDAO) error {
+func (m *MockKitchenOrderDataSource) FindAllInBatches(ctx context.Context, filter dtos.KitchenOrderFilter, batchSize int, handle func([]daos.KitchenOrderDAO) error) error {
+	orders, _, err := m.FindAll(ctx, filter)
+	if err != nil {
+		return err
+	}
+
+	for start := 0; start < len(orders); start += batchSize {
+		if err := handle(orders[start:min(start+batchSize, len(orders))]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *MockKitchenOrderDataSource) Update(ctx context.Context, order daos.KitchenOrderDAO) error {
 	if m.updateFunc != nil {
 		return m.updateFunc(order)
 	}
 	return nil
 }
 
+func (m *MockKitchenOrderDataSource) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
 func makeOrderItemDAO(orderID string) daos.OrderItemDAO {
 	return daos.OrderItemDAO{
 		ID:        "item-1",
@@ -96,7 +115,7 @@ func TestKitchenOrderGateway_Insert_Success(t *testing.T) {
 		nil,
 	)
 
-	err := gateway.Insert(*order)
+	err := gateway.Insert(context.Background(), *order)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -125,7 +144,7 @@ func TestKitchenOrderGateway_Insert_Error(t *testing.T) {
 		nil,
 	)
 
-	err := gateway.Insert(*order)
+	err := gateway.Insert(context.Background(), *order)
 	if err == nil {
 		t.Fatal("expected error, got nil")
 	}
@@ -153,7 +172,7 @@ func TestKitchenOrderGateway_FindByID_Success(t *testing.T) {
 
 	gateway := NewKitchenOrderGateway(mock)
 
-	order, err := gateway.FindByID("id-1")
+	order, err := gateway.FindByID(context.Background(), "id-1")
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -172,7 +191,7 @@ func TestKitchenOrderGateway_FindByID_NotFound(t *testing.T) {
 
 	gateway := NewKitchenOrderGateway(mock)
 
-	_, err := gateway.FindByID("x")
+	_, err := gateway.FindByID(context.Background(), "x")
 	if err == nil {
 		t.Fatal("expected error, got nil")
 	}
@@ -205,15 +224,16 @@ func TestKitchenOrderGateway_FindByID_InvalidItem(t *testing.T) {
 
 	gateway := NewKitchenOrderGateway(mock)
 
-	_, err := gateway.FindByID("id-1")
+	_, err := gateway.FindByID(context.Background(), "id-1")
 	if err == nil {
 		t.Fatal("expected domain error, got nil")
 	}
 }
 
 func TestKitchenOrderGateway_FindAll_Success(t *testing.T) {
+	nextCursor := "next-page"
 	mock := &MockKitchenOrderDataSource{
-		findAllFunc: func(filter dtos.KitchenOrderFilter) ([]daos.KitchenOrderDAO, error) {
+		findAllFunc: func(filter dtos.KitchenOrderFilter) ([]daos.KitchenOrderDAO, dtos.PageInfo, error) {
 			return []daos.KitchenOrderDAO{
 				{
 					ID:      "id-1",
@@ -228,13 +248,13 @@ func TestKitchenOrderGateway_FindAll_Success(t *testing.T) {
 					},
 					CreatedAt: time.Now(),
 				},
-			}, nil
+			}, dtos.PageInfo{NextCursor: &nextCursor, Total: 3}, nil
 		},
 	}
 
 	gateway := NewKitchenOrderGateway(mock)
 
-	orders, err := gateway.FindAll(dtos.KitchenOrderFilter{})
+	orders, pageInfo, err := gateway.FindAll(context.Background(), dtos.KitchenOrderFilter{})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -242,18 +262,64 @@ func TestKitchenOrderGateway_FindAll_Success(t *testing.T) {
 	if len(orders) != 1 {
 		t.Fatalf("expected 1 order")
 	}
+
+	if pageInfo.Total != 3 || pageInfo.NextCursor == nil || *pageInfo.NextCursor != nextCursor {
+		t.Fatalf("expected page info to be propagated, got %+v", pageInfo)
+	}
+}
+
+func TestKitchenOrderGateway_FindAllInBatches_MapsStatusTimestamps(t *testing.T) {
+	createdAt := time.Now()
+	preparingAt := createdAt.Add(time.Minute)
+	mock := &MockKitchenOrderDataSource{
+		findAllFunc: func(filter dtos.KitchenOrderFilter) ([]daos.KitchenOrderDAO, dtos.PageInfo, error) {
+			orders := make([]daos.KitchenOrderDAO, 3)
+			for i := range orders {
+				orders[i] = daos.KitchenOrderDAO{
+					ID:      "id-1",
+					OrderID: "order-1",
+					Slug:    "001",
+					Status: daos.OrderStatusDAO{
+						ID:   constants.KITCHEN_ORDER_STATUS_PREPARING_ID,
+						Name: "Em preparação",
+					},
+					PreparingAt: &preparingAt,
+					CreatedAt:   createdAt,
+				}
+			}
+			return orders, dtos.PageInfo{}, nil
+		},
+	}
+
+	gateway := NewKitchenOrderGateway(mock)
+
+	var batches []int
+	err := gateway.FindAllInBatches(context.Background(), dtos.KitchenOrderFilter{}, 2, func(orders []entities.KitchenOrder) error {
+		batches = append(batches, len(orders))
+		if orders[0].PreparingAt == nil || !orders[0].PreparingAt.Equal(preparingAt) {
+			t.Errorf("expected preparing_at to be mapped, got %v", orders[0].PreparingAt)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(batches) != 2 || batches[0] != 2 || batches[1] != 1 {
+		t.Fatalf("expected batches of 2 and 1, got %v", batches)
+	}
 }
 
 func TestKitchenOrderGateway_FindAll_Error(t *testing.T) {
 	mock := &MockKitchenOrderDataSource{
-		findAllFunc: func(filter dtos.KitchenOrderFilter) ([]daos.KitchenOrderDAO, error) {
-			return nil, errors.New("db error")
+		findAllFunc: func(filter dtos.KitchenOrderFilter) ([]daos.KitchenOrderDAO, dtos.PageInfo, error) {
+			return nil, dtos.PageInfo{}, errors.New("db error")
 		},
 	}
 
 	gateway := NewKitchenOrderGateway(mock)
 
-	_, err := gateway.FindAll(dtos.KitchenOrderFilter{})
+	_, _, err := gateway.FindAll(context.Background(), dtos.KitchenOrderFilter{})
 	if err == nil {
 		t.Fatal("expected error")
 	}
@@ -287,7 +353,7 @@ func TestKitchenOrderGateway_Update_Success(t *testing.T) {
 		&now,
 	)
 
-	err := gateway.Update(*order)
+	err := gateway.Update(context.Background(), *order)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -321,7 +387,7 @@ func TestKitchenOrderGateway_Update_NotFound(t *testing.T) {
 		&now,
 	)
 
-	err := gateway.Update(*order)
+	err := gateway.Update(context.Background(), *order)
 	if err == nil {
 		t.Fatal("expected error")
 	}
@@ -0,0 +1,71 @@
+package gateways
+
+import (
+	"time"
+
+	"tech_challenge/internal/daos"
+	"tech_challenge/internal/domain/entities"
+	"tech_challenge/internal/interfaces"
+)
+
+type KitchenOrderEventGateway struct {
+	dataSource interfaces.IKitchenOrderEventDataSource
+}
+
+func NewKitchenOrderEventGateway(dataSource interfaces.IKitchenOrderEventDataSource) *KitchenOrderEventGateway {
+	return &KitchenOrderEventGateway{
+		dataSource: dataSource,
+	}
+}
+
+func (g *KitchenOrderEventGateway) FindAfter(afterID uint64, limit int) ([]entities.KitchenOrderEvent, error) {
+	eventDAOs, err := g.dataSource.FindAfter(afterID, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return toKitchenOrderEventEntities(eventDAOs)
+}
+
+func (g *KitchenOrderEventGateway) FindByIDs(ids []uint64) ([]entities.KitchenOrderEvent, error) {
+	eventDAOs, err := g.dataSource.FindByIDs(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	return toKitchenOrderEventEntities(eventDAOs)
+}
+
+func (g *KitchenOrderEventGateway) FindLastID() (uint64, error) {
+	return g.dataSource.FindLastID()
+}
+
+func (g *KitchenOrderEventGateway) DeleteOlderThan(before time.Time) (int64, error) {
+	return g.dataSource.DeleteOlderThan(before)
+}
+
+func toKitchenOrderEventEntities(eventDAOs []daos.KitchenOrderEventDAO) ([]entities.KitchenOrderEvent, error) {
+	events := make([]entities.KitchenOrderEvent, 0, len(eventDAOs))
+	for _, eventDAO := range eventDAOs {
+		status, err := entities.NewOrderStatus(eventDAO.StatusID, eventDAO.StatusName)
+		if err != nil {
+			return nil, err
+		}
+
+		event := entities.NewKitchenOrderEvent(
+			eventDAO.ID,
+			eventDAO.Type,
+			eventDAO.KitchenOrderID,
+			eventDAO.OrderID,
+			eventDAO.Slug,
+			*status,
+			eventDAO.CreatedAt,
+		)
+		event.Reason = eventDAO.Reason
+		event.Actor = eventDAO.Actor
+
+		events = append(events, *event)
+	}
+
+	return events, nil
+}
@@ -0,0 +1,133 @@
+package gateways
+
+import (
+	"context"
+	"time"
+
+	"tech_challenge/internal/application/dtos"
+	"tech_challenge/internal/daos"
+	"tech_challenge/internal/domain/entities"
+	"tech_challenge/internal/interfaces"
+)
+
+type KitchenReportGateway struct {
+	dataSource interfaces.IKitchenReportDataSource
+}
+
+func NewKitchenReportGateway(dataSource interfaces.IKitchenReportDataSource) *KitchenReportGateway {
+	return &KitchenReportGateway{
+		dataSource: dataSource,
+	}
+}
+
+func (g *KitchenReportGateway) CountThroughput(ctx context.Context, filter dtos.KitchenReportFilter) ([]entities.ThroughputBucket, error) {
+	bucketDAOs, err := g.dataSource.CountThroughput(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	buckets := make([]entities.ThroughputBucket, len(bucketDAOs))
+	for i, bucketDAO := range bucketDAOs {
+		buckets[i] = entities.ThroughputBucket{
+			PeriodStart: bucketDAO.PeriodStart,
+			Received:    bucketDAO.Received,
+			Finished:    bucketDAO.Finished,
+		}
+	}
+
+	return buckets, nil
+}
+
+func (g *KitchenReportGateway) FindStageDurations(ctx context.Context, filter dtos.KitchenReportFilter) ([]entities.StageDuration, error) {
+	durationDAOs, err := g.dataSource.FindStageDurations(ctx, filter, false)
+	if err != nil {
+		return nil, err
+	}
+
+	stages := make([]entities.StageDuration, len(durationDAOs))
+	for i, durationDAO := range durationDAOs {
+		stages[i] = toStageDurationEntity(durationDAO)
+	}
+
+	return stages, nil
+}
+
+// FindStageDurationsByPeriod junta as linhas de cada período em um único bucket
+func (g *KitchenReportGateway) FindStageDurationsByPeriod(ctx context.Context, filter dtos.KitchenReportFilter) ([]entities.PrepTimeBucket, error) {
+	durationDAOs, err := g.dataSource.FindStageDurations(ctx, filter, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var buckets []entities.PrepTimeBucket
+	positions := make(map[int64]int)
+
+	for _, durationDAO := range durationDAOs {
+		if durationDAO.PeriodStart == nil {
+			continue
+		}
+
+		key := durationDAO.PeriodStart.Unix()
+		position, ok := positions[key]
+		if !ok {
+			position = len(buckets)
+			positions[key] = position
+			buckets = append(buckets, entities.PrepTimeBucket{PeriodStart: *durationDAO.PeriodStart})
+		}
+
+		buckets[position].Stages = append(buckets[position].Stages, toStageDurationEntity(durationDAO))
+	}
+
+	return buckets, nil
+}
+
+func (g *KitchenReportGateway) FindSlowestProducts(ctx context.Context, filter dtos.KitchenReportFilter) ([]entities.ProductPrepTime, error) {
+	productDAOs, err := g.dataSource.FindSlowestProducts(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	products := make([]entities.ProductPrepTime, len(productDAOs))
+	for i, productDAO := range productDAOs {
+		products[i] = entities.ProductPrepTime{
+			ProductID:      productDAO.ProductID,
+			Orders:         productDAO.Orders,
+			AverageSeconds: productDAO.AverageSeconds,
+			P90Seconds:     productDAO.P90Seconds,
+		}
+	}
+
+	return products, nil
+}
+
+func (g *KitchenReportGateway) CountByStatus(ctx context.Context, statusIDs []string) ([]entities.BoardStatusCount, error) {
+	countDAOs, err := g.dataSource.CountByStatus(ctx, statusIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make([]entities.BoardStatusCount, len(countDAOs))
+	for i, countDAO := range countDAOs {
+		counts[i] = entities.BoardStatusCount{
+			StatusID:   countDAO.StatusID,
+			StatusName: countDAO.StatusName,
+			Orders:     countDAO.Orders,
+		}
+	}
+
+	return counts, nil
+}
+
+func (g *KitchenReportGateway) FindOldestCreatedAt(ctx context.Context, statusID string) (*time.Time, error) {
+	return g.dataSource.FindOldestCreatedAt(ctx, statusID)
+}
+
+func toStageDurationEntity(durationDAO daos.StageDurationDAO) entities.StageDuration {
+	return entities.StageDuration{
+		StatusID:       durationDAO.StatusID,
+		StatusName:     durationDAO.StatusName,
+		Orders:         durationDAO.Orders,
+		AverageSeconds: durationDAO.AverageSeconds,
+		P90Seconds:     durationDAO.P90Seconds,
+	}
+}
@@ -1,6 +1,8 @@
 package gateways
 
 import (
+	"context"
+
 	"tech_challenge/internal/domain/entities"
 	"tech_challenge/internal/interfaces"
 )
@@ -15,8 +17,8 @@ func NewOrderStatusGateway(dataSource interfaces.IOrderStatusDataSource) *OrderS
 	}
 }
 
-func (o *OrderStatusGateway) FindAll() ([]entities.OrderStatus, error) {
-	orderStatusDAOs, err := o.dataSource.FindAll()
+func (o *OrderStatusGateway) FindAll(ctx context.Context) ([]entities.OrderStatus, error) {
+	orderStatusDAOs, err := o.dataSource.FindAll(ctx)
 
 	if err != nil {
 		return nil, err
@@ -40,8 +42,8 @@ func (o *OrderStatusGateway) FindAll() ([]entities.OrderStatus, error) {
 	return orderStatusList, nil
 }
 
-func (g *OrderStatusGateway) FindByID(id string) (entities.OrderStatus, error) {
-	orderStatusDAO, err := g.dataSource.FindByID(id)
+func (g *OrderStatusGateway) FindByID(ctx context.Context, id string) (entities.OrderStatus, error) {
+	orderStatusDAO, err := g.dataSource.FindByID(ctx, id)
 	if err != nil {
 		return entities.OrderStatus{}, err
 	}
@@ -1,6 +1,7 @@
 package gateways
 
 import (
+	"context"
 	"testing"
 
 	"tech_challenge/internal/daos"
@@ -14,14 +15,14 @@ type MockOrderStatusDataSource struct {
 	findAllFunc  func() ([]daos.OrderStatusDAO, error)
 }
 
-func (m *MockOrderStatusDataSource) FindByID(id string) (daos.OrderStatusDAO, error) {
+func (m *MockOrderStatusDataSource) FindByID(ctx context.Context, id string) (daos.OrderStatusDAO, error) {
 	if m.findByIDFunc != nil {
 		return m.findByIDFunc(id)
 	}
 	return daos.OrderStatusDAO{}, nil
 }
 
-func (m *MockOrderStatusDataSource) FindAll() ([]daos.OrderStatusDAO, error) {
+func (m *MockOrderStatusDataSource) FindAll(ctx context.Context) ([]daos.OrderStatusDAO, error) {
 	if m.findAllFunc != nil {
 		return m.findAllFunc()
 	}
@@ -59,7 +60,7 @@ func TestOrderStatusGateway_FindAll(t *testing.T) {
 	gateway := NewOrderStatusGateway(mockDataSource)
 
 	// Act
-	result, err := gateway.FindAll()
+	result, err := gateway.FindAll(context.Background())
 
 	// Assert
 	if err != nil {
@@ -109,7 +110,7 @@ func TestOrderStatusGateway_FindByID(t *testing.T) {
 	gateway := NewOrderStatusGateway(mockDataSource)
 
 	// Act
-	result, err := gateway.FindByID(constants.KITCHEN_ORDER_STATUS_RECEIVED_ID)
+	result, err := gateway.FindByID(context.Background(), constants.KITCHEN_ORDER_STATUS_RECEIVED_ID)
 
 	// Assert
 	if err != nil {
@@ -136,7 +137,7 @@ func TestOrderStatusGateway_FindByID_NotFound(t *testing.T) {
 	gateway := NewOrderStatusGateway(mockDataSource)
 
 	// Act
-	_, err := gateway.FindByID("invalid-id")
+	_, err := gateway.FindByID(context.Background(), "invalid-id")
 
 	// Assert
 	if err == nil {
@@ -0,0 +1,95 @@
+package gateways
+
+import (
+	"context"
+	"time"
+
+	"tech_challenge/internal/application/dtos"
+	"tech_challenge/internal/daos"
+	"tech_challenge/internal/domain/entities"
+	"tech_challenge/internal/interfaces"
+)
+
+type PrintJobGateway struct {
+	dataSource interfaces.IPrintJobDataSource
+}
+
+func NewPrintJobGateway(dataSource interfaces.IPrintJobDataSource) *PrintJobGateway {
+	return &PrintJobGateway{
+		dataSource: dataSource,
+	}
+}
+
+func (g *PrintJobGateway) InsertAll(ctx context.Context, jobs []entities.PrintJob) error {
+	jobDAOs := make([]daos.PrintJobDAO, len(jobs))
+	for i, job := range jobs {
+		jobDAOs[i] = toPrintJobDAO(job)
+	}
+
+	return g.dataSource.InsertAll(ctx, jobDAOs)
+}
+
+func (g *PrintJobGateway) FindAll(filter dtos.PrintJobFilter) ([]entities.PrintJob, error) {
+	jobDAOs, err := g.dataSource.FindAll(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return toPrintJobEntities(jobDAOs), nil
+}
+
+func (g *PrintJobGateway) ClaimDue(now, leaseUntil time.Time, limit int) ([]entities.PrintJob, error) {
+	jobDAOs, err := g.dataSource.ClaimDue(now, leaseUntil, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return toPrintJobEntities(jobDAOs), nil
+}
+
+func (g *PrintJobGateway) Update(job entities.PrintJob) error {
+	return g.dataSource.Update(toPrintJobDAO(job))
+}
+
+func toPrintJobDAO(job entities.PrintJob) daos.PrintJobDAO {
+	return daos.PrintJobDAO{
+		ID:             job.ID,
+		KitchenOrderID: job.KitchenOrderID,
+		PrinterID:      job.PrinterID,
+		Station:        job.Station,
+		ItemIDs:        job.ItemIDs,
+		Status:         job.Status,
+		Attempts:       job.Attempts,
+		LastError:      job.LastError,
+		NextAttemptAt:  job.NextAttemptAt,
+		PrintedAt:      job.PrintedAt,
+		Reprint:        job.Reprint,
+		RequestedBy:    job.RequestedBy,
+		CreatedAt:      job.CreatedAt,
+		UpdatedAt:      job.UpdatedAt,
+	}
+}
+
+func toPrintJobEntities(jobDAOs []daos.PrintJobDAO) []entities.PrintJob {
+	jobs := make([]entities.PrintJob, len(jobDAOs))
+	for i, jobDAO := range jobDAOs {
+		jobs[i] = entities.PrintJob{
+			ID:             jobDAO.ID,
+			KitchenOrderID: jobDAO.KitchenOrderID,
+			PrinterID:      jobDAO.PrinterID,
+			Station:        jobDAO.Station,
+			ItemIDs:        jobDAO.ItemIDs,
+			Status:         jobDAO.Status,
+			Attempts:       jobDAO.Attempts,
+			LastError:      jobDAO.LastError,
+			NextAttemptAt:  jobDAO.NextAttemptAt,
+			PrintedAt:      jobDAO.PrintedAt,
+			Reprint:        jobDAO.Reprint,
+			RequestedBy:    jobDAO.RequestedBy,
+			CreatedAt:      jobDAO.CreatedAt,
+			UpdatedAt:      jobDAO.UpdatedAt,
+		}
+	}
+
+	return jobs
+}
@@ -0,0 +1,51 @@
+package gateways
+
+import (
+	"context"
+
+	"tech_challenge/internal/daos"
+	"tech_challenge/internal/domain/entities"
+	"tech_challenge/internal/interfaces"
+)
+
+type PrintRouteGateway struct {
+	dataSource interfaces.IPrintRouteDataSource
+}
+
+func NewPrintRouteGateway(dataSource interfaces.IPrintRouteDataSource) *PrintRouteGateway {
+	return &PrintRouteGateway{
+		dataSource: dataSource,
+	}
+}
+
+func (g *PrintRouteGateway) FindAll(ctx context.Context) ([]entities.PrintRoute, error) {
+	routeDAOs, err := g.dataSource.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	routes := make([]entities.PrintRoute, 0, len(routeDAOs))
+	for _, routeDAO := range routeDAOs {
+		route, err := entities.NewPrintRoute(routeDAO.ID, routeDAO.Position, routeDAO.ProductPattern, routeDAO.Station)
+		if err != nil {
+			return nil, err
+		}
+		routes = append(routes, *route)
+	}
+
+	return routes, nil
+}
+
+func (g *PrintRouteGateway) ReplaceAll(routes []entities.PrintRoute) error {
+	routeDAOs := make([]daos.PrintRouteDAO, len(routes))
+	for i, route := range routes {
+		routeDAOs[i] = daos.PrintRouteDAO{
+			ID:             route.ID,
+			Position:       route.Position,
+			ProductPattern: route.ProductPattern,
+			Station:        route.Station,
+		}
+	}
+
+	return g.dataSource.ReplaceAll(routeDAOs)
+}
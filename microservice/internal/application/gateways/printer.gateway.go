@@ -0,0 +1,104 @@
+package gateways
+
+import (
+	"context"
+
+	"tech_challenge/internal/daos"
+	"tech_challenge/internal/domain/entities"
+	"tech_challenge/internal/interfaces"
+)
+
+type PrinterGateway struct {
+	dataSource interfaces.IPrinterDataSource
+}
+
+func NewPrinterGateway(dataSource interfaces.IPrinterDataSource) *PrinterGateway {
+	return &PrinterGateway{
+		dataSource: dataSource,
+	}
+}
+
+func (g *PrinterGateway) Insert(printer entities.Printer) error {
+	return g.dataSource.Insert(toPrinterDAO(printer))
+}
+
+func (g *PrinterGateway) FindByID(id string) (entities.Printer, error) {
+	printerDAO, err := g.dataSource.FindByID(id)
+	if err != nil {
+		return entities.Printer{}, err
+	}
+
+	printer, err := toPrinterEntity(printerDAO)
+	if err != nil {
+		return entities.Printer{}, err
+	}
+
+	return *printer, nil
+}
+
+func (g *PrinterGateway) FindAll() ([]entities.Printer, error) {
+	printerDAOs, err := g.dataSource.FindAll()
+	if err != nil {
+		return nil, err
+	}
+
+	return toPrinterEntities(printerDAOs)
+}
+
+func (g *PrinterGateway) FindAllActive(ctx context.Context) ([]entities.Printer, error) {
+	printerDAOs, err := g.dataSource.FindAllActive(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return toPrinterEntities(printerDAOs)
+}
+
+func (g *PrinterGateway) Update(printer entities.Printer) error {
+	return g.dataSource.Update(toPrinterDAO(printer))
+}
+
+func (g *PrinterGateway) Delete(id string) error {
+	return g.dataSource.Delete(id)
+}
+
+func toPrinterDAO(printer entities.Printer) daos.PrinterDAO {
+	return daos.PrinterDAO{
+		ID:        printer.ID,
+		Name:      printer.Name,
+		Host:      printer.Host,
+		Port:      printer.Port,
+		Station:   printer.Station,
+		Width:     printer.Width,
+		Active:    printer.Active,
+		CreatedAt: printer.CreatedAt,
+		UpdatedAt: printer.UpdatedAt,
+	}
+}
+
+func toPrinterEntity(printerDAO daos.PrinterDAO) (*entities.Printer, error) {
+	return entities.NewPrinter(
+		printerDAO.ID,
+		printerDAO.Name,
+		printerDAO.Host,
+		printerDAO.Port,
+		printerDAO.Station,
+		printerDAO.Width,
+		printerDAO.Active,
+		printerDAO.CreatedAt,
+		printerDAO.UpdatedAt,
+	)
+}
+
+func toPrinterEntities(printerDAOs []daos.PrinterDAO) ([]entities.Printer, error) {
+	printers := make([]entities.Printer, 0, len(printerDAOs))
+	for _, printerDAO := range printerDAOs {
+		printer, err := toPrinterEntity(printerDAO)
+		if err != nil {
+			return nil, err
+		}
+		printers = append(printers, *printer)
+	}
+
+	return printers, nil
+}
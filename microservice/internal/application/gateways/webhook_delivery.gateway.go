@@ -0,0 +1,57 @@
+package gateways
+
+import (
+	"tech_challenge/internal/daos"
+	"tech_challenge/internal/domain/entities"
+	"tech_challenge/internal/interfaces"
+)
+
+type WebhookDeliveryGateway struct {
+	dataSource interfaces.IWebhookDeliveryDataSource
+}
+
+func NewWebhookDeliveryGateway(dataSource interfaces.IWebhookDeliveryDataSource) *WebhookDeliveryGateway {
+	return &WebhookDeliveryGateway{
+		dataSource: dataSource,
+	}
+}
+
+func (g *WebhookDeliveryGateway) Insert(delivery entities.WebhookDelivery) error {
+	return g.dataSource.Insert(daos.WebhookDeliveryDAO{
+		ID:             delivery.ID,
+		SubscriptionID: delivery.SubscriptionID,
+		EventID:        delivery.EventID,
+		Event:          delivery.Event,
+		Attempt:        delivery.Attempt,
+		StatusCode:     delivery.StatusCode,
+		Success:        delivery.Success,
+		Error:          delivery.Error,
+		DurationMs:     delivery.DurationMs,
+		CreatedAt:      delivery.CreatedAt,
+	})
+}
+
+func (g *WebhookDeliveryGateway) FindBySubscriptionID(subscriptionID string, limit int) ([]entities.WebhookDelivery, error) {
+	deliveryDAOs, err := g.dataSource.FindBySubscriptionID(subscriptionID, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries := make([]entities.WebhookDelivery, 0, len(deliveryDAOs))
+	for _, deliveryDAO := range deliveryDAOs {
+		deliveries = append(deliveries, *entities.NewWebhookDelivery(
+			deliveryDAO.ID,
+			deliveryDAO.SubscriptionID,
+			deliveryDAO.EventID,
+			deliveryDAO.Event,
+			deliveryDAO.Attempt,
+			deliveryDAO.StatusCode,
+			deliveryDAO.Success,
+			deliveryDAO.Error,
+			deliveryDAO.DurationMs,
+			deliveryDAO.CreatedAt,
+		))
+	}
+
+	return deliveries, nil
+}
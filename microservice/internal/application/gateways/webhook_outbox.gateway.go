@@ -0,0 +1,77 @@
+package gateways
+
+import (
+	"context"
+	"time"
+
+	"tech_challenge/internal/daos"
+	"tech_challenge/internal/domain/entities"
+	"tech_challenge/internal/interfaces"
+)
+
+type WebhookOutboxGateway struct {
+	dataSource interfaces.IWebhookOutboxDataSource
+}
+
+func NewWebhookOutboxGateway(dataSource interfaces.IWebhookOutboxDataSource) *WebhookOutboxGateway {
+	return &WebhookOutboxGateway{
+		dataSource: dataSource,
+	}
+}
+
+func (g *WebhookOutboxGateway) InsertAll(ctx context.Context, entries []entities.WebhookOutboxEntry) error {
+	entryDAOs := make([]daos.WebhookOutboxDAO, len(entries))
+	for i, entry := range entries {
+		entryDAOs[i] = toWebhookOutboxDAO(entry)
+	}
+
+	return g.dataSource.InsertAll(ctx, entryDAOs)
+}
+
+func (g *WebhookOutboxGateway) ClaimDue(now, leaseUntil time.Time, limit int) ([]entities.WebhookOutboxEntry, error) {
+	entryDAOs, err := g.dataSource.ClaimDue(now, leaseUntil, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]entities.WebhookOutboxEntry, len(entryDAOs))
+	for i, entryDAO := range entryDAOs {
+		entries[i] = entities.WebhookOutboxEntry{
+			ID:             entryDAO.ID,
+			SubscriptionID: entryDAO.SubscriptionID,
+			EventID:        entryDAO.EventID,
+			Event:          entryDAO.Event,
+			Payload:        entryDAO.Payload,
+			Status:         entryDAO.Status,
+			Attempts:       entryDAO.Attempts,
+			LastError:      entryDAO.LastError,
+			NextAttemptAt:  entryDAO.NextAttemptAt,
+			DeliveredAt:    entryDAO.DeliveredAt,
+			CreatedAt:      entryDAO.CreatedAt,
+			UpdatedAt:      entryDAO.UpdatedAt,
+		}
+	}
+
+	return entries, nil
+}
+
+func (g *WebhookOutboxGateway) Update(entry entities.WebhookOutboxEntry) error {
+	return g.dataSource.Update(toWebhookOutboxDAO(entry))
+}
+
+func toWebhookOutboxDAO(entry entities.WebhookOutboxEntry) daos.WebhookOutboxDAO {
+	return daos.WebhookOutboxDAO{
+		ID:             entry.ID,
+		SubscriptionID: entry.SubscriptionID,
+		EventID:        entry.EventID,
+		Event:          entry.Event,
+		Payload:        entry.Payload,
+		Status:         entry.Status,
+		Attempts:       entry.Attempts,
+		LastError:      entry.LastError,
+		NextAttemptAt:  entry.NextAttemptAt,
+		DeliveredAt:    entry.DeliveredAt,
+		CreatedAt:      entry.CreatedAt,
+		UpdatedAt:      entry.UpdatedAt,
+	}
+}
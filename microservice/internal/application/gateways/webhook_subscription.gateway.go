@@ -0,0 +1,110 @@
+package gateways
+
+import (
+	"tech_challenge/internal/daos"
+	"tech_challenge/internal/domain/entities"
+	"tech_challenge/internal/interfaces"
+)
+
+type WebhookSubscriptionGateway struct {
+	dataSource interfaces.IWebhookSubscriptionDataSource
+}
+
+func NewWebhookSubscriptionGateway(dataSource interfaces.IWebhookSubscriptionDataSource) *WebhookSubscriptionGateway {
+	return &WebhookSubscriptionGateway{
+		dataSource: dataSource,
+	}
+}
+
+func (g *WebhookSubscriptionGateway) Insert(subscription entities.WebhookSubscription) error {
+	return g.dataSource.Insert(toWebhookSubscriptionDAO(subscription))
+}
+
+func (g *WebhookSubscriptionGateway) FindByID(id string) (entities.WebhookSubscription, error) {
+	subscriptionDAO, err := g.dataSource.FindByID(id)
+	if err != nil {
+		return entities.WebhookSubscription{}, err
+	}
+
+	subscription, err := toWebhookSubscriptionEntity(subscriptionDAO)
+	if err != nil {
+		return entities.WebhookSubscription{}, err
+	}
+
+	return *subscription, nil
+}
+
+func (g *WebhookSubscriptionGateway) FindAll() ([]entities.WebhookSubscription, error) {
+	subscriptionDAOs, err := g.dataSource.FindAll()
+	if err != nil {
+		return nil, err
+	}
+
+	return toWebhookSubscriptionEntities(subscriptionDAOs)
+}
+
+func (g *WebhookSubscriptionGateway) FindAllActive() ([]entities.WebhookSubscription, error) {
+	subscriptionDAOs, err := g.dataSource.FindAllActive()
+	if err != nil {
+		return nil, err
+	}
+
+	return toWebhookSubscriptionEntities(subscriptionDAOs)
+}
+
+func (g *WebhookSubscriptionGateway) Update(subscription entities.WebhookSubscription) error {
+	return g.dataSource.Update(toWebhookSubscriptionDAO(subscription))
+}
+
+func (g *WebhookSubscriptionGateway) Delete(id string) error {
+	return g.dataSource.Delete(id)
+}
+
+func (g *WebhookSubscriptionGateway) IncrementFailures(id string, disableAfter int) error {
+	return g.dataSource.IncrementFailures(id, disableAfter)
+}
+
+func (g *WebhookSubscriptionGateway) ResetFailures(id string) error {
+	return g.dataSource.ResetFailures(id)
+}
+
+func toWebhookSubscriptionDAO(subscription entities.WebhookSubscription) daos.WebhookSubscriptionDAO {
+	return daos.WebhookSubscriptionDAO{
+		ID:                  subscription.ID,
+		URL:                 subscription.URL,
+		Secret:              subscription.Secret,
+		Events:              subscription.Events,
+		Active:              subscription.Active,
+		ConsecutiveFailures: subscription.ConsecutiveFailures,
+		CreatedAt:           subscription.CreatedAt,
+		UpdatedAt:           subscription.UpdatedAt,
+	}
+}
+
+func toWebhookSubscriptionEntity(subscriptionDAO daos.WebhookSubscriptionDAO) (*entities.WebhookSubscription, error) {
+	return entities.NewWebhookSubscription(
+		subscriptionDAO.ID,
+		subscriptionDAO.URL,
+		subscriptionDAO.Secret,
+		subscriptionDAO.Events,
+		subscriptionDAO.Active,
+		subscriptionDAO.ConsecutiveFailures,
+		subscriptionDAO.CreatedAt,
+		subscriptionDAO.UpdatedAt,
+	)
+}
+
+func toWebhookSubscriptionEntities(subscriptionDAOs []daos.WebhookSubscriptionDAO) ([]entities.WebhookSubscription, error) {
+	subscriptions := make([]entities.WebhookSubscription, 0, len(subscriptionDAOs))
+
+	for _, subscriptionDAO := range subscriptionDAOs {
+		subscription, err := toWebhookSubscriptionEntity(subscriptionDAO)
+		if err != nil {
+			return nil, err
+		}
+
+		subscriptions = append(subscriptions, *subscription)
+	}
+
+	return subscriptions, nil
+}
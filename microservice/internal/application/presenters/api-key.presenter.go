@@ -0,0 +1,36 @@
+package presenters
+
+import (
+	"tech_challenge/internal/application/dtos"
+	"tech_challenge/internal/domain/entities"
+)
+
+// ToResponseAPIKey nunca expõe a chave nem o hash; a chave só é exibida na criação
+func ToResponseAPIKey(apiKey entities.APIKey) dtos.APIKeyResponseDTO {
+	return dtos.APIKeyResponseDTO{
+		ID:         apiKey.ID,
+		Name:       apiKey.Name,
+		Prefix:     apiKey.Prefix,
+		Scopes:     apiKey.Scopes,
+		CreatedBy:  apiKey.CreatedBy,
+		CreatedAt:  apiKey.CreatedAt,
+		LastUsedAt: apiKey.LastUsedAt,
+		RevokedAt:  apiKey.RevokedAt,
+	}
+}
+
+func ToResponseCreatedAPIKey(apiKey entities.APIKey, key string) dtos.APIKeyResponseDTO {
+	response := ToResponseAPIKey(apiKey)
+	response.Key = key
+	return response
+}
+
+func ToResponseListAPIKey(apiKeys []entities.APIKey) []dtos.APIKeyResponseDTO {
+	apiKeyResponse := make([]dtos.APIKeyResponseDTO, len(apiKeys))
+
+	for i, apiKey := range apiKeys {
+		apiKeyResponse[i] = ToResponseAPIKey(apiKey)
+	}
+
+	return apiKeyResponse
+}
@@ -0,0 +1,24 @@
+package presenters
+
+import (
+	"tech_challenge/internal/application/dtos"
+	"tech_challenge/internal/domain/entities"
+)
+
+func ToResponseDisplayBoard(board entities.DisplayBoard) dtos.DisplayBoardDTO {
+	return dtos.DisplayBoardDTO{
+		Preparing: toResponseDisplayBoardEntries(board.Preparing),
+		Ready:     toResponseDisplayBoardEntries(board.Ready),
+	}
+}
+
+func toResponseDisplayBoardEntries(entries []entities.DisplayBoardEntry) []dtos.DisplayBoardEntryDTO {
+	response := make([]dtos.DisplayBoardEntryDTO, len(entries))
+	for i, entry := range entries {
+		response[i] = dtos.DisplayBoardEntryDTO{
+			Slug:  entry.Slug,
+			Since: entry.Since,
+		}
+	}
+	return response
+}
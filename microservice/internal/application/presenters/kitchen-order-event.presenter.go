@@ -0,0 +1,31 @@
+package presenters
+
+import (
+	"tech_challenge/internal/application/dtos"
+	"tech_challenge/internal/domain/entities"
+)
+
+func ToResponseKitchenOrderEvent(event entities.KitchenOrderEvent) dtos.KitchenOrderEventDTO {
+	return dtos.KitchenOrderEventDTO{
+		ID:             event.ID,
+		Type:           event.Type,
+		KitchenOrderID: event.KitchenOrderID,
+		OrderID:        event.OrderID,
+		Slug:           event.Slug,
+		Status: dtos.OrderStatusDTO{
+			ID:   event.Status.ID,
+			Name: event.Status.Name.Value(),
+		},
+		Reason:     event.Reason,
+		Actor:      event.Actor,
+		OccurredAt: event.OccurredAt,
+	}
+}
+
+func ToResponseKitchenOrderEventList(events []entities.KitchenOrderEvent) []dtos.KitchenOrderEventDTO {
+	response := make([]dtos.KitchenOrderEventDTO, len(events))
+	for i, event := range events {
+		response[i] = ToResponseKitchenOrderEvent(event)
+	}
+	return response
+}
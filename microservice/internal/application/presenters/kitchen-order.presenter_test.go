@@ -371,3 +371,38 @@ func TestToResponseList_WithItemsInOrders(t *testing.T) {
 		t.Errorf("Expected response[1].ID 'id2', got %s", responses[1].ID)
 	}
 }
+
+func TestToTicket(t *testing.T) {
+	// Arrange
+	status, _ := entities.NewOrderStatus(constants.KITCHEN_ORDER_STATUS_PREPARING_ID, "Em preparação")
+	item, _ := entities.NewOrderItem("item-1", "order-123", "burger", 2, 25.5)
+	now := time.Now()
+	reason := "Cliente devolveu o lanche frio"
+
+	kitchenOrder, _ := entities.NewKitchenOrder("test-id", "order-123", "001", *status, now, nil)
+	kitchenOrder.AddItem(*item)
+	kitchenOrder.StatusReason = &reason
+	kitchenOrder.PreparingAt = &now
+
+	// Act
+	ticket := ToTicket(*kitchenOrder)
+
+	// Assert
+	if ticket.Slug != "001" || ticket.Status.Name != "Em preparação" {
+		t.Errorf("Expected slug and status to be copied, got %+v", ticket)
+	}
+
+	if len(ticket.Items) != 1 || ticket.Items[0].ProductID != "burger" || ticket.Items[0].Quantity != 2 {
+		t.Errorf("Expected the order items, got %+v", ticket.Items)
+	}
+
+	if ticket.Notes == nil || *ticket.Notes != reason {
+		t.Errorf("Expected the status reason as ticket notes, got %v", ticket.Notes)
+	}
+
+	if ticket.PreparingAt == nil || !ticket.PreparingAt.Equal(now) {
+		t.Errorf("Expected preparing_at to be copied, got %v", ticket.PreparingAt)
+	} else {
+		t.Log("✓ Comanda montada com itens, observação e horários")
+	}
+}
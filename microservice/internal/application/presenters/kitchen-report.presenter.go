@@ -0,0 +1,95 @@
+package presenters
+
+import (
+	"tech_challenge/internal/application/dtos"
+	"tech_challenge/internal/domain/entities"
+)
+
+func ToResponseThroughputReport(report entities.ThroughputReport) dtos.ThroughputReportDTO {
+	buckets := make([]dtos.ThroughputBucketDTO, len(report.Buckets))
+
+	for i, bucket := range report.Buckets {
+		buckets[i] = dtos.ThroughputBucketDTO{
+			PeriodStart:     bucket.PeriodStart,
+			Received:        bucket.Received,
+			Finished:        bucket.Finished,
+			ReceivedPerHour: bucket.ReceivedPerHour,
+			FinishedPerHour: bucket.FinishedPerHour,
+		}
+	}
+
+	return dtos.ThroughputReportDTO{
+		From:            report.From,
+		To:              report.To,
+		GroupBy:         report.GroupBy,
+		Received:        report.Received,
+		Finished:        report.Finished,
+		ReceivedPerHour: report.ReceivedPerHour,
+		FinishedPerHour: report.FinishedPerHour,
+		Buckets:         buckets,
+	}
+}
+
+func ToResponsePrepTimeReport(report entities.PrepTimeReport) dtos.PrepTimeReportDTO {
+	buckets := make([]dtos.PrepTimeBucketDTO, len(report.Buckets))
+
+	for i, bucket := range report.Buckets {
+		buckets[i] = dtos.PrepTimeBucketDTO{
+			PeriodStart: bucket.PeriodStart,
+			Stages:      toResponseListStageDuration(bucket.Stages),
+		}
+	}
+
+	products := make([]dtos.ProductPrepTimeDTO, len(report.SlowestProducts))
+
+	for i, product := range report.SlowestProducts {
+		products[i] = dtos.ProductPrepTimeDTO{
+			ProductID:      product.ProductID,
+			Orders:         product.Orders,
+			AverageSeconds: product.AverageSeconds,
+			P90Seconds:     product.P90Seconds,
+		}
+	}
+
+	return dtos.PrepTimeReportDTO{
+		From:            report.From,
+		To:              report.To,
+		GroupBy:         report.GroupBy,
+		Stages:          toResponseListStageDuration(report.Stages),
+		Buckets:         buckets,
+		SlowestProducts: products,
+	}
+}
+
+func ToResponseKitchenBoardStats(stats entities.KitchenBoardStats) dtos.KitchenBoardStatsDTO {
+	statuses := make([]dtos.BoardStatusCountDTO, len(stats.Statuses))
+
+	for i, status := range stats.Statuses {
+		statuses[i] = dtos.BoardStatusCountDTO{
+			StatusID:   status.StatusID,
+			StatusName: status.StatusName,
+			Orders:     status.Orders,
+		}
+	}
+
+	return dtos.KitchenBoardStatsDTO{
+		Statuses:                 statuses,
+		OldestReceivedAgeSeconds: stats.OldestReceivedAge.Seconds(),
+	}
+}
+
+func toResponseListStageDuration(stages []entities.StageDuration) []dtos.StageDurationDTO {
+	stageResponse := make([]dtos.StageDurationDTO, len(stages))
+
+	for i, stage := range stages {
+		stageResponse[i] = dtos.StageDurationDTO{
+			StatusID:       stage.StatusID,
+			StatusName:     stage.StatusName,
+			Orders:         stage.Orders,
+			AverageSeconds: stage.AverageSeconds,
+			P90Seconds:     stage.P90Seconds,
+		}
+	}
+
+	return stageResponse
+}
@@ -13,12 +13,15 @@ func ToResponse(kitchenOrder entities.KitchenOrder) dtos.KitchenOrderResponseDTO
 	}
 
 	return dtos.KitchenOrderResponseDTO{
-		ID:        kitchenOrder.ID,
-		OrderID:   kitchenOrder.OrderID,
-		Slug:      kitchenOrder.Slug.Value(),
-		Status:    status,
-		CreatedAt: kitchenOrder.CreatedAt,
-		UpdatedAt: kitchenOrder.UpdatedAt,
+		ID:              kitchenOrder.ID,
+		OrderID:         kitchenOrder.OrderID,
+		Slug:            kitchenOrder.Slug.Value(),
+		Status:          status,
+		Version:         kitchenOrder.Version,
+		StatusReason:    kitchenOrder.StatusReason,
+		StatusChangedBy: kitchenOrder.StatusChangedBy,
+		CreatedAt:       kitchenOrder.CreatedAt,
+		UpdatedAt:       kitchenOrder.UpdatedAt,
 	}
 }
 
@@ -31,3 +34,68 @@ func ToResponseList(kitchenOrders []entities.KitchenOrder) []dtos.KitchenOrderRe
 
 	return kitchenOrderResponse
 }
+
+func ToResponsePage(kitchenOrders []entities.KitchenOrder, pageInfo dtos.PageInfo) dtos.KitchenOrderListResponseDTO {
+	return dtos.KitchenOrderListResponseDTO{
+		Items:      ToResponseList(kitchenOrders),
+		NextCursor: pageInfo.NextCursor,
+		Total:      pageInfo.Total,
+	}
+}
+
+func ToExportRow(kitchenOrder entities.KitchenOrder) dtos.KitchenOrderExportDTO {
+	return dtos.KitchenOrderExportDTO{
+		ID:         kitchenOrder.ID,
+		OrderID:    kitchenOrder.OrderID,
+		CustomerID: kitchenOrder.CustomerID,
+		Slug:       kitchenOrder.Slug.Value(),
+		Status: dtos.OrderStatusDTO{
+			ID:   kitchenOrder.Status.ID,
+			Name: kitchenOrder.Status.Name.Value(),
+		},
+		Amount:              kitchenOrder.Amount,
+		Items:               toOrderItemDTOs(kitchenOrder.Items),
+		StatusReason:        kitchenOrder.StatusReason,
+		CreatedAt:           kitchenOrder.CreatedAt,
+		PreparingAt:         kitchenOrder.PreparingAt,
+		ReadyAt:             kitchenOrder.ReadyAt,
+		FinishedAt:          kitchenOrder.FinishedAt,
+		WaitingDuration:     kitchenOrder.WaitingDuration(),
+		PreparationDuration: kitchenOrder.PreparationDuration(),
+		TotalDuration:       kitchenOrder.TotalDuration(),
+	}
+}
+
+// ToTicket usa o motivo da última mudança de status, como o do recall, como observação da comanda
+func ToTicket(kitchenOrder entities.KitchenOrder) dtos.KitchenOrderTicketDTO {
+	return dtos.KitchenOrderTicketDTO{
+		ID:      kitchenOrder.ID,
+		OrderID: kitchenOrder.OrderID,
+		Slug:    kitchenOrder.Slug.Value(),
+		Status: dtos.OrderStatusDTO{
+			ID:   kitchenOrder.Status.ID,
+			Name: kitchenOrder.Status.Name.Value(),
+		},
+		Items:       toOrderItemDTOs(kitchenOrder.Items),
+		Notes:       kitchenOrder.StatusReason,
+		CreatedAt:   kitchenOrder.CreatedAt,
+		PreparingAt: kitchenOrder.PreparingAt,
+		ReadyAt:     kitchenOrder.ReadyAt,
+		FinishedAt:  kitchenOrder.FinishedAt,
+	}
+}
+
+func toOrderItemDTOs(items []entities.OrderItem) []dtos.OrderItemDTO {
+	itemDTOs := make([]dtos.OrderItemDTO, len(items))
+	for i, item := range items {
+		itemDTOs[i] = dtos.OrderItemDTO{
+			ID:        item.ID,
+			OrderID:   item.OrderID,
+			ProductID: item.ProductID,
+			Quantity:  item.Quantity,
+			UnitPrice: item.UnitPrice,
+		}
+	}
+
+	return itemDTOs
+}
@@ -0,0 +1,82 @@
+package presenters
+
+import (
+	"tech_challenge/internal/application/dtos"
+	"tech_challenge/internal/domain/entities"
+)
+
+func ToResponsePrinter(printer entities.Printer) dtos.PrinterResponseDTO {
+	return dtos.PrinterResponseDTO{
+		ID:        printer.ID,
+		Name:      printer.Name,
+		Host:      printer.Host,
+		Port:      printer.Port,
+		Station:   printer.Station,
+		Width:     printer.Width,
+		Active:    printer.Active,
+		CreatedAt: printer.CreatedAt,
+		UpdatedAt: printer.UpdatedAt,
+	}
+}
+
+func ToResponseListPrinter(printers []entities.Printer) []dtos.PrinterResponseDTO {
+	printerResponse := make([]dtos.PrinterResponseDTO, len(printers))
+
+	for i, printer := range printers {
+		printerResponse[i] = ToResponsePrinter(printer)
+	}
+
+	return printerResponse
+}
+
+func ToResponseListPrintRoute(routes []entities.PrintRoute) []dtos.PrintRouteResponseDTO {
+	routeResponse := make([]dtos.PrintRouteResponseDTO, len(routes))
+
+	for i, route := range routes {
+		routeResponse[i] = dtos.PrintRouteResponseDTO{
+			ID:             route.ID,
+			Position:       route.Position,
+			ProductPattern: route.ProductPattern,
+			Station:        route.Station,
+		}
+	}
+
+	return routeResponse
+}
+
+func ToResponsePrintJob(job entities.PrintJob) dtos.PrintJobResponseDTO {
+	return dtos.PrintJobResponseDTO{
+		ID:             job.ID,
+		KitchenOrderID: job.KitchenOrderID,
+		PrinterID:      job.PrinterID,
+		Station:        job.Station,
+		ItemIDs:        job.ItemIDs,
+		Status:         job.Status,
+		Attempts:       job.Attempts,
+		LastError:      job.LastError,
+		NextAttemptAt:  job.NextAttemptAt,
+		PrintedAt:      job.PrintedAt,
+		Reprint:        job.Reprint,
+		RequestedBy:    job.RequestedBy,
+		CreatedAt:      job.CreatedAt,
+		UpdatedAt:      job.UpdatedAt,
+	}
+}
+
+func ToResponseListPrintJob(jobs []entities.PrintJob) []dtos.PrintJobResponseDTO {
+	jobResponse := make([]dtos.PrintJobResponseDTO, len(jobs))
+
+	for i, job := range jobs {
+		jobResponse[i] = ToResponsePrintJob(job)
+	}
+
+	return jobResponse
+}
+
+func ToPrinterTarget(printer entities.Printer) dtos.PrinterTargetDTO {
+	return dtos.PrinterTargetDTO{
+		ID:      printer.ID,
+		Address: printer.Address(),
+		Width:   printer.Width,
+	}
+}
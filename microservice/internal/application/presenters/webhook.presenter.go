@@ -0,0 +1,60 @@
+package presenters
+
+import (
+	"tech_challenge/internal/application/dtos"
+	"tech_challenge/internal/domain/entities"
+)
+
+// ToResponseWebhookSubscription omite o segredo, que só é exibido na criação
+func ToResponseWebhookSubscription(subscription entities.WebhookSubscription) dtos.WebhookSubscriptionResponseDTO {
+	return dtos.WebhookSubscriptionResponseDTO{
+		ID:                  subscription.ID,
+		URL:                 subscription.URL,
+		Events:              subscription.Events,
+		Active:              subscription.Active,
+		ConsecutiveFailures: subscription.ConsecutiveFailures,
+		CreatedAt:           subscription.CreatedAt,
+		UpdatedAt:           subscription.UpdatedAt,
+	}
+}
+
+func ToResponseCreatedWebhookSubscription(subscription entities.WebhookSubscription) dtos.WebhookSubscriptionResponseDTO {
+	response := ToResponseWebhookSubscription(subscription)
+	response.Secret = subscription.Secret
+	return response
+}
+
+func ToResponseListWebhookSubscription(subscriptions []entities.WebhookSubscription) []dtos.WebhookSubscriptionResponseDTO {
+	subscriptionResponse := make([]dtos.WebhookSubscriptionResponseDTO, len(subscriptions))
+
+	for i, subscription := range subscriptions {
+		subscriptionResponse[i] = ToResponseWebhookSubscription(subscription)
+	}
+
+	return subscriptionResponse
+}
+
+func ToResponseWebhookDelivery(delivery entities.WebhookDelivery) dtos.WebhookDeliveryResponseDTO {
+	return dtos.WebhookDeliveryResponseDTO{
+		ID:             delivery.ID,
+		SubscriptionID: delivery.SubscriptionID,
+		EventID:        delivery.EventID,
+		Event:          delivery.Event,
+		Attempt:        delivery.Attempt,
+		StatusCode:     delivery.StatusCode,
+		Success:        delivery.Success,
+		Error:          delivery.Error,
+		DurationMs:     delivery.DurationMs,
+		CreatedAt:      delivery.CreatedAt,
+	}
+}
+
+func ToResponseListWebhookDelivery(deliveries []entities.WebhookDelivery) []dtos.WebhookDeliveryResponseDTO {
+	deliveryResponse := make([]dtos.WebhookDeliveryResponseDTO, len(deliveries))
+
+	for i, delivery := range deliveries {
+		deliveryResponse[i] = ToResponseWebhookDelivery(delivery)
+	}
+
+	return deliveryResponse
+}
@@ -0,0 +1,15 @@
+package daos
+
+import "time"
+
+type APIKeyDAO struct {
+	ID         string
+	Name       string
+	Prefix     string
+	KeyHash    string
+	Scopes     []string
+	CreatedBy  *string
+	CreatedAt  time.Time
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+}
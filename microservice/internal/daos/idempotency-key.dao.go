@@ -0,0 +1,11 @@
+package daos
+
+import "time"
+
+type IdempotencyKeyDAO struct {
+	Scope       string
+	Key         string
+	Fingerprint string
+	ResourceID  *string
+	CreatedAt   time.Time
+}
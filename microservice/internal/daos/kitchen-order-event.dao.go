@@ -0,0 +1,16 @@
+package daos
+
+import "time"
+
+type KitchenOrderEventDAO struct {
+	ID             uint64
+	Type           string
+	KitchenOrderID string
+	OrderID        string
+	Slug           string
+	StatusID       string
+	StatusName     string
+	Reason         *string
+	Actor          *string
+	CreatedAt      time.Time
+}
@@ -3,15 +3,21 @@ package daos
 import "time"
 
 type KitchenOrderDAO struct {
-	ID         string
-	OrderID    string
-	CustomerID *string
-	Amount     float64
-	Status     OrderStatusDAO
-	Slug       string
-	Items      []OrderItemDAO
-	CreatedAt  time.Time
-	UpdatedAt  *time.Time
+	ID              string
+	OrderID         string
+	CustomerID      *string
+	Amount          float64
+	Status          OrderStatusDAO
+	Slug            string
+	Items           []OrderItemDAO
+	Version         uint64
+	StatusReason    *string
+	StatusChangedBy *string
+	PreparingAt     *time.Time
+	ReadyAt         *time.Time
+	FinishedAt      *time.Time
+	CreatedAt       time.Time
+	UpdatedAt       *time.Time
 }
 
 type OrderItemDAO struct {
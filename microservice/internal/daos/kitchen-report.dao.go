@@ -0,0 +1,32 @@
+package daos
+
+import "time"
+
+type ThroughputBucketDAO struct {
+	PeriodStart time.Time
+	Received    int
+	Finished    int
+}
+
+type StageDurationDAO struct {
+	// PeriodStart é nil na linha que resume o período inteiro
+	PeriodStart    *time.Time
+	StatusID       string
+	StatusName     string
+	Orders         int
+	AverageSeconds float64
+	P90Seconds     float64
+}
+
+type ProductPrepTimeDAO struct {
+	ProductID      string
+	Orders         int
+	AverageSeconds float64
+	P90Seconds     float64
+}
+
+type BoardStatusCountDAO struct {
+	StatusID   string
+	StatusName string
+	Orders     int
+}
@@ -0,0 +1,39 @@
+package daos
+
+import "time"
+
+type PrinterDAO struct {
+	ID        string
+	Name      string
+	Host      string
+	Port      int
+	Station   string
+	Width     int
+	Active    bool
+	CreatedAt time.Time
+	UpdatedAt *time.Time
+}
+
+type PrintRouteDAO struct {
+	ID             string
+	Position       int
+	ProductPattern string
+	Station        string
+}
+
+type PrintJobDAO struct {
+	ID             string
+	KitchenOrderID string
+	PrinterID      string
+	Station        string
+	ItemIDs        []string
+	Status         string
+	Attempts       int
+	LastError      string
+	NextAttemptAt  time.Time
+	PrintedAt      *time.Time
+	Reprint        bool
+	RequestedBy    *string
+	CreatedAt      time.Time
+	UpdatedAt      *time.Time
+}
@@ -0,0 +1,42 @@
+package daos
+
+import "time"
+
+type WebhookSubscriptionDAO struct {
+	ID                  string
+	URL                 string
+	Secret              string
+	Events              []string
+	Active              bool
+	ConsecutiveFailures int
+	CreatedAt           time.Time
+	UpdatedAt           *time.Time
+}
+
+type WebhookDeliveryDAO struct {
+	ID             string
+	SubscriptionID string
+	EventID        string
+	Event          string
+	Attempt        int
+	StatusCode     int
+	Success        bool
+	Error          string
+	DurationMs     int64
+	CreatedAt      time.Time
+}
+
+type WebhookOutboxDAO struct {
+	ID             string
+	SubscriptionID string
+	EventID        string
+	Event          string
+	Payload        []byte
+	Status         string
+	Attempts       int
+	LastError      string
+	NextAttemptAt  time.Time
+	DeliveredAt    *time.Time
+	CreatedAt      time.Time
+	UpdatedAt      *time.Time
+}
@@ -0,0 +1,108 @@
+package entities
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"tech_challenge/internal/domain/exceptions"
+	"tech_challenge/internal/shared/config/constants"
+	identity_manager "tech_challenge/internal/shared/pkg/identity"
+)
+
+var APIKeyScopes = []string{
+	constants.API_KEY_SCOPE_KITCHEN_ORDERS_READ,
+	constants.API_KEY_SCOPE_KITCHEN_ORDERS_CREATE,
+	constants.API_KEY_SCOPE_WEBHOOKS_MANAGE,
+	constants.API_KEY_SCOPE_PRINTERS_MANAGE,
+	constants.API_KEY_SCOPE_REPORTS_READ,
+}
+
+// APIKey identifica outro serviço que chama a API; apenas o hash da chave é guardado
+type APIKey struct {
+	ID         string
+	Name       string
+	Prefix     string
+	KeyHash    string
+	Scopes     []string
+	CreatedBy  *string
+	CreatedAt  time.Time
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+}
+
+func NewAPIKey(id, name, prefix, keyHash string, scopes []string, createdBy *string, createdAt time.Time, lastUsedAt, revokedAt *time.Time) (*APIKey, error) {
+	name = strings.TrimSpace(name)
+
+	if name == "" {
+		return nil, &exceptions.InvalidAPIKeyDataException{
+			Message: "API key name must not be empty",
+		}
+	}
+
+	if len(name) > constants.API_KEY_NAME_MAX_LENGTH {
+		return nil, &exceptions.InvalidAPIKeyDataException{
+			Message: "API key name must be at most 100 characters long",
+		}
+	}
+
+	if err := ValidateAPIKeyScopes(scopes); err != nil {
+		return nil, err
+	}
+
+	return &APIKey{
+		ID:         id,
+		Name:       name,
+		Prefix:     prefix,
+		KeyHash:    keyHash,
+		Scopes:     scopes,
+		CreatedBy:  createdBy,
+		CreatedAt:  createdAt,
+		LastUsedAt: lastUsedAt,
+		RevokedAt:  revokedAt,
+	}, nil
+}
+
+func ValidateAPIKeyID(id string) error {
+	if !identity_manager.IsValidUUID(id) {
+		return &exceptions.InvalidAPIKeyDataException{
+			Message: "Invalid API Key ID",
+		}
+	}
+
+	return nil
+}
+
+func ValidateAPIKeyScopes(scopes []string) error {
+	if len(scopes) == 0 {
+		return &exceptions.InvalidAPIKeyDataException{
+			Message: "API key must have at least one scope",
+		}
+	}
+
+	for _, scope := range scopes {
+		if !slices.Contains(APIKeyScopes, scope) {
+			return &exceptions.InvalidAPIKeyDataException{
+				Message: "Unknown API key scope: " + scope,
+			}
+		}
+	}
+
+	return nil
+}
+
+func (k *APIKey) IsRevoked() bool {
+	return k.RevokedAt != nil
+}
+
+// Revoke preserva a data da primeira revogação quando chamado novamente
+func (k *APIKey) Revoke(revokedAt time.Time) {
+	if k.RevokedAt == nil {
+		k.RevokedAt = &revokedAt
+	}
+}
+
+// ShouldTouchLastUsed limita a frequência de gravação do último uso para não escrever no banco a cada requisição
+func (k *APIKey) ShouldTouchLastUsed(now time.Time, interval time.Duration) bool {
+	return k.LastUsedAt == nil || now.Sub(*k.LastUsedAt) >= interval
+}
@@ -0,0 +1,86 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"tech_challenge/internal/domain/exceptions"
+	"tech_challenge/internal/shared/config/constants"
+)
+
+func TestNewAPIKey_Success(t *testing.T) {
+	now := time.Now()
+	createdBy := "manager-1"
+
+	apiKey, err := NewAPIKey(
+		"550e8400-e29b-41d4-a716-446655440000",
+		"  orders-service ",
+		"0123456789ab",
+		"hash",
+		[]string{constants.API_KEY_SCOPE_KITCHEN_ORDERS_CREATE},
+		&createdBy,
+		now,
+		nil,
+		nil,
+	)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "orders-service", apiKey.Name)
+	assert.Equal(t, &createdBy, apiKey.CreatedBy)
+	assert.False(t, apiKey.IsRevoked())
+}
+
+func TestNewAPIKey_InvalidData(t *testing.T) {
+	scopes := []string{constants.API_KEY_SCOPE_KITCHEN_ORDERS_READ}
+	longName := string(make([]byte, constants.API_KEY_NAME_MAX_LENGTH+1))
+
+	cases := map[string]struct {
+		name   string
+		scopes []string
+	}{
+		"empty name":    {"   ", scopes},
+		"long name":     {longName, scopes},
+		"no scopes":     {"orders-service", nil},
+		"unknown scope": {"orders-service", []string{"kitchen-orders:delete"}},
+	}
+
+	for description, tc := range cases {
+		_, err := NewAPIKey("id", tc.name, "prefix", "hash", tc.scopes, nil, time.Now(), nil, nil)
+
+		assert.IsType(t, &exceptions.InvalidAPIKeyDataException{}, err, description)
+	}
+}
+
+func TestValidateAPIKeyID(t *testing.T) {
+	assert.NoError(t, ValidateAPIKeyID("550e8400-e29b-41d4-a716-446655440000"))
+	assert.IsType(t, &exceptions.InvalidAPIKeyDataException{}, ValidateAPIKeyID("invalid"))
+}
+
+func TestAPIKey_RevokeKeepsFirstRevocation(t *testing.T) {
+	apiKey := APIKey{}
+	first := time.Now().Add(-time.Hour)
+
+	apiKey.Revoke(first)
+	apiKey.Revoke(time.Now())
+
+	assert.True(t, apiKey.IsRevoked())
+	assert.Equal(t, first, *apiKey.RevokedAt)
+}
+
+func TestAPIKey_ShouldTouchLastUsed(t *testing.T) {
+	now := time.Now()
+	apiKey := APIKey{}
+
+	assert.True(t, apiKey.ShouldTouchLastUsed(now, time.Minute))
+
+	recent := now.Add(-30 * time.Second)
+	apiKey.LastUsedAt = &recent
+	assert.False(t, apiKey.ShouldTouchLastUsed(now, time.Minute))
+
+	old := now.Add(-2 * time.Minute)
+	apiKey.LastUsedAt = &old
+	assert.True(t, apiKey.ShouldTouchLastUsed(now, time.Minute))
+	t.Log("✓ Último uso gravado no máximo uma vez por intervalo")
+}
@@ -0,0 +1,63 @@
+package entities
+
+import (
+	"time"
+
+	"tech_challenge/internal/shared/config/constants"
+)
+
+type DisplayBoardEntry struct {
+	Slug  string
+	Since time.Time
+}
+
+// DisplayBoard é a visão pública do quadro: apenas senhas, sem dados do pedido ou do cliente
+type DisplayBoard struct {
+	Preparing []DisplayBoardEntry
+	Ready     []DisplayBoardEntry
+}
+
+// NewDisplayBoard agrupa os pedidos por coluna; pedidos prontos há mais de readyTTL saem do quadro
+func NewDisplayBoard(kitchenOrders []KitchenOrder, now time.Time, readyTTL time.Duration) DisplayBoard {
+	board := DisplayBoard{
+		Preparing: []DisplayBoardEntry{},
+		Ready:     []DisplayBoardEntry{},
+	}
+
+	for _, kitchenOrder := range kitchenOrders {
+		entry := DisplayBoardEntry{
+			Slug:  kitchenOrder.Slug.Value(),
+			Since: displayBoardSince(kitchenOrder),
+		}
+
+		switch kitchenOrder.Status.ID {
+		case constants.KITCHEN_ORDER_STATUS_RECEIVED_ID, constants.KITCHEN_ORDER_STATUS_PREPARING_ID:
+			board.Preparing = append(board.Preparing, entry)
+		case constants.KITCHEN_ORDER_STATUS_READY_ID:
+			if readyTTL <= 0 || now.Sub(entry.Since) < readyTTL {
+				board.Ready = append(board.Ready, entry)
+			}
+		}
+	}
+
+	return board
+}
+
+// displayBoardSince devolve quando o pedido entrou na coluna atual. O UpdatedAt muda em qualquer alteração
+// (como um motivo ou uma reimpressão) e só é usado para pedidos anteriores aos timestamps de etapa
+func displayBoardSince(kitchenOrder KitchenOrder) time.Time {
+	stageAt := kitchenOrder.PreparingAt
+	if kitchenOrder.Status.ID == constants.KITCHEN_ORDER_STATUS_READY_ID {
+		stageAt = kitchenOrder.ReadyAt
+	}
+
+	if stageAt != nil {
+		return *stageAt
+	}
+
+	if kitchenOrder.UpdatedAt != nil {
+		return *kitchenOrder.UpdatedAt
+	}
+
+	return kitchenOrder.CreatedAt
+}
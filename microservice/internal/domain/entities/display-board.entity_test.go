@@ -0,0 +1,79 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"tech_challenge/internal/shared/config/constants"
+)
+
+func newDisplayBoardTestOrder(t *testing.T, slug, statusID string, createdAt time.Time, updatedAt *time.Time) KitchenOrder {
+	t.Helper()
+
+	status, err := NewOrderStatus(statusID, "Status")
+	assert.NoError(t, err)
+
+	order, err := NewKitchenOrder("550e8400-e29b-41d4-a716-446655440000", "order-"+slug, slug, *status, createdAt, updatedAt)
+	assert.NoError(t, err)
+
+	return *order
+}
+
+func TestNewDisplayBoard_GroupsByColumn(t *testing.T) {
+	now := time.Now()
+	readyAt := now.Add(-time.Minute)
+
+	board := NewDisplayBoard([]KitchenOrder{
+		newDisplayBoardTestOrder(t, "003", constants.KITCHEN_ORDER_STATUS_READY_ID, now.Add(-10*time.Minute), &readyAt),
+		newDisplayBoardTestOrder(t, "001", constants.KITCHEN_ORDER_STATUS_RECEIVED_ID, now, nil),
+		newDisplayBoardTestOrder(t, "002", constants.KITCHEN_ORDER_STATUS_PREPARING_ID, now, nil),
+		newDisplayBoardTestOrder(t, "004", constants.KITCHEN_ORDER_STATUS_FINISHED_ID, now, nil),
+	}, now, 5*time.Minute)
+
+	assert.Equal(t, []DisplayBoardEntry{{Slug: "001", Since: now}, {Slug: "002", Since: now}}, board.Preparing)
+	assert.Equal(t, []DisplayBoardEntry{{Slug: "003", Since: readyAt}}, board.Ready)
+}
+
+func TestNewDisplayBoard_HidesExpiredReadyOrders(t *testing.T) {
+	now := time.Now()
+	readyAt := now.Add(-6 * time.Minute)
+
+	board := NewDisplayBoard([]KitchenOrder{
+		newDisplayBoardTestOrder(t, "003", constants.KITCHEN_ORDER_STATUS_READY_ID, now.Add(-10*time.Minute), &readyAt),
+	}, now, 5*time.Minute)
+
+	assert.Empty(t, board.Ready)
+	assert.NotNil(t, board.Ready, "empty columns are rendered as empty lists")
+	t.Log("✓ Pedido pronto há mais tempo que o limite saiu do quadro")
+}
+
+func TestNewDisplayBoard_ReadyTTLStartsAtReadyAt(t *testing.T) {
+	now := time.Now()
+	readyAt := now.Add(-6 * time.Minute)
+	updatedAt := now.Add(-time.Minute)
+
+	// Uma alteração posterior (ex.: reimpressão do ticket) não pode manter o pedido no quadro
+	order := newDisplayBoardTestOrder(t, "003", constants.KITCHEN_ORDER_STATUS_READY_ID, now.Add(-10*time.Minute), &updatedAt)
+	order.ReadyAt = &readyAt
+
+	board := NewDisplayBoard([]KitchenOrder{order}, now, 5*time.Minute)
+	assert.Empty(t, board.Ready)
+
+	order.ReadyAt = &updatedAt
+	board = NewDisplayBoard([]KitchenOrder{order}, now, 5*time.Minute)
+	assert.Equal(t, []DisplayBoardEntry{{Slug: "003", Since: updatedAt}}, board.Ready)
+	t.Log("✓ Tempo no quadro medido a partir do ReadyAt")
+}
+
+func TestNewDisplayBoard_ZeroTTLKeepsReadyOrders(t *testing.T) {
+	now := time.Now()
+	readyAt := now.Add(-24 * time.Hour)
+
+	board := NewDisplayBoard([]KitchenOrder{
+		newDisplayBoardTestOrder(t, "003", constants.KITCHEN_ORDER_STATUS_READY_ID, readyAt, &readyAt),
+	}, now, 0)
+
+	assert.Len(t, board.Ready, 1)
+}
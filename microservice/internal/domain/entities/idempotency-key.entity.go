@@ -0,0 +1,48 @@
+package entities
+
+import (
+	"strings"
+	"time"
+
+	"tech_challenge/internal/domain/exceptions"
+	"tech_challenge/internal/shared/config/constants"
+)
+
+// IdempotencyKey associa a chave enviada pelo cliente ao recurso criado na primeira execução da requisição
+type IdempotencyKey struct {
+	Scope       string
+	Key         string
+	Fingerprint string
+	ResourceID  *string
+	CreatedAt   time.Time
+}
+
+func NewIdempotencyKey(scope, key, fingerprint string, resourceID *string, createdAt time.Time) (*IdempotencyKey, error) {
+	if strings.TrimSpace(key) == "" {
+		return nil, &exceptions.InvalidIdempotencyKeyException{
+			Message: "Idempotency-Key must not be empty",
+		}
+	}
+
+	if len(key) > constants.IDEMPOTENCY_KEY_MAX_LENGTH {
+		return nil, &exceptions.InvalidIdempotencyKeyException{
+			Message: "Idempotency-Key must be at most 255 characters long",
+		}
+	}
+
+	return &IdempotencyKey{
+		Scope:       scope,
+		Key:         key,
+		Fingerprint: fingerprint,
+		ResourceID:  resourceID,
+		CreatedAt:   createdAt,
+	}, nil
+}
+
+func (k *IdempotencyKey) IsCompleted() bool {
+	return k.ResourceID != nil
+}
+
+func (k *IdempotencyKey) Matches(fingerprint string) bool {
+	return k.Fingerprint == fingerprint
+}
@@ -0,0 +1,37 @@
+package entities
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"tech_challenge/internal/domain/exceptions"
+	"tech_challenge/internal/shared/config/constants"
+)
+
+func TestNewIdempotencyKey_Success(t *testing.T) {
+	key, err := NewIdempotencyKey(constants.IDEMPOTENCY_SCOPE_CREATE_KITCHEN_ORDER, "key-1", "abc", nil, time.Now())
+
+	assert.NoError(t, err)
+	assert.False(t, key.IsCompleted())
+	assert.True(t, key.Matches("abc"))
+	assert.False(t, key.Matches("def"))
+}
+
+func TestNewIdempotencyKey_InvalidKeys(t *testing.T) {
+	for _, invalidKey := range []string{"", "   ", strings.Repeat("a", constants.IDEMPOTENCY_KEY_MAX_LENGTH+1)} {
+		_, err := NewIdempotencyKey(constants.IDEMPOTENCY_SCOPE_CREATE_KITCHEN_ORDER, invalidKey, "abc", nil, time.Now())
+
+		assert.IsType(t, &exceptions.InvalidIdempotencyKeyException{}, err)
+	}
+}
+
+func TestIdempotencyKey_IsCompleted(t *testing.T) {
+	resourceID := "550e8400-e29b-41d4-a716-446655440000"
+	key, _ := NewIdempotencyKey(constants.IDEMPOTENCY_SCOPE_CREATE_KITCHEN_ORDER, "key-1", "abc", &resourceID, time.Now())
+
+	assert.True(t, key.IsCompleted())
+	t.Log("✓ Chave com recurso associado está concluída")
+}
@@ -0,0 +1,43 @@
+package entities
+
+import (
+	"slices"
+	"time"
+)
+
+type BoardStatusCount struct {
+	StatusID   string
+	StatusName string
+	Orders     int
+}
+
+// KitchenBoardStats resume o quadro da cozinha no momento da consulta
+type KitchenBoardStats struct {
+	Statuses []BoardStatusCount
+	// OldestReceivedAge é zero quando não há pedidos aguardando preparo
+	OldestReceivedAge time.Duration
+}
+
+// NewKitchenBoardStats ordena os status pelo fluxo da cozinha e inclui com zero os que não vieram na contagem
+func NewKitchenBoardStats(counts []BoardStatusCount, oldestReceivedAt *time.Time, now time.Time) KitchenBoardStats {
+	stats := KitchenBoardStats{
+		Statuses: make([]BoardStatusCount, len(ReportStageStatusIDs)),
+	}
+
+	for i, statusID := range ReportStageStatusIDs {
+		stats.Statuses[i] = BoardStatusCount{StatusID: statusID}
+
+		position := slices.IndexFunc(counts, func(count BoardStatusCount) bool {
+			return count.StatusID == statusID
+		})
+		if position >= 0 {
+			stats.Statuses[i] = counts[position]
+		}
+	}
+
+	if oldestReceivedAt != nil && now.After(*oldestReceivedAt) {
+		stats.OldestReceivedAge = now.Sub(*oldestReceivedAt)
+	}
+
+	return stats
+}
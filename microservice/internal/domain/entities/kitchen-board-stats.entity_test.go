@@ -0,0 +1,35 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"tech_challenge/internal/shared/config/constants"
+)
+
+func TestNewKitchenBoardStats_FillsMissingStatuses(t *testing.T) {
+	now := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+	oldestReceivedAt := now.Add(-90 * time.Second)
+
+	stats := NewKitchenBoardStats([]BoardStatusCount{
+		{StatusID: constants.KITCHEN_ORDER_STATUS_PREPARING_ID, StatusName: "Em preparação", Orders: 2},
+	}, &oldestReceivedAt, now)
+
+	assert.Equal(t, []BoardStatusCount{
+		{StatusID: constants.KITCHEN_ORDER_STATUS_RECEIVED_ID},
+		{StatusID: constants.KITCHEN_ORDER_STATUS_PREPARING_ID, StatusName: "Em preparação", Orders: 2},
+		{StatusID: constants.KITCHEN_ORDER_STATUS_READY_ID},
+	}, stats.Statuses)
+	assert.Equal(t, 90*time.Second, stats.OldestReceivedAge)
+	t.Log("✓ Status sem pedidos aparecem com zero")
+}
+
+func TestNewKitchenBoardStats_NoReceivedOrders(t *testing.T) {
+	now := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+	future := now.Add(time.Minute)
+
+	assert.Zero(t, NewKitchenBoardStats(nil, nil, now).OldestReceivedAge)
+	assert.Zero(t, NewKitchenBoardStats(nil, &future, now).OldestReceivedAge, "clock skew never yields a negative age")
+}
@@ -0,0 +1,91 @@
+package entities
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"tech_challenge/internal/domain/exceptions"
+	"tech_challenge/internal/shared/config/constants"
+)
+
+type kitchenOrderCommandTransition struct {
+	from []string
+	to   string
+}
+
+// kitchenOrderCommandTransitions define de quais status cada comando do KDS pode partir
+var kitchenOrderCommandTransitions = map[string]kitchenOrderCommandTransition{
+	constants.KITCHEN_ORDER_COMMAND_START: {
+		from: []string{constants.KITCHEN_ORDER_STATUS_RECEIVED_ID},
+		to:   constants.KITCHEN_ORDER_STATUS_PREPARING_ID,
+	},
+	constants.KITCHEN_ORDER_COMMAND_READY: {
+		// Itens rápidos podem ir direto do recebimento para o balcão
+		from: []string{constants.KITCHEN_ORDER_STATUS_RECEIVED_ID, constants.KITCHEN_ORDER_STATUS_PREPARING_ID},
+		to:   constants.KITCHEN_ORDER_STATUS_READY_ID,
+	},
+	constants.KITCHEN_ORDER_COMMAND_FINISH: {
+		from: []string{constants.KITCHEN_ORDER_STATUS_READY_ID},
+		to:   constants.KITCHEN_ORDER_STATUS_FINISHED_ID,
+	},
+	constants.KITCHEN_ORDER_COMMAND_RECALL: {
+		// Recall devolve para a preparação um pedido que já saiu dela
+		from: []string{constants.KITCHEN_ORDER_STATUS_READY_ID, constants.KITCHEN_ORDER_STATUS_FINISHED_ID},
+		to:   constants.KITCHEN_ORDER_STATUS_PREPARING_ID,
+	},
+}
+
+// ResolveKitchenOrderCommand traduz um comando do KDS no status de destino do pedido
+func ResolveKitchenOrderCommand(command string, currentStatusID string) (string, error) {
+	transition, ok := kitchenOrderCommandTransitions[command]
+	if !ok {
+		return "", &exceptions.InvalidKitchenOrderCommandException{
+			Message: "Unknown kitchen order command: " + command,
+		}
+	}
+
+	if !slices.Contains(transition.from, currentStatusID) {
+		return "", &exceptions.InvalidKitchenOrderTransitionException{
+			Message: fmt.Sprintf("Kitchen order cannot %s from its current status", command),
+		}
+	}
+
+	return transition.to, nil
+}
+
+// ValidateKitchenOrderStatusChange é a regra comum ao PUT, à alteração em lote e aos comandos do KDS: qualquer avanço é permitido,
+// inclusive finalizar direto no fechamento do turno, mas devolver à preparação um pedido pronto ou finalizado é um recall e exige motivo
+func ValidateKitchenOrderStatusChange(currentStatusID string, statusID string, reason *string) error {
+	recall := kitchenOrderCommandTransitions[constants.KITCHEN_ORDER_COMMAND_RECALL]
+	if statusID != recall.to || !slices.Contains(recall.from, currentStatusID) {
+		return nil
+	}
+
+	if reason == nil {
+		return &exceptions.InvalidKitchenOrderTransitionException{
+			Message: "Kitchen order can only go back to preparation through a recall with a reason",
+		}
+	}
+
+	return ValidateKitchenOrderStatusReason(*reason)
+}
+
+// ValidateKitchenOrderStatusReason valida o motivo obrigatório de um recall
+func ValidateKitchenOrderStatusReason(reason string) error {
+	reason = strings.TrimSpace(reason)
+
+	if reason == "" {
+		return &exceptions.InvalidKitchenOrderCommandException{
+			Message: "Reason is required to recall a kitchen order",
+		}
+	}
+
+	if len(reason) > constants.KITCHEN_ORDER_STATUS_REASON_MAX_LENGTH {
+		return &exceptions.InvalidKitchenOrderCommandException{
+			Message: fmt.Sprintf("Reason must be at most %d characters", constants.KITCHEN_ORDER_STATUS_REASON_MAX_LENGTH),
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,109 @@
+package entities
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"tech_challenge/internal/domain/exceptions"
+	"tech_challenge/internal/shared/config/constants"
+)
+
+func TestResolveKitchenOrderCommand_ForwardCommands(t *testing.T) {
+	cases := []struct {
+		command  string
+		from     string
+		expected string
+	}{
+		{constants.KITCHEN_ORDER_COMMAND_START, constants.KITCHEN_ORDER_STATUS_RECEIVED_ID, constants.KITCHEN_ORDER_STATUS_PREPARING_ID},
+		{constants.KITCHEN_ORDER_COMMAND_READY, constants.KITCHEN_ORDER_STATUS_PREPARING_ID, constants.KITCHEN_ORDER_STATUS_READY_ID},
+		{constants.KITCHEN_ORDER_COMMAND_READY, constants.KITCHEN_ORDER_STATUS_RECEIVED_ID, constants.KITCHEN_ORDER_STATUS_READY_ID},
+		{constants.KITCHEN_ORDER_COMMAND_FINISH, constants.KITCHEN_ORDER_STATUS_READY_ID, constants.KITCHEN_ORDER_STATUS_FINISHED_ID},
+	}
+
+	for _, tc := range cases {
+		statusID, err := ResolveKitchenOrderCommand(tc.command, tc.from)
+
+		assert.NoError(t, err, "command %q", tc.command)
+		assert.Equal(t, tc.expected, statusID, "command %q", tc.command)
+	}
+}
+
+func TestResolveKitchenOrderCommand_InvalidTransitions(t *testing.T) {
+	cases := []struct {
+		command string
+		from    string
+	}{
+		{constants.KITCHEN_ORDER_COMMAND_START, constants.KITCHEN_ORDER_STATUS_PREPARING_ID},
+		{constants.KITCHEN_ORDER_COMMAND_START, constants.KITCHEN_ORDER_STATUS_FINISHED_ID},
+		{constants.KITCHEN_ORDER_COMMAND_READY, constants.KITCHEN_ORDER_STATUS_READY_ID},
+		{constants.KITCHEN_ORDER_COMMAND_FINISH, constants.KITCHEN_ORDER_STATUS_PREPARING_ID},
+		{constants.KITCHEN_ORDER_COMMAND_FINISH, constants.KITCHEN_ORDER_STATUS_FINISHED_ID},
+	}
+
+	for _, tc := range cases {
+		_, err := ResolveKitchenOrderCommand(tc.command, tc.from)
+
+		assert.IsType(t, &exceptions.InvalidKitchenOrderTransitionException{}, err, "command %q", tc.command)
+	}
+
+	t.Log("✓ Transições fora da ordem da cozinha rejeitadas")
+}
+
+func TestResolveKitchenOrderCommand_Recall(t *testing.T) {
+	for _, current := range []string{constants.KITCHEN_ORDER_STATUS_READY_ID, constants.KITCHEN_ORDER_STATUS_FINISHED_ID} {
+		statusID, err := ResolveKitchenOrderCommand(constants.KITCHEN_ORDER_COMMAND_RECALL, current)
+
+		assert.NoError(t, err)
+		assert.Equal(t, constants.KITCHEN_ORDER_STATUS_PREPARING_ID, statusID)
+	}
+}
+
+func TestResolveKitchenOrderCommand_RecallNotAllowed(t *testing.T) {
+	for _, current := range []string{constants.KITCHEN_ORDER_STATUS_RECEIVED_ID, constants.KITCHEN_ORDER_STATUS_PREPARING_ID} {
+		_, err := ResolveKitchenOrderCommand(constants.KITCHEN_ORDER_COMMAND_RECALL, current)
+
+		assert.IsType(t, &exceptions.InvalidKitchenOrderTransitionException{}, err)
+	}
+}
+
+func TestResolveKitchenOrderCommand_Unknown(t *testing.T) {
+	_, err := ResolveKitchenOrderCommand("explode", constants.KITCHEN_ORDER_STATUS_RECEIVED_ID)
+
+	assert.IsType(t, &exceptions.InvalidKitchenOrderCommandException{}, err)
+	t.Log("✓ Comando desconhecido rejeitado")
+}
+
+func TestValidateKitchenOrderStatusReason(t *testing.T) {
+	assert.NoError(t, ValidateKitchenOrderStatusReason("Cliente devolveu o lanche frio"))
+
+	for _, reason := range []string{"", "   ", strings.Repeat("a", constants.KITCHEN_ORDER_STATUS_REASON_MAX_LENGTH+1)} {
+		assert.IsType(t, &exceptions.InvalidKitchenOrderCommandException{}, ValidateKitchenOrderStatusReason(reason))
+	}
+}
+
+func TestValidateKitchenOrderStatusChange(t *testing.T) {
+	reason := "Cliente devolveu o lanche frio"
+
+	allowed := [][2]string{
+		{constants.KITCHEN_ORDER_STATUS_RECEIVED_ID, constants.KITCHEN_ORDER_STATUS_PREPARING_ID},
+		{constants.KITCHEN_ORDER_STATUS_RECEIVED_ID, constants.KITCHEN_ORDER_STATUS_FINISHED_ID},
+		{constants.KITCHEN_ORDER_STATUS_PREPARING_ID, constants.KITCHEN_ORDER_STATUS_FINISHED_ID},
+		{constants.KITCHEN_ORDER_STATUS_READY_ID, constants.KITCHEN_ORDER_STATUS_FINISHED_ID},
+	}
+	for _, tc := range allowed {
+		assert.NoError(t, ValidateKitchenOrderStatusChange(tc[0], tc[1], nil), "%s -> %s", tc[0], tc[1])
+	}
+
+	for _, from := range []string{constants.KITCHEN_ORDER_STATUS_READY_ID, constants.KITCHEN_ORDER_STATUS_FINISHED_ID} {
+		err := ValidateKitchenOrderStatusChange(from, constants.KITCHEN_ORDER_STATUS_PREPARING_ID, nil)
+		assert.IsType(t, &exceptions.InvalidKitchenOrderTransitionException{}, err, "%s -> preparing without reason", from)
+
+		blank := "  "
+		err = ValidateKitchenOrderStatusChange(from, constants.KITCHEN_ORDER_STATUS_PREPARING_ID, &blank)
+		assert.IsType(t, &exceptions.InvalidKitchenOrderCommandException{}, err, "%s -> preparing with blank reason", from)
+
+		assert.NoError(t, ValidateKitchenOrderStatusChange(from, constants.KITCHEN_ORDER_STATUS_PREPARING_ID, &reason))
+	}
+}
@@ -0,0 +1,27 @@
+package entities
+
+import "time"
+
+type KitchenOrderEvent struct {
+	ID             uint64
+	Type           string
+	KitchenOrderID string
+	OrderID        string
+	Slug           string
+	Status         OrderStatus
+	Reason         *string
+	Actor          *string
+	OccurredAt     time.Time
+}
+
+func NewKitchenOrderEvent(id uint64, eventType, kitchenOrderID, orderID, slug string, status OrderStatus, occurredAt time.Time) *KitchenOrderEvent {
+	return &KitchenOrderEvent{
+		ID:             id,
+		Type:           eventType,
+		KitchenOrderID: kitchenOrderID,
+		OrderID:        orderID,
+		Slug:           slug,
+		Status:         status,
+		OccurredAt:     occurredAt,
+	}
+}
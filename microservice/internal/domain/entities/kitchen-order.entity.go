@@ -17,10 +17,22 @@ type KitchenOrder struct {
 	Status     OrderStatus
 	Slug       value_objects.Slug
 	Items      []OrderItem
-	CreatedAt  time.Time
-	UpdatedAt  *time.Time
+	Version    uint64
+	// StatusReason registra o motivo informado na última mudança de status, como no recall
+	StatusReason *string
+	// StatusChangedBy é o subject do token de quem fez a última mudança de status
+	StatusChangedBy *string
+	// PreparingAt, ReadyAt e FinishedAt registram quando o pedido entrou em cada etapa
+	PreparingAt     *time.Time
+	ReadyAt         *time.Time
+	FinishedAt      *time.Time
+	CreatedAt       time.Time
+	UpdatedAt       *time.Time
 }
 
+// Versão de um pedido recém-criado; cada alteração persistida incrementa a versão
+const kitchenOrderInitialVersion = 1
+
 func NewKitchenOrder(id, orderID, slug string, status OrderStatus, createdAt time.Time, updatedAt *time.Time) (*KitchenOrder, error) {
 	slugValueObject, err := value_objects.NewSlug(slug)
 	if err != nil {
@@ -34,6 +46,7 @@ func NewKitchenOrder(id, orderID, slug string, status OrderStatus, createdAt tim
 		StatusID:  status.ID,
 		Slug:      slugValueObject,
 		Items:     []OrderItem{},
+		Version:   kitchenOrderInitialVersion,
 		CreatedAt: createdAt,
 		UpdatedAt: updatedAt,
 	}, nil
@@ -54,6 +67,7 @@ func NewKitchenOrderWithOrderData(id, orderID, slug string, customerID *string,
 		StatusID:   status.ID,
 		Slug:       slugValueObject,
 		Items:      items,
+		Version:    kitchenOrderInitialVersion,
 		CreatedAt:  createdAt,
 		UpdatedAt:  updatedAt,
 	}, nil
@@ -84,3 +98,27 @@ func (c *KitchenOrder) CalcTotalAmount() {
 	}
 	c.Amount = total
 }
+
+// WaitingDuration é o tempo entre o recebimento do pedido e o início do preparo
+func (c *KitchenOrder) WaitingDuration() *time.Duration {
+	return durationBetween(&c.CreatedAt, c.PreparingAt)
+}
+
+// PreparationDuration é o tempo entre o início do preparo e o pedido ficar pronto
+func (c *KitchenOrder) PreparationDuration() *time.Duration {
+	return durationBetween(c.PreparingAt, c.ReadyAt)
+}
+
+// TotalDuration é o tempo entre o recebimento e a finalização do pedido
+func (c *KitchenOrder) TotalDuration() *time.Duration {
+	return durationBetween(&c.CreatedAt, c.FinishedAt)
+}
+
+func durationBetween(start, end *time.Time) *time.Duration {
+	if start == nil || end == nil || end.Before(*start) {
+		return nil
+	}
+
+	duration := end.Sub(*start)
+	return &duration
+}
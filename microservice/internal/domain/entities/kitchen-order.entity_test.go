@@ -280,3 +280,34 @@ func TestKitchenOrder_CalcTotalAmount(t *testing.T) {
 		t.Errorf("Expected amount %f, got %f", expected, kitchenOrder.Amount)
 	}
 }
+
+func TestKitchenOrder_Durations(t *testing.T) {
+	status, _ := NewOrderStatus(constants.KITCHEN_ORDER_STATUS_FINISHED_ID, "Finalizado")
+	createdAt := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+	kitchenOrder, _ := NewKitchenOrder("550e8400-e29b-41d4-a716-446655440000", "order-123", "001", *status, createdAt, nil)
+
+	if kitchenOrder.WaitingDuration() != nil || kitchenOrder.PreparationDuration() != nil || kitchenOrder.TotalDuration() != nil {
+		t.Errorf("Expected no durations before the order enters each stage")
+	}
+
+	preparingAt := createdAt.Add(2 * time.Minute)
+	readyAt := preparingAt.Add(8 * time.Minute)
+	finishedAt := readyAt.Add(2 * time.Minute)
+	kitchenOrder.PreparingAt = &preparingAt
+	kitchenOrder.ReadyAt = &readyAt
+	kitchenOrder.FinishedAt = &finishedAt
+
+	if *kitchenOrder.WaitingDuration() != 2*time.Minute {
+		t.Errorf("Expected waiting duration of 2m, got %v", *kitchenOrder.WaitingDuration())
+	}
+
+	if *kitchenOrder.PreparationDuration() != 8*time.Minute {
+		t.Errorf("Expected preparation duration of 8m, got %v", *kitchenOrder.PreparationDuration())
+	}
+
+	if *kitchenOrder.TotalDuration() != 12*time.Minute {
+		t.Errorf("Expected total duration of 12m, got %v", *kitchenOrder.TotalDuration())
+	} else {
+		t.Log("✓ Durações calculadas a partir dos horários de cada etapa")
+	}
+}
@@ -0,0 +1,170 @@
+package entities
+
+import (
+	"math"
+	"slices"
+	"time"
+
+	"tech_challenge/internal/shared/config/constants"
+)
+
+// ReportStageStatusIDs são os status medidos nos relatórios, na ordem do fluxo da cozinha; finalizado encerra o pedido
+var ReportStageStatusIDs = []string{
+	constants.KITCHEN_ORDER_STATUS_RECEIVED_ID,
+	constants.KITCHEN_ORDER_STATUS_PREPARING_ID,
+	constants.KITCHEN_ORDER_STATUS_READY_ID,
+}
+
+type ThroughputBucket struct {
+	PeriodStart     time.Time
+	Received        int
+	Finished        int
+	ReceivedPerHour float64
+	FinishedPerHour float64
+}
+
+type ThroughputReport struct {
+	From            time.Time
+	To              time.Time
+	GroupBy         string
+	Received        int
+	Finished        int
+	ReceivedPerHour float64
+	FinishedPerHour float64
+	Buckets         []ThroughputBucket
+}
+
+// NewThroughputReport completa com zero os períodos sem pedidos; a taxa por hora de cada período
+// considera só a parte dele que cai dentro do intervalo consultado
+func NewThroughputReport(from, to time.Time, groupBy string, counts []ThroughputBucket) ThroughputReport {
+	countsByPeriod := make(map[int64]ThroughputBucket, len(counts))
+	for _, count := range counts {
+		countsByPeriod[count.PeriodStart.Unix()] = count
+	}
+
+	report := ThroughputReport{From: from, To: to, GroupBy: groupBy}
+
+	for _, start := range ReportPeriods(from, to, groupBy) {
+		bucket := countsByPeriod[start.Unix()]
+		bucket.PeriodStart = start
+
+		hours := overlapHours(start, nextReportPeriod(start, groupBy), from, to)
+		bucket.ReceivedPerHour = perHour(bucket.Received, hours)
+		bucket.FinishedPerHour = perHour(bucket.Finished, hours)
+
+		report.Received += bucket.Received
+		report.Finished += bucket.Finished
+		report.Buckets = append(report.Buckets, bucket)
+	}
+
+	hours := to.Sub(from).Hours()
+	report.ReceivedPerHour = perHour(report.Received, hours)
+	report.FinishedPerHour = perHour(report.Finished, hours)
+
+	return report
+}
+
+type StageDuration struct {
+	StatusID       string
+	StatusName     string
+	Orders         int
+	AverageSeconds float64
+	P90Seconds     float64
+}
+
+type PrepTimeBucket struct {
+	PeriodStart time.Time
+	Stages      []StageDuration
+}
+
+type ProductPrepTime struct {
+	ProductID      string
+	Orders         int
+	AverageSeconds float64
+	P90Seconds     float64
+}
+
+type PrepTimeReport struct {
+	From            time.Time
+	To              time.Time
+	GroupBy         string
+	Stages          []StageDuration
+	Buckets         []PrepTimeBucket
+	SlowestProducts []ProductPrepTime
+}
+
+// NewPrepTimeReport ordena os status de cada período pelo fluxo da cozinha; períodos sem pedidos ficam de fora
+func NewPrepTimeReport(from, to time.Time, groupBy string, stages []StageDuration, buckets []PrepTimeBucket, slowestProducts []ProductPrepTime) PrepTimeReport {
+	sortStageDurations(stages)
+	for _, bucket := range buckets {
+		sortStageDurations(bucket.Stages)
+	}
+
+	slices.SortFunc(buckets, func(a, b PrepTimeBucket) int {
+		return a.PeriodStart.Compare(b.PeriodStart)
+	})
+
+	return PrepTimeReport{
+		From:            from,
+		To:              to,
+		GroupBy:         groupBy,
+		Stages:          stages,
+		Buckets:         buckets,
+		SlowestProducts: slowestProducts,
+	}
+}
+
+// ReportPeriodStart trunca o horário para o início da hora ou do dia em UTC
+func ReportPeriodStart(t time.Time, groupBy string) time.Time {
+	t = t.UTC()
+
+	if groupBy == constants.REPORT_GROUP_BY_HOUR {
+		return t.Truncate(time.Hour)
+	}
+
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// ReportPeriods lista o início de cada período que toca o intervalo
+func ReportPeriods(from, to time.Time, groupBy string) []time.Time {
+	var periods []time.Time
+
+	for start := ReportPeriodStart(from, groupBy); !start.After(to); start = nextReportPeriod(start, groupBy) {
+		periods = append(periods, start)
+	}
+
+	return periods
+}
+
+func nextReportPeriod(start time.Time, groupBy string) time.Time {
+	if groupBy == constants.REPORT_GROUP_BY_HOUR {
+		return start.Add(time.Hour)
+	}
+
+	return start.AddDate(0, 0, 1)
+}
+
+func overlapHours(start, end, from, to time.Time) float64 {
+	if start.Before(from) {
+		start = from
+	}
+	if end.After(to) {
+		end = to
+	}
+
+	return end.Sub(start).Hours()
+}
+
+func perHour(count int, hours float64) float64 {
+	if count == 0 || hours <= 0 {
+		return 0
+	}
+
+	return math.Round(float64(count)/hours*100) / 100
+}
+
+func sortStageDurations(stages []StageDuration) {
+	slices.SortFunc(stages, func(a, b StageDuration) int {
+		return slices.Index(ReportStageStatusIDs, a.StatusID) - slices.Index(ReportStageStatusIDs, b.StatusID)
+	})
+}
@@ -0,0 +1,70 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tech_challenge/internal/shared/config/constants"
+)
+
+func TestReportPeriods(t *testing.T) {
+	from := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	to := time.Date(2024, 1, 15, 12, 59, 59, 0, time.UTC)
+
+	hours := ReportPeriods(from, to, constants.REPORT_GROUP_BY_HOUR)
+	require.Len(t, hours, 3)
+	assert.Equal(t, time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC), hours[0])
+	assert.Equal(t, time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC), hours[2])
+
+	// Dias são contados em UTC, mesmo quando o intervalo chega em outro fuso
+	saoPaulo := time.FixedZone("BRT", -3*60*60)
+	days := ReportPeriods(time.Date(2024, 1, 15, 22, 0, 0, 0, saoPaulo), time.Date(2024, 1, 16, 22, 0, 0, 0, saoPaulo), constants.REPORT_GROUP_BY_DAY)
+	assert.Equal(t, []time.Time{
+		time.Date(2024, 1, 16, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 1, 17, 0, 0, 0, 0, time.UTC),
+	}, days)
+}
+
+func TestNewThroughputReport_FillsGapsAndRates(t *testing.T) {
+	from := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	to := time.Date(2024, 1, 15, 13, 0, 0, 0, time.UTC)
+
+	report := NewThroughputReport(from, to, constants.REPORT_GROUP_BY_HOUR, []ThroughputBucket{
+		{PeriodStart: time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC), Received: 6, Finished: 3},
+		{PeriodStart: time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC), Received: 10, Finished: 12},
+	})
+
+	require.Len(t, report.Buckets, 4)
+	assert.Equal(t, 12.0, report.Buckets[0].ReceivedPerHour, "only half of the first hour is in the range")
+	assert.Equal(t, 0, report.Buckets[1].Received)
+	assert.Equal(t, 12.0, report.Buckets[2].FinishedPerHour)
+	assert.Equal(t, 0.0, report.Buckets[3].ReceivedPerHour, "the last period starts at the end of the range")
+
+	assert.Equal(t, 16, report.Received)
+	assert.Equal(t, 15, report.Finished)
+	assert.Equal(t, 6.4, report.ReceivedPerHour)
+	assert.Equal(t, 6.0, report.FinishedPerHour)
+	t.Log("✓ Períodos sem pedidos preenchidos com zero")
+}
+
+func TestNewPrepTimeReport_OrdersStagesByKitchenFlow(t *testing.T) {
+	stages := []StageDuration{
+		{StatusID: constants.KITCHEN_ORDER_STATUS_READY_ID},
+		{StatusID: constants.KITCHEN_ORDER_STATUS_RECEIVED_ID},
+		{StatusID: constants.KITCHEN_ORDER_STATUS_PREPARING_ID},
+	}
+	buckets := []PrepTimeBucket{
+		{PeriodStart: time.Date(2024, 1, 16, 0, 0, 0, 0, time.UTC)},
+		{PeriodStart: time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)},
+	}
+
+	report := NewPrepTimeReport(time.Time{}, time.Time{}, constants.REPORT_GROUP_BY_DAY, stages, buckets, nil)
+
+	assert.Equal(t, constants.KITCHEN_ORDER_STATUS_RECEIVED_ID, report.Stages[0].StatusID)
+	assert.Equal(t, constants.KITCHEN_ORDER_STATUS_PREPARING_ID, report.Stages[1].StatusID)
+	assert.Equal(t, constants.KITCHEN_ORDER_STATUS_READY_ID, report.Stages[2].StatusID)
+	assert.Equal(t, 15, report.Buckets[0].PeriodStart.Day())
+}
@@ -0,0 +1,98 @@
+package entities
+
+import (
+	"slices"
+	"time"
+
+	"tech_challenge/internal/shared/config/constants"
+)
+
+var PrintJobStatuses = []string{
+	constants.PRINT_JOB_STATUS_PENDING,
+	constants.PRINT_JOB_STATUS_PRINTING,
+	constants.PRINT_JOB_STATUS_PRINTED,
+	constants.PRINT_JOB_STATUS_FAILED,
+}
+
+// PrintJob é a comanda de um pedido na fila de uma impressora; o registro também serve de histórico de impressão
+type PrintJob struct {
+	ID             string
+	KitchenOrderID string
+	PrinterID      string
+	Station        string
+	// ItemIDs limita a comanda aos itens da praça; vazio imprime o pedido inteiro
+	ItemIDs   []string
+	Status    string
+	Attempts  int
+	LastError string
+	// NextAttemptAt é quando a próxima tentativa pode começar; durante a impressão marca o fim da reserva do trabalho
+	NextAttemptAt time.Time
+	PrintedAt     *time.Time
+	Reprint       bool
+	RequestedBy   *string
+	CreatedAt     time.Time
+	UpdatedAt     *time.Time
+}
+
+func NewPrintJob(id, kitchenOrderID, printerID, station string, itemIDs []string, reprint bool, requestedBy *string, createdAt time.Time) *PrintJob {
+	return &PrintJob{
+		ID:             id,
+		KitchenOrderID: kitchenOrderID,
+		PrinterID:      printerID,
+		Station:        station,
+		ItemIDs:        itemIDs,
+		Status:         constants.PRINT_JOB_STATUS_PENDING,
+		NextAttemptAt:  createdAt,
+		Reprint:        reprint,
+		RequestedBy:    requestedBy,
+		CreatedAt:      createdAt,
+	}
+}
+
+func IsValidPrintJobStatus(status string) bool {
+	return slices.Contains(PrintJobStatuses, status)
+}
+
+// FilterItems devolve apenas os itens do pedido que pertencem a este trabalho
+func (j *PrintJob) FilterItems(items []OrderItem) []OrderItem {
+	if len(j.ItemIDs) == 0 {
+		return items
+	}
+
+	filtered := make([]OrderItem, 0, len(j.ItemIDs))
+	for _, item := range items {
+		if slices.Contains(j.ItemIDs, item.ID) {
+			filtered = append(filtered, item)
+		}
+	}
+
+	return filtered
+}
+
+func (j *PrintJob) MarkPrinted(printedAt time.Time) {
+	j.Status = constants.PRINT_JOB_STATUS_PRINTED
+	j.LastError = ""
+	j.PrintedAt = &printedAt
+	j.UpdatedAt = &printedAt
+}
+
+// MarkAttemptFailed devolve o trabalho para a fila ou o encerra como falho quando não há mais tentativas
+func (j *PrintJob) MarkAttemptFailed(reason string, maxAttempts int, nextAttemptAt, now time.Time) {
+	j.LastError = reason
+	j.UpdatedAt = &now
+
+	if j.Attempts >= maxAttempts {
+		j.Status = constants.PRINT_JOB_STATUS_FAILED
+		return
+	}
+
+	j.Status = constants.PRINT_JOB_STATUS_PENDING
+	j.NextAttemptAt = nextAttemptAt
+}
+
+// MarkFailed encerra o trabalho sem novas tentativas, como quando a impressora foi removida
+func (j *PrintJob) MarkFailed(reason string, now time.Time) {
+	j.Status = constants.PRINT_JOB_STATUS_FAILED
+	j.LastError = reason
+	j.UpdatedAt = &now
+}
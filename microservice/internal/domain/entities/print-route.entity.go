@@ -0,0 +1,70 @@
+package entities
+
+import (
+	"path"
+	"strings"
+
+	"tech_challenge/internal/domain/exceptions"
+)
+
+// PrintRoute envia os itens cujo produto casa com o padrão para a praça informada
+type PrintRoute struct {
+	ID       string
+	Position int
+	// ProductPattern segue a sintaxe de path.Match, por exemplo "burger-*"
+	ProductPattern string
+	Station        string
+}
+
+func NewPrintRoute(id string, position int, productPattern, station string) (*PrintRoute, error) {
+	productPattern = strings.TrimSpace(productPattern)
+
+	if productPattern == "" {
+		return nil, &exceptions.InvalidPrintRouteDataException{
+			Message: "Print route product pattern is required",
+		}
+	}
+
+	if _, err := path.Match(productPattern, ""); err != nil {
+		return nil, &exceptions.InvalidPrintRouteDataException{
+			Message: "Invalid print route product pattern: " + productPattern,
+		}
+	}
+
+	station, err := NormalizePrintStation(station)
+	if err != nil {
+		return nil, &exceptions.InvalidPrintRouteDataException{Message: err.Error()}
+	}
+
+	return &PrintRoute{
+		ID:             id,
+		Position:       position,
+		ProductPattern: productPattern,
+		Station:        station,
+	}, nil
+}
+
+func (r *PrintRoute) Matches(productID string) bool {
+	matched, err := path.Match(r.ProductPattern, productID)
+	return err == nil && matched
+}
+
+// RouteOrderItems separa os itens por praça: vale a primeira regra que casar e os demais itens vão para a praça padrão
+func RouteOrderItems(items []OrderItem, routes []PrintRoute, defaultStation string) map[string][]OrderItem {
+	itemsByStation := make(map[string][]OrderItem)
+
+	for _, item := range items {
+		station := defaultStation
+
+		for _, route := range routes {
+			if route.Matches(item.ProductID) {
+				station = route.Station
+				break
+			}
+		}
+
+		itemsByStation[station] = append(itemsByStation[station], item)
+	}
+
+	return itemsByStation
+}
@@ -0,0 +1,119 @@
+package entities
+
+import (
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"tech_challenge/internal/domain/exceptions"
+	"tech_challenge/internal/shared/config/constants"
+	identity_manager "tech_challenge/internal/shared/pkg/identity"
+)
+
+// Printer é uma impressora térmica de rede que recebe as comandas em ESC/POS pela porta raw (9100)
+type Printer struct {
+	ID      string
+	Name    string
+	Host    string
+	Port    int
+	Station string
+	// Width é o número de colunas; zero usa a largura padrão das comandas
+	Width     int
+	Active    bool
+	CreatedAt time.Time
+	UpdatedAt *time.Time
+}
+
+func NewPrinter(id, name, host string, port int, station string, width int, active bool, createdAt time.Time, updatedAt *time.Time) (*Printer, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || len(name) > constants.PRINTER_NAME_MAX_LENGTH {
+		return nil, &exceptions.InvalidPrinterDataException{
+			Message: "Printer name is required and must be at most 100 characters long",
+		}
+	}
+
+	if err := ValidatePrinterHost(host); err != nil {
+		return nil, err
+	}
+
+	if port < 1 || port > 65535 {
+		return nil, &exceptions.InvalidPrinterDataException{
+			Message: "Printer port must be between 1 and 65535",
+		}
+	}
+
+	station, err := NormalizePrintStation(station)
+	if err != nil {
+		return nil, &exceptions.InvalidPrinterDataException{Message: err.Error()}
+	}
+
+	if width != 0 && (width < constants.KITCHEN_TICKET_MIN_WIDTH || width > constants.KITCHEN_TICKET_MAX_WIDTH) {
+		return nil, &exceptions.InvalidPrinterDataException{
+			Message: "Printer width must be between 24 and 64 columns",
+		}
+	}
+
+	return &Printer{
+		ID:        id,
+		Name:      name,
+		Host:      host,
+		Port:      port,
+		Station:   station,
+		Width:     width,
+		Active:    active,
+		CreatedAt: createdAt,
+		UpdatedAt: updatedAt,
+	}, nil
+}
+
+func ValidatePrinterID(id string) error {
+	if !identity_manager.IsValidUUID(id) {
+		return &exceptions.InvalidPrinterDataException{
+			Message: "Invalid Printer ID",
+		}
+	}
+
+	return nil
+}
+
+// ValidatePrinterHost aceita um hostname ou IP; a porta é informada à parte
+func ValidatePrinterHost(host string) error {
+	invalid := host == "" ||
+		strings.ContainsAny(host, " \t/\\@") ||
+		(strings.Contains(host, ":") && net.ParseIP(host) == nil)
+
+	if invalid {
+		return &exceptions.InvalidPrinterDataException{
+			Message: "Printer host must be a hostname or IP address",
+		}
+	}
+
+	return nil
+}
+
+// NormalizePrintStation padroniza o nome da praça para que as regras e as impressoras se encontrem
+func NormalizePrintStation(station string) (string, error) {
+	station = strings.ToLower(strings.TrimSpace(station))
+
+	if station == "" || len(station) > constants.PRINTER_STATION_MAX_LENGTH {
+		return "", &exceptions.InvalidPrinterDataException{
+			Message: "Printer station is required and must be at most 50 characters long",
+		}
+	}
+
+	return station, nil
+}
+
+func (p *Printer) IsEmpty() bool {
+	return p.ID == ""
+}
+
+func (p *Printer) Address() string {
+	return net.JoinHostPort(p.Host, strconv.Itoa(p.Port))
+}
+
+// ReceivesFullTicket indica a impressora da expedição, que confere o pedido inteiro antes da entrega
+func (p *Printer) ReceivesFullTicket() bool {
+	return p.Station == constants.PRINT_STATION_EXPO
+}
@@ -0,0 +1,99 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"tech_challenge/internal/domain/exceptions"
+	"tech_challenge/internal/shared/config/constants"
+)
+
+func TestNewPrinter_Success(t *testing.T) {
+	printer, err := NewPrinter("id", "  Chapa  ", "192.168.0.50", 9100, " Grill ", 0, true, time.Now(), nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Chapa", printer.Name)
+	assert.Equal(t, "grill", printer.Station)
+	assert.Equal(t, "192.168.0.50:9100", printer.Address())
+	assert.False(t, printer.ReceivesFullTicket())
+}
+
+func TestNewPrinter_IPv6Address(t *testing.T) {
+	printer, err := NewPrinter("id", "Expo", "fe80::1", 9100, constants.PRINT_STATION_EXPO, 32, true, time.Now(), nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "[fe80::1]:9100", printer.Address())
+	assert.True(t, printer.ReceivesFullTicket())
+}
+
+func TestNewPrinter_InvalidData(t *testing.T) {
+	cases := []struct {
+		name    string
+		host    string
+		port    int
+		station string
+		width   int
+	}{
+		{"", "printer.local", 9100, "grill", 0},
+		{"Chapa", "tcp://printer", 9100, "grill", 0},
+		{"Chapa", "printer:9100", 9100, "grill", 0},
+		{"Chapa", "printer.local", 0, "grill", 0},
+		{"Chapa", "printer.local", 9100, " ", 0},
+		{"Chapa", "printer.local", 9100, "grill", 10},
+	}
+
+	for _, tc := range cases {
+		_, err := NewPrinter("id", tc.name, tc.host, tc.port, tc.station, tc.width, true, time.Now(), nil)
+
+		assert.IsType(t, &exceptions.InvalidPrinterDataException{}, err, "%+v", tc)
+	}
+}
+
+func TestNewPrintRoute_InvalidPattern(t *testing.T) {
+	_, err := NewPrintRoute("id", 0, "burger-[", "grill")
+	assert.IsType(t, &exceptions.InvalidPrintRouteDataException{}, err)
+
+	_, err = NewPrintRoute("id", 0, "burger-*", "")
+	assert.IsType(t, &exceptions.InvalidPrintRouteDataException{}, err)
+}
+
+func TestRouteOrderItems_FirstMatchWins(t *testing.T) {
+	grill, _ := NewPrintRoute("r1", 0, "burger-*", "grill")
+	bar, _ := NewPrintRoute("r2", 1, "*-drink", "Bar")
+	catchAll, _ := NewPrintRoute("r3", 2, "burger-*", "fryer")
+
+	items := []OrderItem{
+		{ID: "i1", ProductID: "burger-classic"},
+		{ID: "i2", ProductID: "soda-drink"},
+		{ID: "i3", ProductID: "fries"},
+	}
+
+	result := RouteOrderItems(items, []PrintRoute{*grill, *bar, *catchAll}, constants.PRINT_STATION_DEFAULT)
+
+	assert.Len(t, result, 3)
+	assert.Equal(t, "i1", result["grill"][0].ID)
+	assert.Equal(t, "i2", result["bar"][0].ID)
+	assert.Equal(t, "i3", result[constants.PRINT_STATION_DEFAULT][0].ID)
+	assert.Empty(t, result["fryer"])
+	t.Log("✓ Itens separados pela primeira regra que casar")
+}
+
+func TestPrintJob_RetryUntilMaxAttempts(t *testing.T) {
+	now := time.Now()
+	job := NewPrintJob("job-1", "order-1", "printer-1", "grill", []string{"i1"}, false, nil, now)
+
+	job.Attempts = 1
+	job.MarkAttemptFailed("connection refused", 2, now.Add(time.Second), now)
+	assert.Equal(t, constants.PRINT_JOB_STATUS_PENDING, job.Status)
+	assert.Equal(t, now.Add(time.Second), job.NextAttemptAt)
+
+	job.Attempts = 2
+	job.MarkAttemptFailed("connection refused", 2, now.Add(time.Minute), now)
+	assert.Equal(t, constants.PRINT_JOB_STATUS_FAILED, job.Status)
+	assert.Equal(t, "connection refused", job.LastError)
+
+	filtered := job.FilterItems([]OrderItem{{ID: "i1"}, {ID: "i2"}})
+	assert.Len(t, filtered, 1)
+}
@@ -0,0 +1,31 @@
+package entities
+
+import "time"
+
+type WebhookDelivery struct {
+	ID             string
+	SubscriptionID string
+	EventID        string
+	Event          string
+	Attempt        int
+	StatusCode     int
+	Success        bool
+	Error          string
+	DurationMs     int64
+	CreatedAt      time.Time
+}
+
+func NewWebhookDelivery(id, subscriptionID, eventID, event string, attempt, statusCode int, success bool, errorMessage string, durationMs int64, createdAt time.Time) *WebhookDelivery {
+	return &WebhookDelivery{
+		ID:             id,
+		SubscriptionID: subscriptionID,
+		EventID:        eventID,
+		Event:          event,
+		Attempt:        attempt,
+		StatusCode:     statusCode,
+		Success:        success,
+		Error:          errorMessage,
+		DurationMs:     durationMs,
+		CreatedAt:      createdAt,
+	}
+}
@@ -0,0 +1,70 @@
+package entities
+
+import (
+	"time"
+
+	"tech_challenge/internal/shared/config/constants"
+)
+
+// WebhookOutboxEntry é a entrega pendente de um evento a uma inscrição; o payload é gravado já serializado,
+// para que todas as tentativas enviem exatamente o mesmo corpo
+type WebhookOutboxEntry struct {
+	ID             string
+	SubscriptionID string
+	EventID        string
+	Event          string
+	Payload        []byte
+	Status         string
+	Attempts       int
+	LastError      string
+	// NextAttemptAt é quando a próxima tentativa pode começar; durante o envio marca o fim da reserva da entrega
+	NextAttemptAt time.Time
+	DeliveredAt   *time.Time
+	CreatedAt     time.Time
+	UpdatedAt     *time.Time
+}
+
+func NewWebhookOutboxEntry(id, subscriptionID, eventID, event string, payload []byte, createdAt time.Time) *WebhookOutboxEntry {
+	return &WebhookOutboxEntry{
+		ID:             id,
+		SubscriptionID: subscriptionID,
+		EventID:        eventID,
+		Event:          event,
+		Payload:        payload,
+		Status:         constants.WEBHOOK_OUTBOX_STATUS_PENDING,
+		NextAttemptAt:  createdAt,
+		CreatedAt:      createdAt,
+	}
+}
+
+func (e *WebhookOutboxEntry) MarkDelivered(deliveredAt time.Time) {
+	e.Status = constants.WEBHOOK_OUTBOX_STATUS_DELIVERED
+	e.LastError = ""
+	e.DeliveredAt = &deliveredAt
+	e.UpdatedAt = &deliveredAt
+}
+
+// MarkAttemptFailed devolve a entrega para a fila ou a encerra como falha quando não há mais tentativas
+func (e *WebhookOutboxEntry) MarkAttemptFailed(reason string, maxAttempts int, nextAttemptAt, now time.Time) {
+	e.LastError = reason
+	e.UpdatedAt = &now
+
+	if e.Attempts >= maxAttempts {
+		e.Status = constants.WEBHOOK_OUTBOX_STATUS_FAILED
+		return
+	}
+
+	e.Status = constants.WEBHOOK_OUTBOX_STATUS_PENDING
+	e.NextAttemptAt = nextAttemptAt
+}
+
+// MarkFailed encerra a entrega sem novas tentativas, como quando a inscrição foi removida ou desativada
+func (e *WebhookOutboxEntry) MarkFailed(reason string, now time.Time) {
+	e.Status = constants.WEBHOOK_OUTBOX_STATUS_FAILED
+	e.LastError = reason
+	e.UpdatedAt = &now
+}
+
+func (e *WebhookOutboxEntry) IsFailed() bool {
+	return e.Status == constants.WEBHOOK_OUTBOX_STATUS_FAILED
+}
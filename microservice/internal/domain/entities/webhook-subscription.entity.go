@@ -0,0 +1,101 @@
+package entities
+
+import (
+	"net/url"
+	"slices"
+	"time"
+
+	"tech_challenge/internal/domain/exceptions"
+	"tech_challenge/internal/shared/config/constants"
+	identity_manager "tech_challenge/internal/shared/pkg/identity"
+)
+
+const webhookSecretMinLength = 16
+
+var WebhookEvents = []string{
+	constants.WEBHOOK_EVENT_KITCHEN_ORDER_RECEIVED,
+	constants.WEBHOOK_EVENT_KITCHEN_ORDER_PREPARING,
+	constants.WEBHOOK_EVENT_KITCHEN_ORDER_READY,
+	constants.WEBHOOK_EVENT_KITCHEN_ORDER_FINISHED,
+}
+
+type WebhookSubscription struct {
+	ID                  string
+	URL                 string
+	Secret              string
+	Events              []string
+	Active              bool
+	ConsecutiveFailures int
+	CreatedAt           time.Time
+	UpdatedAt           *time.Time
+}
+
+func NewWebhookSubscription(id, callbackURL, secret string, events []string, active bool, consecutiveFailures int, createdAt time.Time, updatedAt *time.Time) (*WebhookSubscription, error) {
+	if err := ValidateWebhookURL(callbackURL); err != nil {
+		return nil, err
+	}
+
+	if len(secret) < webhookSecretMinLength {
+		return nil, &exceptions.InvalidWebhookSubscriptionDataException{
+			Message: "Webhook secret must be at least 16 characters long",
+		}
+	}
+
+	if err := ValidateWebhookEvents(events); err != nil {
+		return nil, err
+	}
+
+	return &WebhookSubscription{
+		ID:                  id,
+		URL:                 callbackURL,
+		Secret:              secret,
+		Events:              events,
+		Active:              active,
+		ConsecutiveFailures: consecutiveFailures,
+		CreatedAt:           createdAt,
+		UpdatedAt:           updatedAt,
+	}, nil
+}
+
+func ValidateWebhookID(id string) error {
+	if !identity_manager.IsValidUUID(id) {
+		return &exceptions.InvalidWebhookSubscriptionDataException{
+			Message: "Invalid Webhook Subscription ID",
+		}
+	}
+
+	return nil
+}
+
+func ValidateWebhookURL(callbackURL string) error {
+	parsed, err := url.ParseRequestURI(callbackURL)
+
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return &exceptions.InvalidWebhookSubscriptionDataException{
+			Message: "Webhook URL must be an absolute http or https URL",
+		}
+	}
+
+	return nil
+}
+
+func ValidateWebhookEvents(events []string) error {
+	for _, event := range events {
+		if !slices.Contains(WebhookEvents, event) {
+			return &exceptions.InvalidWebhookSubscriptionDataException{
+				Message: "Unknown webhook event: " + event,
+			}
+		}
+	}
+
+	return nil
+}
+
+func (w *WebhookSubscription) IsEmpty() bool {
+	return w.ID == ""
+}
+
+// IsSubscribedTo considera uma lista de eventos vazia como inscrição em todos os eventos
+func (w *WebhookSubscription) IsSubscribedTo(event string) bool {
+	return len(w.Events) == 0 || slices.Contains(w.Events, event)
+}
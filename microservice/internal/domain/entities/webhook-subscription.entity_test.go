@@ -0,0 +1,68 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"tech_challenge/internal/domain/exceptions"
+	"tech_challenge/internal/shared/config/constants"
+)
+
+func TestNewWebhookSubscription_Success(t *testing.T) {
+	now := time.Now()
+
+	subscription, err := NewWebhookSubscription(
+		"550e8400-e29b-41d4-a716-446655440000",
+		"https://partner.example.com/hooks",
+		"0123456789abcdef",
+		[]string{constants.WEBHOOK_EVENT_KITCHEN_ORDER_READY},
+		true,
+		0,
+		now,
+		nil,
+	)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "https://partner.example.com/hooks", subscription.URL)
+	assert.True(t, subscription.Active)
+	assert.Equal(t, now, subscription.CreatedAt)
+}
+
+func TestNewWebhookSubscription_InvalidURL(t *testing.T) {
+	invalidURLs := []string{"", "not-a-url", "ftp://example.com/hook", "/relative/path", "http://"}
+
+	for _, invalidURL := range invalidURLs {
+		_, err := NewWebhookSubscription("id", invalidURL, "0123456789abcdef", nil, true, 0, time.Now(), nil)
+
+		assert.IsType(t, &exceptions.InvalidWebhookSubscriptionDataException{}, err, "url %q", invalidURL)
+	}
+}
+
+func TestNewWebhookSubscription_ShortSecret(t *testing.T) {
+	_, err := NewWebhookSubscription("id", "https://example.com", "short", nil, true, 0, time.Now(), nil)
+
+	assert.IsType(t, &exceptions.InvalidWebhookSubscriptionDataException{}, err)
+}
+
+func TestNewWebhookSubscription_UnknownEvent(t *testing.T) {
+	_, err := NewWebhookSubscription("id", "https://example.com", "0123456789abcdef", []string{"order.created"}, true, 0, time.Now(), nil)
+
+	assert.IsType(t, &exceptions.InvalidWebhookSubscriptionDataException{}, err)
+	assert.Contains(t, err.Error(), "order.created")
+}
+
+func TestWebhookSubscription_IsSubscribedTo(t *testing.T) {
+	filtered := WebhookSubscription{Events: []string{constants.WEBHOOK_EVENT_KITCHEN_ORDER_READY}}
+	assert.True(t, filtered.IsSubscribedTo(constants.WEBHOOK_EVENT_KITCHEN_ORDER_READY))
+	assert.False(t, filtered.IsSubscribedTo(constants.WEBHOOK_EVENT_KITCHEN_ORDER_FINISHED))
+
+	all := WebhookSubscription{Events: []string{}}
+	assert.True(t, all.IsSubscribedTo(constants.WEBHOOK_EVENT_KITCHEN_ORDER_FINISHED))
+}
+
+func TestValidateWebhookID(t *testing.T) {
+	assert.NoError(t, ValidateWebhookID("550e8400-e29b-41d4-a716-446655440000"))
+	assert.IsType(t, &exceptions.InvalidWebhookSubscriptionDataException{}, ValidateWebhookID("invalid"))
+}
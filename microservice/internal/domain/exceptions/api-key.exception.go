@@ -0,0 +1,25 @@
+package exceptions
+
+type APIKeyNotFoundException struct {
+	Message string
+}
+
+type InvalidAPIKeyDataException struct {
+	Message string
+}
+
+func (e *APIKeyNotFoundException) Error() string {
+	if e.Message == "" {
+		return "API Key not found"
+	}
+
+	return e.Message
+}
+
+func (e *InvalidAPIKeyDataException) Error() string {
+	if e.Message == "" {
+		return "Invalid API Key data"
+	}
+
+	return e.Message
+}
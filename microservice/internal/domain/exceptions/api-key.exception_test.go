@@ -0,0 +1,26 @@
+package exceptions
+
+import "testing"
+
+func TestAPIKeyExceptions_DefaultMessages(t *testing.T) {
+	cases := map[string]error{
+		"API Key not found":    &APIKeyNotFoundException{},
+		"Invalid API Key data": &InvalidAPIKeyDataException{},
+	}
+
+	for expected, exception := range cases {
+		if exception.Error() != expected {
+			t.Errorf("Expected message '%s', got '%s'", expected, exception.Error())
+		}
+	}
+}
+
+func TestAPIKeyExceptions_CustomMessage(t *testing.T) {
+	exception := &InvalidAPIKeyDataException{Message: "Unknown API key scope: orders:delete"}
+
+	if exception.Error() != "Unknown API key scope: orders:delete" {
+		t.Errorf("Expected custom message, got '%s'", exception.Error())
+	} else {
+		t.Log("✓ Mensagem customizada retornada")
+	}
+}
@@ -0,0 +1,25 @@
+package exceptions
+
+type UnauthorizedException struct {
+	Message string
+}
+
+type ForbiddenException struct {
+	Message string
+}
+
+func (e *UnauthorizedException) Error() string {
+	if e.Message == "" {
+		return "Authentication is required"
+	}
+
+	return e.Message
+}
+
+func (e *ForbiddenException) Error() string {
+	if e.Message == "" {
+		return "You do not have permission to perform this action"
+	}
+
+	return e.Message
+}
@@ -0,0 +1,24 @@
+package exceptions
+
+import "testing"
+
+func TestAuthExceptions_DefaultMessages(t *testing.T) {
+	cases := map[string]error{
+		"Authentication is required":                        &UnauthorizedException{},
+		"You do not have permission to perform this action": &ForbiddenException{},
+	}
+
+	for expected, exception := range cases {
+		if exception.Error() != expected {
+			t.Errorf("Expected message '%s', got '%s'", expected, exception.Error())
+		}
+	}
+}
+
+func TestAuthExceptions_CustomMessage(t *testing.T) {
+	exception := &UnauthorizedException{Message: "Token has expired"}
+
+	if exception.Error() != "Token has expired" {
+		t.Errorf("Expected custom message, got '%s'", exception.Error())
+	}
+}
@@ -0,0 +1,13 @@
+package exceptions
+
+type DisplayBoardStreamLimitException struct {
+	Message string
+}
+
+func (e *DisplayBoardStreamLimitException) Error() string {
+	if e.Message == "" {
+		return "Too many display board connections, try again later"
+	}
+
+	return e.Message
+}
@@ -0,0 +1,13 @@
+package exceptions
+
+import "testing"
+
+func TestDisplayBoardStreamLimitException_DefaultMessage(t *testing.T) {
+	exception := &DisplayBoardStreamLimitException{}
+
+	if exception.Error() != "Too many display board connections, try again later" {
+		t.Errorf("Expected default message, got '%s'", exception.Error())
+	} else {
+		t.Log("✓ Mensagem padrão retornada")
+	}
+}
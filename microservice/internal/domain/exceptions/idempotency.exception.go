@@ -0,0 +1,37 @@
+package exceptions
+
+type InvalidIdempotencyKeyException struct {
+	Message string
+}
+
+type IdempotencyKeyConflictException struct {
+	Message string
+}
+
+type IdempotencyKeyInProgressException struct {
+	Message string
+}
+
+func (e *InvalidIdempotencyKeyException) Error() string {
+	if e.Message == "" {
+		return "Invalid Idempotency-Key"
+	}
+
+	return e.Message
+}
+
+func (e *IdempotencyKeyConflictException) Error() string {
+	if e.Message == "" {
+		return "Idempotency-Key was already used with a different request"
+	}
+
+	return e.Message
+}
+
+func (e *IdempotencyKeyInProgressException) Error() string {
+	if e.Message == "" {
+		return "A request with this Idempotency-Key is still being processed"
+	}
+
+	return e.Message
+}
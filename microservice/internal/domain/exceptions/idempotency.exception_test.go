@@ -0,0 +1,25 @@
+package exceptions
+
+import "testing"
+
+func TestIdempotencyExceptions_DefaultMessages(t *testing.T) {
+	cases := map[string]error{
+		"Invalid Idempotency-Key":                                      &InvalidIdempotencyKeyException{},
+		"Idempotency-Key was already used with a different request":    &IdempotencyKeyConflictException{},
+		"A request with this Idempotency-Key is still being processed": &IdempotencyKeyInProgressException{},
+	}
+
+	for expected, exception := range cases {
+		if exception.Error() != expected {
+			t.Errorf("Expected message '%s', got '%s'", expected, exception.Error())
+		}
+	}
+}
+
+func TestIdempotencyExceptions_CustomMessage(t *testing.T) {
+	exception := &InvalidIdempotencyKeyException{Message: "Idempotency-Key is too long"}
+
+	if exception.Error() != "Idempotency-Key is too long" {
+		t.Errorf("Expected custom message, got '%s'", exception.Error())
+	}
+}
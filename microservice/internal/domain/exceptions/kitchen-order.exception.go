@@ -8,6 +8,30 @@ type InvalidKitchenOrderDataException struct {
 	Message string
 }
 
+type InvalidKitchenOrderFilterException struct {
+	Message string
+}
+
+type InvalidKitchenOrderCommandException struct {
+	Message string
+}
+
+type InvalidKitchenOrderTransitionException struct {
+	Message string
+}
+
+type KitchenOrderVersionMismatchException struct {
+	Message string
+}
+
+type KitchenOrderUpdateConflictException struct {
+	Message string
+}
+
+type InvalidKitchenOrderTicketException struct {
+	Message string
+}
+
 func (e *KitchenOrderNotFoundException) Error() string {
 	if e.Message == "" {
 		return "Kitchen Order not found"
@@ -23,3 +47,51 @@ func (e *InvalidKitchenOrderDataException) Error() string {
 
 	return e.Message
 }
+
+func (e *InvalidKitchenOrderFilterException) Error() string {
+	if e.Message == "" {
+		return "Invalid Kitchen Order filter"
+	}
+
+	return e.Message
+}
+
+func (e *InvalidKitchenOrderCommandException) Error() string {
+	if e.Message == "" {
+		return "Invalid Kitchen Order command"
+	}
+
+	return e.Message
+}
+
+func (e *InvalidKitchenOrderTransitionException) Error() string {
+	if e.Message == "" {
+		return "Invalid Kitchen Order status transition"
+	}
+
+	return e.Message
+}
+
+func (e *KitchenOrderVersionMismatchException) Error() string {
+	if e.Message == "" {
+		return "Kitchen Order version does not match the expected version"
+	}
+
+	return e.Message
+}
+
+func (e *KitchenOrderUpdateConflictException) Error() string {
+	if e.Message == "" {
+		return "Kitchen Order was modified by another request"
+	}
+
+	return e.Message
+}
+
+func (e *InvalidKitchenOrderTicketException) Error() string {
+	if e.Message == "" {
+		return "Invalid Kitchen Order ticket request"
+	}
+
+	return e.Message
+}
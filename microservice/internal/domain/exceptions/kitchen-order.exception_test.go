@@ -132,3 +132,86 @@ func TestKitchenOrderExceptions_TypeAssertion(t *testing.T) {
 		t.Error("Type assertion to InvalidKitchenOrderDataException failed")
 	}
 }
+
+func TestInvalidKitchenOrderCommandException_DefaultMessage(t *testing.T) {
+	// Arrange
+	exception := &InvalidKitchenOrderCommandException{}
+
+	// Act
+	message := exception.Error()
+
+	// Assert
+	expectedMessage := "Invalid Kitchen Order command"
+	if message != expectedMessage {
+		t.Errorf("Expected message '%s', got '%s'", expectedMessage, message)
+	}
+}
+
+func TestInvalidKitchenOrderCommandException_CustomMessage(t *testing.T) {
+	// Arrange
+	exception := &InvalidKitchenOrderCommandException{Message: "Unknown command"}
+
+	// Act
+	message := exception.Error()
+
+	// Assert
+	if message != "Unknown command" {
+		t.Errorf("Expected message 'Unknown command', got '%s'", message)
+	}
+}
+
+func TestKitchenOrderVersionMismatchException_DefaultMessage(t *testing.T) {
+	// Arrange
+	exception := &KitchenOrderVersionMismatchException{}
+
+	// Act
+	message := exception.Error()
+
+	// Assert
+	expectedMessage := "Kitchen Order version does not match the expected version"
+	if message != expectedMessage {
+		t.Errorf("Expected message '%s', got '%s'", expectedMessage, message)
+	}
+}
+
+func TestKitchenOrderUpdateConflictException_DefaultMessage(t *testing.T) {
+	// Arrange
+	exception := &KitchenOrderUpdateConflictException{}
+
+	// Act
+	message := exception.Error()
+
+	// Assert
+	expectedMessage := "Kitchen Order was modified by another request"
+	if message != expectedMessage {
+		t.Errorf("Expected message '%s', got '%s'", expectedMessage, message)
+	}
+}
+
+func TestInvalidKitchenOrderTransitionException_DefaultMessage(t *testing.T) {
+	// Arrange
+	exception := &InvalidKitchenOrderTransitionException{}
+
+	// Act
+	message := exception.Error()
+
+	// Assert
+	expectedMessage := "Invalid Kitchen Order status transition"
+	if message != expectedMessage {
+		t.Errorf("Expected message '%s', got '%s'", expectedMessage, message)
+	}
+}
+
+func TestInvalidKitchenOrderTicketException_DefaultMessage(t *testing.T) {
+	// Arrange
+	exception := &InvalidKitchenOrderTicketException{}
+
+	// Act
+	message := exception.Error()
+
+	// Assert
+	expectedMessage := "Invalid Kitchen Order ticket request"
+	if message != expectedMessage {
+		t.Errorf("Expected message '%s', got '%s'", expectedMessage, message)
+	}
+}
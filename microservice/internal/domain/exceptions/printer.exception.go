@@ -0,0 +1,49 @@
+package exceptions
+
+type PrinterNotFoundException struct {
+	Message string
+}
+
+type InvalidPrinterDataException struct {
+	Message string
+}
+
+type InvalidPrintRouteDataException struct {
+	Message string
+}
+
+type InvalidPrintJobFilterException struct {
+	Message string
+}
+
+func (e *PrinterNotFoundException) Error() string {
+	if e.Message == "" {
+		return "Printer not found"
+	}
+
+	return e.Message
+}
+
+func (e *InvalidPrinterDataException) Error() string {
+	if e.Message == "" {
+		return "Invalid Printer data"
+	}
+
+	return e.Message
+}
+
+func (e *InvalidPrintRouteDataException) Error() string {
+	if e.Message == "" {
+		return "Invalid Print Route data"
+	}
+
+	return e.Message
+}
+
+func (e *InvalidPrintJobFilterException) Error() string {
+	if e.Message == "" {
+		return "Invalid Print Job filter"
+	}
+
+	return e.Message
+}
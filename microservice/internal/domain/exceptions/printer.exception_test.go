@@ -0,0 +1,28 @@
+package exceptions
+
+import "testing"
+
+func TestPrinterExceptions_DefaultMessages(t *testing.T) {
+	cases := map[string]error{
+		"Printer not found":        &PrinterNotFoundException{},
+		"Invalid Printer data":     &InvalidPrinterDataException{},
+		"Invalid Print Route data": &InvalidPrintRouteDataException{},
+		"Invalid Print Job filter": &InvalidPrintJobFilterException{},
+	}
+
+	for expected, exception := range cases {
+		if exception.Error() != expected {
+			t.Errorf("Expected message '%s', got '%s'", expected, exception.Error())
+		}
+	}
+}
+
+func TestPrinterExceptions_CustomMessage(t *testing.T) {
+	exception := &InvalidPrinterDataException{Message: "Printer port must be between 1 and 65535"}
+
+	if exception.Error() != "Printer port must be between 1 and 65535" {
+		t.Errorf("Expected custom message, got '%s'", exception.Error())
+	} else {
+		t.Log("✓ Mensagem customizada retornada")
+	}
+}
@@ -0,0 +1,13 @@
+package exceptions
+
+type RateLimitExceededException struct {
+	Message string
+}
+
+func (e *RateLimitExceededException) Error() string {
+	if e.Message == "" {
+		return "Too many requests, try again later"
+	}
+
+	return e.Message
+}
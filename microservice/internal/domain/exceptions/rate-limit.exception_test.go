@@ -0,0 +1,13 @@
+package exceptions
+
+import "testing"
+
+func TestRateLimitExceededException_DefaultMessage(t *testing.T) {
+	exception := &RateLimitExceededException{}
+
+	if exception.Error() != "Too many requests, try again later" {
+		t.Errorf("Expected default message, got '%s'", exception.Error())
+	} else {
+		t.Log("✓ Mensagem padrão retornada")
+	}
+}
@@ -0,0 +1,13 @@
+package exceptions
+
+type InvalidReportFilterException struct {
+	Message string
+}
+
+func (e *InvalidReportFilterException) Error() string {
+	if e.Message == "" {
+		return "Invalid Report filter"
+	}
+
+	return e.Message
+}
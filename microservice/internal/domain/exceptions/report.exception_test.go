@@ -0,0 +1,16 @@
+package exceptions
+
+import "testing"
+
+func TestInvalidReportFilterException_Messages(t *testing.T) {
+	if message := (&InvalidReportFilterException{}).Error(); message != "Invalid Report filter" {
+		t.Errorf("Expected default message, got '%s'", message)
+	}
+
+	exception := &InvalidReportFilterException{Message: "group_by must be hour or day"}
+	if exception.Error() != "group_by must be hour or day" {
+		t.Errorf("Expected custom message, got '%s'", exception.Error())
+	} else {
+		t.Log("✓ Mensagem customizada retornada")
+	}
+}
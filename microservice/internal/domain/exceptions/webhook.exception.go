@@ -0,0 +1,25 @@
+package exceptions
+
+type WebhookSubscriptionNotFoundException struct {
+	Message string
+}
+
+type InvalidWebhookSubscriptionDataException struct {
+	Message string
+}
+
+func (e *WebhookSubscriptionNotFoundException) Error() string {
+	if e.Message == "" {
+		return "Webhook Subscription not found"
+	}
+
+	return e.Message
+}
+
+func (e *InvalidWebhookSubscriptionDataException) Error() string {
+	if e.Message == "" {
+		return "Invalid Webhook Subscription data"
+	}
+
+	return e.Message
+}
@@ -0,0 +1,16 @@
+package factories
+
+import (
+	"tech_challenge/internal/application/controllers"
+	"tech_challenge/internal/infra/api_keys"
+	"tech_challenge/internal/shared/config/env"
+	"tech_challenge/internal/shared/infra/auth"
+)
+
+func NewAPIKeyController() *controllers.APIKeyController {
+	return controllers.NewAPIKeyController(NewAPIKeyDataSource(), env.GetConfig().APIKey.LastUsedInterval)
+}
+
+func NewAPIKeyVerifier() auth.APIKeyVerifier {
+	return api_keys.NewAPIKeyVerifier(NewAPIKeyController())
+}
@@ -0,0 +1,10 @@
+package factories
+
+import (
+	"tech_challenge/internal/application/controllers"
+	"tech_challenge/internal/shared/config/env"
+)
+
+func NewDisplayBoardController() *controllers.DisplayBoardController {
+	return controllers.NewDisplayBoardController(NewKitchenOrderDataSource(), env.GetConfig().DisplayBoard.ReadyTTL)
+}
@@ -12,3 +12,43 @@ func NewKitchenOrderDataSource() interfaces.IKitchenOrderDataSource {
 func NewOrderStatusDataSource() interfaces.IOrderStatusDataSource {
 	return data_sources.NewGormOrderStatusDataSource()
 }
+
+func NewWebhookSubscriptionDataSource() interfaces.IWebhookSubscriptionDataSource {
+	return data_sources.NewGormWebhookSubscriptionDataSource()
+}
+
+func NewWebhookDeliveryDataSource() interfaces.IWebhookDeliveryDataSource {
+	return data_sources.NewGormWebhookDeliveryDataSource()
+}
+
+func NewWebhookOutboxDataSource() interfaces.IWebhookOutboxDataSource {
+	return data_sources.NewGormWebhookOutboxDataSource()
+}
+
+func NewKitchenOrderEventDataSource() interfaces.IKitchenOrderEventDataSource {
+	return data_sources.NewGormKitchenOrderEventDataSource()
+}
+
+func NewIdempotencyKeyDataSource() interfaces.IIdempotencyKeyDataSource {
+	return data_sources.NewGormIdempotencyKeyDataSource()
+}
+
+func NewAPIKeyDataSource() interfaces.IAPIKeyDataSource {
+	return data_sources.NewGormAPIKeyDataSource()
+}
+
+func NewPrinterDataSource() interfaces.IPrinterDataSource {
+	return data_sources.NewGormPrinterDataSource()
+}
+
+func NewPrintRouteDataSource() interfaces.IPrintRouteDataSource {
+	return data_sources.NewGormPrintRouteDataSource()
+}
+
+func NewPrintJobDataSource() interfaces.IPrintJobDataSource {
+	return data_sources.NewGormPrintJobDataSource()
+}
+
+func NewKitchenReportDataSource() interfaces.IKitchenReportDataSource {
+	return data_sources.NewGormKitchenReportDataSource()
+}
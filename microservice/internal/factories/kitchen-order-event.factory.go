@@ -0,0 +1,34 @@
+package factories
+
+import (
+	"sync"
+
+	"tech_challenge/internal/application/controllers"
+	"tech_challenge/internal/infra/streaming"
+	"tech_challenge/internal/shared/config/env"
+)
+
+var (
+	kitchenOrderEventHub     *streaming.KitchenOrderEventHub
+	kitchenOrderEventHubOnce sync.Once
+)
+
+func NewKitchenOrderEventController() *controllers.KitchenOrderEventController {
+	return controllers.NewKitchenOrderEventController(NewKitchenOrderEventDataSource())
+}
+
+// GetKitchenOrderEventHub retorna o hub compartilhado pela réplica, para que todas as conexões usem um único polling
+func GetKitchenOrderEventHub() *streaming.KitchenOrderEventHub {
+	kitchenOrderEventHubOnce.Do(func() {
+		config := env.GetConfig()
+
+		// A mesma tabela alimenta o stream e os relatórios de etapas, então vale a maior retenção
+		kitchenOrderEventHub = streaming.NewKitchenOrderEventHub(
+			NewKitchenOrderEventController(),
+			config.Stream.PollInterval,
+			max(config.Stream.Retention, config.Report.EventRetention),
+		)
+	})
+
+	return kitchenOrderEventHub
+}
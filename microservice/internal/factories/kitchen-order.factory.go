@@ -0,0 +1,26 @@
+package factories
+
+import (
+	"context"
+	"log/slog"
+
+	"tech_challenge/internal/application/controllers"
+	"tech_challenge/internal/shared/config/env"
+	shared_factories "tech_challenge/internal/shared/factories"
+	"tech_challenge/internal/shared/pkg/logger"
+)
+
+func NewKitchenOrderController() *controllers.KitchenOrderController {
+	messageBroker, err := shared_factories.NewMessageBroker(context.Background())
+	if err != nil {
+		slog.Warn("Failed to create message broker", logger.ErrorKey, err)
+		// Continue without message broker for now
+		messageBroker = nil
+	}
+
+	return controllers.NewKitchenOrderController(NewKitchenOrderDataSource(), NewOrderStatusDataSource(), messageBroker, NewWebhookDispatcher(), NewPrintDispatcher())
+}
+
+func NewIdempotencyKeyController() *controllers.IdempotencyKeyController {
+	return controllers.NewIdempotencyKeyController(NewIdempotencyKeyDataSource(), env.GetConfig().Idempotency.KeyTTL)
+}
@@ -0,0 +1,15 @@
+package factories
+
+import (
+	"tech_challenge/internal/application/controllers"
+	"tech_challenge/internal/infra/metrics"
+	"tech_challenge/internal/shared/config/env"
+)
+
+func NewKitchenReportController() *controllers.KitchenReportController {
+	return controllers.NewKitchenReportController(NewKitchenReportDataSource())
+}
+
+func NewKitchenBoardCollector() *metrics.KitchenBoardCollector {
+	return metrics.NewKitchenBoardCollector(NewKitchenReportController(), env.GetConfig().Metrics.BoardCacheTTL)
+}
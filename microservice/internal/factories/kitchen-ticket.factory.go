@@ -0,0 +1,28 @@
+package factories
+
+import (
+	"log/slog"
+	"time"
+	// A imagem de runtime não traz a base de fusos horários
+	_ "time/tzdata"
+
+	"tech_challenge/internal/infra/tickets"
+	"tech_challenge/internal/shared/config/env"
+	"tech_challenge/internal/shared/pkg/logger"
+)
+
+func NewKitchenTicketOptions() tickets.Options {
+	config := env.GetConfig()
+
+	location, err := time.LoadLocation(config.Ticket.Timezone)
+	if err != nil {
+		slog.Warn("Invalid TICKET_TIMEZONE, printing tickets in UTC", "timezone", config.Ticket.Timezone, logger.ErrorKey, err)
+		location = time.UTC
+	}
+
+	return tickets.Options{
+		Width:    config.Ticket.Width,
+		Location: location,
+		QRCode:   config.Ticket.QRCode,
+	}
+}
@@ -0,0 +1,51 @@
+package factories
+
+import (
+	"sync"
+
+	"tech_challenge/internal/application/controllers"
+	"tech_challenge/internal/application/dtos"
+	"tech_challenge/internal/infra/printing"
+	"tech_challenge/internal/interfaces"
+	"tech_challenge/internal/shared/config/env"
+)
+
+var (
+	printQueue     *printing.PrintQueue
+	printQueueOnce sync.Once
+)
+
+func NewPrinterController() *controllers.PrinterController {
+	config := env.GetConfig()
+
+	return controllers.NewPrinterController(
+		NewPrinterDataSource(),
+		NewPrintRouteDataSource(),
+		NewPrintJobDataSource(),
+		NewKitchenOrderDataSource(),
+		printing.NewTCPPrinterSender(NewKitchenTicketOptions(), config.Printing.ConnectTimeout, config.Printing.WriteTimeout),
+		dtos.PrintRetryPolicyDTO{
+			MaxAttempts: config.Printing.MaxAttempts,
+			BaseDelay:   config.Printing.RetryBaseDelay,
+			Lease:       config.Printing.Lease,
+		},
+	)
+}
+
+// GetPrintQueue retorna a fila compartilhada pela réplica; ela só processa trabalhos depois de Start
+func GetPrintQueue() *printing.PrintQueue {
+	printQueueOnce.Do(func() {
+		printQueue = printing.NewPrintQueue(NewPrinterController(), env.GetConfig().Printing.PollInterval)
+	})
+
+	return printQueue
+}
+
+// NewPrintDispatcher retorna nil com a impressão desabilitada, e os pedidos são criados sem enfileirar comandas
+func NewPrintDispatcher() interfaces.IPrintDispatcher {
+	if !env.GetConfig().Printing.Enabled {
+		return nil
+	}
+
+	return printing.NewPrintDispatcher(NewPrinterController(), GetPrintQueue())
+}
@@ -0,0 +1,48 @@
+package factories
+
+import (
+	"sync"
+
+	"tech_challenge/internal/application/controllers"
+	"tech_challenge/internal/application/dtos"
+	"tech_challenge/internal/infra/webhooks"
+	"tech_challenge/internal/interfaces"
+	"tech_challenge/internal/shared/config/env"
+)
+
+var (
+	webhookQueue     *webhooks.WebhookQueue
+	webhookQueueOnce sync.Once
+)
+
+func NewWebhookController() *controllers.WebhookController {
+	config := env.GetConfig()
+
+	return controllers.NewWebhookController(
+		NewWebhookSubscriptionDataSource(),
+		NewWebhookDeliveryDataSource(),
+		NewWebhookOutboxDataSource(),
+		webhooks.NewHTTPWebhookSender(config.Webhook.Timeout, config.Webhook.AllowPrivateNetworks),
+		// Fora de desenvolvimento os eventos só saem por https
+		webhooks.NewWebhookURLValidator(!config.IsDevelopment(), config.Webhook.AllowPrivateNetworks),
+		dtos.WebhookRetryPolicyDTO{
+			MaxAttempts:          config.Webhook.MaxAttempts,
+			BaseDelay:            config.Webhook.RetryBaseDelay,
+			DisableAfterFailures: config.Webhook.DisableAfterFailures,
+			Lease:                config.Webhook.Lease,
+		},
+	)
+}
+
+// GetWebhookQueue retorna a fila compartilhada pela réplica; ela só envia entregas depois de Start
+func GetWebhookQueue() *webhooks.WebhookQueue {
+	webhookQueueOnce.Do(func() {
+		webhookQueue = webhooks.NewWebhookQueue(NewWebhookController(), env.GetConfig().Webhook.PollInterval)
+	})
+
+	return webhookQueue
+}
+
+func NewWebhookDispatcher() interfaces.IWebhookDispatcher {
+	return webhooks.NewWebhookDispatcher(NewWebhookController(), GetWebhookQueue())
+}
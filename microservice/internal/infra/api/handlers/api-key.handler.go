@@ -0,0 +1,155 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"tech_challenge/internal/application/controllers"
+	"tech_challenge/internal/application/dtos"
+	"tech_challenge/internal/factories"
+	"tech_challenge/internal/infra/api/schemas"
+	"tech_challenge/internal/shared/pkg/logger"
+)
+
+type APIKeyHandler struct {
+	apiKeyController controllers.APIKeyController
+}
+
+func NewAPIKeyHandler() *APIKeyHandler {
+	return &APIKeyHandler{
+		apiKeyController: *factories.NewAPIKeyController(),
+	}
+}
+
+func (h *APIKeyHandler) toAPIKeyResponseSchema(apiKey dtos.APIKeyResponseDTO) schemas.APIKeyResponseSchema {
+	return schemas.APIKeyResponseSchema{
+		ID:         apiKey.ID,
+		Name:       apiKey.Name,
+		Prefix:     apiKey.Prefix,
+		Key:        apiKey.Key,
+		Scopes:     apiKey.Scopes,
+		CreatedBy:  apiKey.CreatedBy,
+		CreatedAt:  apiKey.CreatedAt,
+		LastUsedAt: apiKey.LastUsedAt,
+		RevokedAt:  apiKey.RevokedAt,
+	}
+}
+
+// @Summary Create an API key for another service
+// @Description The key is only returned on creation and must be sent in the X-API-Key header. Scopes: kitchen-orders:read, kitchen-orders:create, webhooks:manage, printers:manage, reports:read
+// @Tags API Keys
+// @Accept json
+// @Produce json
+// @Param request body schemas.CreateAPIKeyRequestSchema true "API key"
+// @Security BearerAuth
+// @Success 201 {object} schemas.APIKeyResponseSchema
+// @Failure 400 {object} schemas.ProblemDetailsSchema
+// @Failure 401 {object} schemas.ProblemDetailsSchema
+// @Failure 403 {object} schemas.ProblemDetailsSchema
+// @Failure 429 {object} schemas.ProblemDetailsSchema
+// @Router /api-keys/ [post]
+func (h *APIKeyHandler) Create(ctx *gin.Context) {
+	var request schemas.CreateAPIKeyRequestSchema
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		if ctxErr := ctx.Error(err); ctxErr != nil {
+			logger.FromContext(ctx).Error("Error setting context error", logger.ErrorKey, ctxErr)
+		}
+		return
+	}
+
+	apiKey, err := h.apiKeyController.Create(dtos.CreateAPIKeyDTO{
+		Name:      request.Name,
+		Scopes:    request.Scopes,
+		CreatedBy: actorFromContext(ctx),
+	})
+
+	if err != nil {
+		if ctxErr := ctx.Error(err); ctxErr != nil {
+			logger.FromContext(ctx).Error("Error setting context error", logger.ErrorKey, ctxErr)
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, h.toAPIKeyResponseSchema(apiKey))
+}
+
+// @Summary List API keys
+// @Description Revoked keys are kept for auditing
+// @Tags API Keys
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} schemas.APIKeyResponseSchema
+// @Failure 401 {object} schemas.ProblemDetailsSchema
+// @Failure 403 {object} schemas.ProblemDetailsSchema
+// @Failure 429 {object} schemas.ProblemDetailsSchema
+// @Failure 500 {object} schemas.ProblemDetailsSchema
+// @Router /api-keys/ [get]
+func (h *APIKeyHandler) FindAll(ctx *gin.Context) {
+	apiKeys, err := h.apiKeyController.FindAll()
+
+	if err != nil {
+		if ctxErr := ctx.Error(err); ctxErr != nil {
+			logger.FromContext(ctx).Error("Error setting context error", logger.ErrorKey, ctxErr)
+		}
+		return
+	}
+
+	apiKeyResponses := make([]schemas.APIKeyResponseSchema, len(apiKeys))
+	for i, apiKey := range apiKeys {
+		apiKeyResponses[i] = h.toAPIKeyResponseSchema(apiKey)
+	}
+
+	ctx.JSON(http.StatusOK, apiKeyResponses)
+}
+
+// @Summary Get an API key by ID
+// @Tags API Keys
+// @Produce json
+// @Param id path string true "API key ID"
+// @Security BearerAuth
+// @Success 200 {object} schemas.APIKeyResponseSchema
+// @Failure 400 {object} schemas.ProblemDetailsSchema
+// @Failure 401 {object} schemas.ProblemDetailsSchema
+// @Failure 403 {object} schemas.ProblemDetailsSchema
+// @Failure 404 {object} schemas.ProblemDetailsSchema
+// @Failure 429 {object} schemas.ProblemDetailsSchema
+// @Router /api-keys/{id} [get]
+func (h *APIKeyHandler) FindByID(ctx *gin.Context) {
+	apiKey, err := h.apiKeyController.FindByID(ctx.Param("id"))
+
+	if err != nil {
+		if ctxErr := ctx.Error(err); ctxErr != nil {
+			logger.FromContext(ctx).Error("Error setting context error", logger.ErrorKey, ctxErr)
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, h.toAPIKeyResponseSchema(apiKey))
+}
+
+// @Summary Revoke an API key
+// @Description Requests with a revoked key are rejected immediately; revoking twice keeps the original date
+// @Tags API Keys
+// @Produce json
+// @Param id path string true "API key ID"
+// @Security BearerAuth
+// @Success 200 {object} schemas.APIKeyResponseSchema
+// @Failure 400 {object} schemas.ProblemDetailsSchema
+// @Failure 401 {object} schemas.ProblemDetailsSchema
+// @Failure 403 {object} schemas.ProblemDetailsSchema
+// @Failure 404 {object} schemas.ProblemDetailsSchema
+// @Failure 429 {object} schemas.ProblemDetailsSchema
+// @Router /api-keys/{id}/revoke [post]
+func (h *APIKeyHandler) Revoke(ctx *gin.Context) {
+	apiKey, err := h.apiKeyController.Revoke(ctx.Param("id"))
+
+	if err != nil {
+		if ctxErr := ctx.Error(err); ctxErr != nil {
+			logger.FromContext(ctx).Error("Error setting context error", logger.ErrorKey, ctxErr)
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, h.toAPIKeyResponseSchema(apiKey))
+}
@@ -0,0 +1,159 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"tech_challenge/internal/application/controllers"
+	"tech_challenge/internal/application/dtos"
+	"tech_challenge/internal/factories"
+	"tech_challenge/internal/infra/api/schemas"
+	"tech_challenge/internal/infra/streaming"
+	"tech_challenge/internal/shared/config/constants"
+	"tech_challenge/internal/shared/config/env"
+	"tech_challenge/internal/shared/pkg/logger"
+)
+
+type DisplayBoardHandler struct {
+	displayBoardController controllers.DisplayBoardController
+	feed                   *streaming.DisplayBoardFeed
+	heartbeatInterval      time.Duration
+}
+
+func NewDisplayBoardHandler() *DisplayBoardHandler {
+	config := env.GetConfig()
+	displayBoardController := factories.NewDisplayBoardController()
+
+	return &DisplayBoardHandler{
+		displayBoardController: *displayBoardController,
+		feed: streaming.NewDisplayBoardFeed(
+			factories.GetKitchenOrderEventHub(),
+			displayBoardRenderer{displayBoardController: *displayBoardController},
+			config.Stream.HeartbeatInterval,
+			config.DisplayBoard.MaxStreams,
+		),
+		heartbeatInterval: config.Stream.HeartbeatInterval,
+	}
+}
+
+// displayBoardRenderer monta as mensagens públicas que o feed serializa uma única vez para todas as telas
+type displayBoardRenderer struct {
+	displayBoardController controllers.DisplayBoardController
+}
+
+func (r displayBoardRenderer) Board(ctx context.Context) (any, error) {
+	board, err := r.displayBoardController.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return toDisplayBoardResponseSchema(board), nil
+}
+
+func (r displayBoardRenderer) Chime(event dtos.KitchenOrderEventDTO) (any, bool) {
+	if event.Type != constants.KITCHEN_ORDER_EVENT_STATUS_CHANGED || event.Status.ID != constants.KITCHEN_ORDER_STATUS_READY_ID {
+		return nil, false
+	}
+
+	return schemas.DisplayBoardChimeSchema{Slug: event.Slug}, true
+}
+
+func toDisplayBoardResponseSchema(board dtos.DisplayBoardDTO) schemas.DisplayBoardResponseSchema {
+	response := schemas.DisplayBoardResponseSchema{
+		Preparing: make([]schemas.DisplayBoardPreparingEntrySchema, len(board.Preparing)),
+		Ready:     make([]schemas.DisplayBoardReadyEntrySchema, len(board.Ready)),
+	}
+
+	for i, entry := range board.Preparing {
+		response.Preparing[i] = schemas.DisplayBoardPreparingEntrySchema{Slug: entry.Slug}
+	}
+
+	for i, entry := range board.Ready {
+		response.Ready[i] = schemas.DisplayBoardReadyEntrySchema{Slug: entry.Slug, ReadyAt: entry.Since}
+	}
+
+	return response
+}
+
+// @Summary Customer display board
+// @Description Public, read-only board with the slugs being prepared and the slugs ready for pickup.
+// @Description Ready slugs leave the board after DISPLAY_BOARD_READY_TTL.
+// @Tags DisplayBoard
+// @Produce json
+// @Success 200 {object} schemas.DisplayBoardResponseSchema
+// @Failure 429 {object} schemas.ProblemDetailsSchema
+// @Failure 500 {object} schemas.ProblemDetailsSchema
+// @Router /display-board [get]
+func (h *DisplayBoardHandler) Get(ctx *gin.Context) {
+	board, err := h.displayBoardController.Get(ctx.Request.Context())
+	if err != nil {
+		if ctxErr := ctx.Error(err); ctxErr != nil {
+			logger.FromContext(ctx).Error("Error setting context error", logger.ErrorKey, ctxErr)
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, toDisplayBoardResponseSchema(board))
+}
+
+// @Summary Stream the customer display board
+// @Description Server-Sent Events stream. A "board" event carries the full board on connect and whenever it changes;
+// @Description a "chime" event is sent when a slug becomes ready for pickup.
+// @Description The number of open streams per instance is capped by DISPLAY_BOARD_MAX_STREAMS.
+// @Tags DisplayBoard
+// @Produce text/event-stream
+// @Success 200 {object} schemas.DisplayBoardResponseSchema
+// @Failure 429 {object} schemas.ProblemDetailsSchema
+// @Failure 503 {object} schemas.ProblemDetailsSchema
+// @Router /display-board/stream [get]
+func (h *DisplayBoardHandler) Stream(ctx *gin.Context) {
+	subscription, board, err := h.feed.Subscribe(ctx.Request.Context())
+	if err != nil {
+		if ctxErr := ctx.Error(err); ctxErr != nil {
+			logger.FromContext(ctx).Error("Error setting context error", logger.ErrorKey, ctxErr)
+		}
+		return
+	}
+	defer subscription.Close()
+
+	streaming.SetSSEHeaders(ctx.Writer)
+	ctx.Status(http.StatusOK)
+
+	if err := streaming.WriteSSERetry(ctx.Writer, streamRetryMilliseconds); err != nil {
+		return
+	}
+
+	if err := streaming.WriteSSEPayload(ctx.Writer, "", streaming.DisplayBoardEventBoard, board); err != nil {
+		return
+	}
+	ctx.Writer.Flush()
+
+	heartbeat := time.NewTicker(h.heartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-ctx.Request.Context().Done():
+			return
+
+		case message, ok := <-subscription.Messages():
+			if !ok {
+				return
+			}
+
+			if err := streaming.WriteSSEPayload(ctx.Writer, "", message.Event, message.Payload); err != nil {
+				return
+			}
+			ctx.Writer.Flush()
+
+		case <-heartbeat.C:
+			if err := streaming.WriteSSEComment(ctx.Writer, "heartbeat"); err != nil {
+				return
+			}
+			ctx.Writer.Flush()
+		}
+	}
+}
@@ -0,0 +1,165 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tech_challenge/internal/application/controllers"
+	"tech_challenge/internal/daos"
+	"tech_challenge/internal/infra/streaming"
+	"tech_challenge/internal/shared/config/constants"
+	"tech_challenge/internal/shared/infra/api/middlewares"
+)
+
+func newDisplayBoardTestOrder(id, slug, statusID string, updatedAt *time.Time) daos.KitchenOrderDAO {
+	return daos.KitchenOrderDAO{
+		ID:         id,
+		OrderID:    "order-" + slug,
+		Slug:       slug,
+		CustomerID: &id,
+		Status:     daos.OrderStatusDAO{ID: statusID, Name: kitchenDisplayTestStatuses[statusID]},
+		CreatedAt:  time.Now().Add(-time.Hour),
+		UpdatedAt:  updatedAt,
+	}
+}
+
+func setupDisplayBoardServer(t *testing.T, orders ...daos.KitchenOrderDAO) (*httptest.Server, *fakeBoardDataSource) {
+	return setupDisplayBoardServerWithLimit(t, 0, orders...)
+}
+
+func setupDisplayBoardServerWithLimit(t *testing.T, maxStreams int, orders ...daos.KitchenOrderDAO) (*httptest.Server, *fakeBoardDataSource) {
+	gin.SetMode(gin.TestMode)
+
+	events := &fakeKitchenOrderEventDataSource{}
+	board := &fakeBoardDataSource{orders: map[string]daos.KitchenOrderDAO{}, events: events}
+	for _, order := range orders {
+		board.orders[order.ID] = order
+	}
+
+	hub := streaming.NewKitchenOrderEventHub(controllers.NewKitchenOrderEventController(events), 5*time.Millisecond, 0)
+	displayBoardController := controllers.NewDisplayBoardController(board, 10*time.Minute)
+	handler := &DisplayBoardHandler{
+		displayBoardController: *displayBoardController,
+		feed:                   streaming.NewDisplayBoardFeed(hub, displayBoardRenderer{displayBoardController: *displayBoardController}, time.Hour, maxStreams),
+		heartbeatInterval:      time.Hour,
+	}
+
+	router := gin.New()
+	router.Use(middlewares.ErrorHandlerMiddleware())
+	router.GET("/v1/display-board", handler.Get)
+	router.GET("/v1/display-board/stream", handler.Stream)
+
+	server := httptest.NewServer(router)
+	t.Cleanup(func() {
+		hub.Close()
+		server.Close()
+	})
+
+	return server, board
+}
+
+func TestDisplayBoardHandler_Get(t *testing.T) {
+	recentReady := time.Now().Add(-time.Minute)
+	expiredReady := time.Now().Add(-time.Hour)
+
+	server, _ := setupDisplayBoardServer(t,
+		newDisplayBoardTestOrder("550e8400-e29b-41d4-a716-446655440001", "001", constants.KITCHEN_ORDER_STATUS_PREPARING_ID, nil),
+		newDisplayBoardTestOrder("550e8400-e29b-41d4-a716-446655440002", "002", constants.KITCHEN_ORDER_STATUS_READY_ID, &recentReady),
+		newDisplayBoardTestOrder("550e8400-e29b-41d4-a716-446655440003", "003", constants.KITCHEN_ORDER_STATUS_READY_ID, &expiredReady),
+	)
+
+	resp, err := http.Get(server.URL + "/v1/display-board")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var raw map[string]any
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&raw))
+
+	assert.Equal(t, []any{map[string]any{"slug": "001"}}, raw["preparing"], "only slugs are exposed")
+
+	ready := raw["ready"].([]any)
+	require.Len(t, ready, 1)
+	assert.Equal(t, "002", ready[0].(map[string]any)["slug"])
+	assert.NotContains(t, ready[0], "id")
+	t.Log("✓ Quadro público sem IDs e sem pedidos prontos expirados")
+}
+
+func TestDisplayBoardHandler_StreamSendsChimeWhenReady(t *testing.T) {
+	orderID := "550e8400-e29b-41d4-a716-446655440001"
+	server, board := setupDisplayBoardServer(t,
+		newDisplayBoardTestOrder(orderID, "001", constants.KITCHEN_ORDER_STATUS_PREPARING_ID, nil),
+	)
+
+	reader, _ := openStream(t, server.URL+"/v1/display-board/stream", "")
+	assert.Equal(t, "retry: 3000\n", readFrame(t, reader))
+
+	frame := readFrame(t, reader)
+	assert.Contains(t, frame, "event: board\n")
+	assert.Contains(t, frame, `"preparing":[{"slug":"001"}]`)
+
+	readyAt := time.Now()
+	require.NoError(t, board.Update(context.Background(), newDisplayBoardTestOrder(orderID, "001", constants.KITCHEN_ORDER_STATUS_READY_ID, &readyAt)))
+
+	frame = readFrame(t, reader)
+	assert.Contains(t, frame, "event: chime\n")
+	assert.Contains(t, frame, `{"slug":"001"}`)
+
+	frame = readFrame(t, reader)
+	assert.Contains(t, frame, "event: board\n")
+	assert.Contains(t, frame, `"preparing":[]`)
+	assert.Contains(t, frame, `"ready":[{"slug":"001"`)
+}
+
+func TestDisplayBoardHandler_StreamSkipsUnchangedBoard(t *testing.T) {
+	orderID := "550e8400-e29b-41d4-a716-446655440001"
+	server, board := setupDisplayBoardServer(t,
+		newDisplayBoardTestOrder(orderID, "001", constants.KITCHEN_ORDER_STATUS_RECEIVED_ID, nil),
+	)
+
+	reader, _ := openStream(t, server.URL+"/v1/display-board/stream", "")
+	readFrame(t, reader)
+	readFrame(t, reader)
+
+	// Recebido -> Em preparação não muda a visão pública; Em preparação -> Pronto muda
+	require.NoError(t, board.Update(context.Background(), newDisplayBoardTestOrder(orderID, "001", constants.KITCHEN_ORDER_STATUS_PREPARING_ID, nil)))
+	readyAt := time.Now()
+	require.NoError(t, board.Update(context.Background(), newDisplayBoardTestOrder(orderID, "001", constants.KITCHEN_ORDER_STATUS_READY_ID, &readyAt)))
+
+	assert.Contains(t, readFrame(t, reader), "event: chime\n")
+	assert.Contains(t, readFrame(t, reader), `"ready":[{"slug":"001"`)
+}
+
+func TestDisplayBoardHandler_StreamLimit(t *testing.T) {
+	server, _ := setupDisplayBoardServerWithLimit(t, 1)
+
+	reader, cancel := openStream(t, server.URL+"/v1/display-board/stream", "")
+	readFrame(t, reader)
+	readFrame(t, reader)
+
+	resp, err := http.Get(server.URL + "/v1/display-board/stream")
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+
+	// A vaga é liberada quando a tela desconecta
+	cancel()
+	assert.Eventually(t, func() bool {
+		resp, err := http.Get(server.URL + "/v1/display-board/stream?probe")
+		if err != nil {
+			return false
+		}
+		resp.Body.Close()
+		return resp.StatusCode == http.StatusOK
+	}, 2*time.Second, 10*time.Millisecond)
+	t.Log("✓ Conexões públicas limitadas por instância")
+}
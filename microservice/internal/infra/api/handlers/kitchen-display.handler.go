@@ -0,0 +1,339 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+
+	"tech_challenge/internal/application/controllers"
+	"tech_challenge/internal/application/dtos"
+	"tech_challenge/internal/domain/exceptions"
+	"tech_challenge/internal/factories"
+	"tech_challenge/internal/infra/api/http_errors"
+	"tech_challenge/internal/infra/api/schemas"
+	"tech_challenge/internal/infra/streaming"
+	"tech_challenge/internal/shared/config/constants"
+	"tech_challenge/internal/shared/config/env"
+	"tech_challenge/internal/shared/infra/auth"
+	"tech_challenge/internal/shared/pkg/logger"
+)
+
+const (
+	kitchenDisplayWriteTimeout   = 10 * time.Second
+	kitchenDisplayMaxMessageSize = 4 * 1024
+	kitchenDisplayReplyBuffer    = 16
+
+	kitchenDisplayMessageSnapshot = "snapshot"
+	kitchenDisplayMessageEvent    = "event"
+	kitchenDisplayMessageAck      = "ack"
+	kitchenDisplayMessageError    = "error"
+)
+
+type KitchenDisplayHandler struct {
+	kitchenOrderController controllers.KitchenOrderController
+	hub                    *streaming.KitchenOrderEventHub
+	upgrader               websocket.Upgrader
+	pingInterval           time.Duration
+}
+
+func NewKitchenDisplayHandler() *KitchenDisplayHandler {
+	return &KitchenDisplayHandler{
+		kitchenOrderController: *factories.NewKitchenOrderController(),
+		hub:                    factories.GetKitchenOrderEventHub(),
+		upgrader:               newKitchenDisplayUpgrader(env.GetConfig().KitchenDisplay.AllowedOrigins),
+		pingInterval:           env.GetConfig().Stream.HeartbeatInterval,
+	}
+}
+
+func newKitchenDisplayUpgrader(allowedOrigins []string) websocket.Upgrader {
+	return websocket.Upgrader{
+		ReadBufferSize:  1024,
+		WriteBufferSize: 1024,
+		CheckOrigin:     kitchenDisplayOriginChecker(allowedOrigins),
+	}
+}
+
+// kitchenDisplayOriginChecker aceita clientes sem Origin (fora do navegador), a própria origem da API e as origens configuradas.
+// Como o token pode vir na query string, uma página de outra origem não pode abrir o socket em nome do dispositivo
+func kitchenDisplayOriginChecker(allowedOrigins []string) func(r *http.Request) bool {
+	return func(r *http.Request) bool {
+		origin := r.Header.Get("Origin")
+		if origin == "" {
+			return true
+		}
+
+		parsed, err := url.Parse(origin)
+		if err != nil {
+			return false
+		}
+
+		if strings.EqualFold(parsed.Host, r.Host) {
+			return true
+		}
+
+		return slices.ContainsFunc(allowedOrigins, func(allowed string) bool {
+			return strings.EqualFold(strings.TrimSuffix(allowed, "/"), origin)
+		})
+	}
+}
+
+// kitchenDisplayBoard guarda quais pedidos estão visíveis no dispositivo para repassar também as saídas do quadro
+type kitchenDisplayBoard struct {
+	statusIDs []string
+	visible   map[string]struct{}
+}
+
+func (b *kitchenDisplayBoard) accepts(event dtos.KitchenOrderEventDTO) bool {
+	onBoard := event.Type != constants.KITCHEN_ORDER_EVENT_REMOVED &&
+		(len(b.statusIDs) == 0 || slices.Contains(b.statusIDs, event.Status.ID))
+
+	_, wasVisible := b.visible[event.KitchenOrderID]
+
+	if onBoard {
+		b.visible[event.KitchenOrderID] = struct{}{}
+	} else {
+		delete(b.visible, event.KitchenOrderID)
+	}
+
+	return onBoard || wasVisible
+}
+
+// @Summary Kitchen display WebSocket
+// @Description Upgrades to a WebSocket. The device receives a "snapshot" of its board followed by "event" messages for every change.
+// @Description Commands are sent as JSON {"request_id","command","kitchen_order_id","version","reason"} with command start, ready, finish or recall, and each one is answered on the same socket with an "ack" (updated order) or an "error" message carrying the same request_id.
+// @Description The optional version rejects the command when the order was changed since the device last saw it, and recall requires a reason.
+// @Description Each command is authorized against the roles of the token used to connect, as in the matching POST endpoint.
+// @Description Browsers can send the token in the access_token query parameter; their Origin must be the API itself or listed in KITCHEN_DISPLAY_ALLOWED_ORIGINS.
+// @Tags KitchenOrders
+// @Param station query string false "Station label echoed in the snapshot"
+// @Param status_id query []string false "Board columns shown by the device (repeat or comma-separate)" collectionFormat(multi)
+// @Param access_token query string false "Access token, for clients that cannot send the Authorization header"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Success 101 {object} schemas.KitchenDisplaySnapshotSchema
+// @Failure 400 {object} schemas.ProblemDetailsSchema
+// @Failure 401 {object} schemas.ProblemDetailsSchema
+// @Failure 403 {object} schemas.ProblemDetailsSchema
+// @Failure 429 {object} schemas.ProblemDetailsSchema
+// @Router /kitchen-orders/ws [get]
+func (h *KitchenDisplayHandler) Connect(ctx *gin.Context) {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok {
+		if ctxErr := ctx.Error(&exceptions.UnauthorizedException{}); ctxErr != nil {
+			logger.FromContext(ctx).Error("Error setting context error", logger.ErrorKey, ctxErr)
+		}
+		return
+	}
+
+	board := &kitchenDisplayBoard{
+		statusIDs: parseStatusIDsQuery(ctx),
+		visible:   make(map[string]struct{}),
+	}
+
+	subscription, position, err := h.hub.Subscribe()
+	if err != nil {
+		if ctxErr := ctx.Error(err); ctxErr != nil {
+			logger.FromContext(ctx).Error("Error setting context error", logger.ErrorKey, ctxErr)
+		}
+		return
+	}
+	defer subscription.Close()
+
+	// A assinatura vem antes do snapshot para que nenhuma alteração feita entre os dois se perca
+	kitchenOrders, err := h.findBoard(ctx.Request.Context(), board.statusIDs)
+	if err != nil {
+		if ctxErr := ctx.Error(err); ctxErr != nil {
+			logger.FromContext(ctx).Error("Error setting context error", logger.ErrorKey, ctxErr)
+		}
+		return
+	}
+
+	conn, err := h.upgrader.Upgrade(ctx.Writer, ctx.Request, nil)
+	if err != nil {
+		// O upgrader já respondeu ao cliente com o erro do handshake
+		logger.FromContext(ctx).Warn("Error upgrading kitchen display connection", logger.ErrorKey, err)
+		return
+	}
+	defer conn.Close()
+
+	snapshot := schemas.KitchenDisplaySnapshotSchema{
+		Type:          kitchenDisplayMessageSnapshot,
+		Station:       ctx.Query("station"),
+		LastEventID:   position,
+		KitchenOrders: make([]schemas.KitchenOrderResponseSchema, len(kitchenOrders)),
+	}
+	for i, kitchenOrder := range kitchenOrders {
+		snapshot.KitchenOrders[i] = toKitchenOrderResponseSchema(kitchenOrder)
+		board.visible[kitchenOrder.ID] = struct{}{}
+	}
+
+	if err := h.write(conn, snapshot); err != nil {
+		return
+	}
+
+	replies := make(chan schemas.KitchenDisplayReplySchema, kitchenDisplayReplyBuffer)
+	readerDone := make(chan struct{})
+	stop := make(chan struct{})
+	defer close(stop)
+	go h.readCommands(ctx.Request.Context(), conn, principal, replies, readerDone, stop)
+
+	ping := time.NewTicker(h.pingInterval)
+	defer ping.Stop()
+
+	// Toda escrita no socket acontece neste loop; o gorilla não permite escritores concorrentes
+	for {
+		select {
+		case <-readerDone:
+			return
+
+		case reply := <-replies:
+			if err := h.write(conn, reply); err != nil {
+				return
+			}
+
+		case event, ok := <-subscription.Events():
+			if !ok {
+				_ = conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseGoingAway, ""), time.Now().Add(kitchenDisplayWriteTimeout))
+				return
+			}
+
+			if !board.accepts(event) {
+				continue
+			}
+
+			if err := h.write(conn, schemas.KitchenDisplayEventSchema{
+				Type:  kitchenDisplayMessageEvent,
+				Event: toKitchenOrderStreamEventSchema(event),
+			}); err != nil {
+				return
+			}
+
+		case <-ping.C:
+			if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(kitchenDisplayWriteTimeout)); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// findBoard percorre todas as páginas, como o GetDisplayBoardUseCase; o dispositivo precisa do quadro completo
+func (h *KitchenDisplayHandler) findBoard(ctx context.Context, statusIDs []string) ([]dtos.KitchenOrderResponseDTO, error) {
+	filter := dtos.KitchenOrderFilter{
+		Sort:      constants.KITCHEN_ORDER_SORT_BOARD,
+		StatusIDs: statusIDs,
+		Limit:     constants.KITCHEN_ORDER_MAX_PAGE_LIMIT,
+	}
+
+	var kitchenOrders []dtos.KitchenOrderResponseDTO
+	for {
+		page, err := h.kitchenOrderController.FindAll(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+
+		kitchenOrders = append(kitchenOrders, page.Items...)
+
+		if page.NextCursor == nil {
+			return kitchenOrders, nil
+		}
+		filter.Cursor = *page.NextCursor
+	}
+}
+
+func (h *KitchenDisplayHandler) readCommands(ctx context.Context, conn *websocket.Conn, principal auth.Principal, replies chan<- schemas.KitchenDisplayReplySchema, done chan<- struct{}, stop <-chan struct{}) {
+	defer close(done)
+
+	// Sem pong dentro de dois intervalos de ping a conexão é considerada morta
+	pongWait := 2 * h.pingInterval
+	conn.SetReadLimit(kitchenDisplayMaxMessageSize)
+	_ = conn.SetReadDeadline(time.Now().Add(pongWait))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(pongWait))
+	})
+
+	for {
+		_, payload, err := conn.ReadMessage()
+		if err != nil {
+			if websocket.IsUnexpectedCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+				logger.FromContext(ctx).Info("Kitchen display connection closed", logger.ErrorKey, err)
+			}
+			return
+		}
+
+		_ = conn.SetReadDeadline(time.Now().Add(pongWait))
+
+		select {
+		case replies <- h.executeCommand(ctx, payload, principal):
+		case <-stop:
+			return
+		}
+	}
+}
+
+func (h *KitchenDisplayHandler) executeCommand(ctx context.Context, payload []byte, principal auth.Principal) schemas.KitchenDisplayReplySchema {
+	var command schemas.KitchenDisplayCommandSchema
+	if err := json.Unmarshal(payload, &command); err != nil {
+		return schemas.KitchenDisplayReplySchema{
+			Type:  kitchenDisplayMessageError,
+			Error: "Invalid command payload",
+		}
+	}
+
+	// Comandos desconhecidos seguem para o use case, que responde com o erro de comando inválido
+	if roles, ok := auth.KitchenOrderCommandRoles(command.Command); ok && !principal.HasAnyRole(roles...) {
+		return commandErrorReply(ctx, command, &exceptions.ForbiddenException{})
+	}
+
+	kitchenOrder, err := h.kitchenOrderController.ExecuteCommand(ctx, dtos.KitchenOrderCommandDTO{
+		ID:              command.KitchenOrderID,
+		Command:         command.Command,
+		Reason:          command.Reason,
+		ChangedBy:       &principal.Subject,
+		ExpectedVersion: command.Version,
+	})
+	if err != nil {
+		return commandErrorReply(ctx, command, err)
+	}
+
+	// Os demais dispositivos recebem a alteração pelo feed; o hub é acordado para não esperar o próximo polling
+	h.hub.Notify()
+
+	response := toKitchenOrderResponseSchema(kitchenOrder)
+
+	return schemas.KitchenDisplayReplySchema{
+		Type:         kitchenDisplayMessageAck,
+		RequestID:    command.RequestID,
+		KitchenOrder: &response,
+	}
+}
+
+// commandErrorReply usa o mesmo registro de problemas das respostas HTTP: o dispositivo recebe apenas o código e uma mensagem segura,
+// e o erro completo fica no log do servidor
+func commandErrorReply(ctx context.Context, command schemas.KitchenDisplayCommandSchema, err error) schemas.KitchenDisplayReplySchema {
+	problem, message, isDomainError := http_errors.ResolveProblem(err)
+	if !isDomainError {
+		logger.FromContext(ctx).Error("Error executing kitchen display command", "command", command.Command, logger.KitchenOrderIDKey, command.KitchenOrderID, logger.ErrorKey, err)
+	}
+
+	return schemas.KitchenDisplayReplySchema{
+		Type:      kitchenDisplayMessageError,
+		RequestID: command.RequestID,
+		Error:     message,
+		ErrorCode: problem.Code,
+	}
+}
+
+func (h *KitchenDisplayHandler) write(conn *websocket.Conn, message any) error {
+	if err := conn.SetWriteDeadline(time.Now().Add(kitchenDisplayWriteTimeout)); err != nil {
+		return err
+	}
+
+	return conn.WriteJSON(message)
+}
@@ -0,0 +1,512 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"tech_challenge/internal"
+	"tech_challenge/internal/application/controllers"
+	"tech_challenge/internal/application/dtos"
+	"tech_challenge/internal/daos"
+	"tech_challenge/internal/infra/api/schemas"
+	"tech_challenge/internal/infra/streaming"
+	"tech_challenge/internal/shared/config/constants"
+	"tech_challenge/internal/shared/infra/api/middlewares"
+	"tech_challenge/internal/shared/infra/auth"
+)
+
+const (
+	kitchenDisplayTestOrderID = "550e8400-e29b-41d4-a716-446655440000"
+
+	// kitchenDisplayTestRolesHeader substitui o token nos testes; o middleware de teste monta o ator a partir dele
+	kitchenDisplayTestRolesHeader = "X-Test-Roles"
+)
+
+var kitchenDisplayTestStatuses = map[string]string{
+	constants.KITCHEN_ORDER_STATUS_RECEIVED_ID:  "Recebido",
+	constants.KITCHEN_ORDER_STATUS_PREPARING_ID: "Em preparação",
+	constants.KITCHEN_ORDER_STATUS_READY_ID:     "Pronto",
+	constants.KITCHEN_ORDER_STATUS_FINISHED_ID:  "Finalizado",
+}
+
+// fakeBoardDataSource grava no feed de eventos a cada alteração, como o data source GORM faz na mesma transação
+type fakeBoardDataSource struct {
+	mu     sync.Mutex
+	orders map[string]daos.KitchenOrderDAO
+	events *fakeKitchenOrderEventDataSource
+}
+
+func (f *fakeBoardDataSource) Insert(ctx context.Context, kitchenOrder daos.KitchenOrderDAO) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.orders[kitchenOrder.ID] = kitchenOrder
+	return nil
+}
+
+func (f *fakeBoardDataSource) FindByID(ctx context.Context, id string) (daos.KitchenOrderDAO, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.orders[id], nil
+}
+
+func (f *fakeBoardDataSource) FindAll(ctx context.Context, filter dtos.KitchenOrderFilter) ([]daos.KitchenOrderDAO, dtos.PageInfo, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var result []daos.KitchenOrderDAO
+	for _, order := range f.orders {
+		if (len(filter.StatusIDs) == 0 || slices.Contains(filter.StatusIDs, order.Status.ID)) && order.ID > filter.Cursor {
+			result = append(result, order)
+		}
+	}
+	slices.SortFunc(result, func(a, b daos.KitchenOrderDAO) int { return strings.Compare(a.ID, b.ID) })
+
+	// O cursor é o ID do último pedido da página, o suficiente para os testes percorrerem as páginas
+	pageInfo := dtos.PageInfo{Total: int64(len(result))}
+	if filter.Limit > 0 && len(result) > filter.Limit {
+		result = result[:filter.Limit]
+		nextCursor := result[len(result)-1].ID
+		pageInfo.NextCursor = &nextCursor
+	}
+	return result, pageInfo, nil
+}
+
+func (f *fakeBoardDataSource) FindAllInBatches(ctx context.Context, filter dtos.KitchenOrderFilter, batchSize int, handle func([]daos.KitchenOrderDAO) error) error {
+	result, _, _ := f.FindAll(ctx, filter)
+	if len(result) == 0 {
+		return nil
+	}
+	return handle(result)
+}
+
+func (f *fakeBoardDataSource) Update(ctx context.Context, kitchenOrder daos.KitchenOrderDAO) error {
+	f.mu.Lock()
+	f.orders[kitchenOrder.ID] = kitchenOrder
+	f.mu.Unlock()
+
+	f.events.mu.Lock()
+	defer f.events.mu.Unlock()
+
+	f.events.events = append(f.events.events, daos.KitchenOrderEventDAO{
+		ID:             uint64(len(f.events.events) + 1),
+		Type:           constants.KITCHEN_ORDER_EVENT_STATUS_CHANGED,
+		KitchenOrderID: kitchenOrder.ID,
+		OrderID:        kitchenOrder.OrderID,
+		Slug:           kitchenOrder.Slug,
+		StatusID:       kitchenOrder.Status.ID,
+		StatusName:     kitchenOrder.Status.Name,
+		Reason:         kitchenOrder.StatusReason,
+		Actor:          kitchenOrder.StatusChangedBy,
+		CreatedAt:      time.Now(),
+	})
+	return nil
+}
+
+func (f *fakeBoardDataSource) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+type fakeStatusDataSource struct{}
+
+func (fakeStatusDataSource) FindByID(ctx context.Context, id string) (daos.OrderStatusDAO, error) {
+	return daos.OrderStatusDAO{ID: id, Name: kitchenDisplayTestStatuses[id]}, nil
+}
+
+func (fakeStatusDataSource) FindAll(ctx context.Context) ([]daos.OrderStatusDAO, error) {
+	return nil, nil
+}
+
+func setupKitchenDisplayServer(t *testing.T, statusID string) *httptest.Server {
+	server, _ := setupKitchenDisplayServerWithBoard(t, statusID)
+	return server
+}
+
+func setupKitchenDisplayServerWithBoard(t *testing.T, statusID string) (*httptest.Server, *fakeBoardDataSource) {
+	internal.SetupTestEnv()
+	t.Cleanup(internal.CleanupTestEnv)
+	gin.SetMode(gin.TestMode)
+
+	events := &fakeKitchenOrderEventDataSource{}
+	board := &fakeBoardDataSource{
+		orders: map[string]daos.KitchenOrderDAO{
+			kitchenDisplayTestOrderID: {
+				ID:        kitchenDisplayTestOrderID,
+				OrderID:   "order-001",
+				Slug:      "001",
+				Status:    daos.OrderStatusDAO{ID: statusID, Name: kitchenDisplayTestStatuses[statusID]},
+				CreatedAt: time.Now(),
+			},
+		},
+		events: events,
+	}
+
+	messageBroker := new(MockMessageBroker)
+	messageBroker.On("Publish", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	hub := streaming.NewKitchenOrderEventHub(controllers.NewKitchenOrderEventController(events), time.Hour, 0)
+	handler := &KitchenDisplayHandler{
+		kitchenOrderController: *controllers.NewKitchenOrderController(board, fakeStatusDataSource{}, messageBroker, nil, nil),
+		hub:                    hub,
+		upgrader:               newKitchenDisplayUpgrader([]string{"https://kds.example.com"}),
+		pingInterval:           time.Hour,
+	}
+
+	router := gin.New()
+	router.Use(middlewares.ErrorHandlerMiddleware())
+	router.Use(func(ctx *gin.Context) {
+		if roles := ctx.GetHeader(kitchenDisplayTestRolesHeader); roles != "" {
+			ctx.Set(constants.AUTH_PRINCIPAL_CONTEXT_KEY, auth.Principal{Subject: "device-" + roles, Roles: strings.Split(roles, ",")})
+		}
+	})
+	router.GET("/v1/kitchen-orders/ws", handler.Connect)
+
+	server := httptest.NewServer(router)
+	t.Cleanup(func() {
+		server.Close()
+		hub.Close()
+	})
+
+	return server, board
+}
+
+func dialKitchenDisplay(t *testing.T, server *httptest.Server, query string) *websocket.Conn {
+	t.Helper()
+
+	return dialKitchenDisplayAs(t, server, query, constants.ROLE_MANAGER)
+}
+
+func dialKitchenDisplayAs(t *testing.T, server *httptest.Server, query string, roles ...string) *websocket.Conn {
+	t.Helper()
+
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/v1/kitchen-orders/ws" + query
+	header := http.Header{kitchenDisplayTestRolesHeader: {strings.Join(roles, ",")}}
+	conn, _, err := websocket.DefaultDialer.Dial(url, header)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return conn
+}
+
+func readKitchenDisplayMessage(t *testing.T, conn *websocket.Conn, message any) {
+	t.Helper()
+
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(2*time.Second)))
+	require.NoError(t, conn.ReadJSON(message))
+}
+
+func TestKitchenDisplayHandler_SendsSnapshotOnConnect(t *testing.T) {
+	server := setupKitchenDisplayServer(t, constants.KITCHEN_ORDER_STATUS_RECEIVED_ID)
+
+	conn := dialKitchenDisplay(t, server, "?station=grill")
+
+	var snapshot schemas.KitchenDisplaySnapshotSchema
+	readKitchenDisplayMessage(t, conn, &snapshot)
+
+	assert.Equal(t, "snapshot", snapshot.Type)
+	assert.Equal(t, "grill", snapshot.Station)
+	require.Len(t, snapshot.KitchenOrders, 1)
+	assert.Equal(t, "Recebido", snapshot.KitchenOrders[0].Status)
+	t.Log("✓ Snapshot do quadro enviado na conexão")
+}
+
+func TestKitchenDisplayHandler_SnapshotIncludesEveryPage(t *testing.T) {
+	server, board := setupKitchenDisplayServerWithBoard(t, constants.KITCHEN_ORDER_STATUS_RECEIVED_ID)
+
+	board.mu.Lock()
+	for i := 0; i < constants.KITCHEN_ORDER_MAX_PAGE_LIMIT+5; i++ {
+		id := fmt.Sprintf("order-%04d", i)
+		board.orders[id] = daos.KitchenOrderDAO{
+			ID:     id,
+			Slug:   fmt.Sprintf("%03d", i),
+			Status: daos.OrderStatusDAO{ID: constants.KITCHEN_ORDER_STATUS_RECEIVED_ID, Name: "Recebido"},
+		}
+	}
+	board.mu.Unlock()
+
+	conn := dialKitchenDisplay(t, server, "")
+
+	var snapshot schemas.KitchenDisplaySnapshotSchema
+	readKitchenDisplayMessage(t, conn, &snapshot)
+
+	assert.Len(t, snapshot.KitchenOrders, constants.KITCHEN_ORDER_MAX_PAGE_LIMIT+6)
+	t.Log("✓ Snapshot percorre todas as páginas do quadro")
+}
+
+func TestKitchenDisplayHandler_ChecksOrigin(t *testing.T) {
+	server := setupKitchenDisplayServer(t, constants.KITCHEN_ORDER_STATUS_RECEIVED_ID)
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/v1/kitchen-orders/ws"
+
+	for origin, allowed := range map[string]bool{
+		"https://kds.example.com":      true,
+		server.URL:                     true,
+		"https://attacker.example.com": false,
+		"null":                         false,
+	} {
+		header := http.Header{kitchenDisplayTestRolesHeader: {constants.ROLE_COOK}, "Origin": {origin}}
+		conn, resp, err := websocket.DefaultDialer.Dial(url, header)
+
+		if allowed {
+			require.NoError(t, err, origin)
+			conn.Close()
+			continue
+		}
+
+		require.Error(t, err, origin)
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode, origin)
+	}
+	t.Log("✓ WebSocket aceita apenas a própria origem e as origens configuradas")
+}
+
+func TestKitchenDisplayHandler_CommandIsAcknowledgedAndBroadcast(t *testing.T) {
+	server := setupKitchenDisplayServer(t, constants.KITCHEN_ORDER_STATUS_RECEIVED_ID)
+
+	cook := dialKitchenDisplay(t, server, "")
+	expo := dialKitchenDisplay(t, server, "")
+
+	var snapshot schemas.KitchenDisplaySnapshotSchema
+	readKitchenDisplayMessage(t, cook, &snapshot)
+	readKitchenDisplayMessage(t, expo, &snapshot)
+
+	require.NoError(t, cook.WriteJSON(schemas.KitchenDisplayCommandSchema{
+		RequestID:      "req-1",
+		Command:        constants.KITCHEN_ORDER_COMMAND_START,
+		KitchenOrderID: kitchenDisplayTestOrderID,
+	}))
+
+	// O dispositivo que enviou o comando recebe o ack e o evento, em qualquer ordem
+	var ackReceived, eventReceived bool
+	for i := 0; i < 2; i++ {
+		var message map[string]any
+		readKitchenDisplayMessage(t, cook, &message)
+
+		switch message["type"] {
+		case "ack":
+			ackReceived = true
+			assert.Equal(t, "req-1", message["request_id"])
+			assert.Equal(t, "Em preparação", message["kitchen_order"].(map[string]any)["status"])
+		case "event":
+			eventReceived = true
+		}
+	}
+	assert.True(t, ackReceived)
+	assert.True(t, eventReceived)
+
+	var event schemas.KitchenDisplayEventSchema
+	readKitchenDisplayMessage(t, expo, &event)
+
+	assert.Equal(t, "event", event.Type)
+	assert.Equal(t, kitchenDisplayTestOrderID, event.Event.KitchenOrderID)
+	assert.Equal(t, constants.KITCHEN_ORDER_STATUS_PREPARING_ID, event.Event.StatusID)
+	require.NotNil(t, event.Event.Actor)
+	assert.Equal(t, "device-manager", *event.Event.Actor)
+	t.Log("✓ Alteração confirmada e repassada para os demais dispositivos")
+}
+
+func TestKitchenDisplayHandler_InvalidCommandReturnsError(t *testing.T) {
+	server := setupKitchenDisplayServer(t, constants.KITCHEN_ORDER_STATUS_RECEIVED_ID)
+
+	conn := dialKitchenDisplay(t, server, "")
+
+	var snapshot schemas.KitchenDisplaySnapshotSchema
+	readKitchenDisplayMessage(t, conn, &snapshot)
+
+	require.NoError(t, conn.WriteJSON(schemas.KitchenDisplayCommandSchema{
+		RequestID:      "req-2",
+		Command:        constants.KITCHEN_ORDER_COMMAND_RECALL,
+		KitchenOrderID: kitchenDisplayTestOrderID,
+	}))
+
+	var reply schemas.KitchenDisplayReplySchema
+	readKitchenDisplayMessage(t, conn, &reply)
+
+	assert.Equal(t, "error", reply.Type)
+	assert.Equal(t, "req-2", reply.RequestID)
+	assert.Nil(t, reply.KitchenOrder)
+
+	require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte("not-json")))
+	readKitchenDisplayMessage(t, conn, &reply)
+
+	assert.Equal(t, "error", reply.Type)
+	assert.Equal(t, "Invalid command payload", reply.Error)
+}
+
+func TestKitchenDisplayHandler_StaleVersionIsRejected(t *testing.T) {
+	server := setupKitchenDisplayServer(t, constants.KITCHEN_ORDER_STATUS_RECEIVED_ID)
+
+	conn := dialKitchenDisplay(t, server, "")
+
+	var snapshot schemas.KitchenDisplaySnapshotSchema
+	readKitchenDisplayMessage(t, conn, &snapshot)
+
+	staleVersion := uint64(7)
+	require.NoError(t, conn.WriteJSON(schemas.KitchenDisplayCommandSchema{
+		RequestID:      "req-3",
+		Command:        constants.KITCHEN_ORDER_COMMAND_START,
+		KitchenOrderID: kitchenDisplayTestOrderID,
+		Version:        &staleVersion,
+	}))
+
+	var reply schemas.KitchenDisplayReplySchema
+	readKitchenDisplayMessage(t, conn, &reply)
+
+	assert.Equal(t, "error", reply.Type)
+	assert.Equal(t, "req-3", reply.RequestID)
+	assert.Equal(t, "kitchen-order-version-mismatch", reply.ErrorCode)
+	t.Log("✓ Comando sobre versão desatualizada rejeitado")
+}
+
+func TestKitchenDisplayHandler_StationBoardFiltersEvents(t *testing.T) {
+	server := setupKitchenDisplayServer(t, constants.KITCHEN_ORDER_STATUS_PREPARING_ID)
+
+	// A expedição só mostra pedidos prontos; o pedido entra no quadro quando fica pronto
+	expo := dialKitchenDisplay(t, server, "?station=expo&status_id="+constants.KITCHEN_ORDER_STATUS_READY_ID)
+	cook := dialKitchenDisplay(t, server, "")
+
+	var snapshot schemas.KitchenDisplaySnapshotSchema
+	readKitchenDisplayMessage(t, expo, &snapshot)
+	assert.Empty(t, snapshot.KitchenOrders)
+	readKitchenDisplayMessage(t, cook, &snapshot)
+
+	for _, command := range []string{constants.KITCHEN_ORDER_COMMAND_READY, constants.KITCHEN_ORDER_COMMAND_RECALL} {
+		require.NoError(t, cook.WriteJSON(schemas.KitchenDisplayCommandSchema{
+			Command:        command,
+			KitchenOrderID: kitchenDisplayTestOrderID,
+			Reason:         "Cliente devolveu o lanche frio",
+		}))
+
+		var event schemas.KitchenDisplayEventSchema
+		readKitchenDisplayMessage(t, expo, &event)
+		assert.Equal(t, "event", event.Type)
+
+		if command == constants.KITCHEN_ORDER_COMMAND_READY {
+			assert.Equal(t, constants.KITCHEN_ORDER_STATUS_READY_ID, event.Event.StatusID)
+		} else {
+			// A saída do quadro também é entregue para o dispositivo remover o pedido
+			assert.Equal(t, constants.KITCHEN_ORDER_STATUS_PREPARING_ID, event.Event.StatusID)
+			require.NotNil(t, event.Event.Reason)
+			assert.Equal(t, "Cliente devolveu o lanche frio", *event.Event.Reason)
+		}
+	}
+}
+
+func TestKitchenDisplayHandler_RejectsPlainHTTP(t *testing.T) {
+	server := setupKitchenDisplayServer(t, constants.KITCHEN_ORDER_STATUS_RECEIVED_ID)
+
+	request, err := http.NewRequest(http.MethodGet, server.URL+"/v1/kitchen-orders/ws", nil)
+	require.NoError(t, err)
+	request.Header.Set(kitchenDisplayTestRolesHeader, constants.ROLE_COOK)
+
+	resp, err := http.DefaultClient.Do(request)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestKitchenDisplayHandler_RequiresPrincipal(t *testing.T) {
+	server := setupKitchenDisplayServer(t, constants.KITCHEN_ORDER_STATUS_RECEIVED_ID)
+
+	resp, err := http.Get(server.URL + "/v1/kitchen-orders/ws")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+}
+
+func TestKitchenDisplayHandler_CommandsFollowRolePermissions(t *testing.T) {
+	server := setupKitchenDisplayServer(t, constants.KITCHEN_ORDER_STATUS_RECEIVED_ID)
+
+	cook := dialKitchenDisplayAs(t, server, "", constants.ROLE_COOK)
+
+	var snapshot schemas.KitchenDisplaySnapshotSchema
+	readKitchenDisplayMessage(t, cook, &snapshot)
+
+	// Finalizar é tarefa da expedição
+	require.NoError(t, cook.WriteJSON(schemas.KitchenDisplayCommandSchema{
+		RequestID:      "req-forbidden",
+		Command:        constants.KITCHEN_ORDER_COMMAND_FINISH,
+		KitchenOrderID: kitchenDisplayTestOrderID,
+	}))
+
+	var reply schemas.KitchenDisplayReplySchema
+	readKitchenDisplayMessage(t, cook, &reply)
+
+	assert.Equal(t, "error", reply.Type)
+	assert.Equal(t, "req-forbidden", reply.RequestID)
+	assert.Equal(t, "You do not have permission to perform this action", reply.Error)
+	assert.Equal(t, "forbidden", reply.ErrorCode)
+
+	require.NoError(t, cook.WriteJSON(schemas.KitchenDisplayCommandSchema{
+		RequestID:      "req-start",
+		Command:        constants.KITCHEN_ORDER_COMMAND_START,
+		KitchenOrderID: kitchenDisplayTestOrderID,
+	}))
+
+	for {
+		var message map[string]any
+		readKitchenDisplayMessage(t, cook, &message)
+
+		if message["type"] == "ack" {
+			kitchenOrder := message["kitchen_order"].(map[string]any)
+			assert.Equal(t, "Em preparação", kitchenOrder["status"])
+			assert.Equal(t, "device-cook", kitchenOrder["status_changed_by"])
+			break
+		}
+	}
+	t.Log("✓ Comandos do socket respeitam os papéis do token")
+}
+
+type failingWebhookDispatcher struct{}
+
+func (failingWebhookDispatcher) Dispatch(ctx context.Context, event dtos.WebhookEventDTO) error {
+	return fmt.Errorf("pq: connection to 10.0.3.7:5432 refused")
+}
+
+func (failingWebhookDispatcher) Notify() {}
+
+func TestKitchenDisplayHandler_CommandErrorDoesNotExposeInternalError(t *testing.T) {
+	internal.SetupTestEnv()
+	t.Cleanup(internal.CleanupTestEnv)
+
+	board := &fakeBoardDataSource{
+		orders: map[string]daos.KitchenOrderDAO{
+			kitchenDisplayTestOrderID: {
+				ID:     kitchenDisplayTestOrderID,
+				Slug:   "001",
+				Status: daos.OrderStatusDAO{ID: constants.KITCHEN_ORDER_STATUS_RECEIVED_ID, Name: "Recebido"},
+			},
+		},
+		events: &fakeKitchenOrderEventDataSource{},
+	}
+	messageBroker := new(MockMessageBroker)
+	messageBroker.On("Publish", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	handler := &KitchenDisplayHandler{
+		kitchenOrderController: *controllers.NewKitchenOrderController(board, fakeStatusDataSource{}, messageBroker, failingWebhookDispatcher{}, nil),
+	}
+
+	payload := []byte(`{"request_id":"req-db","command":"` + constants.KITCHEN_ORDER_COMMAND_START + `","kitchen_order_id":"` + kitchenDisplayTestOrderID + `"}`)
+	reply := handler.executeCommand(context.Background(), payload, auth.Principal{Subject: "device-1", Roles: []string{constants.ROLE_MANAGER}})
+
+	assert.Equal(t, "error", reply.Type)
+	assert.Equal(t, "req-db", reply.RequestID)
+	assert.Equal(t, "internal-error", reply.ErrorCode)
+	assert.Equal(t, "Internal server error", reply.Error)
+	assert.NotContains(t, reply.Error, "10.0.3.7")
+	t.Log("✓ Erro interno chega ao dispositivo apenas como código e mensagem genérica")
+}
@@ -0,0 +1,185 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"tech_challenge/internal/application/controllers"
+	"tech_challenge/internal/application/dtos"
+	"tech_challenge/internal/domain/exceptions"
+	"tech_challenge/internal/factories"
+	"tech_challenge/internal/infra/api/schemas"
+	"tech_challenge/internal/infra/streaming"
+	"tech_challenge/internal/shared/config/env"
+	"tech_challenge/internal/shared/pkg/logger"
+)
+
+const streamRetryMilliseconds = 3000
+
+type KitchenOrderStreamHandler struct {
+	kitchenOrderEventController controllers.KitchenOrderEventController
+	hub                         *streaming.KitchenOrderEventHub
+	heartbeatInterval           time.Duration
+}
+
+func NewKitchenOrderStreamHandler() *KitchenOrderStreamHandler {
+	return &KitchenOrderStreamHandler{
+		kitchenOrderEventController: *factories.NewKitchenOrderEventController(),
+		hub:                         factories.GetKitchenOrderEventHub(),
+		heartbeatInterval:           env.GetConfig().Stream.HeartbeatInterval,
+	}
+}
+
+func toKitchenOrderStreamEventSchema(event dtos.KitchenOrderEventDTO) schemas.KitchenOrderStreamEventSchema {
+	return schemas.KitchenOrderStreamEventSchema{
+		EventID:        event.ID,
+		Type:           event.Type,
+		KitchenOrderID: event.KitchenOrderID,
+		OrderID:        event.OrderID,
+		Slug:           event.Slug,
+		StatusID:       event.Status.ID,
+		Status:         event.Status.Name,
+		Reason:         event.Reason,
+		Actor:          event.Actor,
+		OccurredAt:     event.OccurredAt,
+	}
+}
+
+// @Summary Stream kitchen order changes
+// @Description Server-Sent Events stream with "created", "status-changed" and "removed" events.
+// @Description Reconnect with the Last-Event-ID header (or last_event_id query) to resume without losing events.
+// @Tags KitchenOrders
+// @Produce text/event-stream
+// @Param Last-Event-ID header int false "Last event ID received"
+// @Param last_event_id query int false "Alternative to the Last-Event-ID header"
+// @Param access_token query string false "Access token, for clients that cannot send the Authorization header"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Success 200 {object} schemas.KitchenOrderStreamEventSchema
+// @Failure 400 {object} schemas.ProblemDetailsSchema
+// @Failure 401 {object} schemas.ProblemDetailsSchema
+// @Failure 403 {object} schemas.ProblemDetailsSchema
+// @Failure 429 {object} schemas.ProblemDetailsSchema
+// @Router /kitchen-orders/stream [get]
+func (h *KitchenOrderStreamHandler) Stream(ctx *gin.Context) {
+	lastEventIDStr := ctx.GetHeader("Last-Event-ID")
+	if lastEventIDStr == "" {
+		lastEventIDStr = ctx.Query("last_event_id")
+	}
+
+	var lastEventID uint64
+	resume := lastEventIDStr != ""
+	if resume {
+		parsed, err := strconv.ParseUint(lastEventIDStr, 10, 64)
+		if err != nil {
+			if ctxErr := ctx.Error(&exceptions.InvalidKitchenOrderFilterException{Message: "Last-Event-ID must be a positive integer"}); ctxErr != nil {
+				logger.FromContext(ctx).Error("Error setting context error", logger.ErrorKey, ctxErr)
+			}
+			return
+		}
+		lastEventID = parsed
+	}
+
+	subscription, position, err := h.hub.Subscribe()
+	if err != nil {
+		if ctxErr := ctx.Error(err); ctxErr != nil {
+			logger.FromContext(ctx).Error("Error setting context error", logger.ErrorKey, ctxErr)
+		}
+		return
+	}
+	defer subscription.Close()
+
+	streaming.SetSSEHeaders(ctx.Writer)
+	ctx.Status(http.StatusOK)
+
+	if err := streaming.WriteSSERetry(ctx.Writer, streamRetryMilliseconds); err != nil {
+		return
+	}
+	ctx.Writer.Flush()
+
+	if !resume {
+		lastEventID = position
+	}
+
+	// Reenvia o que o cliente perdeu até a posição atual do hub; o restante chega pela assinatura
+	for lastEventID < position {
+		events, err := h.kitchenOrderEventController.FindAfter(lastEventID, 0)
+		if err != nil {
+			logger.FromContext(ctx).Error("Error replaying kitchen order events", logger.ErrorKey, err)
+			return
+		}
+
+		if len(events) == 0 {
+			break
+		}
+
+		for _, event := range events {
+			if event.ID > position {
+				break
+			}
+			if !h.writeEvent(ctx, event.ID, event) {
+				return
+			}
+			lastEventID = event.ID
+		}
+
+		if events[len(events)-1].ID > position {
+			break
+		}
+	}
+
+	hubPosition := position
+
+	heartbeat := time.NewTicker(h.heartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-ctx.Request.Context().Done():
+			return
+
+		case event, ok := <-subscription.Events():
+			if !ok {
+				return
+			}
+
+			// Evento que ficou visível depois dos seguintes (transação mais longa):
+			// entregue sem recuar o Last-Event-ID do cliente
+			if event.ID <= hubPosition {
+				if !h.writeEvent(ctx, lastEventID, event) {
+					return
+				}
+				continue
+			}
+			hubPosition = event.ID
+
+			if event.ID <= lastEventID {
+				continue
+			}
+
+			if !h.writeEvent(ctx, event.ID, event) {
+				return
+			}
+			lastEventID = event.ID
+
+		case <-heartbeat.C:
+			if err := streaming.WriteSSEComment(ctx.Writer, "heartbeat"); err != nil {
+				return
+			}
+			ctx.Writer.Flush()
+		}
+	}
+}
+
+// writeEvent usa cursor como id SSE, que é a posição de retomada do cliente
+func (h *KitchenOrderStreamHandler) writeEvent(ctx *gin.Context, cursor uint64, event dtos.KitchenOrderEventDTO) bool {
+	if err := streaming.WriteSSEEvent(ctx.Writer, strconv.FormatUint(cursor, 10), event.Type, toKitchenOrderStreamEventSchema(event)); err != nil {
+		return false
+	}
+
+	ctx.Writer.Flush()
+	return true
+}
@@ -0,0 +1,231 @@
+package handlers
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tech_challenge/internal/application/controllers"
+	"tech_challenge/internal/daos"
+	"tech_challenge/internal/infra/streaming"
+	"tech_challenge/internal/shared/config/constants"
+	"tech_challenge/internal/shared/infra/api/middlewares"
+)
+
+type fakeKitchenOrderEventDataSource struct {
+	mu     sync.Mutex
+	events []daos.KitchenOrderEventDAO
+}
+
+func (f *fakeKitchenOrderEventDataSource) add(id uint64, eventType string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.events = append(f.events, daos.KitchenOrderEventDAO{
+		ID:             id,
+		Type:           eventType,
+		KitchenOrderID: "550e8400-e29b-41d4-a716-446655440000",
+		OrderID:        "order-001",
+		Slug:           "001",
+		StatusID:       constants.KITCHEN_ORDER_STATUS_READY_ID,
+		StatusName:     "Pronto",
+		CreatedAt:      time.Now(),
+	})
+}
+
+func (f *fakeKitchenOrderEventDataSource) FindAfter(afterID uint64, limit int) ([]daos.KitchenOrderEventDAO, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var result []daos.KitchenOrderEventDAO
+	for _, event := range f.events {
+		if event.ID > afterID && len(result) < limit {
+			result = append(result, event)
+		}
+	}
+	return result, nil
+}
+
+func (f *fakeKitchenOrderEventDataSource) FindByIDs(ids []uint64) ([]daos.KitchenOrderEventDAO, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var result []daos.KitchenOrderEventDAO
+	for _, event := range f.events {
+		if slices.Contains(ids, event.ID) {
+			result = append(result, event)
+		}
+	}
+	return result, nil
+}
+
+func (f *fakeKitchenOrderEventDataSource) FindLastID() (uint64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if len(f.events) == 0 {
+		return 0, nil
+	}
+	return f.events[len(f.events)-1].ID, nil
+}
+
+func (f *fakeKitchenOrderEventDataSource) DeleteOlderThan(before time.Time) (int64, error) {
+	return 0, nil
+}
+
+func setupStreamServer(t *testing.T, dataSource *fakeKitchenOrderEventDataSource, heartbeat time.Duration) *httptest.Server {
+	gin.SetMode(gin.TestMode)
+
+	controller := controllers.NewKitchenOrderEventController(dataSource)
+	hub := streaming.NewKitchenOrderEventHub(controller, 5*time.Millisecond, 0)
+
+	handler := &KitchenOrderStreamHandler{
+		kitchenOrderEventController: *controller,
+		hub:                         hub,
+		heartbeatInterval:           heartbeat,
+	}
+
+	router := gin.New()
+	router.Use(middlewares.ErrorHandlerMiddleware())
+	router.GET("/v1/kitchen-orders/stream", handler.Stream)
+
+	server := httptest.NewServer(router)
+	t.Cleanup(func() {
+		hub.Close()
+		server.Close()
+	})
+
+	return server
+}
+
+func openStream(t *testing.T, url string, lastEventID string) (*bufio.Reader, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	t.Cleanup(func() {
+		cancel()
+		resp.Body.Close()
+	})
+
+	return bufio.NewReader(resp.Body), cancel
+}
+
+// readFrame lê um bloco SSE completo (até a linha em branco)
+func readFrame(t *testing.T, reader *bufio.Reader) string {
+	t.Helper()
+
+	frame := make(chan string, 1)
+	go func() {
+		var lines []string
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				frame <- strings.Join(lines, "")
+				return
+			}
+			if line == "\n" {
+				frame <- strings.Join(lines, "")
+				return
+			}
+			lines = append(lines, line)
+		}
+	}()
+
+	select {
+	case value := <-frame:
+		return value
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for SSE frame")
+		return ""
+	}
+}
+
+func TestKitchenOrderStreamHandler_StreamsNewEvents(t *testing.T) {
+	dataSource := &fakeKitchenOrderEventDataSource{}
+	dataSource.add(1, constants.KITCHEN_ORDER_EVENT_CREATED)
+	server := setupStreamServer(t, dataSource, time.Hour)
+
+	reader, _ := openStream(t, server.URL+"/v1/kitchen-orders/stream", "")
+	assert.Equal(t, "retry: 3000\n", readFrame(t, reader))
+
+	dataSource.add(2, constants.KITCHEN_ORDER_EVENT_STATUS_CHANGED)
+
+	frame := readFrame(t, reader)
+	assert.Contains(t, frame, "id: 2\n")
+	assert.Contains(t, frame, "event: status-changed\n")
+	assert.Contains(t, frame, `"status":"Pronto"`)
+	assert.NotContains(t, frame, "id: 1\n", "events before connecting are not replayed without Last-Event-ID")
+}
+
+func TestKitchenOrderStreamHandler_ResumesFromLastEventID(t *testing.T) {
+	dataSource := &fakeKitchenOrderEventDataSource{}
+	dataSource.add(1, constants.KITCHEN_ORDER_EVENT_CREATED)
+	dataSource.add(2, constants.KITCHEN_ORDER_EVENT_STATUS_CHANGED)
+	dataSource.add(3, constants.KITCHEN_ORDER_EVENT_REMOVED)
+	server := setupStreamServer(t, dataSource, time.Hour)
+
+	reader, _ := openStream(t, server.URL+"/v1/kitchen-orders/stream", "1")
+	readFrame(t, reader)
+
+	assert.Contains(t, readFrame(t, reader), "id: 2\n")
+	assert.Contains(t, readFrame(t, reader), "event: removed\n")
+
+	dataSource.add(4, constants.KITCHEN_ORDER_EVENT_CREATED)
+	assert.Contains(t, readFrame(t, reader), "id: 4\n")
+}
+
+func TestKitchenOrderStreamHandler_DeliversLateEventsWithoutRewindingCursor(t *testing.T) {
+	dataSource := &fakeKitchenOrderEventDataSource{}
+	dataSource.add(1, constants.KITCHEN_ORDER_EVENT_CREATED)
+	server := setupStreamServer(t, dataSource, time.Hour)
+
+	reader, _ := openStream(t, server.URL+"/v1/kitchen-orders/stream", "")
+	readFrame(t, reader)
+
+	// O evento 2 é commitado depois do 3
+	dataSource.add(3, constants.KITCHEN_ORDER_EVENT_STATUS_CHANGED)
+	assert.Contains(t, readFrame(t, reader), "id: 3\n")
+
+	dataSource.add(2, constants.KITCHEN_ORDER_EVENT_STATUS_CHANGED)
+	frame := readFrame(t, reader)
+	assert.Contains(t, frame, `"event_id":2`)
+	assert.Contains(t, frame, "id: 3\n", "the resume cursor must not move back")
+}
+
+func TestKitchenOrderStreamHandler_SendsHeartbeats(t *testing.T) {
+	server := setupStreamServer(t, &fakeKitchenOrderEventDataSource{}, 10*time.Millisecond)
+
+	reader, _ := openStream(t, server.URL+"/v1/kitchen-orders/stream", "")
+	readFrame(t, reader)
+
+	assert.Equal(t, ": heartbeat\n", readFrame(t, reader))
+}
+
+func TestKitchenOrderStreamHandler_InvalidLastEventID(t *testing.T) {
+	server := setupStreamServer(t, &fakeKitchenOrderEventDataSource{}, time.Hour)
+
+	resp, err := http.Get(server.URL + "/v1/kitchen-orders/stream?last_event_id=abc")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
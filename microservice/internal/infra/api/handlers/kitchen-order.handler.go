@@ -1,154 +1,878 @@
 package handlers
 
 import (
-	"context"
-	"log"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
 
 	"tech_challenge/internal/application/controllers"
 	"tech_challenge/internal/application/dtos"
+	"tech_challenge/internal/domain/exceptions"
 	"tech_challenge/internal/factories"
+	"tech_challenge/internal/infra/api/http_errors"
 	"tech_challenge/internal/infra/api/schemas"
-	shared_factories "tech_challenge/internal/shared/factories"
+	"tech_challenge/internal/infra/export"
+	"tech_challenge/internal/infra/tickets"
+	"tech_challenge/internal/interfaces"
+	"tech_challenge/internal/shared/config/constants"
+	"tech_challenge/internal/shared/infra/auth"
+	"tech_challenge/internal/shared/pkg/logger"
+)
+
+const (
+	idempotencyKeyHeader     = "Idempotency-Key"
+	idempotentReplayedHeader = "Idempotent-Replayed"
 )
 
 type KitchenOrderHandler struct {
-	kitchenOrderController controllers.KitchenOrderController
+	kitchenOrderController   controllers.KitchenOrderController
+	idempotencyKeyController controllers.IdempotencyKeyController
+	ticketOptions            tickets.Options
 }
 
 func NewKitchenOrderHandler() *KitchenOrderHandler {
-	kitchenOrderDataSource := factories.NewKitchenOrderDataSource()
-	orderStatusDataSource := factories.NewOrderStatusDataSource()
-	
-	messageBroker, err := shared_factories.NewMessageBroker(context.Background())
-	if err != nil {
-		log.Printf("Warning: Failed to create message broker: %v", err)
-		// Continue without message broker for now
-		messageBroker = nil
-	}
-
-	kitchenOrderController := controllers.NewKitchenOrderController(kitchenOrderDataSource, orderStatusDataSource, messageBroker)
-
 	return &KitchenOrderHandler{
-		kitchenOrderController: *kitchenOrderController,
+		kitchenOrderController:   *factories.NewKitchenOrderController(),
+		idempotencyKeyController: *factories.NewIdempotencyKeyController(),
+		ticketOptions:            factories.NewKitchenTicketOptions(),
 	}
 }
 
-func (h *KitchenOrderHandler) toKitchenOrderResponseSchema(kitchenOrder dtos.KitchenOrderResponseDTO) schemas.KitchenOrderResponseSchema {
+func toKitchenOrderResponseSchema(kitchenOrder dtos.KitchenOrderResponseDTO) schemas.KitchenOrderResponseSchema {
 	return schemas.KitchenOrderResponseSchema{
-		ID:        kitchenOrder.ID,
-		OrderID:   kitchenOrder.OrderID,
-		Status:    kitchenOrder.Status.Name,
-		Slug:      kitchenOrder.Slug,
-		CreatedAt: kitchenOrder.CreatedAt,
-		UpdatedAt: kitchenOrder.UpdatedAt,
+		ID:              kitchenOrder.ID,
+		OrderID:         kitchenOrder.OrderID,
+		Status:          kitchenOrder.Status.Name,
+		Slug:            kitchenOrder.Slug,
+		Version:         kitchenOrder.Version,
+		StatusReason:    kitchenOrder.StatusReason,
+		StatusChangedBy: kitchenOrder.StatusChangedBy,
+		CreatedAt:       kitchenOrder.CreatedAt,
+		UpdatedAt:       kitchenOrder.UpdatedAt,
 	}
 }
 
-// @Summary List all kitchenOrders
+// @Summary List kitchenOrders
+// @Description Cursor-paginated list; pass next_cursor back as cursor to fetch the next page
 // @Tags KitchenOrders
 // @Produce json
-// @Success 200 {array} schemas.KitchenOrderResponseSchema
-// @Failure 500 {object} schemas.ErrorMessageSchema
+// @Param sort query string false "Sort order" Enums(board, created_at, -created_at) default(board)
+// @Param limit query int false "Page size (max 200)" default(50)
+// @Param cursor query string false "Opaque cursor returned as next_cursor"
+// @Param created_at_from query string false "RFC3339 lower bound for created_at"
+// @Param created_at_to query string false "RFC3339 upper bound for created_at"
+// @Param status_id query []string false "Status IDs (repeat or comma-separate)" collectionFormat(multi)
+// @Param order_id query string false "Order ID"
+// @Param slug query string false "Slug"
+// @Param customer_id query string false "Customer ID"
+// @Param include_finished query bool false "Include orders with status Finalizado" default(false)
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Success 200 {object} schemas.KitchenOrderListResponseSchema
+// @Failure 400 {object} schemas.ProblemDetailsSchema
+// @Failure 401 {object} schemas.ProblemDetailsSchema
+// @Failure 403 {object} schemas.ProblemDetailsSchema
+// @Failure 429 {object} schemas.ProblemDetailsSchema
+// @Failure 500 {object} schemas.ProblemDetailsSchema
 // @Router /kitchen-orders/ [get]
 func (h *KitchenOrderHandler) FindAll(ctx *gin.Context) {
-	var filter dtos.KitchenOrderFilter
+	filter, err := h.parseKitchenOrderFilter(ctx)
+	if err != nil {
+		if ctxErr := ctx.Error(err); ctxErr != nil {
+			logger.FromContext(ctx).Error("Error setting context error", logger.ErrorKey, ctxErr)
+		}
+		return
+	}
+
+	kitchenOrderPage, err := h.kitchenOrderController.FindAll(ctx.Request.Context(), filter)
+
+	if err != nil {
+		if ctxErr := ctx.Error(err); ctxErr != nil {
+			logger.FromContext(ctx).Error("Error setting context error", logger.ErrorKey, ctxErr)
+		}
+		return
+	}
+
+	kitchenOrderResponses := make([]schemas.KitchenOrderResponseSchema, len(kitchenOrderPage.Items))
+	for i, kitchenOrder := range kitchenOrderPage.Items {
+		kitchenOrderResponses[i] = toKitchenOrderResponseSchema(kitchenOrder)
+	}
+
+	ctx.JSON(http.StatusOK, schemas.KitchenOrderListResponseSchema{
+		Items:      kitchenOrderResponses,
+		NextCursor: kitchenOrderPage.NextCursor,
+		Total:      kitchenOrderPage.Total,
+	})
+}
+
+func (h *KitchenOrderHandler) parseKitchenOrderFilter(ctx *gin.Context) (dtos.KitchenOrderFilter, error) {
+	filter := dtos.KitchenOrderFilter{
+		Sort:   ctx.Query("sort"),
+		Cursor: ctx.Query("cursor"),
+		Limit:  constants.KITCHEN_ORDER_DEFAULT_PAGE_LIMIT,
+	}
+
+	if limitStr := ctx.Query("limit"); limitStr != "" {
+		limit, err := strconv.Atoi(limitStr)
+		if err != nil || limit < 1 {
+			return filter, &exceptions.InvalidKitchenOrderFilterException{Message: "limit must be a positive integer"}
+		}
+		filter.Limit = limit
+	}
+
+	createdAtFrom, err := parseTimeQuery(ctx, "created_at_from")
+	if err != nil {
+		return filter, err
+	}
+	filter.CreatedAtFrom = createdAtFrom
+
+	createdAtTo, err := parseTimeQuery(ctx, "created_at_to")
+	if err != nil {
+		return filter, err
+	}
+	filter.CreatedAtTo = createdAtTo
+
+	filter.StatusIDs = parseStatusIDsQuery(ctx)
 
-	if createdAtFromStr := ctx.Query("created_at_from"); createdAtFromStr != "" {
-		if t, err := time.Parse(time.RFC3339, createdAtFromStr); err == nil {
-			filter.CreatedAtFrom = &t
+	filter.OrderID = optionalQuery(ctx, "order_id")
+	filter.Slug = optionalQuery(ctx, "slug")
+	filter.CustomerID = optionalQuery(ctx, "customer_id")
+
+	if includeFinishedStr := ctx.Query("include_finished"); includeFinishedStr != "" {
+		includeFinished, err := strconv.ParseBool(includeFinishedStr)
+		if err != nil {
+			return filter, &exceptions.InvalidKitchenOrderFilterException{Message: "include_finished must be a boolean"}
 		}
+		filter.IncludeFinished = includeFinished
 	}
 
-	if createdAtToStr := ctx.Query("created_at_to"); createdAtToStr != "" {
-		if t, err := time.Parse(time.RFC3339, createdAtToStr); err == nil {
-			filter.CreatedAtTo = &t
+	return filter, nil
+}
+
+// Aceita tanto ?status_id=a&status_id=b quanto ?status_id=a,b
+func parseStatusIDsQuery(ctx *gin.Context) []string {
+	var statusIDs []string
+
+	for _, values := range ctx.QueryArray("status_id") {
+		for _, statusID := range strings.Split(values, ",") {
+			if statusID = strings.TrimSpace(statusID); statusID != "" {
+				statusIDs = append(statusIDs, statusID)
+			}
 		}
 	}
 
-	if statusIDStr := ctx.Query("status_id"); statusIDStr != "" {
-		if id, err := strconv.ParseUint(statusIDStr, 10, 64); err == nil {
-			u := uint(id)
-			filter.StatusID = &u
+	return statusIDs
+}
+
+func parseTimeQuery(ctx *gin.Context, name string) (*time.Time, error) {
+	value := ctx.Query(name)
+	if value == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, &exceptions.InvalidKitchenOrderFilterException{
+			Message: fmt.Sprintf("%s must be an RFC3339 timestamp", name),
 		}
 	}
 
-	kitchenOrders, err := h.kitchenOrderController.FindAll(filter)
+	return &t, nil
+}
+
+func optionalQuery(ctx *gin.Context, name string) *string {
+	value := strings.TrimSpace(ctx.Query(name))
+	if value == "" {
+		return nil
+	}
+
+	return &value
+}
 
+// @Summary Export kitchenOrders
+// @Description Streams every order created in the period, finished ones included, with items, status timestamps and prep durations in seconds.
+// @Description Accepts the same filters as the list endpoint; limit and cursor are ignored.
+// @Tags KitchenOrders
+// @Produce text/csv
+// @Produce application/x-ndjson
+// @Param format query string false "Export format" Enums(csv, ndjson) default(csv)
+// @Param from query string true "Start of the period (RFC3339 or YYYY-MM-DD)"
+// @Param to query string true "End of the period (RFC3339 or YYYY-MM-DD, inclusive)"
+// @Param sort query string false "Sort order" Enums(board, created_at, -created_at) default(created_at)
+// @Param status_id query []string false "Status IDs (repeat or comma-separate)" collectionFormat(multi)
+// @Param order_id query string false "Order ID"
+// @Param slug query string false "Slug"
+// @Param customer_id query string false "Customer ID"
+// @Param include_finished query bool false "Include orders with status Finalizado" default(true)
+// @Security BearerAuth
+// @Success 200 {array} schemas.KitchenOrderExportSchema "One object per line when format=ndjson"
+// @Failure 400 {object} schemas.ProblemDetailsSchema
+// @Failure 401 {object} schemas.ProblemDetailsSchema
+// @Failure 403 {object} schemas.ProblemDetailsSchema
+// @Failure 429 {object} schemas.ProblemDetailsSchema
+// @Failure 500 {object} schemas.ProblemDetailsSchema
+// @Router /kitchen-orders/export [get]
+func (h *KitchenOrderHandler) Export(ctx *gin.Context) {
+	filter, err := h.parseKitchenOrderExportFilter(ctx)
 	if err != nil {
 		if ctxErr := ctx.Error(err); ctxErr != nil {
-			log.Printf("Error setting context error: %v", ctxErr)
+			logger.FromContext(ctx).Error("Error setting context error", logger.ErrorKey, ctxErr)
 		}
 		return
 	}
 
-	kitchenOrderResponses := make([]schemas.KitchenOrderResponseSchema, len(kitchenOrders))
-	for i, kitchenOrder := range kitchenOrders {
-		kitchenOrderResponses[i] = h.toKitchenOrderResponseSchema(kitchenOrder)
+	format := ctx.DefaultQuery("format", constants.KITCHEN_ORDER_EXPORT_FORMAT_CSV)
+	response := &exportResponseWriter{ctx: ctx, filename: fmt.Sprintf("kitchen-orders-%s.%s", time.Now().UTC().Format("20060102-150405"), format)}
+
+	var writer interfaces.IKitchenOrderExportWriter
+	switch format {
+	case constants.KITCHEN_ORDER_EXPORT_FORMAT_CSV:
+		response.contentType = "text/csv; charset=utf-8"
+		writer = export.NewCSVKitchenOrderWriter(response)
+	case constants.KITCHEN_ORDER_EXPORT_FORMAT_NDJSON:
+		response.contentType = "application/x-ndjson"
+		writer = export.NewNDJSONKitchenOrderWriter(response)
+	default:
+		if ctxErr := ctx.Error(&exceptions.InvalidKitchenOrderFilterException{Message: "format must be csv or ndjson"}); ctxErr != nil {
+			logger.FromContext(ctx).Error("Error setting context error", logger.ErrorKey, ctxErr)
+		}
+		return
 	}
 
-	ctx.JSON(http.StatusOK, kitchenOrderResponses)
+	if err := h.kitchenOrderController.Export(ctx.Request.Context(), filter, writer); err != nil {
+		// Com o corpo já em andamento não há como responder com um erro; a conexão é encerrada e o cliente recebe o arquivo truncado
+		if response.started {
+			logger.FromContext(ctx).Error("Error streaming kitchen order export", logger.ErrorKey, err)
+			ctx.Abort()
+			return
+		}
+
+		if ctxErr := ctx.Error(err); ctxErr != nil {
+			logger.FromContext(ctx).Error("Error setting context error", logger.ErrorKey, ctxErr)
+		}
+		return
+	}
+}
+
+// parseKitchenOrderExportFilter reaproveita o filtro da listagem; from e to delimitam o período e finalizados entram por padrão
+func (h *KitchenOrderHandler) parseKitchenOrderExportFilter(ctx *gin.Context) (dtos.KitchenOrderFilter, error) {
+	filter, err := h.parseKitchenOrderFilter(ctx)
+	if err != nil {
+		return filter, err
+	}
+
+	from, err := parseDateOrTimeQuery(ctx, "from", false)
+	if err != nil {
+		return filter, err
+	}
+	if from != nil {
+		filter.CreatedAtFrom = from
+	}
+
+	to, err := parseDateOrTimeQuery(ctx, "to", true)
+	if err != nil {
+		return filter, err
+	}
+	if to != nil {
+		filter.CreatedAtTo = to
+	}
+
+	if ctx.Query("include_finished") == "" {
+		filter.IncludeFinished = true
+	}
+
+	if filter.Sort == "" {
+		filter.Sort = constants.KITCHEN_ORDER_SORT_CREATED_AT
+	}
+
+	return filter, nil
+}
+
+// parseDateOrTimeQuery aceita RFC3339 ou apenas a data; uma data final cobre o dia inteiro
+func parseDateOrTimeQuery(ctx *gin.Context, name string, endOfDay bool) (*time.Time, error) {
+	value := ctx.Query(name)
+	if value == "" {
+		return nil, nil
+	}
+
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return &t, nil
+	}
+
+	date, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return nil, &exceptions.InvalidKitchenOrderFilterException{
+			Message: fmt.Sprintf("%s must be an RFC3339 timestamp or a YYYY-MM-DD date", name),
+		}
+	}
+
+	if endOfDay {
+		date = date.Add(24*time.Hour - time.Nanosecond)
+	}
+
+	return &date, nil
+}
+
+// exportResponseWriter só envia o status e os cabeçalhos do arquivo na primeira escrita,
+// para que erros de validação ainda sejam respondidos como problem+json
+type exportResponseWriter struct {
+	ctx         *gin.Context
+	contentType string
+	filename    string
+	started     bool
+}
+
+func (w *exportResponseWriter) Write(p []byte) (int, error) {
+	if !w.started {
+		w.started = true
+		w.ctx.Header("Content-Type", w.contentType)
+		w.ctx.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", w.filename))
+		w.ctx.Status(http.StatusOK)
+	}
+
+	return w.ctx.Writer.Write(p)
+}
+
+// @Summary Create a kitchenOrder
+// @Description Creates a kitchen order with its items. Send an Idempotency-Key header to make retries safe:
+// @Description a retry with the same key and body returns the order created by the first request (Idempotent-Replayed: true),
+// @Description while the same key with a different body is rejected. Keys are scoped to the authenticated client.
+// @Tags KitchenOrders
+// @Accept json
+// @Produce json
+// @Param Idempotency-Key header string false "Client-generated key (max 255 characters)"
+// @Param request body schemas.CreateKitchenOrderRequestSchema true "Create request"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Success 201 {object} schemas.KitchenOrderResponseSchema
+// @Header 201 {string} Idempotent-Replayed "true when the response is a replay of a previous request"
+// @Failure 400 {object} schemas.ProblemDetailsSchema
+// @Failure 401 {object} schemas.ProblemDetailsSchema
+// @Failure 403 {object} schemas.ProblemDetailsSchema
+// @Failure 409 {object} schemas.ProblemDetailsSchema
+// @Failure 422 {object} schemas.ProblemDetailsSchema
+// @Failure 429 {object} schemas.ProblemDetailsSchema
+// @Failure 500 {object} schemas.ProblemDetailsSchema
+// @Router /kitchen-orders/ [post]
+func (h *KitchenOrderHandler) Create(ctx *gin.Context) {
+	var request schemas.CreateKitchenOrderRequestSchema
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		if ctxErr := ctx.Error(err); ctxErr != nil {
+			logger.FromContext(ctx).Error("Error setting context error", logger.ErrorKey, ctxErr)
+		}
+		return
+	}
+
+	// Um cabeçalho presente porém vazio é rejeitado pela validação da chave, e não ignorado
+	_, hasIdempotencyKey := ctx.Request.Header[http.CanonicalHeaderKey(idempotencyKeyHeader)]
+	scope := idempotencyScope(ctx, constants.IDEMPOTENCY_SCOPE_CREATE_KITCHEN_ORDER)
+	key := ctx.GetHeader(idempotencyKeyHeader)
+
+	if hasIdempotencyKey {
+		fingerprint, err := fingerprintRequest(request)
+		if err != nil {
+			if ctxErr := ctx.Error(err); ctxErr != nil {
+				logger.FromContext(ctx).Error("Error setting context error", logger.ErrorKey, ctxErr)
+			}
+			return
+		}
+
+		reservation, err := h.idempotencyKeyController.Reserve(dtos.IdempotencyKeyDTO{
+			Scope:       scope,
+			Key:         key,
+			Fingerprint: fingerprint,
+		})
+		if err != nil {
+			if ctxErr := ctx.Error(err); ctxErr != nil {
+				logger.FromContext(ctx).Error("Error setting context error", logger.ErrorKey, ctxErr)
+			}
+			return
+		}
+
+		if reservation.Replayed {
+			kitchenOrder, err := h.kitchenOrderController.FindByID(ctx.Request.Context(), reservation.ResourceID)
+			if err != nil {
+				if ctxErr := ctx.Error(err); ctxErr != nil {
+					logger.FromContext(ctx).Error("Error setting context error", logger.ErrorKey, ctxErr)
+				}
+				return
+			}
+
+			ctx.Header(idempotentReplayedHeader, "true")
+			ctx.JSON(http.StatusCreated, toKitchenOrderResponseSchema(kitchenOrder))
+			return
+		}
+	}
+
+	items := make([]dtos.CreateOrderItemDTO, len(request.Items))
+	for i, item := range request.Items {
+		items[i] = dtos.CreateOrderItemDTO{
+			ProductID: item.ProductID,
+			Quantity:  item.Quantity,
+			UnitPrice: item.UnitPrice,
+		}
+	}
+
+	kitchenOrder, err := h.kitchenOrderController.Create(ctx.Request.Context(), dtos.CreateKitchenOrderDTO{
+		OrderID:    request.OrderID,
+		CustomerID: request.CustomerID,
+		Items:      items,
+	})
+
+	if err != nil {
+		if hasIdempotencyKey {
+			// Libera a chave para que o cliente possa tentar novamente com o mesmo Idempotency-Key
+			if releaseErr := h.idempotencyKeyController.Release(scope, key); releaseErr != nil {
+				logger.FromContext(ctx).Error("Error releasing idempotency key", logger.ErrorKey, releaseErr)
+			}
+		}
+
+		if ctxErr := ctx.Error(err); ctxErr != nil {
+			logger.FromContext(ctx).Error("Error setting context error", logger.ErrorKey, ctxErr)
+		}
+		return
+	}
+
+	if hasIdempotencyKey {
+		// O pedido já foi criado; se a chave não for concluída ela expira e o dedupe por order_id cobre a nova tentativa
+		if completeErr := h.idempotencyKeyController.Complete(scope, key, kitchenOrder.ID); completeErr != nil {
+			logger.FromContext(ctx).Error("Error completing idempotency key", logger.ErrorKey, completeErr, logger.KitchenOrderIDKey, kitchenOrder.ID)
+		}
+	}
+
+	ctx.JSON(http.StatusCreated, toKitchenOrderResponseSchema(kitchenOrder))
+}
+
+// idempotencyScope separa as chaves por cliente autenticado, assim dois integradores que geram o mesmo
+// Idempotency-Key não recebem o pedido um do outro. Subjects longos viram hash para caber na coluna
+func idempotencyScope(ctx *gin.Context, base string) string {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok {
+		return base
+	}
+
+	subject := principal.Subject
+	if len(base)+1+len(subject) > constants.IDEMPOTENCY_SCOPE_MAX_LENGTH {
+		sum := sha256.Sum256([]byte(subject))
+		subject = hex.EncodeToString(sum[:])
+	}
+
+	return base + ":" + subject
+}
+
+// fingerprintRequest identifica o corpo da requisição para detectar reuso da chave com outro payload
+func fingerprintRequest(request any) (string, error) {
+	payload, err := json.Marshal(request)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(payload)
+	return hex.EncodeToString(sum[:]), nil
 }
 
 // @Summary Get a kitchenOrder by ID
 // @Tags KitchenOrders
 // @Produce json
 // @Param id path string true "KitchenOrder ID"
+// @Security BearerAuth
+// @Security ApiKeyAuth
 // @Success 200 {object} schemas.KitchenOrderResponseSchema
-// @Failure 400 {object} schemas.InvalidKitchenOrderDataErrorSchema
-// @Failure 404 {object} schemas.KitchenOrderNotFoundErrorSchema
+// @Header 200 {string} ETag "Current version of the kitchenOrder, to be sent back in If-Match"
+// @Failure 400 {object} schemas.ProblemDetailsSchema
+// @Failure 401 {object} schemas.ProblemDetailsSchema
+// @Failure 403 {object} schemas.ProblemDetailsSchema
+// @Failure 404 {object} schemas.ProblemDetailsSchema
+// @Failure 429 {object} schemas.ProblemDetailsSchema
 // @Router /kitchen-orders/{id} [get]
 func (h *KitchenOrderHandler) FindByID(ctx *gin.Context) {
 	kitchenOrderID := ctx.Param("id")
 
-	kitchenOrder, err := h.kitchenOrderController.FindByID(kitchenOrderID)
+	kitchenOrder, err := h.kitchenOrderController.FindByID(ctx.Request.Context(), kitchenOrderID)
+
+	if err != nil {
+		if ctxErr := ctx.Error(err); ctxErr != nil {
+			logger.FromContext(ctx).Error("Error setting context error", logger.ErrorKey, ctxErr)
+		}
+		return
+	}
+
+	setKitchenOrderETag(ctx, kitchenOrder)
+	ctx.JSON(http.StatusOK, toKitchenOrderResponseSchema(kitchenOrder))
+}
+
+// @Summary Print a kitchenOrder ticket
+// @Description Renders the kitchen ticket as fixed-width text or as an ESC/POS byte stream ready to be sent to a thermal printer.
+// @Description The ESC/POS ticket includes a QR code with the order id unless qr=false; width and qr default to the service configuration.
+// @Tags KitchenOrders
+// @Produce plain
+// @Produce octet-stream
+// @Param id path string true "KitchenOrder ID"
+// @Param format query string false "Ticket format" Enums(text, escpos) default(text)
+// @Param width query int false "Printer columns (24-64)"
+// @Param qr query bool false "Print a QR code with the order id (ESC/POS only)"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Success 200 {string} string "Rendered ticket"
+// @Failure 400 {object} schemas.ProblemDetailsSchema
+// @Failure 401 {object} schemas.ProblemDetailsSchema
+// @Failure 403 {object} schemas.ProblemDetailsSchema
+// @Failure 404 {object} schemas.ProblemDetailsSchema
+// @Failure 429 {object} schemas.ProblemDetailsSchema
+// @Failure 500 {object} schemas.ProblemDetailsSchema
+// @Router /kitchen-orders/{id}/ticket [get]
+func (h *KitchenOrderHandler) Ticket(ctx *gin.Context) {
+	renderer, err := h.parseTicketRenderer(ctx)
+	if err != nil {
+		if ctxErr := ctx.Error(err); ctxErr != nil {
+			logger.FromContext(ctx).Error("Error setting context error", logger.ErrorKey, ctxErr)
+		}
+		return
+	}
+
+	ticket, err := h.kitchenOrderController.FindTicket(ctx.Request.Context(), ctx.Param("id"))
+	if err != nil {
+		if ctxErr := ctx.Error(err); ctxErr != nil {
+			logger.FromContext(ctx).Error("Error setting context error", logger.ErrorKey, ctxErr)
+		}
+		return
+	}
 
+	output, err := renderer.Render(ticket)
 	if err != nil {
 		if ctxErr := ctx.Error(err); ctxErr != nil {
-			log.Printf("Error setting context error: %v", ctxErr)
+			logger.FromContext(ctx).Error("Error setting context error", logger.ErrorKey, ctxErr)
 		}
 		return
 	}
 
-	ctx.JSON(http.StatusOK, h.toKitchenOrderResponseSchema(kitchenOrder))
+	if ctx.DefaultQuery("format", constants.KITCHEN_TICKET_FORMAT_TEXT) == constants.KITCHEN_TICKET_FORMAT_ESCPOS {
+		ctx.Header("Content-Disposition", fmt.Sprintf("attachment; filename=\"ticket-%s.bin\"", ticket.Slug))
+	}
+
+	ctx.Data(http.StatusOK, renderer.ContentType(), output)
+}
+
+func (h *KitchenOrderHandler) parseTicketRenderer(ctx *gin.Context) (tickets.Renderer, error) {
+	options := h.ticketOptions
+
+	if widthStr := ctx.Query("width"); widthStr != "" {
+		width, err := strconv.Atoi(widthStr)
+		if err != nil || width < constants.KITCHEN_TICKET_MIN_WIDTH || width > constants.KITCHEN_TICKET_MAX_WIDTH {
+			return nil, &exceptions.InvalidKitchenOrderTicketException{
+				Message: fmt.Sprintf("width must be between %d and %d", constants.KITCHEN_TICKET_MIN_WIDTH, constants.KITCHEN_TICKET_MAX_WIDTH),
+			}
+		}
+		options.Width = width
+	}
+
+	if qrStr := ctx.Query("qr"); qrStr != "" {
+		qr, err := strconv.ParseBool(qrStr)
+		if err != nil {
+			return nil, &exceptions.InvalidKitchenOrderTicketException{Message: "qr must be a boolean"}
+		}
+		options.QRCode = qr
+	}
+
+	renderer, ok := tickets.NewRenderer(ctx.DefaultQuery("format", constants.KITCHEN_TICKET_FORMAT_TEXT), options)
+	if !ok {
+		return nil, &exceptions.InvalidKitchenOrderTicketException{Message: "format must be text or escpos"}
+	}
+
+	return renderer, nil
 }
 
 // @Summary Update a kitchenOrder status
 // @Tags KitchenOrders
 // @Accept json
 // @Produce json
+// @Description Send the ETag from a previous response in If-Match to only apply the change if nobody else changed the order meanwhile.
+// @Description Ready or finished orders can only go back to preparation through POST /kitchen-orders/{id}/recall, which requires a reason.
 // @Param id path string true "KitchenOrder ID"
+// @Param If-Match header string false "ETag of the version being changed"
 // @Param request body schemas.UpdateKitchenOrderRequestSchema true "Update request"
+// @Security BearerAuth
 // @Success 200 {object} schemas.KitchenOrderResponseSchema
-// @Failure 400 {object} schemas.InvalidKitchenOrderDataErrorSchema
-// @Failure 404 {object} schemas.KitchenOrderNotFoundErrorSchema
+// @Header 200 {string} ETag "New version of the kitchenOrder"
+// @Failure 400 {object} schemas.ProblemDetailsSchema
+// @Failure 401 {object} schemas.ProblemDetailsSchema
+// @Failure 403 {object} schemas.ProblemDetailsSchema
+// @Failure 404 {object} schemas.ProblemDetailsSchema
+// @Failure 409 {object} schemas.ProblemDetailsSchema
+// @Failure 412 {object} schemas.ProblemDetailsSchema
+// @Failure 429 {object} schemas.ProblemDetailsSchema
 // @Router /kitchen-orders/{id} [put]
 func (h *KitchenOrderHandler) Update(ctx *gin.Context) {
 	kitchenOrderID := ctx.Param("id")
 
 	var request schemas.UpdateKitchenOrderRequestSchema
 	if err := ctx.ShouldBindJSON(&request); err != nil {
-		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		if ctxErr := ctx.Error(err); ctxErr != nil {
+			logger.FromContext(ctx).Error("Error setting context error", logger.ErrorKey, ctxErr)
+		}
+		return
+	}
+
+	expectedVersion, err := parseIfMatchHeader(ctx)
+	if err != nil {
+		if ctxErr := ctx.Error(err); ctxErr != nil {
+			logger.FromContext(ctx).Error("Error setting context error", logger.ErrorKey, ctxErr)
+		}
 		return
 	}
 
 	updateDTO := dtos.UpdateKitchenOrderDTO{
-		ID:       kitchenOrderID,
-		StatusID: request.StatusID,
+		ID:              kitchenOrderID,
+		StatusID:        request.StatusID,
+		ChangedBy:       actorFromContext(ctx),
+		ExpectedVersion: expectedVersion,
+	}
+
+	kitchenOrder, err := h.kitchenOrderController.Update(ctx.Request.Context(), updateDTO)
+
+	if err != nil {
+		if ctxErr := ctx.Error(err); ctxErr != nil {
+			logger.FromContext(ctx).Error("Error setting context error", logger.ErrorKey, ctxErr)
+		}
+		return
+	}
+
+	setKitchenOrderETag(ctx, kitchenOrder)
+	ctx.JSON(http.StatusOK, toKitchenOrderResponseSchema(kitchenOrder))
+}
+
+// @Summary Update the status of several kitchenOrders
+// @Description Applies the status to each order with the same notifications and transition rules as PUT /kitchen-orders/{id}.
+// @Description Orders are updated independently in small batches; the response reports the outcome of each ID.
+// @Description Orders already in the requested status are reported as skipped and send no notification.
+// @Description Ready or finished orders can only go back to preparation through POST /kitchen-orders/{id}/recall; in a bulk request
+// @Description they fail individually with error_code invalid-kitchen-order-transition.
+// @Tags KitchenOrders
+// @Accept json
+// @Produce json
+// @Param request body schemas.BulkUpdateKitchenOrderStatusRequestSchema true "Bulk status request (max 200 IDs)"
+// @Security BearerAuth
+// @Success 200 {object} schemas.BulkUpdateKitchenOrderStatusResponseSchema
+// @Failure 400 {object} schemas.ProblemDetailsSchema
+// @Failure 401 {object} schemas.ProblemDetailsSchema
+// @Failure 403 {object} schemas.ProblemDetailsSchema
+// @Failure 429 {object} schemas.ProblemDetailsSchema
+// @Failure 500 {object} schemas.ProblemDetailsSchema
+// @Router /kitchen-orders/bulk-status [post]
+func (h *KitchenOrderHandler) BulkUpdateStatus(ctx *gin.Context) {
+	var request schemas.BulkUpdateKitchenOrderStatusRequestSchema
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		if ctxErr := ctx.Error(err); ctxErr != nil {
+			logger.FromContext(ctx).Error("Error setting context error", logger.ErrorKey, ctxErr)
+		}
+		return
+	}
+
+	results, err := h.kitchenOrderController.BulkUpdateStatus(ctx.Request.Context(), dtos.BulkUpdateKitchenOrderStatusDTO{
+		IDs:       request.IDs,
+		StatusID:  request.StatusID,
+		ChangedBy: actorFromContext(ctx),
+	})
+
+	if err != nil {
+		if ctxErr := ctx.Error(err); ctxErr != nil {
+			logger.FromContext(ctx).Error("Error setting context error", logger.ErrorKey, ctxErr)
+		}
+		return
 	}
 
-	kitchenOrder, err := h.kitchenOrderController.Update(updateDTO)
+	response := schemas.BulkUpdateKitchenOrderStatusResponseSchema{
+		Results: make([]schemas.BulkUpdateKitchenOrderStatusItemSchema, len(results)),
+	}
+
+	for i, result := range results {
+		item := schemas.BulkUpdateKitchenOrderStatusItemSchema{ID: result.ID}
+
+		if result.Error != nil {
+			if problem, ok := http_errors.ResolveDomainProblem(result.Error); ok {
+				item.ErrorCode = problem.Code
+			}
+			item.Error = result.Error.Error()
+			response.Failed++
+		} else {
+			kitchenOrder := toKitchenOrderResponseSchema(*result.KitchenOrder)
+			item.Success = true
+			item.Skipped = result.Skipped
+			item.KitchenOrder = &kitchenOrder
+
+			if result.Skipped {
+				response.Skipped++
+			} else {
+				response.Succeeded++
+			}
+		}
+
+		response.Results[i] = item
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// @Summary Start preparing a kitchenOrder
+// @Description Moves a received order to preparation.
+// @Tags KitchenOrders
+// @Produce json
+// @Param id path string true "KitchenOrder ID"
+// @Param If-Match header string false "ETag of the version being changed"
+// @Security BearerAuth
+// @Success 200 {object} schemas.KitchenOrderResponseSchema
+// @Header 200 {string} ETag "New version of the kitchenOrder"
+// @Failure 400 {object} schemas.ProblemDetailsSchema
+// @Failure 401 {object} schemas.ProblemDetailsSchema
+// @Failure 403 {object} schemas.ProblemDetailsSchema
+// @Failure 404 {object} schemas.ProblemDetailsSchema
+// @Failure 409 {object} schemas.ProblemDetailsSchema
+// @Failure 412 {object} schemas.ProblemDetailsSchema
+// @Failure 429 {object} schemas.ProblemDetailsSchema
+// @Router /kitchen-orders/{id}/start [post]
+func (h *KitchenOrderHandler) Start(ctx *gin.Context) {
+	h.executeCommand(ctx, constants.KITCHEN_ORDER_COMMAND_START, "")
+}
+
+// @Summary Mark a kitchenOrder as ready
+// @Description Moves a received or preparing order to ready for pickup.
+// @Tags KitchenOrders
+// @Produce json
+// @Param id path string true "KitchenOrder ID"
+// @Param If-Match header string false "ETag of the version being changed"
+// @Security BearerAuth
+// @Success 200 {object} schemas.KitchenOrderResponseSchema
+// @Header 200 {string} ETag "New version of the kitchenOrder"
+// @Failure 400 {object} schemas.ProblemDetailsSchema
+// @Failure 401 {object} schemas.ProblemDetailsSchema
+// @Failure 403 {object} schemas.ProblemDetailsSchema
+// @Failure 404 {object} schemas.ProblemDetailsSchema
+// @Failure 409 {object} schemas.ProblemDetailsSchema
+// @Failure 412 {object} schemas.ProblemDetailsSchema
+// @Failure 429 {object} schemas.ProblemDetailsSchema
+// @Router /kitchen-orders/{id}/ready [post]
+func (h *KitchenOrderHandler) Ready(ctx *gin.Context) {
+	h.executeCommand(ctx, constants.KITCHEN_ORDER_COMMAND_READY, "")
+}
 
+// @Summary Finish a kitchenOrder
+// @Description Moves a ready order to finished once it was picked up.
+// @Tags KitchenOrders
+// @Produce json
+// @Param id path string true "KitchenOrder ID"
+// @Param If-Match header string false "ETag of the version being changed"
+// @Security BearerAuth
+// @Success 200 {object} schemas.KitchenOrderResponseSchema
+// @Header 200 {string} ETag "New version of the kitchenOrder"
+// @Failure 400 {object} schemas.ProblemDetailsSchema
+// @Failure 401 {object} schemas.ProblemDetailsSchema
+// @Failure 403 {object} schemas.ProblemDetailsSchema
+// @Failure 404 {object} schemas.ProblemDetailsSchema
+// @Failure 409 {object} schemas.ProblemDetailsSchema
+// @Failure 412 {object} schemas.ProblemDetailsSchema
+// @Failure 429 {object} schemas.ProblemDetailsSchema
+// @Router /kitchen-orders/{id}/finish [post]
+func (h *KitchenOrderHandler) Finish(ctx *gin.Context) {
+	h.executeCommand(ctx, constants.KITCHEN_ORDER_COMMAND_FINISH, "")
+}
+
+// @Summary Recall a kitchenOrder
+// @Description Moves a ready or finished order back to preparation. The reason is stored on the order and in the change feed.
+// @Tags KitchenOrders
+// @Accept json
+// @Produce json
+// @Param id path string true "KitchenOrder ID"
+// @Param If-Match header string false "ETag of the version being changed"
+// @Param request body schemas.RecallKitchenOrderRequestSchema true "Recall request"
+// @Security BearerAuth
+// @Success 200 {object} schemas.KitchenOrderResponseSchema
+// @Header 200 {string} ETag "New version of the kitchenOrder"
+// @Failure 400 {object} schemas.ProblemDetailsSchema
+// @Failure 401 {object} schemas.ProblemDetailsSchema
+// @Failure 403 {object} schemas.ProblemDetailsSchema
+// @Failure 404 {object} schemas.ProblemDetailsSchema
+// @Failure 409 {object} schemas.ProblemDetailsSchema
+// @Failure 412 {object} schemas.ProblemDetailsSchema
+// @Failure 429 {object} schemas.ProblemDetailsSchema
+// @Router /kitchen-orders/{id}/recall [post]
+func (h *KitchenOrderHandler) Recall(ctx *gin.Context) {
+	var request schemas.RecallKitchenOrderRequestSchema
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		if ctxErr := ctx.Error(err); ctxErr != nil {
+			logger.FromContext(ctx).Error("Error setting context error", logger.ErrorKey, ctxErr)
+		}
+		return
+	}
+
+	h.executeCommand(ctx, constants.KITCHEN_ORDER_COMMAND_RECALL, request.Reason)
+}
+
+// executeCommand aplica o comando ao pedido; o status de destino e a validação da transição ficam no domínio
+func (h *KitchenOrderHandler) executeCommand(ctx *gin.Context, command string, reason string) {
+	expectedVersion, err := parseIfMatchHeader(ctx)
 	if err != nil {
 		if ctxErr := ctx.Error(err); ctxErr != nil {
-			log.Printf("Error setting context error: %v", ctxErr)
+			logger.FromContext(ctx).Error("Error setting context error", logger.ErrorKey, ctxErr)
 		}
 		return
 	}
 
-	ctx.JSON(http.StatusOK, h.toKitchenOrderResponseSchema(kitchenOrder))
+	kitchenOrder, err := h.kitchenOrderController.ExecuteCommand(ctx.Request.Context(), dtos.KitchenOrderCommandDTO{
+		ID:              ctx.Param("id"),
+		Command:         command,
+		Reason:          reason,
+		ChangedBy:       actorFromContext(ctx),
+		ExpectedVersion: expectedVersion,
+	})
+
+	if err != nil {
+		if ctxErr := ctx.Error(err); ctxErr != nil {
+			logger.FromContext(ctx).Error("Error setting context error", logger.ErrorKey, ctxErr)
+		}
+		return
+	}
+
+	setKitchenOrderETag(ctx, kitchenOrder)
+	ctx.JSON(http.StatusOK, toKitchenOrderResponseSchema(kitchenOrder))
+}
+
+// actorFromContext devolve o subject do token ou da API key que autenticou a requisição, registrado na mudança de status
+func actorFromContext(ctx *gin.Context) *string {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	return &principal.Subject
+}
+
+func setKitchenOrderETag(ctx *gin.Context, kitchenOrder dtos.KitchenOrderResponseDTO) {
+	ctx.Header("ETag", strconv.Quote(strconv.FormatUint(kitchenOrder.Version, 10)))
+}
+
+// parseIfMatchHeader devolve a versão exigida pelo cliente; sem cabeçalho ou com "*" qualquer versão é aceita
+func parseIfMatchHeader(ctx *gin.Context) (*uint64, error) {
+	ifMatch := strings.TrimSpace(ctx.GetHeader("If-Match"))
+	if ifMatch == "" || ifMatch == "*" {
+		return nil, nil
+	}
+
+	// ETags fracas (W/) não servem para If-Match, que exige comparação forte
+	if len(ifMatch) < 2 || !strings.HasPrefix(ifMatch, `"`) || !strings.HasSuffix(ifMatch, `"`) {
+		return nil, &exceptions.KitchenOrderVersionMismatchException{}
+	}
+
+	version, err := strconv.ParseUint(ifMatch[1:len(ifMatch)-1], 10, 64)
+	if err != nil {
+		return nil, &exceptions.KitchenOrderVersionMismatchException{}
+	}
+
+	return &version, nil
 }
@@ -4,8 +4,11 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -17,6 +20,13 @@ import (
 	"tech_challenge/internal/application/controllers"
 	"tech_challenge/internal/application/dtos"
 	"tech_challenge/internal/daos"
+	"tech_challenge/internal/domain/exceptions"
+	"tech_challenge/internal/infra/api/http_errors"
+	"tech_challenge/internal/infra/api/schemas"
+	"tech_challenge/internal/interfaces"
+	"tech_challenge/internal/shared/config/constants"
+	"tech_challenge/internal/shared/infra/api/middlewares"
+	"tech_challenge/internal/shared/infra/auth"
 	shared_interfaces "tech_challenge/internal/shared/interfaces"
 )
 
@@ -24,12 +34,12 @@ type MockKitchenOrderDataSource struct {
 	mock.Mock
 }
 
-func (m *MockKitchenOrderDataSource) Insert(kitchenOrder daos.KitchenOrderDAO) error {
+func (m *MockKitchenOrderDataSource) Insert(ctx context.Context, kitchenOrder daos.KitchenOrderDAO) error {
 	args := m.Called(kitchenOrder)
 	return args.Error(0)
 }
 
-func (m *MockKitchenOrderDataSource) FindByID(id string) (daos.KitchenOrderDAO, error) {
+func (m *MockKitchenOrderDataSource) FindByID(ctx context.Context, id string) (daos.KitchenOrderDAO, error) {
 	args := m.Called(id)
 	if args.Get(0) == nil {
 		return daos.KitchenOrderDAO{}, args.Error(1)
@@ -37,24 +47,38 @@ func (m *MockKitchenOrderDataSource) FindByID(id string) (daos.KitchenOrderDAO,
 	return args.Get(0).(daos.KitchenOrderDAO), args.Error(1)
 }
 
-func (m *MockKitchenOrderDataSource) FindAll(filter dtos.KitchenOrderFilter) ([]daos.KitchenOrderDAO, error) {
+func (m *MockKitchenOrderDataSource) FindAll(ctx context.Context, filter dtos.KitchenOrderFilter) ([]daos.KitchenOrderDAO, dtos.PageInfo, error) {
 	args := m.Called(filter)
 	if args.Get(0) == nil {
-		return nil, args.Error(1)
+		return nil, dtos.PageInfo{}, args.Error(2)
+	}
+	return args.Get(0).([]daos.KitchenOrderDAO), args.Get(1).(dtos.PageInfo), args.Error(2)
+}
+
+func (m *MockKitchenOrderDataSource) FindAllInBatches(ctx context.Context, filter dtos.KitchenOrderFilter, batchSize int, handle func([]daos.KitchenOrderDAO) error) error {
+	args := m.Called(filter, batchSize)
+	if orders, ok := args.Get(0).([]daos.KitchenOrderDAO); ok && len(orders) > 0 {
+		if err := handle(orders); err != nil {
+			return err
+		}
 	}
-	return args.Get(0).([]daos.KitchenOrderDAO), args.Error(1)
+	return args.Error(1)
 }
 
-func (m *MockKitchenOrderDataSource) Update(kitchenOrder daos.KitchenOrderDAO) error {
+func (m *MockKitchenOrderDataSource) Update(ctx context.Context, kitchenOrder daos.KitchenOrderDAO) error {
 	args := m.Called(kitchenOrder)
 	return args.Error(0)
 }
 
+func (m *MockKitchenOrderDataSource) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
 type MockOrderStatusDataSource struct {
 	mock.Mock
 }
 
-func (m *MockOrderStatusDataSource) FindByID(id string) (daos.OrderStatusDAO, error) {
+func (m *MockOrderStatusDataSource) FindByID(ctx context.Context, id string) (daos.OrderStatusDAO, error) {
 	args := m.Called(id)
 	if args.Get(0) == nil {
 		return daos.OrderStatusDAO{}, args.Error(1)
@@ -62,7 +86,7 @@ func (m *MockOrderStatusDataSource) FindByID(id string) (daos.OrderStatusDAO, er
 	return args.Get(0).(daos.OrderStatusDAO), args.Error(1)
 }
 
-func (m *MockOrderStatusDataSource) FindAll() ([]daos.OrderStatusDAO, error) {
+func (m *MockOrderStatusDataSource) FindAll(ctx context.Context) ([]daos.OrderStatusDAO, error) {
 	args := m.Called()
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -104,6 +128,71 @@ func (m *MockMessageBroker) Stop() error {
 	return args.Error(0)
 }
 
+// fakeIdempotencyKeyDataSource guarda as chaves em memória
+type fakeIdempotencyKeyDataSource struct {
+	mu   sync.Mutex
+	keys map[string]daos.IdempotencyKeyDAO
+}
+
+func newFakeIdempotencyKeyDataSource() *fakeIdempotencyKeyDataSource {
+	return &fakeIdempotencyKeyDataSource{keys: make(map[string]daos.IdempotencyKeyDAO)}
+}
+
+func (f *fakeIdempotencyKeyDataSource) Reserve(idempotencyKey daos.IdempotencyKeyDAO) (bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	id := idempotencyKey.Scope + "/" + idempotencyKey.Key
+	if _, exists := f.keys[id]; exists {
+		return false, nil
+	}
+
+	f.keys[id] = idempotencyKey
+	return true, nil
+}
+
+func (f *fakeIdempotencyKeyDataSource) Reclaim(idempotencyKey daos.IdempotencyKeyDAO, staleBefore time.Time) (bool, error) {
+	return false, nil
+}
+
+func (f *fakeIdempotencyKeyDataSource) ReclaimExpired(idempotencyKey daos.IdempotencyKeyDAO, expiredBefore time.Time) (bool, error) {
+	return false, nil
+}
+
+func (f *fakeIdempotencyKeyDataSource) FindByKey(scope, key string) (daos.IdempotencyKeyDAO, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	idempotencyKey, exists := f.keys[scope+"/"+key]
+	if !exists {
+		return daos.IdempotencyKeyDAO{}, errors.New("record not found")
+	}
+
+	return idempotencyKey, nil
+}
+
+func (f *fakeIdempotencyKeyDataSource) Complete(scope, key, resourceID string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	idempotencyKey := f.keys[scope+"/"+key]
+	idempotencyKey.ResourceID = &resourceID
+	f.keys[scope+"/"+key] = idempotencyKey
+	return nil
+}
+
+func (f *fakeIdempotencyKeyDataSource) Release(scope, key string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	delete(f.keys, scope+"/"+key)
+	return nil
+}
+
+func (f *fakeIdempotencyKeyDataSource) DeleteOlderThan(before time.Time) (int64, error) {
+	return 0, nil
+}
+
 // Test helpers
 func setupTestRouter() *gin.Engine {
 	gin.SetMode(gin.TestMode)
@@ -120,7 +209,10 @@ func createHandler(mockDataSource *MockKitchenOrderDataSource, mockStatusDataSou
 			mockDataSource,
 			mockStatusDataSource,
 			mockMessageBroker,
+			nil,
+			nil,
 		),
+		idempotencyKeyController: *controllers.NewIdempotencyKeyController(newFakeIdempotencyKeyDataSource(), time.Hour),
 	}
 }
 
@@ -181,7 +273,7 @@ func TestFindAll_Success(t *testing.T) {
 
 	items := []daos.OrderItemDAO{createTestItem("item-001", "order-001", "prod-001", 2, 50.25)}
 	kitchenOrder := createTestKitchenOrder("550e8400-e29b-41d4-a716-446655440000", "order-001", items)
-	mockDataSource.On("FindAll", mock.Anything).Return([]daos.KitchenOrderDAO{kitchenOrder}, nil)
+	mockDataSource.On("FindAll", mock.Anything).Return([]daos.KitchenOrderDAO{kitchenOrder}, dtos.PageInfo{Total: 1}, nil)
 
 	handler := createHandler(mockDataSource, mockStatusDataSource, mockMessageBroker)
 	router.GET("/kitchen-orders", handler.FindAll)
@@ -191,17 +283,91 @@ func TestFindAll_Success(t *testing.T) {
 	router.ServeHTTP(w, req)
 
 	assert.Equal(t, http.StatusOK, w.Code)
-	var response []map[string]interface{}
+	var response struct {
+		Items      []map[string]interface{} `json:"items"`
+		NextCursor *string                  `json:"next_cursor"`
+		Total      int64                    `json:"total"`
+	}
 	err := json.Unmarshal(w.Body.Bytes(), &response)
 	assert.NoError(t, err)
-	assert.Len(t, response, 1)
-	assert.Equal(t, "550e8400-e29b-41d4-a716-446655440000", response[0]["id"])
-	assert.Equal(t, "order-001", response[0]["order_id"])
-	assert.Equal(t, "001", response[0]["slug"])
-	assert.Equal(t, "Recebido", response[0]["status"])
+	assert.Len(t, response.Items, 1)
+	assert.Equal(t, "550e8400-e29b-41d4-a716-446655440000", response.Items[0]["id"])
+	assert.Equal(t, "order-001", response.Items[0]["order_id"])
+	assert.Equal(t, "001", response.Items[0]["slug"])
+	assert.Equal(t, "Recebido", response.Items[0]["status"])
+	assert.Nil(t, response.NextCursor)
+	assert.Equal(t, int64(1), response.Total)
+	mockDataSource.AssertExpectations(t)
+}
+
+func TestFindAll_Pagination(t *testing.T) {
+	internal.SetupTestEnv()
+	defer internal.CleanupTestEnv()
+
+	router := setupTestRouter()
+	mockDataSource, mockStatusDataSource, mockMessageBroker := createMocks()
+
+	nextCursor := "eyJzIjoiYm9hcmQifQ"
+	mockDataSource.On("FindAll", mock.MatchedBy(func(filter dtos.KitchenOrderFilter) bool {
+		return filter.Limit == 10 && filter.Cursor == "abc" && filter.Sort == "-created_at"
+	})).Return([]daos.KitchenOrderDAO{}, dtos.PageInfo{NextCursor: &nextCursor, Total: 25}, nil)
+
+	handler := createHandler(mockDataSource, mockStatusDataSource, mockMessageBroker)
+	router.GET("/kitchen-orders", handler.FindAll)
+
+	req, _ := http.NewRequest("GET", "/kitchen-orders?limit=10&cursor=abc&sort=-created_at", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"items":[],"next_cursor":"eyJzIjoiYm9hcmQifQ","total":25}`, w.Body.String())
+	mockDataSource.AssertExpectations(t)
+}
+
+func TestFindAll_DefaultLimit(t *testing.T) {
+	internal.SetupTestEnv()
+	defer internal.CleanupTestEnv()
+
+	router := setupTestRouter()
+	mockDataSource, mockStatusDataSource, mockMessageBroker := createMocks()
+
+	mockDataSource.On("FindAll", mock.MatchedBy(func(filter dtos.KitchenOrderFilter) bool {
+		return filter.Limit == constants.KITCHEN_ORDER_DEFAULT_PAGE_LIMIT && filter.Sort == ""
+	})).Return([]daos.KitchenOrderDAO{}, dtos.PageInfo{}, nil)
+
+	handler := createHandler(mockDataSource, mockStatusDataSource, mockMessageBroker)
+	router.GET("/kitchen-orders", handler.FindAll)
+
+	req, _ := http.NewRequest("GET", "/kitchen-orders", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
 	mockDataSource.AssertExpectations(t)
 }
 
+func TestFindAll_InvalidPaginationParams(t *testing.T) {
+	internal.SetupTestEnv()
+	defer internal.CleanupTestEnv()
+
+	router := setupTestRouter()
+	router.Use(middlewares.ErrorHandlerMiddleware())
+	mockDataSource, mockStatusDataSource, mockMessageBroker := createMocks()
+
+	handler := createHandler(mockDataSource, mockStatusDataSource, mockMessageBroker)
+	router.GET("/kitchen-orders", handler.FindAll)
+
+	for _, query := range []string{"limit=abc", "limit=0", "limit=1000", "sort=name"} {
+		req, _ := http.NewRequest("GET", "/kitchen-orders?"+query, nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code, query)
+	}
+
+	mockDataSource.AssertNotCalled(t, "FindAll", mock.Anything)
+}
+
 func TestFindAll_WithFilters(t *testing.T) {
 	internal.SetupTestEnv()
 	defer internal.CleanupTestEnv()
@@ -211,7 +377,7 @@ func TestFindAll_WithFilters(t *testing.T) {
 
 	mockDataSource.On("FindAll", mock.MatchedBy(func(filter dtos.KitchenOrderFilter) bool {
 		return filter.CreatedAtFrom != nil && filter.CreatedAtTo != nil
-	})).Return([]daos.KitchenOrderDAO{}, nil)
+	})).Return([]daos.KitchenOrderDAO{}, dtos.PageInfo{}, nil)
 
 	handler := createHandler(mockDataSource, mockStatusDataSource, mockMessageBroker)
 	router.GET("/kitchen-orders", handler.FindAll)
@@ -392,6 +558,7 @@ func TestUpdate_InvalidJSON(t *testing.T) {
 	defer internal.CleanupTestEnv()
 
 	router := setupTestRouter()
+	router.Use(middlewares.ErrorHandlerMiddleware())
 	mockDataSource, mockStatusDataSource, mockMessageBroker := createMocks()
 
 	handler := createHandler(mockDataSource, mockStatusDataSource, mockMessageBroker)
@@ -404,6 +571,8 @@ func TestUpdate_InvalidJSON(t *testing.T) {
 	router.ServeHTTP(w, req)
 
 	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, "application/problem+json", w.Header().Get("Content-Type"))
+	assert.Contains(t, w.Body.String(), `"code":"malformed-request-body"`)
 }
 
 func TestUpdate_MissingStatusID(t *testing.T) {
@@ -411,6 +580,7 @@ func TestUpdate_MissingStatusID(t *testing.T) {
 	defer internal.CleanupTestEnv()
 
 	router := setupTestRouter()
+	router.Use(middlewares.ErrorHandlerMiddleware())
 	mockDataSource, mockStatusDataSource, mockMessageBroker := createMocks()
 
 	handler := createHandler(mockDataSource, mockStatusDataSource, mockMessageBroker)
@@ -425,6 +595,14 @@ func TestUpdate_MissingStatusID(t *testing.T) {
 	router.ServeHTTP(w, req)
 
 	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	var problem http_errors.ProblemDetails
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &problem))
+	assert.Equal(t, "validation-failed", problem.Code)
+	if assert.Len(t, problem.Errors, 1) {
+		assert.Equal(t, http_errors.ProblemFieldError{Field: "status_id", Code: "required", Message: "is required"}, problem.Errors[0])
+	}
+	t.Log("✓ Erro de validação do corpo com detalhes por campo")
 }
 
 func TestFindAll_DataSourceError(t *testing.T) {
@@ -434,7 +612,7 @@ func TestFindAll_DataSourceError(t *testing.T) {
 	router := setupTestRouter()
 	mockDataSource, mockStatusDataSource, mockMessageBroker := createMocks()
 
-	mockDataSource.On("FindAll", mock.Anything).Return(nil, assert.AnError)
+	mockDataSource.On("FindAll", mock.Anything).Return(nil, dtos.PageInfo{}, assert.AnError)
 
 	handler := createHandler(mockDataSource, mockStatusDataSource, mockMessageBroker)
 	router.GET("/kitchen-orders", handler.FindAll)
@@ -492,3 +670,835 @@ func TestUpdate_DataSourceError(t *testing.T) {
 	assert.Equal(t, http.StatusOK, w.Code)
 	mockDataSource.AssertExpectations(t)
 }
+
+func TestFindAll_SearchFilters(t *testing.T) {
+	internal.SetupTestEnv()
+	defer internal.CleanupTestEnv()
+
+	router := setupTestRouter()
+	mockDataSource, mockStatusDataSource, mockMessageBroker := createMocks()
+
+	mockDataSource.On("FindAll", mock.MatchedBy(func(filter dtos.KitchenOrderFilter) bool {
+		return len(filter.StatusIDs) == 3 &&
+			filter.StatusIDs[2] == constants.KITCHEN_ORDER_STATUS_FINISHED_ID &&
+			filter.OrderID != nil && *filter.OrderID == "order-001" &&
+			filter.Slug != nil && *filter.Slug == "001" &&
+			filter.CustomerID != nil && *filter.CustomerID == "customer-1" &&
+			filter.IncludeFinished
+	})).Return([]daos.KitchenOrderDAO{}, dtos.PageInfo{}, nil)
+
+	handler := createHandler(mockDataSource, mockStatusDataSource, mockMessageBroker)
+	router.GET("/kitchen-orders", handler.FindAll)
+
+	query := "status_id=" + constants.KITCHEN_ORDER_STATUS_RECEIVED_ID + "," + constants.KITCHEN_ORDER_STATUS_READY_ID +
+		"&status_id=" + constants.KITCHEN_ORDER_STATUS_FINISHED_ID +
+		"&order_id=order-001&slug=001&customer_id=customer-1&include_finished=true"
+	req, _ := http.NewRequest("GET", "/kitchen-orders?"+query, nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockDataSource.AssertExpectations(t)
+}
+
+func TestFindAll_MalformedFilters(t *testing.T) {
+	internal.SetupTestEnv()
+	defer internal.CleanupTestEnv()
+
+	router := setupTestRouter()
+	router.Use(middlewares.ErrorHandlerMiddleware())
+	mockDataSource, mockStatusDataSource, mockMessageBroker := createMocks()
+
+	handler := createHandler(mockDataSource, mockStatusDataSource, mockMessageBroker)
+	router.GET("/kitchen-orders", handler.FindAll)
+
+	for _, query := range []string{
+		"created_at_from=yesterday",
+		"created_at_to=2024-13-01",
+		"status_id=1",
+		"include_finished=maybe",
+		"created_at_from=2024-02-01T00:00:00Z&created_at_to=2024-01-01T00:00:00Z",
+	} {
+		req, _ := http.NewRequest("GET", "/kitchen-orders?"+query, nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code, query)
+		assert.Contains(t, w.Body.String(), `"code":"invalid-kitchen-order-filter"`, query)
+	}
+
+	mockDataSource.AssertNotCalled(t, "FindAll", mock.Anything)
+}
+
+func setupCreateKitchenOrderRouter(mockDataSource *MockKitchenOrderDataSource, mockStatusDataSource *MockOrderStatusDataSource) *gin.Engine {
+	router := setupTestRouter()
+	router.Use(middlewares.ErrorHandlerMiddleware())
+	// Simula o middleware de autenticação para os testes que enviam o cliente no cabeçalho
+	router.Use(func(ctx *gin.Context) {
+		if subject := ctx.GetHeader(testSubjectHeader); subject != "" {
+			ctx.Set(constants.AUTH_PRINCIPAL_CONTEXT_KEY, auth.Principal{Subject: subject, Roles: []string{constants.ROLE_SERVICE}})
+		}
+	})
+
+	// O pedido inserido passa a ser devolvido pelo FindByID, como no banco
+	mockDataSource.On("FindAll", mock.Anything).Return([]daos.KitchenOrderDAO{}, dtos.PageInfo{}, nil)
+	mockDataSource.On("Insert", mock.Anything).Run(func(args mock.Arguments) {
+		inserted := args.Get(0).(daos.KitchenOrderDAO)
+		mockDataSource.On("FindByID", inserted.ID).Return(inserted, nil)
+	}).Return(nil)
+	mockStatusDataSource.On("FindByID", constants.KITCHEN_ORDER_STATUS_RECEIVED_ID).Return(daos.OrderStatusDAO{
+		ID:   constants.KITCHEN_ORDER_STATUS_RECEIVED_ID,
+		Name: "Recebido",
+	}, nil)
+
+	handler := createHandler(mockDataSource, mockStatusDataSource, new(MockMessageBroker))
+	router.POST("/kitchen-orders/", handler.Create)
+
+	return router
+}
+
+const testSubjectHeader = "X-Test-Subject"
+
+func postKitchenOrder(router *gin.Engine, body map[string]interface{}, idempotencyKey *string) *httptest.ResponseRecorder {
+	return postKitchenOrderAs(router, "", body, idempotencyKey)
+}
+
+func postKitchenOrderAs(router *gin.Engine, subject string, body map[string]interface{}, idempotencyKey *string) *httptest.ResponseRecorder {
+	jsonBody, _ := json.Marshal(body)
+	req, _ := http.NewRequest("POST", "/kitchen-orders/", bytes.NewBuffer(jsonBody))
+	req.Header.Set("Content-Type", "application/json")
+	if idempotencyKey != nil {
+		req.Header.Set("Idempotency-Key", *idempotencyKey)
+	}
+	if subject != "" {
+		req.Header.Set(testSubjectHeader, subject)
+	}
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func createKitchenOrderRequestBody(orderID string) map[string]interface{} {
+	return map[string]interface{}{
+		"order_id":    orderID,
+		"customer_id": "customer-001",
+		"items": []map[string]interface{}{
+			{"product_id": "burger", "quantity": 2, "unit_price": 25.5},
+		},
+	}
+}
+
+func TestCreate_Success(t *testing.T) {
+	internal.SetupTestEnv()
+	defer internal.CleanupTestEnv()
+
+	mockDataSource, mockStatusDataSource, _ := createMocks()
+	router := setupCreateKitchenOrderRouter(mockDataSource, mockStatusDataSource)
+
+	w := postKitchenOrder(router, createKitchenOrderRequestBody("order-001"), nil)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.Empty(t, w.Header().Get("Idempotent-Replayed"))
+
+	var response map[string]interface{}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, "order-001", response["order_id"])
+	assert.Equal(t, "001", response["slug"])
+	assert.Equal(t, "Recebido", response["status"])
+
+	mockDataSource.AssertCalled(t, "Insert", mock.MatchedBy(func(kitchenOrder daos.KitchenOrderDAO) bool {
+		return len(kitchenOrder.Items) == 1 && kitchenOrder.Amount == 51 && *kitchenOrder.CustomerID == "customer-001"
+	}))
+	t.Log("✓ Pedido criado com os itens enviados")
+}
+
+func TestCreate_IdempotentRetryReplaysResponse(t *testing.T) {
+	internal.SetupTestEnv()
+	defer internal.CleanupTestEnv()
+
+	mockDataSource, mockStatusDataSource, _ := createMocks()
+	router := setupCreateKitchenOrderRouter(mockDataSource, mockStatusDataSource)
+	idempotencyKey := "retry-key-001"
+
+	first := postKitchenOrder(router, createKitchenOrderRequestBody("order-001"), &idempotencyKey)
+	second := postKitchenOrder(router, createKitchenOrderRequestBody("order-001"), &idempotencyKey)
+
+	assert.Equal(t, http.StatusCreated, first.Code)
+	assert.Equal(t, http.StatusCreated, second.Code)
+	assert.Equal(t, "true", second.Header().Get("Idempotent-Replayed"))
+	assert.JSONEq(t, first.Body.String(), second.Body.String())
+	mockDataSource.AssertNumberOfCalls(t, "Insert", 1)
+	t.Log("✓ Nova tentativa devolve o pedido já criado sem duplicar")
+}
+
+func TestCreate_IdempotencyKeyReusedWithDifferentBody(t *testing.T) {
+	internal.SetupTestEnv()
+	defer internal.CleanupTestEnv()
+
+	mockDataSource, mockStatusDataSource, _ := createMocks()
+	router := setupCreateKitchenOrderRouter(mockDataSource, mockStatusDataSource)
+	idempotencyKey := "retry-key-001"
+
+	first := postKitchenOrder(router, createKitchenOrderRequestBody("order-001"), &idempotencyKey)
+	second := postKitchenOrder(router, createKitchenOrderRequestBody("order-002"), &idempotencyKey)
+
+	assert.Equal(t, http.StatusCreated, first.Code)
+	assert.Equal(t, http.StatusUnprocessableEntity, second.Code)
+	mockDataSource.AssertNumberOfCalls(t, "Insert", 1)
+}
+
+func TestCreate_IdempotencyKeyIsScopedByClient(t *testing.T) {
+	internal.SetupTestEnv()
+	defer internal.CleanupTestEnv()
+
+	mockDataSource, mockStatusDataSource, _ := createMocks()
+	router := setupCreateKitchenOrderRouter(mockDataSource, mockStatusDataSource)
+	idempotencyKey := "retry-key-001"
+
+	first := postKitchenOrderAs(router, "api-key:partner-a", createKitchenOrderRequestBody("order-001"), &idempotencyKey)
+	second := postKitchenOrderAs(router, "api-key:partner-b", createKitchenOrderRequestBody("order-002"), &idempotencyKey)
+
+	assert.Equal(t, http.StatusCreated, first.Code)
+	assert.Equal(t, http.StatusCreated, second.Code)
+	assert.Empty(t, second.Header().Get("Idempotent-Replayed"))
+	assert.NotEqual(t, first.Body.String(), second.Body.String())
+	mockDataSource.AssertNumberOfCalls(t, "Insert", 2)
+	t.Log("✓ A mesma chave de clientes diferentes não devolve o pedido de outro cliente")
+}
+
+func TestCreate_BlankIdempotencyKey(t *testing.T) {
+	internal.SetupTestEnv()
+	defer internal.CleanupTestEnv()
+
+	mockDataSource, mockStatusDataSource, _ := createMocks()
+	router := setupCreateKitchenOrderRouter(mockDataSource, mockStatusDataSource)
+	idempotencyKey := ""
+
+	w := postKitchenOrder(router, createKitchenOrderRequestBody("order-001"), &idempotencyKey)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockDataSource.AssertNotCalled(t, "Insert", mock.Anything)
+}
+
+func TestCreate_FailureReleasesIdempotencyKey(t *testing.T) {
+	internal.SetupTestEnv()
+	defer internal.CleanupTestEnv()
+
+	mockDataSource, mockStatusDataSource, _ := createMocks()
+	router := setupCreateKitchenOrderRouter(mockDataSource, mockStatusDataSource)
+	idempotencyKey := "retry-key-001"
+
+	invalidBody := createKitchenOrderRequestBody("order-001")
+	invalidBody["items"] = []map[string]interface{}{{"product_id": "burger", "quantity": 1, "unit_price": -1}}
+
+	w := postKitchenOrder(router, invalidBody, &idempotencyKey)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	// A chave foi liberada; a mesma chave pode ser usada com o corpo corrigido
+	w = postKitchenOrder(router, createKitchenOrderRequestBody("order-001"), &idempotencyKey)
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.Empty(t, w.Header().Get("Idempotent-Replayed"))
+}
+
+func TestCreate_InvalidJSON(t *testing.T) {
+	internal.SetupTestEnv()
+	defer internal.CleanupTestEnv()
+
+	mockDataSource, mockStatusDataSource, _ := createMocks()
+	router := setupCreateKitchenOrderRouter(mockDataSource, mockStatusDataSource)
+
+	w := postKitchenOrder(router, map[string]interface{}{"customer_id": "customer-001"}, nil)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockDataSource.AssertNotCalled(t, "Insert", mock.Anything)
+}
+
+func setupVersionedKitchenOrderRouter(version uint64, updateErr error) (*gin.Engine, *MockKitchenOrderDataSource) {
+	router := setupTestRouter()
+	router.Use(middlewares.ErrorHandlerMiddleware())
+	mockDataSource, mockStatusDataSource, mockMessageBroker := createMocks()
+
+	kitchenOrder := createTestKitchenOrder("550e8400-e29b-41d4-a716-446655440000", "order-001", nil)
+	kitchenOrder.Version = version
+
+	mockDataSource.On("FindByID", kitchenOrder.ID).Return(kitchenOrder, nil)
+	mockDataSource.On("Update", mock.Anything).Return(updateErr)
+	mockStatusDataSource.On("FindByID", "2").Return(daos.OrderStatusDAO{ID: "2", Name: "Em preparação"}, nil)
+	mockMessageBroker.On("Publish", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	handler := createHandler(mockDataSource, mockStatusDataSource, mockMessageBroker)
+	router.GET("/kitchen-orders/:id", handler.FindByID)
+	router.PUT("/kitchen-orders/:id", handler.Update)
+
+	return router, mockDataSource
+}
+
+func putKitchenOrderStatus(router *gin.Engine, ifMatch string) *httptest.ResponseRecorder {
+	jsonBody, _ := json.Marshal(map[string]interface{}{"status_id": "2"})
+	req, _ := http.NewRequest("PUT", "/kitchen-orders/550e8400-e29b-41d4-a716-446655440000", bytes.NewBuffer(jsonBody))
+	req.Header.Set("Content-Type", "application/json")
+	if ifMatch != "" {
+		req.Header.Set("If-Match", ifMatch)
+	}
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestFindByID_ReturnsETag(t *testing.T) {
+	internal.SetupTestEnv()
+	defer internal.CleanupTestEnv()
+
+	router, _ := setupVersionedKitchenOrderRouter(3, nil)
+
+	req, _ := http.NewRequest("GET", "/kitchen-orders/550e8400-e29b-41d4-a716-446655440000", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, `"3"`, w.Header().Get("ETag"))
+	t.Log("✓ ETag com a versão atual do pedido")
+}
+
+func TestUpdate_IfMatch(t *testing.T) {
+	internal.SetupTestEnv()
+	defer internal.CleanupTestEnv()
+
+	t.Run("matching version", func(t *testing.T) {
+		router, mockDataSource := setupVersionedKitchenOrderRouter(3, nil)
+
+		w := putKitchenOrderStatus(router, `"3"`)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, `"4"`, w.Header().Get("ETag"))
+		mockDataSource.AssertCalled(t, "Update", mock.MatchedBy(func(kitchenOrder daos.KitchenOrderDAO) bool {
+			return kitchenOrder.Version == 3
+		}))
+	})
+
+	t.Run("wildcard", func(t *testing.T) {
+		router, _ := setupVersionedKitchenOrderRouter(3, nil)
+
+		w := putKitchenOrderStatus(router, "*")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	for _, ifMatch := range []string{`"2"`, `W/"3"`, "3"} {
+		t.Run("rejects "+ifMatch, func(t *testing.T) {
+			router, mockDataSource := setupVersionedKitchenOrderRouter(3, nil)
+
+			w := putKitchenOrderStatus(router, ifMatch)
+
+			assert.Equal(t, http.StatusPreconditionFailed, w.Code)
+			mockDataSource.AssertNotCalled(t, "Update", mock.Anything)
+		})
+	}
+
+	t.Log("✓ If-Match aplicado na alteração do pedido")
+}
+
+func TestUpdate_RecordsActor(t *testing.T) {
+	internal.SetupTestEnv()
+	defer internal.CleanupTestEnv()
+
+	router := setupTestRouter()
+	router.Use(func(ctx *gin.Context) {
+		ctx.Set(constants.AUTH_PRINCIPAL_CONTEXT_KEY, auth.Principal{Subject: "manager-7", Roles: []string{constants.ROLE_MANAGER}})
+	})
+	router.Use(middlewares.ErrorHandlerMiddleware())
+	mockDataSource, mockStatusDataSource, mockMessageBroker := createMocks()
+
+	kitchenOrder := createTestKitchenOrder("550e8400-e29b-41d4-a716-446655440000", "order-001", nil)
+	mockDataSource.On("FindByID", kitchenOrder.ID).Return(kitchenOrder, nil)
+	mockDataSource.On("Update", mock.Anything).Return(nil)
+	mockStatusDataSource.On("FindByID", "2").Return(daos.OrderStatusDAO{ID: "2", Name: "Em preparação"}, nil)
+	mockMessageBroker.On("Publish", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	handler := createHandler(mockDataSource, mockStatusDataSource, mockMessageBroker)
+	router.PUT("/kitchen-orders/:id", handler.Update)
+
+	w := putKitchenOrderStatus(router, "")
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"status_changed_by":"manager-7"`)
+	mockDataSource.AssertCalled(t, "Update", mock.MatchedBy(func(kitchenOrder daos.KitchenOrderDAO) bool {
+		return kitchenOrder.StatusChangedBy != nil && *kitchenOrder.StatusChangedBy == "manager-7"
+	}))
+	t.Log("✓ Ator do token registrado na mudança de status")
+}
+
+func TestUpdate_ConcurrentUpdate(t *testing.T) {
+	internal.SetupTestEnv()
+	defer internal.CleanupTestEnv()
+
+	router, _ := setupVersionedKitchenOrderRouter(3, interfaces.ErrKitchenOrderVersionConflict)
+
+	assert.Equal(t, http.StatusConflict, putKitchenOrderStatus(router, "").Code)
+	assert.Equal(t, http.StatusPreconditionFailed, putKitchenOrderStatus(router, `"3"`).Code)
+	t.Log("✓ Alteração concorrente não sobrescreve a anterior")
+}
+
+func TestBulkUpdateStatus_ReportsEachOrder(t *testing.T) {
+	internal.SetupTestEnv()
+	defer internal.CleanupTestEnv()
+
+	router := setupTestRouter()
+	router.Use(middlewares.ErrorHandlerMiddleware())
+	mockDataSource, mockStatusDataSource, mockMessageBroker := createMocks()
+
+	foundID := "550e8400-e29b-41d4-a716-446655440000"
+	missingID := "550e8400-e29b-41d4-a716-446655440001"
+	receivedID := "550e8400-e29b-41d4-a716-446655440002"
+
+	readyOrder := createTestKitchenOrder(foundID, "order-001", nil)
+	readyOrder.Status = daos.OrderStatusDAO{ID: constants.KITCHEN_ORDER_STATUS_READY_ID, Name: "Pronto"}
+	receivedOrder := createTestKitchenOrder(receivedID, "order-002", nil)
+	receivedOrder.Status = daos.OrderStatusDAO{ID: constants.KITCHEN_ORDER_STATUS_RECEIVED_ID, Name: "Recebido"}
+
+	mockDataSource.On("FindByID", foundID).Return(readyOrder, nil)
+	mockDataSource.On("FindByID", missingID).Return(nil, errors.New("record not found"))
+	mockDataSource.On("FindByID", receivedID).Return(receivedOrder, nil)
+	mockDataSource.On("Update", mock.Anything).Return(nil)
+	mockStatusDataSource.On("FindByID", constants.KITCHEN_ORDER_STATUS_FINISHED_ID).Return(daos.OrderStatusDAO{
+		ID:   constants.KITCHEN_ORDER_STATUS_FINISHED_ID,
+		Name: "Finalizado",
+	}, nil)
+	mockMessageBroker.On("Publish", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	handler := createHandler(mockDataSource, mockStatusDataSource, mockMessageBroker)
+	router.POST("/kitchen-orders/bulk-status", handler.BulkUpdateStatus)
+
+	jsonBody, _ := json.Marshal(map[string]interface{}{
+		"ids":       []string{foundID, missingID, receivedID},
+		"status_id": constants.KITCHEN_ORDER_STATUS_FINISHED_ID,
+	})
+	req, _ := http.NewRequest("POST", "/kitchen-orders/bulk-status", bytes.NewBuffer(jsonBody))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response schemas.BulkUpdateKitchenOrderStatusResponseSchema
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, 2, response.Succeeded)
+	assert.Equal(t, 1, response.Failed)
+
+	if assert.Len(t, response.Results, 3) {
+		assert.True(t, response.Results[0].Success)
+		assert.Equal(t, "Finalizado", response.Results[0].KitchenOrder.Status)
+		assert.False(t, response.Results[1].Success)
+		assert.Equal(t, missingID, response.Results[1].ID)
+		assert.Equal(t, "Kitchen Order not found", response.Results[1].Error)
+		assert.Equal(t, "kitchen-order-not-found", response.Results[1].ErrorCode)
+		// Como no PUT, um pedido recebido pode ser finalizado direto
+		assert.True(t, response.Results[2].Success)
+		assert.Equal(t, "Finalizado", response.Results[2].KitchenOrder.Status)
+	}
+	t.Log("✓ Resultado individual de cada pedido da alteração em lote")
+}
+
+func TestBulkUpdateStatus_InvalidRequest(t *testing.T) {
+	internal.SetupTestEnv()
+	defer internal.CleanupTestEnv()
+
+	router := setupTestRouter()
+	router.Use(middlewares.ErrorHandlerMiddleware())
+	mockDataSource, mockStatusDataSource, mockMessageBroker := createMocks()
+
+	handler := createHandler(mockDataSource, mockStatusDataSource, mockMessageBroker)
+	router.POST("/kitchen-orders/bulk-status", handler.BulkUpdateStatus)
+
+	for _, body := range []map[string]interface{}{
+		{"ids": []string{}, "status_id": constants.KITCHEN_ORDER_STATUS_FINISHED_ID},
+		{"ids": []string{"550e8400-e29b-41d4-a716-446655440000"}},
+	} {
+		jsonBody, _ := json.Marshal(body)
+		req, _ := http.NewRequest("POST", "/kitchen-orders/bulk-status", bytes.NewBuffer(jsonBody))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	}
+
+	mockDataSource.AssertNotCalled(t, "Update", mock.Anything)
+}
+
+func setupKitchenOrderCommandRouter(statusID string) (*gin.Engine, *MockKitchenOrderDataSource) {
+	router := setupTestRouter()
+	router.Use(middlewares.ErrorHandlerMiddleware())
+	mockDataSource, mockStatusDataSource, mockMessageBroker := createMocks()
+
+	kitchenOrder := createTestKitchenOrder("550e8400-e29b-41d4-a716-446655440000", "order-001", nil)
+	kitchenOrder.Status = daos.OrderStatusDAO{ID: statusID, Name: "Status atual"}
+	kitchenOrder.Version = 1
+
+	statusNames := map[string]string{
+		constants.KITCHEN_ORDER_STATUS_RECEIVED_ID:  "Recebido",
+		constants.KITCHEN_ORDER_STATUS_PREPARING_ID: "Em preparação",
+		constants.KITCHEN_ORDER_STATUS_READY_ID:     "Pronto",
+		constants.KITCHEN_ORDER_STATUS_FINISHED_ID:  "Finalizado",
+	}
+	for id, name := range statusNames {
+		mockStatusDataSource.On("FindByID", id).Return(daos.OrderStatusDAO{ID: id, Name: name}, nil)
+	}
+
+	mockDataSource.On("FindByID", kitchenOrder.ID).Return(kitchenOrder, nil)
+	mockDataSource.On("Update", mock.Anything).Return(nil)
+	mockMessageBroker.On("Publish", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	handler := createHandler(mockDataSource, mockStatusDataSource, mockMessageBroker)
+	router.POST("/kitchen-orders/:id/start", handler.Start)
+	router.POST("/kitchen-orders/:id/ready", handler.Ready)
+	router.POST("/kitchen-orders/:id/finish", handler.Finish)
+	router.POST("/kitchen-orders/:id/recall", handler.Recall)
+
+	return router, mockDataSource
+}
+
+func postKitchenOrderCommand(router *gin.Engine, command string, body string, ifMatch string) *httptest.ResponseRecorder {
+	req, _ := http.NewRequest("POST", "/kitchen-orders/550e8400-e29b-41d4-a716-446655440000/"+command, bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	if ifMatch != "" {
+		req.Header.Set("If-Match", ifMatch)
+	}
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestCommandEndpoints_Success(t *testing.T) {
+	internal.SetupTestEnv()
+	defer internal.CleanupTestEnv()
+
+	cases := []struct {
+		command        string
+		from           string
+		expectedStatus string
+	}{
+		{constants.KITCHEN_ORDER_COMMAND_START, constants.KITCHEN_ORDER_STATUS_RECEIVED_ID, "Em preparação"},
+		{constants.KITCHEN_ORDER_COMMAND_READY, constants.KITCHEN_ORDER_STATUS_PREPARING_ID, "Pronto"},
+		{constants.KITCHEN_ORDER_COMMAND_FINISH, constants.KITCHEN_ORDER_STATUS_READY_ID, "Finalizado"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.command, func(t *testing.T) {
+			router, _ := setupKitchenOrderCommandRouter(tc.from)
+
+			w := postKitchenOrderCommand(router, tc.command, "", `"1"`)
+
+			assert.Equal(t, http.StatusOK, w.Code)
+			assert.Equal(t, `"2"`, w.Header().Get("ETag"))
+
+			var response schemas.KitchenOrderResponseSchema
+			assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+			assert.Equal(t, tc.expectedStatus, response.Status)
+		})
+	}
+	t.Log("✓ Endpoints de intenção resolvem o status no servidor")
+}
+
+func TestCommandEndpoints_InvalidTransition(t *testing.T) {
+	internal.SetupTestEnv()
+	defer internal.CleanupTestEnv()
+
+	router, mockDataSource := setupKitchenOrderCommandRouter(constants.KITCHEN_ORDER_STATUS_RECEIVED_ID)
+
+	w := postKitchenOrderCommand(router, constants.KITCHEN_ORDER_COMMAND_FINISH, "", "")
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+	mockDataSource.AssertNotCalled(t, "Update", mock.Anything)
+}
+
+func TestCommandEndpoints_IfMatchMismatch(t *testing.T) {
+	internal.SetupTestEnv()
+	defer internal.CleanupTestEnv()
+
+	router, mockDataSource := setupKitchenOrderCommandRouter(constants.KITCHEN_ORDER_STATUS_RECEIVED_ID)
+
+	w := postKitchenOrderCommand(router, constants.KITCHEN_ORDER_COMMAND_START, "", `"5"`)
+
+	assert.Equal(t, http.StatusPreconditionFailed, w.Code)
+	mockDataSource.AssertNotCalled(t, "Update", mock.Anything)
+}
+
+func TestRecall_Reason(t *testing.T) {
+	internal.SetupTestEnv()
+	defer internal.CleanupTestEnv()
+
+	for _, body := range []string{"", `{}`, `{"reason":""}`} {
+		t.Run("rejects "+body, func(t *testing.T) {
+			router, mockDataSource := setupKitchenOrderCommandRouter(constants.KITCHEN_ORDER_STATUS_READY_ID)
+
+			w := postKitchenOrderCommand(router, constants.KITCHEN_ORDER_COMMAND_RECALL, body, "")
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			mockDataSource.AssertNotCalled(t, "Update", mock.Anything)
+		})
+	}
+
+	t.Run("stores the reason", func(t *testing.T) {
+		router, mockDataSource := setupKitchenOrderCommandRouter(constants.KITCHEN_ORDER_STATUS_FINISHED_ID)
+
+		w := postKitchenOrderCommand(router, constants.KITCHEN_ORDER_COMMAND_RECALL, `{"reason":"Cliente devolveu o lanche frio"}`, "")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response schemas.KitchenOrderResponseSchema
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "Em preparação", response.Status)
+		if assert.NotNil(t, response.StatusReason) {
+			assert.Equal(t, "Cliente devolveu o lanche frio", *response.StatusReason)
+		}
+
+		mockDataSource.AssertCalled(t, "Update", mock.MatchedBy(func(kitchenOrder daos.KitchenOrderDAO) bool {
+			return kitchenOrder.StatusReason != nil && *kitchenOrder.StatusReason == "Cliente devolveu o lanche frio"
+		}))
+	})
+
+	t.Run("rejects recall of an order in preparation", func(t *testing.T) {
+		router, _ := setupKitchenOrderCommandRouter(constants.KITCHEN_ORDER_STATUS_PREPARING_ID)
+
+		w := postKitchenOrderCommand(router, constants.KITCHEN_ORDER_COMMAND_RECALL, `{"reason":"Pedido errado"}`, "")
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+	t.Log("✓ Recall exige motivo e devolve o pedido para a preparação")
+}
+
+func createExportedKitchenOrder() daos.KitchenOrderDAO {
+	createdAt := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+	preparingAt := createdAt.Add(2 * time.Minute)
+	readyAt := preparingAt.Add(8 * time.Minute)
+	finishedAt := readyAt.Add(2 * time.Minute)
+
+	items := []daos.OrderItemDAO{
+		createTestItem("item-001", "order-001", "burger", 2, 25.5),
+		createTestItem("item-002", "order-001", "fries", 1, 12),
+	}
+	kitchenOrder := createTestKitchenOrder("550e8400-e29b-41d4-a716-446655440000", "order-001", items)
+	kitchenOrder.Status = daos.OrderStatusDAO{ID: constants.KITCHEN_ORDER_STATUS_FINISHED_ID, Name: "Finalizado"}
+	kitchenOrder.CreatedAt = createdAt
+	kitchenOrder.PreparingAt = &preparingAt
+	kitchenOrder.ReadyAt = &readyAt
+	kitchenOrder.FinishedAt = &finishedAt
+
+	return kitchenOrder
+}
+
+func TestExport_CSV(t *testing.T) {
+	internal.SetupTestEnv()
+	defer internal.CleanupTestEnv()
+
+	router := setupTestRouter()
+	router.Use(middlewares.ErrorHandlerMiddleware())
+	mockDataSource, mockStatusDataSource, mockMessageBroker := createMocks()
+
+	mockDataSource.On("FindAllInBatches", mock.MatchedBy(func(filter dtos.KitchenOrderFilter) bool {
+		return filter.IncludeFinished &&
+			filter.Sort == constants.KITCHEN_ORDER_SORT_CREATED_AT &&
+			filter.Limit == 0 &&
+			filter.CreatedAtFrom.Equal(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)) &&
+			filter.CreatedAtTo.Equal(time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC).Add(-time.Nanosecond))
+	}), constants.KITCHEN_ORDER_EXPORT_BATCH_SIZE).Return([]daos.KitchenOrderDAO{createExportedKitchenOrder()}, nil)
+
+	handler := createHandler(mockDataSource, mockStatusDataSource, mockMessageBroker)
+	router.GET("/kitchen-orders/export", handler.Export)
+
+	req, _ := http.NewRequest("GET", "/kitchen-orders/export?from=2024-01-01&to=2024-01-31", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "text/csv; charset=utf-8", w.Header().Get("Content-Type"))
+	assert.Contains(t, w.Header().Get("Content-Disposition"), "attachment; filename=\"kitchen-orders-")
+
+	lines := strings.Split(strings.TrimSpace(w.Body.String()), "\n")
+	assert.Len(t, lines, 2)
+	assert.True(t, strings.HasPrefix(lines[0], "id,order_id,slug,"))
+	assert.Equal(t, "550e8400-e29b-41d4-a716-446655440000,order-001,001,,Finalizado,100.50,burger:2;fries:1,,"+
+		"2024-01-15T12:00:00Z,2024-01-15T12:02:00Z,2024-01-15T12:10:00Z,2024-01-15T12:12:00Z,120,480,720", lines[1])
+	mockDataSource.AssertExpectations(t)
+}
+
+func TestExport_NDJSON(t *testing.T) {
+	internal.SetupTestEnv()
+	defer internal.CleanupTestEnv()
+
+	router := setupTestRouter()
+	mockDataSource, mockStatusDataSource, mockMessageBroker := createMocks()
+
+	mockDataSource.On("FindAllInBatches", mock.Anything, mock.Anything).Return([]daos.KitchenOrderDAO{createExportedKitchenOrder()}, nil)
+
+	handler := createHandler(mockDataSource, mockStatusDataSource, mockMessageBroker)
+	router.GET("/kitchen-orders/export", handler.Export)
+
+	req, _ := http.NewRequest("GET", "/kitchen-orders/export?format=ndjson&from=2024-01-01T00:00:00Z&to=2024-02-01T00:00:00Z", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/x-ndjson", w.Header().Get("Content-Type"))
+
+	var row schemas.KitchenOrderExportSchema
+	assert.NoError(t, json.Unmarshal([]byte(strings.TrimSpace(w.Body.String())), &row))
+	assert.Equal(t, "Finalizado", row.Status)
+	assert.Len(t, row.Items, 2)
+	if assert.NotNil(t, row.PreparationSeconds) {
+		assert.Equal(t, 480.0, *row.PreparationSeconds)
+	}
+}
+
+func TestExport_EmptyPeriodWritesCSVHeader(t *testing.T) {
+	internal.SetupTestEnv()
+	defer internal.CleanupTestEnv()
+
+	router := setupTestRouter()
+	mockDataSource, mockStatusDataSource, mockMessageBroker := createMocks()
+
+	mockDataSource.On("FindAllInBatches", mock.Anything, mock.Anything).Return([]daos.KitchenOrderDAO{}, nil)
+
+	handler := createHandler(mockDataSource, mockStatusDataSource, mockMessageBroker)
+	router.GET("/kitchen-orders/export", handler.Export)
+
+	req, _ := http.NewRequest("GET", "/kitchen-orders/export?from=2024-01-01&to=2024-01-31", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.True(t, strings.HasPrefix(w.Body.String(), "id,order_id,slug,"))
+}
+
+func TestExport_InvalidRequest(t *testing.T) {
+	internal.SetupTestEnv()
+	defer internal.CleanupTestEnv()
+
+	router := setupTestRouter()
+	router.Use(middlewares.ErrorHandlerMiddleware())
+	mockDataSource, mockStatusDataSource, mockMessageBroker := createMocks()
+
+	handler := createHandler(mockDataSource, mockStatusDataSource, mockMessageBroker)
+	router.GET("/kitchen-orders/export", handler.Export)
+
+	for _, query := range []string{
+		"to=2024-01-31",
+		"from=2024-01-01",
+		"from=yesterday&to=2024-01-31",
+		"from=2024-02-01&to=2024-01-01",
+		"from=2024-01-01&to=2024-01-31&format=xlsx",
+	} {
+		req, _ := http.NewRequest("GET", "/kitchen-orders/export?"+query, nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code, query)
+		assert.Contains(t, w.Header().Get("Content-Type"), "application/problem+json", query)
+		assert.Empty(t, w.Header().Get("Content-Disposition"), query)
+	}
+
+	mockDataSource.AssertNotCalled(t, "FindAllInBatches", mock.Anything, mock.Anything)
+}
+
+func TestExport_DataSourceError(t *testing.T) {
+	internal.SetupTestEnv()
+	defer internal.CleanupTestEnv()
+
+	router := setupTestRouter()
+	router.Use(middlewares.ErrorHandlerMiddleware())
+	mockDataSource, mockStatusDataSource, mockMessageBroker := createMocks()
+
+	mockDataSource.On("FindAllInBatches", mock.Anything, mock.Anything).Return(nil, errors.New("database error"))
+
+	handler := createHandler(mockDataSource, mockStatusDataSource, mockMessageBroker)
+	router.GET("/kitchen-orders/export", handler.Export)
+
+	req, _ := http.NewRequest("GET", "/kitchen-orders/export?from=2024-01-01&to=2024-01-31", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.NotContains(t, w.Header().Get("Content-Type"), "text/csv")
+}
+
+func TestTicket_RendersFormats(t *testing.T) {
+	internal.SetupTestEnv()
+	defer internal.CleanupTestEnv()
+
+	router := setupTestRouter()
+	router.Use(middlewares.ErrorHandlerMiddleware())
+	mockDataSource, mockStatusDataSource, mockMessageBroker := createMocks()
+
+	kitchenOrder := createExportedKitchenOrder()
+	mockDataSource.On("FindByID", kitchenOrder.ID).Return(kitchenOrder, nil)
+
+	handler := createHandler(mockDataSource, mockStatusDataSource, mockMessageBroker)
+	router.GET("/kitchen-orders/:id/ticket", handler.Ticket)
+
+	req, _ := http.NewRequest("GET", "/kitchen-orders/"+kitchenOrder.ID+"/ticket", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "text/plain; charset=utf-8", w.Header().Get("Content-Type"))
+	assert.Contains(t, w.Body.String(), "PEDIDO 001")
+	assert.Contains(t, w.Body.String(), "  2x burger")
+
+	req, _ = http.NewRequest("GET", "/kitchen-orders/"+kitchenOrder.ID+"/ticket?format=escpos&width=32&qr=false", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/octet-stream", w.Header().Get("Content-Type"))
+	assert.Contains(t, w.Header().Get("Content-Disposition"), "ticket-001.bin")
+	assert.True(t, bytes.HasPrefix(w.Body.Bytes(), []byte{0x1b, 0x40}))
+	assert.Contains(t, w.Body.String(), strings.Repeat("=", 32)+"\n")
+	assert.NotContains(t, w.Body.String(), strings.Repeat("=", 33))
+}
+
+func TestTicket_InvalidRequest(t *testing.T) {
+	internal.SetupTestEnv()
+	defer internal.CleanupTestEnv()
+
+	router := setupTestRouter()
+	router.Use(middlewares.ErrorHandlerMiddleware())
+	mockDataSource, mockStatusDataSource, mockMessageBroker := createMocks()
+
+	handler := createHandler(mockDataSource, mockStatusDataSource, mockMessageBroker)
+	router.GET("/kitchen-orders/:id/ticket", handler.Ticket)
+
+	for _, query := range []string{"format=pdf", "width=10", "width=abc", "qr=maybe"} {
+		req, _ := http.NewRequest("GET", "/kitchen-orders/550e8400-e29b-41d4-a716-446655440000/ticket?"+query, nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code, query)
+		assert.Contains(t, w.Body.String(), `"code":"invalid-kitchen-order-ticket"`, query)
+	}
+
+	mockDataSource.AssertNotCalled(t, "FindByID", mock.Anything)
+}
+
+func TestTicket_NotFound(t *testing.T) {
+	internal.SetupTestEnv()
+	defer internal.CleanupTestEnv()
+
+	router := setupTestRouter()
+	router.Use(middlewares.ErrorHandlerMiddleware())
+	mockDataSource, mockStatusDataSource, mockMessageBroker := createMocks()
+
+	mockDataSource.On("FindByID", mock.Anything).Return(nil, &exceptions.KitchenOrderNotFoundException{})
+
+	handler := createHandler(mockDataSource, mockStatusDataSource, mockMessageBroker)
+	router.GET("/kitchen-orders/:id/ticket", handler.Ticket)
+
+	req, _ := http.NewRequest("GET", "/kitchen-orders/550e8400-e29b-41d4-a716-446655440000/ticket", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
@@ -0,0 +1,203 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"tech_challenge/internal/application/controllers"
+	"tech_challenge/internal/application/dtos"
+	"tech_challenge/internal/domain/exceptions"
+	"tech_challenge/internal/factories"
+	"tech_challenge/internal/infra/api/schemas"
+	"tech_challenge/internal/shared/pkg/logger"
+)
+
+type KitchenReportHandler struct {
+	kitchenReportController controllers.KitchenReportController
+}
+
+func NewKitchenReportHandler() *KitchenReportHandler {
+	return &KitchenReportHandler{
+		kitchenReportController: *factories.NewKitchenReportController(),
+	}
+}
+
+func toStageDurationSchemas(stages []dtos.StageDurationDTO) []schemas.StageDurationSchema {
+	stageSchemas := make([]schemas.StageDurationSchema, len(stages))
+	for i, stage := range stages {
+		stageSchemas[i] = schemas.StageDurationSchema{
+			StatusID:       stage.StatusID,
+			StatusName:     stage.StatusName,
+			Orders:         stage.Orders,
+			AverageSeconds: stage.AverageSeconds,
+			P90Seconds:     stage.P90Seconds,
+		}
+	}
+
+	return stageSchemas
+}
+
+func toThroughputReportResponseSchema(report dtos.ThroughputReportDTO) schemas.ThroughputReportResponseSchema {
+	response := schemas.ThroughputReportResponseSchema{
+		From:            report.From,
+		To:              report.To,
+		GroupBy:         report.GroupBy,
+		Received:        report.Received,
+		Finished:        report.Finished,
+		ReceivedPerHour: report.ReceivedPerHour,
+		FinishedPerHour: report.FinishedPerHour,
+		Buckets:         make([]schemas.ThroughputBucketSchema, len(report.Buckets)),
+	}
+
+	for i, bucket := range report.Buckets {
+		response.Buckets[i] = schemas.ThroughputBucketSchema{
+			PeriodStart:     bucket.PeriodStart,
+			Received:        bucket.Received,
+			Finished:        bucket.Finished,
+			ReceivedPerHour: bucket.ReceivedPerHour,
+			FinishedPerHour: bucket.FinishedPerHour,
+		}
+	}
+
+	return response
+}
+
+func toPrepTimeReportResponseSchema(report dtos.PrepTimeReportDTO) schemas.PrepTimeReportResponseSchema {
+	response := schemas.PrepTimeReportResponseSchema{
+		From:            report.From,
+		To:              report.To,
+		GroupBy:         report.GroupBy,
+		Stages:          toStageDurationSchemas(report.Stages),
+		Buckets:         make([]schemas.PrepTimeBucketSchema, len(report.Buckets)),
+		SlowestProducts: make([]schemas.ProductPrepTimeSchema, len(report.SlowestProducts)),
+	}
+
+	for i, bucket := range report.Buckets {
+		response.Buckets[i] = schemas.PrepTimeBucketSchema{
+			PeriodStart: bucket.PeriodStart,
+			Stages:      toStageDurationSchemas(bucket.Stages),
+		}
+	}
+
+	for i, product := range report.SlowestProducts {
+		response.SlowestProducts[i] = schemas.ProductPrepTimeSchema{
+			ProductID:      product.ProductID,
+			Orders:         product.Orders,
+			AverageSeconds: product.AverageSeconds,
+			P90Seconds:     product.P90Seconds,
+		}
+	}
+
+	return response
+}
+
+// @Summary Kitchen throughput report
+// @Description Orders received and finished per hour or per day, in UTC. Periods without orders are reported with zero.
+// @Description The per-hour rates only count the part of each period inside the requested range.
+// @Tags Reports
+// @Produce json
+// @Param from query string true "Start of the period (RFC3339 or YYYY-MM-DD)"
+// @Param to query string true "End of the period (RFC3339 or YYYY-MM-DD, inclusive)"
+// @Param group_by query string false "Period size" Enums(hour, day) default(day)
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Success 200 {object} schemas.ThroughputReportResponseSchema
+// @Failure 400 {object} schemas.ProblemDetailsSchema
+// @Failure 401 {object} schemas.ProblemDetailsSchema
+// @Failure 403 {object} schemas.ProblemDetailsSchema
+// @Failure 429 {object} schemas.ProblemDetailsSchema
+// @Failure 500 {object} schemas.ProblemDetailsSchema
+// @Router /reports/throughput [get]
+func (h *KitchenReportHandler) Throughput(ctx *gin.Context) {
+	filter, err := parseKitchenReportFilter(ctx)
+	if err != nil {
+		if ctxErr := ctx.Error(err); ctxErr != nil {
+			logger.FromContext(ctx).Error("Error setting context error", logger.ErrorKey, ctxErr)
+		}
+		return
+	}
+
+	report, err := h.kitchenReportController.Throughput(ctx.Request.Context(), filter)
+	if err != nil {
+		if ctxErr := ctx.Error(err); ctxErr != nil {
+			logger.FromContext(ctx).Error("Error setting context error", logger.ErrorKey, ctxErr)
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, toThroughputReportResponseSchema(report))
+}
+
+// @Summary Kitchen preparation time report
+// @Description Average and p90 time, in seconds, that orders created in the period spent in Recebido, Em preparação and Pronto,
+// @Description for the whole period and per hour or day (UTC), plus the products with the slowest average preparation.
+// @Description Stage times come from the status history, so a recalled order adds up every pass through a status.
+// @Tags Reports
+// @Produce json
+// @Param from query string true "Start of the period (RFC3339 or YYYY-MM-DD)"
+// @Param to query string true "End of the period (RFC3339 or YYYY-MM-DD, inclusive)"
+// @Param group_by query string false "Period size" Enums(hour, day) default(day)
+// @Param products_limit query int false "Number of slowest products (default 10, max 50)"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Success 200 {object} schemas.PrepTimeReportResponseSchema
+// @Failure 400 {object} schemas.ProblemDetailsSchema
+// @Failure 401 {object} schemas.ProblemDetailsSchema
+// @Failure 403 {object} schemas.ProblemDetailsSchema
+// @Failure 429 {object} schemas.ProblemDetailsSchema
+// @Failure 500 {object} schemas.ProblemDetailsSchema
+// @Router /reports/prep-times [get]
+func (h *KitchenReportHandler) PrepTimes(ctx *gin.Context) {
+	filter, err := parseKitchenReportFilter(ctx)
+	if err != nil {
+		if ctxErr := ctx.Error(err); ctxErr != nil {
+			logger.FromContext(ctx).Error("Error setting context error", logger.ErrorKey, ctxErr)
+		}
+		return
+	}
+
+	report, err := h.kitchenReportController.PrepTimes(ctx.Request.Context(), filter)
+	if err != nil {
+		if ctxErr := ctx.Error(err); ctxErr != nil {
+			logger.FromContext(ctx).Error("Error setting context error", logger.ErrorKey, ctxErr)
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, toPrepTimeReportResponseSchema(report))
+}
+
+// parseKitchenReportFilter aceita datas no mesmo formato da exportação; a validação do período fica no use case
+func parseKitchenReportFilter(ctx *gin.Context) (dtos.KitchenReportFilter, error) {
+	filter := dtos.KitchenReportFilter{
+		GroupBy: ctx.Query("group_by"),
+	}
+
+	from, err := parseDateOrTimeQuery(ctx, "from", false)
+	if err != nil {
+		return filter, &exceptions.InvalidReportFilterException{Message: err.Error()}
+	}
+	if from != nil {
+		filter.From = *from
+	}
+
+	to, err := parseDateOrTimeQuery(ctx, "to", true)
+	if err != nil {
+		return filter, &exceptions.InvalidReportFilterException{Message: err.Error()}
+	}
+	if to != nil {
+		filter.To = *to
+	}
+
+	if limitStr := ctx.Query("products_limit"); limitStr != "" {
+		limit, err := strconv.Atoi(limitStr)
+		if err != nil || limit <= 0 {
+			return filter, &exceptions.InvalidReportFilterException{Message: "products_limit must be a positive integer"}
+		}
+		filter.ProductsLimit = limit
+	}
+
+	return filter, nil
+}
@@ -28,11 +28,16 @@ func NewOrderStatusHandler() *OrderStatusHandler {
 // @Tags order-status
 // @Accept json
 // @Produce json
+// @Security BearerAuth
+// @Security ApiKeyAuth
 // @Success 200 {array} dtos.OrderStatusResponseDTO
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 429 {object} schemas.ProblemDetailsSchema
 // @Failure 500 {object} map[string]interface{}
 // @Router /v1/kitchen-orders/status [get]
 func (h *OrderStatusHandler) FindAll(c *gin.Context) {
-	orderStatus, err := h.controller.FindAll()
+	orderStatus, err := h.controller.FindAll(c.Request.Context())
 
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
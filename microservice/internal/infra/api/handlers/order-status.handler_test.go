@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -19,7 +20,7 @@ type MockOrderStatusDataSourceForHandler struct {
 	mock.Mock
 }
 
-func (m *MockOrderStatusDataSourceForHandler) FindByID(id string) (daos.OrderStatusDAO, error) {
+func (m *MockOrderStatusDataSourceForHandler) FindByID(ctx context.Context, id string) (daos.OrderStatusDAO, error) {
 	args := m.Called(id)
 	if args.Get(0) == nil {
 		return daos.OrderStatusDAO{}, args.Error(1)
@@ -27,7 +28,7 @@ func (m *MockOrderStatusDataSourceForHandler) FindByID(id string) (daos.OrderSta
 	return args.Get(0).(daos.OrderStatusDAO), args.Error(1)
 }
 
-func (m *MockOrderStatusDataSourceForHandler) FindAll() ([]daos.OrderStatusDAO, error) {
+func (m *MockOrderStatusDataSourceForHandler) FindAll(ctx context.Context) ([]daos.OrderStatusDAO, error) {
 	args := m.Called()
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
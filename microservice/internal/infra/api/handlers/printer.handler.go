@@ -0,0 +1,406 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"tech_challenge/internal/application/controllers"
+	"tech_challenge/internal/application/dtos"
+	"tech_challenge/internal/domain/exceptions"
+	"tech_challenge/internal/factories"
+	"tech_challenge/internal/infra/api/schemas"
+	"tech_challenge/internal/infra/printing"
+	"tech_challenge/internal/shared/pkg/logger"
+)
+
+type PrinterHandler struct {
+	printerController controllers.PrinterController
+	printQueue        *printing.PrintQueue
+}
+
+func NewPrinterHandler() *PrinterHandler {
+	return &PrinterHandler{
+		printerController: *factories.NewPrinterController(),
+		printQueue:        factories.GetPrintQueue(),
+	}
+}
+
+func (h *PrinterHandler) toPrinterResponseSchema(printer dtos.PrinterResponseDTO) schemas.PrinterResponseSchema {
+	return schemas.PrinterResponseSchema{
+		ID:        printer.ID,
+		Name:      printer.Name,
+		Host:      printer.Host,
+		Port:      printer.Port,
+		Station:   printer.Station,
+		Width:     printer.Width,
+		Active:    printer.Active,
+		CreatedAt: printer.CreatedAt,
+		UpdatedAt: printer.UpdatedAt,
+	}
+}
+
+func (h *PrinterHandler) toPrintRouteResponseSchemas(routes []dtos.PrintRouteResponseDTO) []schemas.PrintRouteResponseSchema {
+	routeResponses := make([]schemas.PrintRouteResponseSchema, len(routes))
+	for i, route := range routes {
+		routeResponses[i] = schemas.PrintRouteResponseSchema{
+			ID:             route.ID,
+			Position:       route.Position,
+			ProductPattern: route.ProductPattern,
+			Station:        route.Station,
+		}
+	}
+
+	return routeResponses
+}
+
+func (h *PrinterHandler) toPrintJobResponseSchemas(jobs []dtos.PrintJobResponseDTO) []schemas.PrintJobResponseSchema {
+	jobResponses := make([]schemas.PrintJobResponseSchema, len(jobs))
+	for i, job := range jobs {
+		jobResponses[i] = schemas.PrintJobResponseSchema{
+			ID:             job.ID,
+			KitchenOrderID: job.KitchenOrderID,
+			PrinterID:      job.PrinterID,
+			Station:        job.Station,
+			ItemIDs:        job.ItemIDs,
+			Status:         job.Status,
+			Attempts:       job.Attempts,
+			LastError:      job.LastError,
+			NextAttemptAt:  job.NextAttemptAt,
+			PrintedAt:      job.PrintedAt,
+			Reprint:        job.Reprint,
+			RequestedBy:    job.RequestedBy,
+			CreatedAt:      job.CreatedAt,
+			UpdatedAt:      job.UpdatedAt,
+		}
+	}
+
+	return jobResponses
+}
+
+// @Summary Register a network printer
+// @Description Printers receive ESC/POS tickets on their raw TCP port (9100 when omitted). A width of 0 uses the default ticket width
+// @Tags Printers
+// @Accept json
+// @Produce json
+// @Param request body schemas.CreatePrinterRequestSchema true "Printer"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Success 201 {object} schemas.PrinterResponseSchema
+// @Failure 400 {object} schemas.ProblemDetailsSchema
+// @Failure 401 {object} schemas.ProblemDetailsSchema
+// @Failure 403 {object} schemas.ProblemDetailsSchema
+// @Failure 429 {object} schemas.ProblemDetailsSchema
+// @Router /printers/ [post]
+func (h *PrinterHandler) Create(ctx *gin.Context) {
+	var request schemas.CreatePrinterRequestSchema
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		if ctxErr := ctx.Error(err); ctxErr != nil {
+			logger.FromContext(ctx).Error("Error setting context error", logger.ErrorKey, ctxErr)
+		}
+		return
+	}
+
+	printer, err := h.printerController.Create(dtos.CreatePrinterDTO{
+		Name:    request.Name,
+		Host:    request.Host,
+		Port:    request.Port,
+		Station: request.Station,
+		Width:   request.Width,
+	})
+
+	if err != nil {
+		if ctxErr := ctx.Error(err); ctxErr != nil {
+			logger.FromContext(ctx).Error("Error setting context error", logger.ErrorKey, ctxErr)
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, h.toPrinterResponseSchema(printer))
+}
+
+// @Summary List printers
+// @Tags Printers
+// @Produce json
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Success 200 {array} schemas.PrinterResponseSchema
+// @Failure 401 {object} schemas.ProblemDetailsSchema
+// @Failure 403 {object} schemas.ProblemDetailsSchema
+// @Failure 429 {object} schemas.ProblemDetailsSchema
+// @Failure 500 {object} schemas.ProblemDetailsSchema
+// @Router /printers/ [get]
+func (h *PrinterHandler) FindAll(ctx *gin.Context) {
+	printers, err := h.printerController.FindAll()
+
+	if err != nil {
+		if ctxErr := ctx.Error(err); ctxErr != nil {
+			logger.FromContext(ctx).Error("Error setting context error", logger.ErrorKey, ctxErr)
+		}
+		return
+	}
+
+	printerResponses := make([]schemas.PrinterResponseSchema, len(printers))
+	for i, printer := range printers {
+		printerResponses[i] = h.toPrinterResponseSchema(printer)
+	}
+
+	ctx.JSON(http.StatusOK, printerResponses)
+}
+
+// @Summary Get a printer by ID
+// @Tags Printers
+// @Produce json
+// @Param id path string true "Printer ID"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Success 200 {object} schemas.PrinterResponseSchema
+// @Failure 400 {object} schemas.ProblemDetailsSchema
+// @Failure 401 {object} schemas.ProblemDetailsSchema
+// @Failure 403 {object} schemas.ProblemDetailsSchema
+// @Failure 404 {object} schemas.ProblemDetailsSchema
+// @Failure 429 {object} schemas.ProblemDetailsSchema
+// @Router /printers/{id} [get]
+func (h *PrinterHandler) FindByID(ctx *gin.Context) {
+	printer, err := h.printerController.FindByID(ctx.Param("id"))
+
+	if err != nil {
+		if ctxErr := ctx.Error(err); ctxErr != nil {
+			logger.FromContext(ctx).Error("Error setting context error", logger.ErrorKey, ctxErr)
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, h.toPrinterResponseSchema(printer))
+}
+
+// @Summary Update a printer
+// @Description Inactive printers stop receiving new tickets and their pending jobs fail
+// @Tags Printers
+// @Accept json
+// @Produce json
+// @Param id path string true "Printer ID"
+// @Param request body schemas.UpdatePrinterRequestSchema true "Printer"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Success 200 {object} schemas.PrinterResponseSchema
+// @Failure 400 {object} schemas.ProblemDetailsSchema
+// @Failure 401 {object} schemas.ProblemDetailsSchema
+// @Failure 403 {object} schemas.ProblemDetailsSchema
+// @Failure 404 {object} schemas.ProblemDetailsSchema
+// @Failure 429 {object} schemas.ProblemDetailsSchema
+// @Router /printers/{id} [put]
+func (h *PrinterHandler) Update(ctx *gin.Context) {
+	var request schemas.UpdatePrinterRequestSchema
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		if ctxErr := ctx.Error(err); ctxErr != nil {
+			logger.FromContext(ctx).Error("Error setting context error", logger.ErrorKey, ctxErr)
+		}
+		return
+	}
+
+	printer, err := h.printerController.Update(dtos.UpdatePrinterDTO{
+		ID:      ctx.Param("id"),
+		Name:    request.Name,
+		Host:    request.Host,
+		Port:    request.Port,
+		Station: request.Station,
+		Width:   request.Width,
+		Active:  *request.Active,
+	})
+
+	if err != nil {
+		if ctxErr := ctx.Error(err); ctxErr != nil {
+			logger.FromContext(ctx).Error("Error setting context error", logger.ErrorKey, ctxErr)
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, h.toPrinterResponseSchema(printer))
+}
+
+// @Summary Delete a printer
+// @Description The print jobs of the printer are kept in the job log
+// @Tags Printers
+// @Param id path string true "Printer ID"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Success 204
+// @Failure 400 {object} schemas.ProblemDetailsSchema
+// @Failure 401 {object} schemas.ProblemDetailsSchema
+// @Failure 403 {object} schemas.ProblemDetailsSchema
+// @Failure 404 {object} schemas.ProblemDetailsSchema
+// @Failure 429 {object} schemas.ProblemDetailsSchema
+// @Router /printers/{id} [delete]
+func (h *PrinterHandler) Delete(ctx *gin.Context) {
+	if err := h.printerController.Delete(ctx.Param("id")); err != nil {
+		if ctxErr := ctx.Error(err); ctxErr != nil {
+			logger.FromContext(ctx).Error("Error setting context error", logger.ErrorKey, ctxErr)
+		}
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+// @Summary List the print routing rules
+// @Description Rules are evaluated in order and the first product pattern that matches sends the item to its station. Unmatched items go to the "kitchen" station and "expo" printers always receive the full ticket
+// @Tags Printers
+// @Produce json
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Success 200 {array} schemas.PrintRouteResponseSchema
+// @Failure 401 {object} schemas.ProblemDetailsSchema
+// @Failure 403 {object} schemas.ProblemDetailsSchema
+// @Failure 429 {object} schemas.ProblemDetailsSchema
+// @Failure 500 {object} schemas.ProblemDetailsSchema
+// @Router /printers/routes [get]
+func (h *PrinterHandler) FindRoutes(ctx *gin.Context) {
+	routes, err := h.printerController.FindRoutes(ctx.Request.Context())
+
+	if err != nil {
+		if ctxErr := ctx.Error(err); ctxErr != nil {
+			logger.FromContext(ctx).Error("Error setting context error", logger.ErrorKey, ctxErr)
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, h.toPrintRouteResponseSchemas(routes))
+}
+
+// @Summary Replace the print routing rules
+// @Description Replaces every rule at once, keeping the order sent. Product patterns use glob syntax, such as "burger-*"
+// @Tags Printers
+// @Accept json
+// @Produce json
+// @Param request body schemas.ReplacePrintRoutesRequestSchema true "Routing rules"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Success 200 {array} schemas.PrintRouteResponseSchema
+// @Failure 400 {object} schemas.ProblemDetailsSchema
+// @Failure 401 {object} schemas.ProblemDetailsSchema
+// @Failure 403 {object} schemas.ProblemDetailsSchema
+// @Failure 429 {object} schemas.ProblemDetailsSchema
+// @Router /printers/routes [put]
+func (h *PrinterHandler) ReplaceRoutes(ctx *gin.Context) {
+	var request schemas.ReplacePrintRoutesRequestSchema
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		if ctxErr := ctx.Error(err); ctxErr != nil {
+			logger.FromContext(ctx).Error("Error setting context error", logger.ErrorKey, ctxErr)
+		}
+		return
+	}
+
+	routeDTOs := make([]dtos.PrintRouteDTO, len(request.Routes))
+	for i, route := range request.Routes {
+		routeDTOs[i] = dtos.PrintRouteDTO{
+			ProductPattern: route.ProductPattern,
+			Station:        route.Station,
+		}
+	}
+
+	routes, err := h.printerController.ReplaceRoutes(routeDTOs)
+
+	if err != nil {
+		if ctxErr := ctx.Error(err); ctxErr != nil {
+			logger.FromContext(ctx).Error("Error setting context error", logger.ErrorKey, ctxErr)
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, h.toPrintRouteResponseSchemas(routes))
+}
+
+// @Summary List the print job log
+// @Description Newest jobs first
+// @Tags Printers
+// @Produce json
+// @Param kitchen_order_id query string false "Kitchen order ID"
+// @Param printer_id query string false "Printer ID"
+// @Param status query string false "Job status" Enums(pending, printing, printed, failed)
+// @Param limit query int false "Maximum number of jobs (default 50, max 200)"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Success 200 {array} schemas.PrintJobResponseSchema
+// @Failure 400 {object} schemas.ProblemDetailsSchema
+// @Failure 401 {object} schemas.ProblemDetailsSchema
+// @Failure 403 {object} schemas.ProblemDetailsSchema
+// @Failure 429 {object} schemas.ProblemDetailsSchema
+// @Router /printers/jobs [get]
+func (h *PrinterHandler) FindJobs(ctx *gin.Context) {
+	filter := dtos.PrintJobFilter{
+		KitchenOrderID: ctx.Query("kitchen_order_id"),
+		PrinterID:      ctx.Query("printer_id"),
+		Status:         ctx.Query("status"),
+	}
+
+	if limitStr := ctx.Query("limit"); limitStr != "" {
+		limit, err := strconv.Atoi(limitStr)
+		if err != nil || limit <= 0 {
+			if ctxErr := ctx.Error(&exceptions.InvalidPrintJobFilterException{Message: "limit must be a positive integer"}); ctxErr != nil {
+				logger.FromContext(ctx).Error("Error setting context error", logger.ErrorKey, ctxErr)
+			}
+			return
+		}
+		filter.Limit = limit
+	}
+
+	jobs, err := h.printerController.FindJobs(filter)
+
+	if err != nil {
+		if ctxErr := ctx.Error(err); ctxErr != nil {
+			logger.FromContext(ctx).Error("Error setting context error", logger.ErrorKey, ctxErr)
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, h.toPrintJobResponseSchemas(jobs))
+}
+
+// @Summary Reprint the tickets of a kitchen order
+// @Description Without a body the tickets are routed to the stations again; with printer_id the ticket goes to that printer only
+// @Tags Kitchen Orders
+// @Accept json
+// @Produce json
+// @Param id path string true "Kitchen Order ID"
+// @Param request body schemas.ReprintKitchenOrderRequestSchema false "Target printer"
+// @Security BearerAuth
+// @Success 202 {array} schemas.PrintJobResponseSchema
+// @Failure 400 {object} schemas.ProblemDetailsSchema
+// @Failure 401 {object} schemas.ProblemDetailsSchema
+// @Failure 403 {object} schemas.ProblemDetailsSchema
+// @Failure 404 {object} schemas.ProblemDetailsSchema
+// @Failure 429 {object} schemas.ProblemDetailsSchema
+// @Router /kitchen-orders/{id}/reprint [post]
+func (h *PrinterHandler) Reprint(ctx *gin.Context) {
+	var request schemas.ReprintKitchenOrderRequestSchema
+	if err := ctx.ShouldBindJSON(&request); err != nil && !errors.Is(err, io.EOF) {
+		if ctxErr := ctx.Error(err); ctxErr != nil {
+			logger.FromContext(ctx).Error("Error setting context error", logger.ErrorKey, ctxErr)
+		}
+		return
+	}
+
+	jobs, err := h.printerController.Enqueue(ctx.Request.Context(), dtos.PrintKitchenOrderDTO{
+		KitchenOrderID: ctx.Param("id"),
+		PrinterID:      request.PrinterID,
+		Reprint:        true,
+		RequestedBy:    actorFromContext(ctx),
+	})
+
+	if err != nil {
+		if ctxErr := ctx.Error(err); ctxErr != nil {
+			logger.FromContext(ctx).Error("Error setting context error", logger.ErrorKey, ctxErr)
+		}
+		return
+	}
+
+	if len(jobs) > 0 && h.printQueue != nil {
+		h.printQueue.Notify()
+	}
+
+	ctx.JSON(http.StatusAccepted, h.toPrintJobResponseSchemas(jobs))
+}
@@ -0,0 +1,241 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"tech_challenge/internal/application/controllers"
+	"tech_challenge/internal/application/dtos"
+	"tech_challenge/internal/factories"
+	"tech_challenge/internal/infra/api/schemas"
+	"tech_challenge/internal/shared/pkg/logger"
+)
+
+type WebhookHandler struct {
+	webhookController controllers.WebhookController
+}
+
+func NewWebhookHandler() *WebhookHandler {
+	return &WebhookHandler{
+		webhookController: *factories.NewWebhookController(),
+	}
+}
+
+func (h *WebhookHandler) toWebhookSubscriptionResponseSchema(subscription dtos.WebhookSubscriptionResponseDTO) schemas.WebhookSubscriptionResponseSchema {
+	return schemas.WebhookSubscriptionResponseSchema{
+		ID:                  subscription.ID,
+		URL:                 subscription.URL,
+		Secret:              subscription.Secret,
+		Events:              subscription.Events,
+		Active:              subscription.Active,
+		ConsecutiveFailures: subscription.ConsecutiveFailures,
+		CreatedAt:           subscription.CreatedAt,
+		UpdatedAt:           subscription.UpdatedAt,
+	}
+}
+
+func (h *WebhookHandler) toWebhookDeliveryResponseSchema(delivery dtos.WebhookDeliveryResponseDTO) schemas.WebhookDeliveryResponseSchema {
+	return schemas.WebhookDeliveryResponseSchema{
+		ID:             delivery.ID,
+		SubscriptionID: delivery.SubscriptionID,
+		EventID:        delivery.EventID,
+		Event:          delivery.Event,
+		Attempt:        delivery.Attempt,
+		StatusCode:     delivery.StatusCode,
+		Success:        delivery.Success,
+		Error:          delivery.Error,
+		DurationMs:     delivery.DurationMs,
+		CreatedAt:      delivery.CreatedAt,
+	}
+}
+
+// @Summary Register a webhook subscription
+// @Description The secret is only returned on creation; it is generated when omitted
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Param request body schemas.CreateWebhookSubscriptionRequestSchema true "Subscription"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Success 201 {object} schemas.WebhookSubscriptionResponseSchema
+// @Failure 400 {object} schemas.ProblemDetailsSchema
+// @Failure 401 {object} schemas.ProblemDetailsSchema
+// @Failure 403 {object} schemas.ProblemDetailsSchema
+// @Failure 429 {object} schemas.ProblemDetailsSchema
+// @Router /webhooks/ [post]
+func (h *WebhookHandler) Create(ctx *gin.Context) {
+	var request schemas.CreateWebhookSubscriptionRequestSchema
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		if ctxErr := ctx.Error(err); ctxErr != nil {
+			logger.FromContext(ctx).Error("Error setting context error", logger.ErrorKey, ctxErr)
+		}
+		return
+	}
+
+	subscription, err := h.webhookController.Create(dtos.CreateWebhookSubscriptionDTO{
+		URL:    request.URL,
+		Secret: request.Secret,
+		Events: request.Events,
+	})
+
+	if err != nil {
+		if ctxErr := ctx.Error(err); ctxErr != nil {
+			logger.FromContext(ctx).Error("Error setting context error", logger.ErrorKey, ctxErr)
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, h.toWebhookSubscriptionResponseSchema(subscription))
+}
+
+// @Summary List webhook subscriptions
+// @Tags Webhooks
+// @Produce json
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Success 200 {array} schemas.WebhookSubscriptionResponseSchema
+// @Failure 401 {object} schemas.ProblemDetailsSchema
+// @Failure 403 {object} schemas.ProblemDetailsSchema
+// @Failure 429 {object} schemas.ProblemDetailsSchema
+// @Failure 500 {object} schemas.ProblemDetailsSchema
+// @Router /webhooks/ [get]
+func (h *WebhookHandler) FindAll(ctx *gin.Context) {
+	subscriptions, err := h.webhookController.FindAll()
+
+	if err != nil {
+		if ctxErr := ctx.Error(err); ctxErr != nil {
+			logger.FromContext(ctx).Error("Error setting context error", logger.ErrorKey, ctxErr)
+		}
+		return
+	}
+
+	subscriptionResponses := make([]schemas.WebhookSubscriptionResponseSchema, len(subscriptions))
+	for i, subscription := range subscriptions {
+		subscriptionResponses[i] = h.toWebhookSubscriptionResponseSchema(subscription)
+	}
+
+	ctx.JSON(http.StatusOK, subscriptionResponses)
+}
+
+// @Summary Get a webhook subscription by ID
+// @Tags Webhooks
+// @Produce json
+// @Param id path string true "Subscription ID"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Success 200 {object} schemas.WebhookSubscriptionResponseSchema
+// @Failure 400 {object} schemas.ProblemDetailsSchema
+// @Failure 401 {object} schemas.ProblemDetailsSchema
+// @Failure 403 {object} schemas.ProblemDetailsSchema
+// @Failure 404 {object} schemas.ProblemDetailsSchema
+// @Failure 429 {object} schemas.ProblemDetailsSchema
+// @Router /webhooks/{id} [get]
+func (h *WebhookHandler) FindByID(ctx *gin.Context) {
+	subscription, err := h.webhookController.FindByID(ctx.Param("id"))
+
+	if err != nil {
+		if ctxErr := ctx.Error(err); ctxErr != nil {
+			logger.FromContext(ctx).Error("Error setting context error", logger.ErrorKey, ctxErr)
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, h.toWebhookSubscriptionResponseSchema(subscription))
+}
+
+// @Summary Update a webhook subscription
+// @Description Re-activating a subscription disabled by failures resets its failure counter
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Param id path string true "Subscription ID"
+// @Param request body schemas.UpdateWebhookSubscriptionRequestSchema true "Subscription"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Success 200 {object} schemas.WebhookSubscriptionResponseSchema
+// @Failure 400 {object} schemas.ProblemDetailsSchema
+// @Failure 401 {object} schemas.ProblemDetailsSchema
+// @Failure 403 {object} schemas.ProblemDetailsSchema
+// @Failure 404 {object} schemas.ProblemDetailsSchema
+// @Failure 429 {object} schemas.ProblemDetailsSchema
+// @Router /webhooks/{id} [put]
+func (h *WebhookHandler) Update(ctx *gin.Context) {
+	var request schemas.UpdateWebhookSubscriptionRequestSchema
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		if ctxErr := ctx.Error(err); ctxErr != nil {
+			logger.FromContext(ctx).Error("Error setting context error", logger.ErrorKey, ctxErr)
+		}
+		return
+	}
+
+	subscription, err := h.webhookController.Update(dtos.UpdateWebhookSubscriptionDTO{
+		ID:     ctx.Param("id"),
+		URL:    request.URL,
+		Events: request.Events,
+		Active: *request.Active,
+	})
+
+	if err != nil {
+		if ctxErr := ctx.Error(err); ctxErr != nil {
+			logger.FromContext(ctx).Error("Error setting context error", logger.ErrorKey, ctxErr)
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, h.toWebhookSubscriptionResponseSchema(subscription))
+}
+
+// @Summary Delete a webhook subscription
+// @Tags Webhooks
+// @Param id path string true "Subscription ID"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Success 204
+// @Failure 400 {object} schemas.ProblemDetailsSchema
+// @Failure 401 {object} schemas.ProblemDetailsSchema
+// @Failure 403 {object} schemas.ProblemDetailsSchema
+// @Failure 404 {object} schemas.ProblemDetailsSchema
+// @Failure 429 {object} schemas.ProblemDetailsSchema
+// @Router /webhooks/{id} [delete]
+func (h *WebhookHandler) Delete(ctx *gin.Context) {
+	if err := h.webhookController.Delete(ctx.Param("id")); err != nil {
+		if ctxErr := ctx.Error(err); ctxErr != nil {
+			logger.FromContext(ctx).Error("Error setting context error", logger.ErrorKey, ctxErr)
+		}
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+// @Summary List the latest delivery attempts of a webhook subscription
+// @Tags Webhooks
+// @Produce json
+// @Param id path string true "Subscription ID"
+// @Security BearerAuth
+// @Security ApiKeyAuth
+// @Success 200 {array} schemas.WebhookDeliveryResponseSchema
+// @Failure 400 {object} schemas.ProblemDetailsSchema
+// @Failure 401 {object} schemas.ProblemDetailsSchema
+// @Failure 403 {object} schemas.ProblemDetailsSchema
+// @Failure 404 {object} schemas.ProblemDetailsSchema
+// @Failure 429 {object} schemas.ProblemDetailsSchema
+// @Router /webhooks/{id}/deliveries [get]
+func (h *WebhookHandler) FindDeliveries(ctx *gin.Context) {
+	deliveries, err := h.webhookController.FindDeliveries(ctx.Param("id"))
+
+	if err != nil {
+		if ctxErr := ctx.Error(err); ctxErr != nil {
+			logger.FromContext(ctx).Error("Error setting context error", logger.ErrorKey, ctxErr)
+		}
+		return
+	}
+
+	deliveryResponses := make([]schemas.WebhookDeliveryResponseSchema, len(deliveries))
+	for i, delivery := range deliveries {
+		deliveryResponses[i] = h.toWebhookDeliveryResponseSchema(delivery)
+	}
+
+	ctx.JSON(http.StatusOK, deliveryResponses)
+}
@@ -0,0 +1,175 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+
+	"tech_challenge/internal"
+	"tech_challenge/internal/application/controllers"
+	"tech_challenge/internal/application/dtos"
+	"tech_challenge/internal/daos"
+	"tech_challenge/internal/infra/api/schemas"
+	"tech_challenge/internal/shared/infra/api/middlewares"
+)
+
+type fakeWebhookSubscriptionDataSource struct {
+	subscriptions map[string]daos.WebhookSubscriptionDAO
+}
+
+func (f *fakeWebhookSubscriptionDataSource) Insert(subscription daos.WebhookSubscriptionDAO) error {
+	f.subscriptions[subscription.ID] = subscription
+	return nil
+}
+
+func (f *fakeWebhookSubscriptionDataSource) FindByID(id string) (daos.WebhookSubscriptionDAO, error) {
+	subscription, ok := f.subscriptions[id]
+	if !ok {
+		return daos.WebhookSubscriptionDAO{}, gorm.ErrRecordNotFound
+	}
+	return subscription, nil
+}
+
+func (f *fakeWebhookSubscriptionDataSource) FindAll() ([]daos.WebhookSubscriptionDAO, error) {
+	subscriptions := make([]daos.WebhookSubscriptionDAO, 0, len(f.subscriptions))
+	for _, subscription := range f.subscriptions {
+		subscriptions = append(subscriptions, subscription)
+	}
+	return subscriptions, nil
+}
+
+func (f *fakeWebhookSubscriptionDataSource) FindAllActive() ([]daos.WebhookSubscriptionDAO, error) {
+	return f.FindAll()
+}
+
+func (f *fakeWebhookSubscriptionDataSource) Update(subscription daos.WebhookSubscriptionDAO) error {
+	f.subscriptions[subscription.ID] = subscription
+	return nil
+}
+
+func (f *fakeWebhookSubscriptionDataSource) Delete(id string) error {
+	if _, ok := f.subscriptions[id]; !ok {
+		return gorm.ErrRecordNotFound
+	}
+	delete(f.subscriptions, id)
+	return nil
+}
+
+func (f *fakeWebhookSubscriptionDataSource) IncrementFailures(id string, disableAfter int) error {
+	return nil
+}
+
+func (f *fakeWebhookSubscriptionDataSource) ResetFailures(id string) error {
+	return nil
+}
+
+type fakeWebhookDeliveryDataSource struct{}
+
+func (f *fakeWebhookDeliveryDataSource) Insert(delivery daos.WebhookDeliveryDAO) error {
+	return nil
+}
+
+func (f *fakeWebhookDeliveryDataSource) FindBySubscriptionID(subscriptionID string, limit int) ([]daos.WebhookDeliveryDAO, error) {
+	return []daos.WebhookDeliveryDAO{}, nil
+}
+
+type acceptAllWebhookURLValidator struct{}
+
+func (acceptAllWebhookURLValidator) Validate(callbackURL string) error {
+	return nil
+}
+
+func setupWebhookRouter() (*gin.Engine, *fakeWebhookSubscriptionDataSource) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(middlewares.ErrorHandlerMiddleware())
+
+	subscriptions := &fakeWebhookSubscriptionDataSource{subscriptions: map[string]daos.WebhookSubscriptionDAO{}}
+	handler := &WebhookHandler{
+		webhookController: *controllers.NewWebhookController(subscriptions, &fakeWebhookDeliveryDataSource{}, nil, nil, acceptAllWebhookURLValidator{}, dtos.WebhookRetryPolicyDTO{}),
+	}
+
+	router.POST("/v1/webhooks/", handler.Create)
+	router.GET("/v1/webhooks/", handler.FindAll)
+	router.GET("/v1/webhooks/:id", handler.FindByID)
+	router.DELETE("/v1/webhooks/:id", handler.Delete)
+	router.GET("/v1/webhooks/:id/deliveries", handler.FindDeliveries)
+
+	return router, subscriptions
+}
+
+func TestWebhookHandler_Create(t *testing.T) {
+	internal.SetupTestEnv()
+	defer internal.CleanupTestEnv()
+
+	router, subscriptions := setupWebhookRouter()
+
+	body, _ := json.Marshal(map[string]interface{}{
+		"url":    "https://partner.example.com/hooks",
+		"events": []string{"kitchen_order.ready"},
+	})
+	req, _ := http.NewRequest("POST", "/v1/webhooks/", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+
+	var response schemas.WebhookSubscriptionResponseSchema
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.NotEmpty(t, response.Secret)
+	assert.Contains(t, subscriptions.subscriptions, response.ID)
+
+	t.Run("secret is not exposed afterwards", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/v1/webhooks/"+response.ID, nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.NotContains(t, w.Body.String(), response.Secret)
+	})
+}
+
+func TestWebhookHandler_Create_InvalidEvent(t *testing.T) {
+	internal.SetupTestEnv()
+	defer internal.CleanupTestEnv()
+
+	router, _ := setupWebhookRouter()
+
+	body, _ := json.Marshal(map[string]interface{}{
+		"url":    "https://partner.example.com/hooks",
+		"events": []string{"kitchen_order.burned"},
+	})
+	req, _ := http.NewRequest("POST", "/v1/webhooks/", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestWebhookHandler_NotFound(t *testing.T) {
+	internal.SetupTestEnv()
+	defer internal.CleanupTestEnv()
+
+	router, _ := setupWebhookRouter()
+	id := "550e8400-e29b-41d4-a716-446655440000"
+
+	for _, request := range []struct{ method, path string }{
+		{"GET", "/v1/webhooks/" + id},
+		{"DELETE", "/v1/webhooks/" + id},
+		{"GET", "/v1/webhooks/" + id + "/deliveries"},
+	} {
+		req, _ := http.NewRequest(request.method, request.path, nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code, request.method+" "+request.path)
+	}
+}
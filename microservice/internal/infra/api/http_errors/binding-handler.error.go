@@ -0,0 +1,105 @@
+package http_errors
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+// HandleBindingErrors responde aos erros do ShouldBindJSON com os campos inválidos do corpo
+func HandleBindingErrors(err error, ctx *gin.Context) bool {
+	var validationErrors validator.ValidationErrors
+	if errors.As(err, &validationErrors) {
+		problem := newProblem(ctx, validationFailedProblem, "One or more fields are invalid")
+		problem.Errors = make([]ProblemFieldError, len(validationErrors))
+
+		for i, fieldError := range validationErrors {
+			problem.Errors[i] = ProblemFieldError{
+				Field:   bindingFieldPath(fieldError.Namespace()),
+				Code:    fieldError.Tag(),
+				Message: validationMessage(fieldError),
+			}
+		}
+
+		writeProblem(ctx, problem)
+		return true
+	}
+
+	var typeError *json.UnmarshalTypeError
+	if errors.As(err, &typeError) {
+		problem := newProblem(ctx, validationFailedProblem, "One or more fields are invalid")
+		problem.Errors = []ProblemFieldError{{
+			Field:   jsonFieldPath(typeError.Field),
+			Code:    "type",
+			Message: fmt.Sprintf("must be of type %s", typeError.Type.String()),
+		}}
+
+		writeProblem(ctx, problem)
+		return true
+	}
+
+	var syntaxError *json.SyntaxError
+	if errors.As(err, &syntaxError) || errors.Is(err, io.ErrUnexpectedEOF) {
+		writeProblem(ctx, newProblem(ctx, malformedRequestBodyProblem, "Request body is not valid JSON"))
+		return true
+	}
+
+	if errors.Is(err, io.EOF) {
+		writeProblem(ctx, newProblem(ctx, malformedRequestBodyProblem, "Request body is required"))
+		return true
+	}
+
+	return false
+}
+
+// bindingFieldPath remove o nome do schema do caminho do campo; os nomes já vêm da tag json
+func bindingFieldPath(namespace string) string {
+	if _, path, found := strings.Cut(namespace, "."); found {
+		return path
+	}
+
+	return namespace
+}
+
+// jsonFieldPath escreve os índices do decoder (items.0.quantity) no mesmo formato do validator (items[0].quantity)
+func jsonFieldPath(field string) string {
+	segments := strings.Split(field, ".")
+
+	var path strings.Builder
+	for i, segment := range segments {
+		if _, err := strconv.Atoi(segment); err == nil && i > 0 {
+			path.WriteString("[" + segment + "]")
+			continue
+		}
+
+		if i > 0 {
+			path.WriteString(".")
+		}
+		path.WriteString(segment)
+	}
+
+	return path.String()
+}
+
+func validationMessage(fieldError validator.FieldError) string {
+	switch fieldError.Tag() {
+	case "required":
+		return "is required"
+	case "min":
+		return fmt.Sprintf("must have at least %s", fieldError.Param())
+	case "max":
+		return fmt.Sprintf("must have at most %s", fieldError.Param())
+	case "gt":
+		return fmt.Sprintf("must be greater than %s", fieldError.Param())
+	case "gte":
+		return fmt.Sprintf("must be greater than or equal to %s", fieldError.Param())
+	}
+
+	return fmt.Sprintf("failed on the %q rule", fieldError.Tag())
+}
@@ -0,0 +1,86 @@
+package http_errors
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tech_challenge/internal/infra/api/schemas"
+)
+
+func bindCreateKitchenOrder(t *testing.T, body string) (*httptest.ResponseRecorder, bool) {
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodPost, "/v1/kitchen-orders", bytes.NewBufferString(body))
+	ctx.Request.Header.Set("Content-Type", "application/json")
+
+	var request schemas.CreateKitchenOrderRequestSchema
+	err := ctx.ShouldBindJSON(&request)
+	require.Error(t, err)
+
+	return w, HandleBindingErrors(err, ctx)
+}
+
+func decodeProblem(t *testing.T, w *httptest.ResponseRecorder) ProblemDetails {
+	var problem ProblemDetails
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &problem))
+	return problem
+}
+
+func TestHandleBindingErrors_ValidationErrors(t *testing.T) {
+	w, handled := bindCreateKitchenOrder(t, `{"items":[{"product_id":"p-1","quantity":0,"unit_price":10}]}`)
+
+	assert.True(t, handled)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, ProblemContentType, w.Header().Get("Content-Type"))
+
+	problem := decodeProblem(t, w)
+	assert.Equal(t, "validation-failed", problem.Code)
+	assert.Equal(t, "/problems/validation-failed", problem.Type)
+	assert.Equal(t, "/v1/kitchen-orders", problem.Instance)
+	assert.ElementsMatch(t, []ProblemFieldError{
+		{Field: "order_id", Code: "required", Message: "is required"},
+		{Field: "items[0].quantity", Code: "required", Message: "is required"},
+	}, problem.Errors)
+	t.Log("✓ Erros de validação com o caminho json de cada campo")
+}
+
+func TestHandleBindingErrors_TypeMismatch(t *testing.T) {
+	w, handled := bindCreateKitchenOrder(t, `{"order_id":"order-1","items":[{"product_id":"p-1","quantity":"two"}]}`)
+
+	assert.True(t, handled)
+
+	problem := decodeProblem(t, w)
+	assert.Equal(t, "validation-failed", problem.Code)
+	if assert.Len(t, problem.Errors, 1) {
+		assert.Equal(t, "items[0].quantity", problem.Errors[0].Field)
+		assert.Equal(t, "type", problem.Errors[0].Code)
+	}
+}
+
+func TestHandleBindingErrors_MalformedBody(t *testing.T) {
+	for _, body := range []string{"", "not-json", `{"order_id":`} {
+		w, handled := bindCreateKitchenOrder(t, body)
+
+		assert.True(t, handled, body)
+		assert.Equal(t, http.StatusBadRequest, w.Code, body)
+		assert.Equal(t, "malformed-request-body", decodeProblem(t, w).Code, body)
+	}
+}
+
+func TestHandleBindingErrors_UnknownError(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+
+	assert.False(t, HandleBindingErrors(errors.New("unknown error"), ctx))
+	assert.Equal(t, http.StatusOK, w.Code)
+}
@@ -1,23 +1,16 @@
 package http_errors
 
 import (
-	"net/http"
-
 	"github.com/gin-gonic/gin"
-
-	"tech_challenge/internal/domain/exceptions"
 )
 
+// HandleDomainErrors responde com o problema registrado para a exceção; erros desconhecidos ficam para o chamador
 func HandleDomainErrors(err error, ctx *gin.Context) bool {
-	switch e := err.(type) {
-	case *exceptions.InvalidKitchenOrderDataException:
-		ctx.JSON(http.StatusBadRequest, gin.H{"error": e.Error()})
-		return true
-
-	case *exceptions.KitchenOrderNotFoundException:
-		ctx.JSON(http.StatusNotFound, gin.H{"error": e.Error()})
-		return true
+	definition, ok := ResolveDomainProblem(err)
+	if !ok {
+		return false
 	}
 
-	return false
+	writeProblem(ctx, newProblem(ctx, definition, err.Error()))
+	return true
 }
@@ -1,14 +1,17 @@
 package http_errors
 
 import (
+	"encoding/json"
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
 	"testing"
 
 	"github.com/gin-gonic/gin"
 
 	"tech_challenge/internal/domain/exceptions"
+	"tech_challenge/internal/shared/config/constants"
 )
 
 func TestHandleDomainErrors_InvalidKitchenOrderDataException(t *testing.T) {
@@ -49,6 +52,111 @@ func TestHandleDomainErrors_KitchenOrderNotFoundException(t *testing.T) {
 	}
 }
 
+func TestHandleDomainErrors_InvalidKitchenOrderFilterException(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+
+	err := &exceptions.InvalidKitchenOrderFilterException{
+		Message: "Invalid cursor",
+	}
+
+	handled := HandleDomainErrors(err, ctx)
+
+	if !handled {
+		t.Error("Expected error to be handled, got false")
+	}
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("Expected status code %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestHandleDomainErrors_KitchenOrderVersionExceptions(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	testCases := []struct {
+		name         string
+		err          error
+		expectedCode int
+	}{
+		{"version mismatch", &exceptions.KitchenOrderVersionMismatchException{}, http.StatusPreconditionFailed},
+		{"update conflict", &exceptions.KitchenOrderUpdateConflictException{}, http.StatusConflict},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(w)
+
+			if !HandleDomainErrors(tc.err, ctx) {
+				t.Error("Expected error to be handled, got false")
+			}
+
+			if w.Code != tc.expectedCode {
+				t.Errorf("Expected status code %d, got %d", tc.expectedCode, w.Code)
+			}
+		})
+	}
+}
+
+func TestHandleDomainErrors_KitchenOrderCommandExceptions(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	testCases := []struct {
+		name         string
+		err          error
+		expectedCode int
+	}{
+		{"invalid command", &exceptions.InvalidKitchenOrderCommandException{}, http.StatusBadRequest},
+		{"invalid transition", &exceptions.InvalidKitchenOrderTransitionException{}, http.StatusConflict},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(w)
+
+			if !HandleDomainErrors(tc.err, ctx) {
+				t.Error("Expected error to be handled, got false")
+			}
+
+			if w.Code != tc.expectedCode {
+				t.Errorf("Expected status code %d, got %d", tc.expectedCode, w.Code)
+			}
+		})
+	}
+}
+
+func TestHandleDomainErrors_IdempotencyExceptions(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	testCases := []struct {
+		name         string
+		err          error
+		expectedCode int
+	}{
+		{"invalid key", &exceptions.InvalidIdempotencyKeyException{}, http.StatusBadRequest},
+		{"conflict", &exceptions.IdempotencyKeyConflictException{}, http.StatusUnprocessableEntity},
+		{"in progress", &exceptions.IdempotencyKeyInProgressException{}, http.StatusConflict},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(w)
+
+			if !HandleDomainErrors(tc.err, ctx) {
+				t.Error("Expected error to be handled, got false")
+			}
+
+			if w.Code != tc.expectedCode {
+				t.Errorf("Expected status code %d, got %d", tc.expectedCode, w.Code)
+			}
+		})
+	}
+}
+
 func TestHandleDomainErrors_UnknownError(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 	w := httptest.NewRecorder()
@@ -65,4 +173,40 @@ func TestHandleDomainErrors_UnknownError(t *testing.T) {
 	if w.Code != http.StatusOK {
 		t.Errorf("Expected status code %d for unhandled error, got %d", http.StatusOK, w.Code)
 	}
-}
\ No newline at end of file
+}
+func TestHandleDomainErrors_ProblemDetails(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/v1/kitchen-orders/status/unknown", nil)
+	ctx.Request.Header.Set("Accept-Language", "pt-BR,pt;q=0.9,en;q=0.8")
+	ctx.Set(constants.TRACE_ID_CONTEXT_KEY, "trace-123")
+
+	if !HandleDomainErrors(&exceptions.OrderStatusNotFoundException{}, ctx) {
+		t.Fatal("Expected error to be handled, got false")
+	}
+
+	if contentType := w.Header().Get("Content-Type"); contentType != ProblemContentType {
+		t.Errorf("Expected content type %s, got %s", ProblemContentType, contentType)
+	}
+
+	var problem ProblemDetails
+	if err := json.Unmarshal(w.Body.Bytes(), &problem); err != nil {
+		t.Fatalf("Expected problem+json body, got %v", err)
+	}
+
+	expected := ProblemDetails{
+		Type:     "/problems/order-status-not-found",
+		Title:    "Status do pedido não encontrado",
+		Status:   http.StatusNotFound,
+		Detail:   "Order Status not found",
+		Instance: "/v1/kitchen-orders/status/unknown",
+		Code:     "order-status-not-found",
+		TraceID:  "trace-123",
+	}
+	if !reflect.DeepEqual(problem, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, problem)
+	} else {
+		t.Log("✓ Problem details com código estável, título traduzido e trace id")
+	}
+}
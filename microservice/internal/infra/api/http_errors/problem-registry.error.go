@@ -0,0 +1,94 @@
+package http_errors
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"tech_challenge/internal/domain/exceptions"
+)
+
+// ProblemDefinition associa um erro ao status HTTP e ao código estável exposto aos clientes
+type ProblemDefinition struct {
+	Status int
+	Code   string
+}
+
+type problemRegistration struct {
+	matches    func(err error) bool
+	definition ProblemDefinition
+}
+
+func matchError[T error](err error) bool {
+	var target T
+	return errors.As(err, &target)
+}
+
+func register[T error](status int, code string) problemRegistration {
+	return problemRegistration{
+		matches:    matchError[T],
+		definition: ProblemDefinition{Status: status, Code: code},
+	}
+}
+
+// domainProblems é o registro central das exceções de domínio; todo novo erro exposto pela API entra aqui
+var domainProblems = []problemRegistration{
+	register[*exceptions.KitchenOrderNotFoundException](http.StatusNotFound, "kitchen-order-not-found"),
+	register[*exceptions.InvalidKitchenOrderDataException](http.StatusBadRequest, "invalid-kitchen-order-data"),
+	register[*exceptions.InvalidKitchenOrderFilterException](http.StatusBadRequest, "invalid-kitchen-order-filter"),
+	register[*exceptions.InvalidKitchenOrderCommandException](http.StatusBadRequest, "invalid-kitchen-order-command"),
+	register[*exceptions.InvalidKitchenOrderTransitionException](http.StatusConflict, "invalid-kitchen-order-transition"),
+	register[*exceptions.KitchenOrderVersionMismatchException](http.StatusPreconditionFailed, "kitchen-order-version-mismatch"),
+	register[*exceptions.KitchenOrderUpdateConflictException](http.StatusConflict, "kitchen-order-update-conflict"),
+	register[*exceptions.InvalidKitchenOrderTicketException](http.StatusBadRequest, "invalid-kitchen-order-ticket"),
+	register[*exceptions.OrderStatusNotFoundException](http.StatusNotFound, "order-status-not-found"),
+	register[*exceptions.InvalidOrderStatusDataException](http.StatusBadRequest, "invalid-order-status-data"),
+	register[*exceptions.WebhookSubscriptionNotFoundException](http.StatusNotFound, "webhook-subscription-not-found"),
+	register[*exceptions.InvalidWebhookSubscriptionDataException](http.StatusBadRequest, "invalid-webhook-subscription-data"),
+	register[*exceptions.PrinterNotFoundException](http.StatusNotFound, "printer-not-found"),
+	register[*exceptions.InvalidPrinterDataException](http.StatusBadRequest, "invalid-printer-data"),
+	register[*exceptions.InvalidPrintRouteDataException](http.StatusBadRequest, "invalid-print-route-data"),
+	register[*exceptions.InvalidPrintJobFilterException](http.StatusBadRequest, "invalid-print-job-filter"),
+	register[*exceptions.InvalidReportFilterException](http.StatusBadRequest, "invalid-report-filter"),
+	register[*exceptions.InvalidIdempotencyKeyException](http.StatusBadRequest, "invalid-idempotency-key"),
+	register[*exceptions.IdempotencyKeyConflictException](http.StatusUnprocessableEntity, "idempotency-key-conflict"),
+	register[*exceptions.IdempotencyKeyInProgressException](http.StatusConflict, "idempotency-key-in-progress"),
+	register[*exceptions.APIKeyNotFoundException](http.StatusNotFound, "api-key-not-found"),
+	register[*exceptions.InvalidAPIKeyDataException](http.StatusBadRequest, "invalid-api-key-data"),
+	register[*exceptions.UnauthorizedException](http.StatusUnauthorized, "unauthorized"),
+	register[*exceptions.ForbiddenException](http.StatusForbidden, "forbidden"),
+	register[*exceptions.RateLimitExceededException](http.StatusTooManyRequests, "rate-limit-exceeded"),
+	register[*exceptions.DisplayBoardStreamLimitException](http.StatusServiceUnavailable, "display-board-stream-limit"),
+}
+
+var (
+	validationFailedProblem     = ProblemDefinition{Status: http.StatusBadRequest, Code: "validation-failed"}
+	malformedRequestBodyProblem = ProblemDefinition{Status: http.StatusBadRequest, Code: "malformed-request-body"}
+	internalErrorProblem        = ProblemDefinition{Status: http.StatusInternalServerError, Code: "internal-error"}
+	requestTimeoutProblem       = ProblemDefinition{Status: http.StatusGatewayTimeout, Code: "request-timeout"}
+)
+
+// ResolveDomainProblem devolve a definição registrada para a exceção de domínio
+func ResolveDomainProblem(err error) (ProblemDefinition, bool) {
+	for _, registration := range domainProblems {
+		if registration.matches(err) {
+			return registration.definition, true
+		}
+	}
+
+	return ProblemDefinition{}, false
+}
+
+// ResolveProblem mapeia qualquer erro para o problema e uma mensagem segura para canais fora do HTTP, como o WebSocket do KDS.
+// Só as exceções de domínio levam a própria mensagem; os demais erros não são expostos e devem ser registrados pelo chamador
+func ResolveProblem(err error) (ProblemDefinition, string, bool) {
+	if definition, ok := ResolveDomainProblem(err); ok {
+		return definition, err.Error(), true
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		return requestTimeoutProblem, requestTimeoutDetail, false
+	}
+
+	return internalErrorProblem, internalErrorDetail, false
+}
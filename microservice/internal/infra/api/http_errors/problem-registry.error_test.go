@@ -0,0 +1,113 @@
+package http_errors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"tech_challenge/internal/domain/exceptions"
+)
+
+func TestResolveDomainProblem_RegisteredExceptions(t *testing.T) {
+	testCases := []struct {
+		err          error
+		expectedCode string
+		status       int
+	}{
+		{&exceptions.KitchenOrderNotFoundException{}, "kitchen-order-not-found", http.StatusNotFound},
+		{&exceptions.InvalidKitchenOrderDataException{}, "invalid-kitchen-order-data", http.StatusBadRequest},
+		{&exceptions.InvalidKitchenOrderFilterException{}, "invalid-kitchen-order-filter", http.StatusBadRequest},
+		{&exceptions.InvalidKitchenOrderCommandException{}, "invalid-kitchen-order-command", http.StatusBadRequest},
+		{&exceptions.InvalidKitchenOrderTransitionException{}, "invalid-kitchen-order-transition", http.StatusConflict},
+		{&exceptions.KitchenOrderVersionMismatchException{}, "kitchen-order-version-mismatch", http.StatusPreconditionFailed},
+		{&exceptions.KitchenOrderUpdateConflictException{}, "kitchen-order-update-conflict", http.StatusConflict},
+		{&exceptions.OrderStatusNotFoundException{}, "order-status-not-found", http.StatusNotFound},
+		{&exceptions.InvalidOrderStatusDataException{}, "invalid-order-status-data", http.StatusBadRequest},
+		{&exceptions.WebhookSubscriptionNotFoundException{}, "webhook-subscription-not-found", http.StatusNotFound},
+		{&exceptions.InvalidWebhookSubscriptionDataException{}, "invalid-webhook-subscription-data", http.StatusBadRequest},
+		{&exceptions.PrinterNotFoundException{}, "printer-not-found", http.StatusNotFound},
+		{&exceptions.InvalidPrinterDataException{}, "invalid-printer-data", http.StatusBadRequest},
+		{&exceptions.InvalidPrintRouteDataException{}, "invalid-print-route-data", http.StatusBadRequest},
+		{&exceptions.InvalidPrintJobFilterException{}, "invalid-print-job-filter", http.StatusBadRequest},
+		{&exceptions.InvalidReportFilterException{}, "invalid-report-filter", http.StatusBadRequest},
+		{&exceptions.InvalidIdempotencyKeyException{}, "invalid-idempotency-key", http.StatusBadRequest},
+		{&exceptions.IdempotencyKeyConflictException{}, "idempotency-key-conflict", http.StatusUnprocessableEntity},
+		{&exceptions.IdempotencyKeyInProgressException{}, "idempotency-key-in-progress", http.StatusConflict},
+		{&exceptions.APIKeyNotFoundException{}, "api-key-not-found", http.StatusNotFound},
+		{&exceptions.InvalidAPIKeyDataException{}, "invalid-api-key-data", http.StatusBadRequest},
+		{&exceptions.UnauthorizedException{}, "unauthorized", http.StatusUnauthorized},
+		{&exceptions.ForbiddenException{}, "forbidden", http.StatusForbidden},
+		{&exceptions.RateLimitExceededException{}, "rate-limit-exceeded", http.StatusTooManyRequests},
+		{&exceptions.DisplayBoardStreamLimitException{}, "display-board-stream-limit", http.StatusServiceUnavailable},
+	}
+
+	for _, tc := range testCases {
+		definition, ok := ResolveDomainProblem(tc.err)
+
+		if assert.True(t, ok, "%T should be registered", tc.err) {
+			assert.Equal(t, tc.expectedCode, definition.Code)
+			assert.Equal(t, tc.status, definition.Status)
+		}
+	}
+
+	t.Log("✓ Todas as exceções de domínio possuem status e código")
+}
+
+func TestResolveDomainProblem_WrappedAndUnknownErrors(t *testing.T) {
+	wrapped := fmt.Errorf("loading order: %w", &exceptions.KitchenOrderNotFoundException{})
+
+	definition, ok := ResolveDomainProblem(wrapped)
+	assert.True(t, ok)
+	assert.Equal(t, "kitchen-order-not-found", definition.Code)
+
+	_, ok = ResolveDomainProblem(errors.New("unknown error"))
+	assert.False(t, ok)
+}
+
+func TestResolveProblem_HidesNonDomainErrors(t *testing.T) {
+	definition, message, ok := ResolveProblem(&exceptions.KitchenOrderNotFoundException{})
+	assert.True(t, ok)
+	assert.Equal(t, "kitchen-order-not-found", definition.Code)
+	assert.Equal(t, (&exceptions.KitchenOrderNotFoundException{}).Error(), message)
+
+	definition, message, ok = ResolveProblem(fmt.Errorf("querying order: %w", context.DeadlineExceeded))
+	assert.False(t, ok)
+	assert.Equal(t, http.StatusGatewayTimeout, definition.Status)
+	assert.Equal(t, requestTimeoutDetail, message)
+
+	definition, message, ok = ResolveProblem(errors.New("pq: password authentication failed for user kitchen"))
+	assert.False(t, ok)
+	assert.Equal(t, "internal-error", definition.Code)
+	assert.Equal(t, internalErrorDetail, message)
+}
+
+func TestProblemTitles_EveryCodeIsTranslated(t *testing.T) {
+	codes := []string{validationFailedProblem.Code, malformedRequestBodyProblem.Code, internalErrorProblem.Code, requestTimeoutProblem.Code}
+	for _, registration := range domainProblems {
+		codes = append(codes, registration.definition.Code)
+	}
+
+	for language, titles := range problemTitles {
+		for _, code := range codes {
+			assert.NotEmpty(t, titles[code], "missing %s title for %s", language, code)
+		}
+	}
+}
+
+func TestProblemLanguage(t *testing.T) {
+	testCases := map[string]string{
+		"":                problemLanguageEnglish,
+		"pt-BR,pt;q=0.9":  problemLanguagePortuguese,
+		"fr-FR, en;q=0.8": problemLanguageEnglish,
+		"de, pt-PT;q=0.5": problemLanguagePortuguese,
+		"es":              problemLanguageEnglish,
+	}
+
+	for acceptLanguage, expected := range testCases {
+		assert.Equal(t, expected, problemLanguage(acceptLanguage), acceptLanguage)
+	}
+}
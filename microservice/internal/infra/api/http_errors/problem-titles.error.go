@@ -0,0 +1,100 @@
+package http_errors
+
+import "strings"
+
+const (
+	problemLanguageEnglish    = "en"
+	problemLanguagePortuguese = "pt-BR"
+)
+
+// problemTitles guarda os títulos por idioma; o código é a chave estável e o título pode ser traduzido livremente
+var problemTitles = map[string]map[string]string{
+	problemLanguageEnglish: {
+		"kitchen-order-not-found":           "Kitchen order not found",
+		"invalid-kitchen-order-data":        "Invalid kitchen order data",
+		"invalid-kitchen-order-filter":      "Invalid kitchen order filter",
+		"invalid-kitchen-order-command":     "Invalid kitchen order command",
+		"invalid-kitchen-order-transition":  "Invalid kitchen order status transition",
+		"kitchen-order-version-mismatch":    "Kitchen order version mismatch",
+		"kitchen-order-update-conflict":     "Kitchen order update conflict",
+		"invalid-kitchen-order-ticket":      "Invalid kitchen order ticket request",
+		"order-status-not-found":            "Order status not found",
+		"invalid-order-status-data":         "Invalid order status data",
+		"webhook-subscription-not-found":    "Webhook subscription not found",
+		"invalid-webhook-subscription-data": "Invalid webhook subscription data",
+		"printer-not-found":                 "Printer not found",
+		"invalid-printer-data":              "Invalid printer data",
+		"invalid-print-route-data":          "Invalid print route data",
+		"invalid-print-job-filter":          "Invalid print job filter",
+		"invalid-report-filter":             "Invalid report filter",
+		"invalid-idempotency-key":           "Invalid Idempotency-Key",
+		"idempotency-key-conflict":          "Idempotency-Key reused with a different request",
+		"idempotency-key-in-progress":       "Request with this Idempotency-Key in progress",
+		"api-key-not-found":                 "API key not found",
+		"invalid-api-key-data":              "Invalid API key data",
+		"unauthorized":                      "Unauthorized",
+		"forbidden":                         "Forbidden",
+		"rate-limit-exceeded":               "Too many requests",
+		"display-board-stream-limit":        "Display board connection limit reached",
+		"validation-failed":                 "Request validation failed",
+		"malformed-request-body":            "Malformed request body",
+		"internal-error":                    "Internal server error",
+		"request-timeout":                   "Request timed out",
+	},
+	problemLanguagePortuguese: {
+		"kitchen-order-not-found":           "Pedido da cozinha não encontrado",
+		"invalid-kitchen-order-data":        "Dados do pedido da cozinha inválidos",
+		"invalid-kitchen-order-filter":      "Filtro de pedidos da cozinha inválido",
+		"invalid-kitchen-order-command":     "Comando do pedido da cozinha inválido",
+		"invalid-kitchen-order-transition":  "Transição de status do pedido inválida",
+		"kitchen-order-version-mismatch":    "Versão do pedido da cozinha divergente",
+		"kitchen-order-update-conflict":     "Conflito na alteração do pedido da cozinha",
+		"invalid-kitchen-order-ticket":      "Requisição de comanda do pedido inválida",
+		"order-status-not-found":            "Status do pedido não encontrado",
+		"invalid-order-status-data":         "Dados do status do pedido inválidos",
+		"webhook-subscription-not-found":    "Assinatura de webhook não encontrada",
+		"invalid-webhook-subscription-data": "Dados da assinatura de webhook inválidos",
+		"printer-not-found":                 "Impressora não encontrada",
+		"invalid-printer-data":              "Dados da impressora inválidos",
+		"invalid-print-route-data":          "Regras de roteamento de impressão inválidas",
+		"invalid-print-job-filter":          "Filtro de trabalhos de impressão inválido",
+		"invalid-report-filter":             "Filtro de relatório inválido",
+		"invalid-idempotency-key":           "Idempotency-Key inválida",
+		"idempotency-key-conflict":          "Idempotency-Key reutilizada com outra requisição",
+		"idempotency-key-in-progress":       "Requisição com esta Idempotency-Key em andamento",
+		"api-key-not-found":                 "API key não encontrada",
+		"invalid-api-key-data":              "Dados da API key inválidos",
+		"unauthorized":                      "Não autenticado",
+		"forbidden":                         "Acesso negado",
+		"rate-limit-exceeded":               "Muitas requisições",
+		"display-board-stream-limit":        "Limite de conexões do quadro atingido",
+		"validation-failed":                 "Falha na validação da requisição",
+		"malformed-request-body":            "Corpo da requisição malformado",
+		"internal-error":                    "Erro interno do servidor",
+		"request-timeout":                   "Tempo da requisição esgotado",
+	},
+}
+
+// problemLanguage escolhe o primeiro idioma do Accept-Language que tenha tradução, com inglês como padrão
+func problemLanguage(acceptLanguage string) string {
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		tag := strings.ToLower(strings.TrimSpace(strings.SplitN(part, ";", 2)[0]))
+
+		switch {
+		case strings.HasPrefix(tag, "pt"):
+			return problemLanguagePortuguese
+		case strings.HasPrefix(tag, "en"):
+			return problemLanguageEnglish
+		}
+	}
+
+	return problemLanguageEnglish
+}
+
+func problemTitle(code string, acceptLanguage string) string {
+	if title, ok := problemTitles[problemLanguage(acceptLanguage)][code]; ok {
+		return title
+	}
+
+	return problemTitles[problemLanguageEnglish][code]
+}
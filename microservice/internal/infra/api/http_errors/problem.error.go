@@ -0,0 +1,80 @@
+package http_errors
+
+import (
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"tech_challenge/internal/shared/config/constants"
+)
+
+const (
+	ProblemContentType = "application/problem+json"
+
+	// Os tipos são referências relativas; o código estável também vai no campo code
+	problemTypePrefix = "/problems/"
+
+	internalErrorDetail  = "Internal server error"
+	requestTimeoutDetail = "The request took too long to complete"
+)
+
+// ProblemDetails é o corpo de erro no formato RFC 7807
+type ProblemDetails struct {
+	Type     string              `json:"type"`
+	Title    string              `json:"title"`
+	Status   int                 `json:"status"`
+	Detail   string              `json:"detail,omitempty"`
+	Instance string              `json:"instance,omitempty"`
+	Code     string              `json:"code"`
+	TraceID  string              `json:"trace_id,omitempty"`
+	Errors   []ProblemFieldError `json:"errors,omitempty"`
+}
+
+// ProblemFieldError detalha um campo inválido do corpo da requisição
+type ProblemFieldError struct {
+	Field   string `json:"field"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+func newProblem(ctx *gin.Context, definition ProblemDefinition, detail string) ProblemDetails {
+	problem := ProblemDetails{
+		Type:    problemTypePrefix + definition.Code,
+		Title:   problemTitle(definition.Code, acceptLanguage(ctx)),
+		Status:  definition.Status,
+		Detail:  detail,
+		Code:    definition.Code,
+		TraceID: ctx.GetString(constants.TRACE_ID_CONTEXT_KEY),
+	}
+
+	if ctx.Request != nil && ctx.Request.URL != nil {
+		problem.Instance = ctx.Request.URL.Path
+	}
+
+	return problem
+}
+
+func writeProblem(ctx *gin.Context, problem ProblemDetails) {
+	// O render JSON do gin preserva o Content-Type já definido
+	ctx.Header("Content-Type", ProblemContentType)
+	ctx.Header("Content-Language", problemLanguage(acceptLanguage(ctx)))
+	ctx.JSON(problem.Status, problem)
+}
+
+func acceptLanguage(ctx *gin.Context) string {
+	if ctx.Request == nil {
+		return ""
+	}
+
+	return strings.TrimSpace(ctx.GetHeader("Accept-Language"))
+}
+
+// WriteInternalProblem responde com o problema genérico, sem expor o erro original ao cliente
+func WriteInternalProblem(ctx *gin.Context) {
+	writeProblem(ctx, newProblem(ctx, internalErrorProblem, internalErrorDetail))
+}
+
+// WriteTimeoutProblem responde quando uma consulta estourou o prazo; o cliente pode tentar de novo
+func WriteTimeoutProblem(ctx *gin.Context) {
+	writeProblem(ctx, newProblem(ctx, requestTimeoutProblem, requestTimeoutDetail))
+}
@@ -0,0 +1,21 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"tech_challenge/internal/infra/api/handlers"
+	"tech_challenge/internal/shared/infra/api/middlewares"
+	"tech_challenge/internal/shared/infra/auth"
+)
+
+func RegisterAPIKeyRoutes(router *gin.RouterGroup) {
+	apiKeyHandler := handlers.NewAPIKeyHandler()
+
+	// Apenas gerentes administram as chaves; uma API key não consegue criar ou revogar outras
+	router.Use(middlewares.RequireRoles(auth.ManagerRoles...))
+
+	router.POST("/", apiKeyHandler.Create)
+	router.GET("/", apiKeyHandler.FindAll)
+	router.GET("/:id", apiKeyHandler.FindByID)
+	router.POST("/:id/revoke", apiKeyHandler.Revoke)
+}
@@ -0,0 +1,14 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"tech_challenge/internal/infra/api/handlers"
+)
+
+func RegisterDisplayBoardRoutes(router *gin.RouterGroup) {
+	displayBoardHandler := handlers.NewDisplayBoardHandler()
+
+	router.GET("", displayBoardHandler.Get)
+	router.GET("/stream", displayBoardHandler.Stream)
+}
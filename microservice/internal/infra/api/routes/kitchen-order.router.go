@@ -4,18 +4,48 @@ import (
 	"github.com/gin-gonic/gin"
 
 	"tech_challenge/internal/infra/api/handlers"
+	"tech_challenge/internal/shared/config/constants"
+	"tech_challenge/internal/shared/infra/api/middlewares"
+	"tech_challenge/internal/shared/infra/auth"
 )
 
 func RegisterKitchenOrderRoutes(router *gin.RouterGroup) {
 	kitchenOrderHandler := handlers.NewKitchenOrderHandler()
 	orderStatusHandler := handlers.NewOrderStatusHandler()
+	kitchenOrderStreamHandler := handlers.NewKitchenOrderStreamHandler()
+	kitchenDisplayHandler := handlers.NewKitchenDisplayHandler()
+	printerHandler := handlers.NewPrinterHandler()
+
+	canRead := middlewares.RequirePermission(auth.ReadKitchenOrders)
+	canCreate := middlewares.RequirePermission(auth.CreateKitchenOrders)
+	canWrite := middlewares.RequireRoles(auth.WriteRoles...)
+	isManager := middlewares.RequireRoles(auth.ManagerRoles...)
+	canReprint := middlewares.RequireRoles(auth.ReprintRoles...)
 
 	// GET
-	router.GET("/", kitchenOrderHandler.FindAll)
-	router.GET("/:id", kitchenOrderHandler.FindByID)
-	
-	router.PUT("/:id", kitchenOrderHandler.Update)
-	
+	router.GET("/", canRead, kitchenOrderHandler.FindAll)
+	router.GET("/stream", canRead, kitchenOrderStreamHandler.Stream)
+	// Os comandos enviados pelo socket são autorizados um a um pelo handler
+	router.GET("/ws", canRead, kitchenDisplayHandler.Connect)
+	router.GET("/export", isManager, kitchenOrderHandler.Export)
+	router.GET("/:id", canRead, kitchenOrderHandler.FindByID)
+	router.GET("/:id/ticket", canRead, kitchenOrderHandler.Ticket)
+
+	router.POST("/", canCreate, kitchenOrderHandler.Create)
+	router.POST("/bulk-status", isManager, kitchenOrderHandler.BulkUpdateStatus)
+	router.POST("/:id/start", requireCommandRoles(constants.KITCHEN_ORDER_COMMAND_START), kitchenOrderHandler.Start)
+	router.POST("/:id/ready", requireCommandRoles(constants.KITCHEN_ORDER_COMMAND_READY), kitchenOrderHandler.Ready)
+	router.POST("/:id/finish", requireCommandRoles(constants.KITCHEN_ORDER_COMMAND_FINISH), kitchenOrderHandler.Finish)
+	router.POST("/:id/recall", requireCommandRoles(constants.KITCHEN_ORDER_COMMAND_RECALL), kitchenOrderHandler.Recall)
+	router.POST("/:id/reprint", canReprint, printerHandler.Reprint)
+
+	router.PUT("/:id", canWrite, kitchenOrderHandler.Update)
+
 	// Status endpoints
-	router.GET("/status", orderStatusHandler.FindAll)
+	router.GET("/status", canRead, orderStatusHandler.FindAll)
+}
+
+func requireCommandRoles(command string) gin.HandlerFunc {
+	roles, _ := auth.KitchenOrderCommandRoles(command)
+	return middlewares.RequireRoles(roles...)
 }
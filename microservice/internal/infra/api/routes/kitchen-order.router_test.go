@@ -55,6 +55,10 @@ func TestRegisterKitchenOrderRoutes(t *testing.T) {
 		"GET",
 		"GET",
 		"GET",
+		"GET",
+		"GET",
+		"GET",
+		"GET",
 	}
 
 	methodCount := make(map[string]int)
@@ -65,4 +69,8 @@ func TestRegisterKitchenOrderRoutes(t *testing.T) {
 	if methodCount["GET"] != len(expectedRoutes) {
 		t.Errorf("Expected %d GET routes, got %d", len(expectedRoutes), methodCount["GET"])
 	}
+
+	if methodCount["POST"] != 7 {
+		t.Errorf("Expected 7 POST routes, got %d", methodCount["POST"])
+	}
 }
\ No newline at end of file
@@ -0,0 +1,18 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"tech_challenge/internal/infra/api/handlers"
+	"tech_challenge/internal/shared/infra/api/middlewares"
+	"tech_challenge/internal/shared/infra/auth"
+)
+
+func RegisterKitchenReportRoutes(router *gin.RouterGroup) {
+	kitchenReportHandler := handlers.NewKitchenReportHandler()
+
+	router.Use(middlewares.RequirePermission(auth.ReadReports))
+
+	router.GET("/throughput", kitchenReportHandler.Throughput)
+	router.GET("/prep-times", kitchenReportHandler.PrepTimes)
+}
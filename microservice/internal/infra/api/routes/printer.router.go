@@ -0,0 +1,24 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"tech_challenge/internal/infra/api/handlers"
+	"tech_challenge/internal/shared/infra/api/middlewares"
+	"tech_challenge/internal/shared/infra/auth"
+)
+
+func RegisterPrinterRoutes(router *gin.RouterGroup) {
+	printerHandler := handlers.NewPrinterHandler()
+
+	router.Use(middlewares.RequirePermission(auth.ManagePrinters))
+
+	router.POST("/", printerHandler.Create)
+	router.GET("/", printerHandler.FindAll)
+	router.GET("/jobs", printerHandler.FindJobs)
+	router.GET("/routes", printerHandler.FindRoutes)
+	router.PUT("/routes", printerHandler.ReplaceRoutes)
+	router.GET("/:id", printerHandler.FindByID)
+	router.PUT("/:id", printerHandler.Update)
+	router.DELETE("/:id", printerHandler.Delete)
+}
@@ -0,0 +1,22 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"tech_challenge/internal/infra/api/handlers"
+	"tech_challenge/internal/shared/infra/api/middlewares"
+	"tech_challenge/internal/shared/infra/auth"
+)
+
+func RegisterWebhookRoutes(router *gin.RouterGroup) {
+	webhookHandler := handlers.NewWebhookHandler()
+
+	router.Use(middlewares.RequirePermission(auth.ManageWebhooks))
+
+	router.POST("/", webhookHandler.Create)
+	router.GET("/", webhookHandler.FindAll)
+	router.GET("/:id", webhookHandler.FindByID)
+	router.PUT("/:id", webhookHandler.Update)
+	router.DELETE("/:id", webhookHandler.Delete)
+	router.GET("/:id/deliveries", webhookHandler.FindDeliveries)
+}
@@ -0,0 +1,20 @@
+package schemas
+
+import "time"
+
+type CreateAPIKeyRequestSchema struct {
+	Name   string   `json:"name" binding:"required" example:"orders-service"`
+	Scopes []string `json:"scopes" binding:"required" example:"kitchen-orders:read,kitchen-orders:create"`
+}
+
+type APIKeyResponseSchema struct {
+	ID         string     `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Name       string     `json:"name" example:"orders-service"`
+	Prefix     string     `json:"prefix" example:"3f9a1c987b2f"`
+	Key        string     `json:"key,omitempty" example:"ko_3f9a1c987b2f_4f9c2b..."`
+	Scopes     []string   `json:"scopes" example:"kitchen-orders:read,kitchen-orders:create"`
+	CreatedBy  *string    `json:"created_by" example:"manager-1"`
+	CreatedAt  time.Time  `json:"created_at" example:"2023-10-01T12:00:00Z"`
+	LastUsedAt *time.Time `json:"last_used_at" example:"2023-10-01T12:00:00Z"`
+	RevokedAt  *time.Time `json:"revoked_at" example:"2023-10-01T12:00:00Z"`
+}
@@ -0,0 +1,21 @@
+package schemas
+
+import "time"
+
+type DisplayBoardPreparingEntrySchema struct {
+	Slug string `json:"slug" example:"001"`
+}
+
+type DisplayBoardReadyEntrySchema struct {
+	Slug    string    `json:"slug" example:"002"`
+	ReadyAt time.Time `json:"ready_at" example:"2023-10-01T12:00:00Z"`
+}
+
+type DisplayBoardResponseSchema struct {
+	Preparing []DisplayBoardPreparingEntrySchema `json:"preparing"`
+	Ready     []DisplayBoardReadyEntrySchema     `json:"ready"`
+}
+
+type DisplayBoardChimeSchema struct {
+	Slug string `json:"slug" example:"002"`
+}
@@ -0,0 +1,29 @@
+package schemas
+
+type KitchenDisplayCommandSchema struct {
+	RequestID      string  `json:"request_id" example:"a1b2c3"`
+	Command        string  `json:"command" example:"ready" enums:"start,ready,finish,recall"`
+	KitchenOrderID string  `json:"kitchen_order_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Version        *uint64 `json:"version,omitempty" example:"3"`
+	Reason         string  `json:"reason,omitempty" example:"Cliente devolveu o lanche frio"`
+}
+
+type KitchenDisplaySnapshotSchema struct {
+	Type          string                       `json:"type" example:"snapshot"`
+	Station       string                       `json:"station,omitempty" example:"grill"`
+	LastEventID   uint64                       `json:"last_event_id" example:"42"`
+	KitchenOrders []KitchenOrderResponseSchema `json:"kitchen_orders"`
+}
+
+type KitchenDisplayEventSchema struct {
+	Type  string                        `json:"type" example:"event"`
+	Event KitchenOrderStreamEventSchema `json:"event"`
+}
+
+type KitchenDisplayReplySchema struct {
+	Type         string                      `json:"type" example:"ack" enums:"ack,error"`
+	RequestID    string                      `json:"request_id,omitempty" example:"a1b2c3"`
+	KitchenOrder *KitchenOrderResponseSchema `json:"kitchen_order,omitempty"`
+	Error        string                      `json:"error,omitempty" example:"Kitchen Order not found"`
+	ErrorCode    string                      `json:"error_code,omitempty" example:"kitchen-order-not-found"`
+}
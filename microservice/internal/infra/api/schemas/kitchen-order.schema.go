@@ -4,6 +4,18 @@ import (
 	"time"
 )
 
+type CreateOrderItemRequestSchema struct {
+	ProductID string  `json:"product_id" binding:"required" example:"product-123"`
+	Quantity  int     `json:"quantity" binding:"required,gt=0" example:"2"`
+	UnitPrice float64 `json:"unit_price" binding:"gte=0" example:"25.90"`
+}
+
+type CreateKitchenOrderRequestSchema struct {
+	OrderID    string                         `json:"order_id" binding:"required" example:"123e4567-e89b-12d3-a456-426614174000"`
+	CustomerID *string                        `json:"customer_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Items      []CreateOrderItemRequestSchema `json:"items" binding:"dive"`
+}
+
 type UpdateKitchenOrderRequestSchema struct {
 	StatusID string `json:"status_id" binding:"required"`
 }
@@ -12,6 +24,31 @@ type UpdateKitchenOrderSchema struct {
 	StatusID string `json:"status_id" binding:"required"`
 }
 
+type RecallKitchenOrderRequestSchema struct {
+	Reason string `json:"reason" binding:"required,max=255" example:"Cliente devolveu o lanche frio"`
+}
+
+type BulkUpdateKitchenOrderStatusRequestSchema struct {
+	IDs      []string `json:"ids" binding:"required,min=1" example:"123e4567-e89b-12d3-a456-426614174000"`
+	StatusID string   `json:"status_id" binding:"required" example:"bd91a1ee-1234-4cde-9c2a-efb1d2a3a789"`
+}
+
+type BulkUpdateKitchenOrderStatusItemSchema struct {
+	ID           string                      `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Success      bool                        `json:"success" example:"true"`
+	Skipped      bool                        `json:"skipped" example:"false"`
+	KitchenOrder *KitchenOrderResponseSchema `json:"kitchen_order,omitempty"`
+	ErrorCode    string                      `json:"error_code,omitempty" example:"invalid-kitchen-order-transition"`
+	Error        string                      `json:"error,omitempty" example:"Kitchen order cannot move from its current status to the requested status"`
+}
+
+type BulkUpdateKitchenOrderStatusResponseSchema struct {
+	Results   []BulkUpdateKitchenOrderStatusItemSchema `json:"results"`
+	Succeeded int                                      `json:"succeeded" example:"8"`
+	Skipped   int                                      `json:"skipped" example:"1"`
+	Failed    int                                      `json:"failed" example:"1"`
+}
+
 type OrderItemResponseSchema struct {
 	ID        string  `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
 	OrderID   string  `json:"order_id" example:"123e4567-e89b-12d3-a456-426614174000"`
@@ -21,18 +58,51 @@ type OrderItemResponseSchema struct {
 }
 
 type KitchenOrderResponseSchema struct {
-	ID        string     `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
-	OrderID   string     `json:"order_id" example:"123e4567-e89b-12d3-a456-426614174000"`
-	Slug      string     `json:"slug" example:"001"`
-	Status    string     `json:"status" example:"Pronto"`
-	CreatedAt time.Time  `json:"created_at" example:"2023-10-01T12:00:00Z"`
-	UpdatedAt *time.Time `json:"updated_at" example:"2023-10-01T12:00:00Z"`
+	ID              string     `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	OrderID         string     `json:"order_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Slug            string     `json:"slug" example:"001"`
+	Status          string     `json:"status" example:"Pronto"`
+	Version         uint64     `json:"version" example:"3"`
+	StatusReason    *string    `json:"status_reason,omitempty" example:"Cliente devolveu o lanche frio"`
+	StatusChangedBy *string    `json:"status_changed_by,omitempty" example:"user-42"`
+	CreatedAt       time.Time  `json:"created_at" example:"2023-10-01T12:00:00Z"`
+	UpdatedAt       *time.Time `json:"updated_at" example:"2023-10-01T12:00:00Z"`
+}
+
+type KitchenOrderListResponseSchema struct {
+	Items      []KitchenOrderResponseSchema `json:"items"`
+	NextCursor *string                      `json:"next_cursor" example:"eyJzIjoiYm9hcmQiLCJyIjoxfQ"`
+	Total      int64                        `json:"total" example:"42"`
 }
 
-type KitchenOrderNotFoundErrorSchema struct {
-	Error string `json:"error" example:"Kitchen order not found"`
+// KitchenOrderExportSchema é uma linha do export em NDJSON; as durações são em segundos
+type KitchenOrderExportSchema struct {
+	ID                 string                    `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	OrderID            string                    `json:"order_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	CustomerID         *string                   `json:"customer_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Slug               string                    `json:"slug" example:"001"`
+	Status             string                    `json:"status" example:"Finalizado"`
+	Amount             float64                   `json:"amount" example:"51.80"`
+	Items              []OrderItemResponseSchema `json:"items"`
+	StatusReason       *string                   `json:"status_reason,omitempty" example:"Cliente devolveu o lanche frio"`
+	CreatedAt          time.Time                 `json:"created_at" example:"2023-10-01T12:00:00Z"`
+	PreparingAt        *time.Time                `json:"preparing_at" example:"2023-10-01T12:02:00Z"`
+	ReadyAt            *time.Time                `json:"ready_at" example:"2023-10-01T12:10:00Z"`
+	FinishedAt         *time.Time                `json:"finished_at" example:"2023-10-01T12:12:00Z"`
+	WaitingSeconds     *float64                  `json:"waiting_seconds" example:"120"`
+	PreparationSeconds *float64                  `json:"preparation_seconds" example:"480"`
+	TotalSeconds       *float64                  `json:"total_seconds" example:"720"`
 }
 
-type InvalidKitchenOrderDataErrorSchema struct {
-	Error string `json:"error" example:"Invalid kitchen order data"`
+type KitchenOrderStreamEventSchema struct {
+	EventID        uint64    `json:"event_id" example:"42"`
+	Type           string    `json:"type" example:"status-changed"`
+	KitchenOrderID string    `json:"kitchen_order_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	OrderID        string    `json:"order_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Slug           string    `json:"slug" example:"001"`
+	StatusID       string    `json:"status_id" example:"5a8b2b16-9b47-4e35-ae27-28f7994ef456"`
+	Status         string    `json:"status" example:"Pronto"`
+	Reason         *string   `json:"reason,omitempty" example:"Cliente devolveu o lanche frio"`
+	Actor          *string   `json:"actor,omitempty" example:"user-42"`
+	OccurredAt     time.Time `json:"occurred_at" example:"2023-10-01T12:00:00Z"`
 }
@@ -0,0 +1,51 @@
+package schemas
+
+import "time"
+
+type ThroughputBucketSchema struct {
+	PeriodStart     time.Time `json:"period_start" example:"2024-01-15T12:00:00Z"`
+	Received        int       `json:"received" example:"42"`
+	Finished        int       `json:"finished" example:"40"`
+	ReceivedPerHour float64   `json:"received_per_hour" example:"42"`
+	FinishedPerHour float64   `json:"finished_per_hour" example:"40"`
+}
+
+type ThroughputReportResponseSchema struct {
+	From            time.Time                `json:"from" example:"2024-01-15T00:00:00Z"`
+	To              time.Time                `json:"to" example:"2024-01-15T23:59:59Z"`
+	GroupBy         string                   `json:"group_by" example:"hour"`
+	Received        int                      `json:"received" example:"310"`
+	Finished        int                      `json:"finished" example:"305"`
+	ReceivedPerHour float64                  `json:"received_per_hour" example:"12.92"`
+	FinishedPerHour float64                  `json:"finished_per_hour" example:"12.71"`
+	Buckets         []ThroughputBucketSchema `json:"buckets"`
+}
+
+type StageDurationSchema struct {
+	StatusID       string  `json:"status_id" example:"3f6c2b1e-8f1a-4c2d-9b7e-1a2b3c4d5e6f"`
+	StatusName     string  `json:"status_name" example:"Em preparação"`
+	Orders         int     `json:"orders" example:"120"`
+	AverageSeconds float64 `json:"average_seconds" example:"412.5"`
+	P90Seconds     float64 `json:"p90_seconds" example:"780"`
+}
+
+type PrepTimeBucketSchema struct {
+	PeriodStart time.Time             `json:"period_start" example:"2024-01-15T00:00:00Z"`
+	Stages      []StageDurationSchema `json:"stages"`
+}
+
+type ProductPrepTimeSchema struct {
+	ProductID      string  `json:"product_id" example:"x-burger-duplo"`
+	Orders         int     `json:"orders" example:"35"`
+	AverageSeconds float64 `json:"average_seconds" example:"640"`
+	P90Seconds     float64 `json:"p90_seconds" example:"910"`
+}
+
+type PrepTimeReportResponseSchema struct {
+	From            time.Time               `json:"from" example:"2024-01-15T00:00:00Z"`
+	To              time.Time               `json:"to" example:"2024-01-21T23:59:59Z"`
+	GroupBy         string                  `json:"group_by" example:"day"`
+	Stages          []StageDurationSchema   `json:"stages"`
+	Buckets         []PrepTimeBucketSchema  `json:"buckets"`
+	SlowestProducts []ProductPrepTimeSchema `json:"slowest_products"`
+}
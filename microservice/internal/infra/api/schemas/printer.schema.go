@@ -0,0 +1,69 @@
+package schemas
+
+import "time"
+
+type CreatePrinterRequestSchema struct {
+	Name    string `json:"name" binding:"required" example:"Chapa"`
+	Host    string `json:"host" binding:"required" example:"192.168.0.50"`
+	Port    int    `json:"port" example:"9100"`
+	Station string `json:"station" binding:"required" example:"grill"`
+	Width   int    `json:"width" example:"48"`
+}
+
+type UpdatePrinterRequestSchema struct {
+	Name    string `json:"name" binding:"required" example:"Chapa"`
+	Host    string `json:"host" binding:"required" example:"192.168.0.50"`
+	Port    int    `json:"port" example:"9100"`
+	Station string `json:"station" binding:"required" example:"grill"`
+	Width   int    `json:"width" example:"48"`
+	Active  *bool  `json:"active" binding:"required" example:"true"`
+}
+
+type PrinterResponseSchema struct {
+	ID        string     `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Name      string     `json:"name" example:"Chapa"`
+	Host      string     `json:"host" example:"192.168.0.50"`
+	Port      int        `json:"port" example:"9100"`
+	Station   string     `json:"station" example:"grill"`
+	Width     int        `json:"width" example:"48"`
+	Active    bool       `json:"active" example:"true"`
+	CreatedAt time.Time  `json:"created_at" example:"2023-10-01T12:00:00Z"`
+	UpdatedAt *time.Time `json:"updated_at" example:"2023-10-01T12:00:00Z"`
+}
+
+type PrintRouteRequestSchema struct {
+	ProductPattern string `json:"product_pattern" binding:"required" example:"burger-*"`
+	Station        string `json:"station" binding:"required" example:"grill"`
+}
+
+type ReplacePrintRoutesRequestSchema struct {
+	Routes []PrintRouteRequestSchema `json:"routes" binding:"required,dive"`
+}
+
+type PrintRouteResponseSchema struct {
+	ID             string `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Position       int    `json:"position" example:"0"`
+	ProductPattern string `json:"product_pattern" example:"burger-*"`
+	Station        string `json:"station" example:"grill"`
+}
+
+type ReprintKitchenOrderRequestSchema struct {
+	PrinterID string `json:"printer_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+}
+
+type PrintJobResponseSchema struct {
+	ID             string     `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	KitchenOrderID string     `json:"kitchen_order_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	PrinterID      string     `json:"printer_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Station        string     `json:"station" example:"grill"`
+	ItemIDs        []string   `json:"item_ids" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Status         string     `json:"status" example:"printed"`
+	Attempts       int        `json:"attempts" example:"1"`
+	LastError      string     `json:"last_error,omitempty" example:"failed to connect to printer: connection refused"`
+	NextAttemptAt  time.Time  `json:"next_attempt_at" example:"2023-10-01T12:00:00Z"`
+	PrintedAt      *time.Time `json:"printed_at" example:"2023-10-01T12:00:00Z"`
+	Reprint        bool       `json:"reprint" example:"false"`
+	RequestedBy    *string    `json:"requested_by,omitempty" example:"user-42"`
+	CreatedAt      time.Time  `json:"created_at" example:"2023-10-01T12:00:00Z"`
+	UpdatedAt      *time.Time `json:"updated_at" example:"2023-10-01T12:00:00Z"`
+}
@@ -0,0 +1,19 @@
+package schemas
+
+// ProblemDetailsSchema documenta as respostas de erro application/problem+json (RFC 7807)
+type ProblemDetailsSchema struct {
+	Type     string                    `json:"type" example:"/problems/kitchen-order-not-found"`
+	Title    string                    `json:"title" example:"Kitchen order not found"`
+	Status   int                       `json:"status" example:"404"`
+	Detail   string                    `json:"detail,omitempty" example:"Kitchen Order not found"`
+	Instance string                    `json:"instance,omitempty" example:"/v1/kitchen-orders/123e4567-e89b-12d3-a456-426614174000"`
+	Code     string                    `json:"code" example:"kitchen-order-not-found"`
+	TraceID  string                    `json:"trace_id,omitempty" example:"4bf92f3577b34da6a3ce929d0e0e4736"`
+	Errors   []ProblemFieldErrorSchema `json:"errors,omitempty"`
+}
+
+type ProblemFieldErrorSchema struct {
+	Field   string `json:"field" example:"items[0].quantity"`
+	Code    string `json:"code" example:"required"`
+	Message string `json:"message" example:"is required"`
+}
@@ -0,0 +1,39 @@
+package schemas
+
+import "time"
+
+type CreateWebhookSubscriptionRequestSchema struct {
+	URL    string   `json:"url" binding:"required" example:"https://partner.example.com/webhooks/kitchen"`
+	Secret string   `json:"secret" example:"a-shared-secret-with-16-chars-or-more"`
+	Events []string `json:"events" example:"kitchen_order.ready,kitchen_order.finished"`
+}
+
+type UpdateWebhookSubscriptionRequestSchema struct {
+	URL    string   `json:"url" binding:"required" example:"https://partner.example.com/webhooks/kitchen"`
+	Events []string `json:"events" example:"kitchen_order.ready"`
+	Active *bool    `json:"active" binding:"required" example:"true"`
+}
+
+type WebhookSubscriptionResponseSchema struct {
+	ID                  string     `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	URL                 string     `json:"url" example:"https://partner.example.com/webhooks/kitchen"`
+	Secret              string     `json:"secret,omitempty" example:"4f9c2b..."`
+	Events              []string   `json:"events" example:"kitchen_order.ready"`
+	Active              bool       `json:"active" example:"true"`
+	ConsecutiveFailures int        `json:"consecutive_failures" example:"0"`
+	CreatedAt           time.Time  `json:"created_at" example:"2023-10-01T12:00:00Z"`
+	UpdatedAt           *time.Time `json:"updated_at" example:"2023-10-01T12:00:00Z"`
+}
+
+type WebhookDeliveryResponseSchema struct {
+	ID             string    `json:"id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	SubscriptionID string    `json:"subscription_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	EventID        string    `json:"event_id" example:"123e4567-e89b-12d3-a456-426614174000"`
+	Event          string    `json:"event" example:"kitchen_order.ready"`
+	Attempt        int       `json:"attempt" example:"1"`
+	StatusCode     int       `json:"status_code" example:"200"`
+	Success        bool      `json:"success" example:"true"`
+	Error          string    `json:"error,omitempty" example:"unexpected status code 500"`
+	DurationMs     int64     `json:"duration_ms" example:"120"`
+	CreatedAt      time.Time `json:"created_at" example:"2023-10-01T12:00:00Z"`
+}
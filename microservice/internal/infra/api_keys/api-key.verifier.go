@@ -0,0 +1,35 @@
+package api_keys
+
+import (
+	"context"
+
+	"tech_challenge/internal/application/controllers"
+	"tech_challenge/internal/shared/infra/auth"
+)
+
+// subjectPrefix diferencia serviços de usuários no histórico de quem alterou cada pedido. O Subject usa o ID da chave,
+// que não muda nem se repete; o nome é livre e serve só para exibição
+const subjectPrefix = "api-key:"
+
+type APIKeyVerifier struct {
+	apiKeyController controllers.APIKeyController
+}
+
+func NewAPIKeyVerifier(apiKeyController *controllers.APIKeyController) *APIKeyVerifier {
+	return &APIKeyVerifier{
+		apiKeyController: *apiKeyController,
+	}
+}
+
+func (v *APIKeyVerifier) VerifyAPIKey(_ context.Context, key string) (auth.Principal, error) {
+	apiKey, err := v.apiKeyController.Authenticate(key)
+	if err != nil {
+		return auth.Principal{}, err
+	}
+
+	return auth.Principal{
+		Subject: subjectPrefix + apiKey.ID,
+		Name:    apiKey.Name,
+		Scopes:  apiKey.Scopes,
+	}, nil
+}
@@ -0,0 +1,89 @@
+package api_keys
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tech_challenge/internal/application/controllers"
+	"tech_challenge/internal/daos"
+	"tech_challenge/internal/interfaces"
+	"tech_challenge/internal/shared/config/constants"
+	"tech_challenge/internal/shared/pkg/apikey"
+)
+
+type fakeAPIKeyDataSource struct {
+	keys []daos.APIKeyDAO
+}
+
+func (ds *fakeAPIKeyDataSource) Insert(apiKey daos.APIKeyDAO) error {
+	ds.keys = append(ds.keys, apiKey)
+	return nil
+}
+
+func (ds *fakeAPIKeyDataSource) FindByID(id string) (daos.APIKeyDAO, error) {
+	for _, apiKey := range ds.keys {
+		if apiKey.ID == id {
+			return apiKey, nil
+		}
+	}
+	return daos.APIKeyDAO{}, errors.New("record not found")
+}
+
+func (ds *fakeAPIKeyDataSource) FindByPrefix(prefix string) (daos.APIKeyDAO, error) {
+	for _, apiKey := range ds.keys {
+		if apiKey.Prefix == prefix {
+			return apiKey, nil
+		}
+	}
+	return daos.APIKeyDAO{}, interfaces.ErrAPIKeyNotFound
+}
+
+func (ds *fakeAPIKeyDataSource) FindAll() ([]daos.APIKeyDAO, error) {
+	return ds.keys, nil
+}
+
+func (ds *fakeAPIKeyDataSource) Revoke(id string, revokedAt time.Time) error {
+	return nil
+}
+
+func (ds *fakeAPIKeyDataSource) TouchLastUsed(id string, usedAt time.Time) error {
+	return nil
+}
+
+func TestAPIKeyVerifier_SubjectUsesKeyID(t *testing.T) {
+	dataSource := &fakeAPIKeyDataSource{}
+	verifier := NewAPIKeyVerifier(controllers.NewAPIKeyController(dataSource, time.Hour))
+
+	// Duas chaves com o mesmo nome não podem compartilhar identidade
+	var keys []string
+	for _, id := range []string{"6ba7b810-9dad-11d1-80b4-00c04fd430c8", "6ba7b811-9dad-11d1-80b4-00c04fd430c8"} {
+		key, prefix, err := apikey.Generate()
+		require.NoError(t, err)
+
+		_ = dataSource.Insert(daos.APIKeyDAO{
+			ID:        id,
+			Name:      "orders-service",
+			Prefix:    prefix,
+			KeyHash:   apikey.Hash(key),
+			Scopes:    []string{constants.API_KEY_SCOPE_KITCHEN_ORDERS_CREATE},
+			CreatedAt: time.Now(),
+		})
+		keys = append(keys, key)
+	}
+
+	first, err := verifier.VerifyAPIKey(context.Background(), keys[0])
+	require.NoError(t, err)
+	second, err := verifier.VerifyAPIKey(context.Background(), keys[1])
+	require.NoError(t, err)
+
+	assert.Equal(t, "api-key:6ba7b810-9dad-11d1-80b4-00c04fd430c8", first.Subject)
+	assert.Equal(t, "api-key:6ba7b811-9dad-11d1-80b4-00c04fd430c8", second.Subject)
+	assert.Equal(t, "orders-service", first.Name)
+	assert.Equal(t, []string{constants.API_KEY_SCOPE_KITCHEN_ORDERS_CREATE}, first.Scopes)
+	t.Log("✓ Subject da chave não depende do nome")
+}
@@ -0,0 +1,93 @@
+package data_sources
+
+import (
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	"tech_challenge/internal/daos"
+	"tech_challenge/internal/infra/database/mappers"
+	"tech_challenge/internal/infra/database/models"
+	"tech_challenge/internal/interfaces"
+	"tech_challenge/internal/shared/infra/database"
+)
+
+type GormAPIKeyDataSource struct {
+	db *gorm.DB
+}
+
+func NewGormAPIKeyDataSource() *GormAPIKeyDataSource {
+	return &GormAPIKeyDataSource{
+		db: database.GetDB(),
+	}
+}
+
+func (r *GormAPIKeyDataSource) Insert(apiKey daos.APIKeyDAO) error {
+	return r.db.Create(mappers.FromDAOToModelAPIKey(apiKey)).Error
+}
+
+func (r *GormAPIKeyDataSource) FindByID(id string) (daos.APIKeyDAO, error) {
+	var apiKey *models.APIKeyModel
+
+	if err := r.db.First(&apiKey, "id = ?", id).Error; err != nil {
+		return daos.APIKeyDAO{}, err
+	}
+
+	return mappers.FromModelToDAOAPIKey(apiKey), nil
+}
+
+func (r *GormAPIKeyDataSource) FindByPrefix(prefix string) (daos.APIKeyDAO, error) {
+	var apiKey *models.APIKeyModel
+
+	err := r.db.First(&apiKey, "prefix = ?", prefix).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return daos.APIKeyDAO{}, interfaces.ErrAPIKeyNotFound
+	}
+
+	if err != nil {
+		return daos.APIKeyDAO{}, err
+	}
+
+	return mappers.FromModelToDAOAPIKey(apiKey), nil
+}
+
+func (r *GormAPIKeyDataSource) FindAll() ([]daos.APIKeyDAO, error) {
+	var apiKeys []*models.APIKeyModel
+
+	if err := r.db.Order("created_at ASC").Find(&apiKeys).Error; err != nil {
+		return nil, err
+	}
+
+	return mappers.FromModelArrayToDAOArrayAPIKey(apiKeys), nil
+}
+
+// Revoke não sobrescreve a data de uma chave já revogada
+func (r *GormAPIKeyDataSource) Revoke(id string, revokedAt time.Time) error {
+	result := r.db.Model(&models.APIKeyModel{}).
+		Where("id = ? AND revoked_at IS NULL", id).
+		Update("revoked_at", revokedAt)
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		var count int64
+		if err := r.db.Model(&models.APIKeyModel{}).Where("id = ?", id).Count(&count).Error; err != nil {
+			return err
+		}
+
+		if count == 0 {
+			return gorm.ErrRecordNotFound
+		}
+	}
+
+	return nil
+}
+
+func (r *GormAPIKeyDataSource) TouchLastUsed(id string, usedAt time.Time) error {
+	return r.db.Model(&models.APIKeyModel{}).
+		Where("id = ?", id).
+		Update("last_used_at", usedAt).Error
+}
@@ -0,0 +1,112 @@
+package data_sources
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"tech_challenge/internal/daos"
+	"tech_challenge/internal/infra/database/models"
+	"tech_challenge/internal/interfaces"
+	"tech_challenge/internal/shared/config/constants"
+)
+
+func setupAPIKeyTestDB(t *testing.T) *GormAPIKeyDataSource {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("Failed to connect to test database: %v", err)
+	}
+
+	if err := db.AutoMigrate(&models.APIKeyModel{}); err != nil {
+		t.Fatalf("Failed to migrate test database: %v", err)
+	}
+
+	return &GormAPIKeyDataSource{db: db}
+}
+
+func newAPIKeyDAO(id, prefix string) daos.APIKeyDAO {
+	return daos.APIKeyDAO{
+		ID:        id,
+		Name:      "orders-service",
+		Prefix:    prefix,
+		KeyHash:   "hash-" + prefix,
+		Scopes:    []string{constants.API_KEY_SCOPE_KITCHEN_ORDERS_READ, constants.API_KEY_SCOPE_KITCHEN_ORDERS_CREATE},
+		CreatedAt: time.Now(),
+	}
+}
+
+func TestGormAPIKeyDataSource_InsertAndFind(t *testing.T) {
+	dataSource := setupAPIKeyTestDB(t)
+
+	if err := dataSource.Insert(newAPIKeyDAO("key-1", "0123456789ab")); err != nil {
+		t.Fatalf("Insert failed: %v", err)
+	}
+
+	if err := dataSource.Insert(newAPIKeyDAO("key-2", "0123456789ab")); err == nil {
+		t.Error("Expected duplicated prefix to be rejected")
+	}
+
+	apiKey, err := dataSource.FindByPrefix("0123456789ab")
+	if err != nil {
+		t.Fatalf("FindByPrefix failed: %v", err)
+	}
+
+	if apiKey.ID != "key-1" || len(apiKey.Scopes) != 2 || apiKey.Scopes[1] != constants.API_KEY_SCOPE_KITCHEN_ORDERS_CREATE {
+		t.Errorf("Unexpected API key %+v", apiKey)
+	}
+
+	if _, err := dataSource.FindByPrefix("unknown"); !errors.Is(err, interfaces.ErrAPIKeyNotFound) {
+		t.Errorf("Expected record not found, got %v", err)
+	} else {
+		t.Log("✓ API key localizada pelo prefixo")
+	}
+}
+
+func TestGormAPIKeyDataSource_Revoke(t *testing.T) {
+	dataSource := setupAPIKeyTestDB(t)
+	dataSource.Insert(newAPIKeyDAO("key-1", "0123456789ab"))
+
+	first := time.Now().Add(-time.Hour).UTC()
+	if err := dataSource.Revoke("key-1", first); err != nil {
+		t.Fatalf("Revoke failed: %v", err)
+	}
+
+	if err := dataSource.Revoke("key-1", time.Now()); err != nil {
+		t.Fatalf("Second revoke failed: %v", err)
+	}
+
+	apiKey, _ := dataSource.FindByID("key-1")
+	if apiKey.RevokedAt == nil || !apiKey.RevokedAt.Equal(first) {
+		t.Errorf("Expected revocation date %v, got %v", first, apiKey.RevokedAt)
+	}
+
+	if err := dataSource.Revoke("missing", time.Now()); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Errorf("Expected record not found, got %v", err)
+	} else {
+		t.Log("✓ Revogação preserva a primeira data")
+	}
+}
+
+func TestGormAPIKeyDataSource_TouchLastUsed(t *testing.T) {
+	dataSource := setupAPIKeyTestDB(t)
+	dataSource.Insert(newAPIKeyDAO("key-1", "0123456789ab"))
+
+	usedAt := time.Now().UTC()
+	if err := dataSource.TouchLastUsed("key-1", usedAt); err != nil {
+		t.Fatalf("TouchLastUsed failed: %v", err)
+	}
+
+	apiKeys, err := dataSource.FindAll()
+	if err != nil || len(apiKeys) != 1 {
+		t.Fatalf("Expected 1 API key, got %d (%v)", len(apiKeys), err)
+	}
+
+	if apiKeys[0].LastUsedAt == nil || !apiKeys[0].LastUsedAt.Equal(usedAt) {
+		t.Errorf("Expected last used %v, got %v", usedAt, apiKeys[0].LastUsedAt)
+	} else {
+		t.Log("✓ Último uso registrado")
+	}
+}
@@ -0,0 +1,91 @@
+package data_sources
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"tech_challenge/internal/daos"
+	"tech_challenge/internal/infra/database/mappers"
+	"tech_challenge/internal/infra/database/models"
+	"tech_challenge/internal/shared/infra/database"
+)
+
+type GormIdempotencyKeyDataSource struct {
+	db *gorm.DB
+}
+
+func NewGormIdempotencyKeyDataSource() *GormIdempotencyKeyDataSource {
+	return &GormIdempotencyKeyDataSource{
+		db: database.GetDB(),
+	}
+}
+
+// Reserve grava a chave apenas se ela ainda não existir; retorna false quando outra requisição já a reservou
+func (r *GormIdempotencyKeyDataSource) Reserve(idempotencyKey daos.IdempotencyKeyDAO) (bool, error) {
+	result := r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(mappers.FromDAOToModelIdempotencyKey(idempotencyKey))
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
+// Reclaim assume uma reserva abandonada (sem recurso e criada antes de staleBefore), por exemplo após uma queda no meio da criação
+func (r *GormIdempotencyKeyDataSource) Reclaim(idempotencyKey daos.IdempotencyKeyDAO, staleBefore time.Time) (bool, error) {
+	result := r.db.Model(&models.IdempotencyKeyModel{}).
+		Where("scope = ? AND idempotency_key = ? AND resource_id IS NULL AND created_at < ?", idempotencyKey.Scope, idempotencyKey.Key, staleBefore).
+		Updates(map[string]any{
+			"fingerprint": idempotencyKey.Fingerprint,
+			"created_at":  idempotencyKey.CreatedAt,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
+// ReclaimExpired reaproveita uma chave que passou do TTL e ainda não foi removida pela limpeza periódica
+func (r *GormIdempotencyKeyDataSource) ReclaimExpired(idempotencyKey daos.IdempotencyKeyDAO, expiredBefore time.Time) (bool, error) {
+	result := r.db.Model(&models.IdempotencyKeyModel{}).
+		Where("scope = ? AND idempotency_key = ? AND created_at < ?", idempotencyKey.Scope, idempotencyKey.Key, expiredBefore).
+		Updates(map[string]any{
+			"fingerprint": idempotencyKey.Fingerprint,
+			"resource_id": nil,
+			"created_at":  idempotencyKey.CreatedAt,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
+func (r *GormIdempotencyKeyDataSource) FindByKey(scope, key string) (daos.IdempotencyKeyDAO, error) {
+	var idempotencyKey models.IdempotencyKeyModel
+
+	if err := r.db.Where("scope = ? AND idempotency_key = ?", scope, key).First(&idempotencyKey).Error; err != nil {
+		return daos.IdempotencyKeyDAO{}, err
+	}
+
+	return mappers.FromModelToDAOIdempotencyKey(&idempotencyKey), nil
+}
+
+func (r *GormIdempotencyKeyDataSource) Complete(scope, key, resourceID string) error {
+	return r.db.Model(&models.IdempotencyKeyModel{}).
+		Where("scope = ? AND idempotency_key = ?", scope, key).
+		Update("resource_id", resourceID).Error
+}
+
+// Release remove uma reserva pendente para que a requisição possa ser repetida após uma falha
+func (r *GormIdempotencyKeyDataSource) Release(scope, key string) error {
+	return r.db.Where("scope = ? AND idempotency_key = ? AND resource_id IS NULL", scope, key).Delete(&models.IdempotencyKeyModel{}).Error
+}
+
+func (r *GormIdempotencyKeyDataSource) DeleteOlderThan(before time.Time) (int64, error) {
+	result := r.db.Where("created_at < ?", before).Delete(&models.IdempotencyKeyModel{})
+
+	return result.RowsAffected, result.Error
+}
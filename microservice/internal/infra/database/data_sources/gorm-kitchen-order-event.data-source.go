@@ -0,0 +1,62 @@
+package data_sources
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"tech_challenge/internal/daos"
+	"tech_challenge/internal/infra/database/mappers"
+	"tech_challenge/internal/infra/database/models"
+	"tech_challenge/internal/shared/infra/database"
+)
+
+type GormKitchenOrderEventDataSource struct {
+	db *gorm.DB
+}
+
+func NewGormKitchenOrderEventDataSource() *GormKitchenOrderEventDataSource {
+	return &GormKitchenOrderEventDataSource{
+		db: database.GetDB(),
+	}
+}
+
+func (r *GormKitchenOrderEventDataSource) FindAfter(afterID uint64, limit int) ([]daos.KitchenOrderEventDAO, error) {
+	var events []*models.KitchenOrderEventModel
+
+	if err := r.db.Where("id > ?", afterID).Order("id ASC").Limit(limit).Find(&events).Error; err != nil {
+		return nil, err
+	}
+
+	return mappers.FromModelArrayToDAOArrayKitchenOrderEvent(events), nil
+}
+
+func (r *GormKitchenOrderEventDataSource) FindByIDs(ids []uint64) ([]daos.KitchenOrderEventDAO, error) {
+	var events []*models.KitchenOrderEventModel
+
+	if len(ids) == 0 {
+		return []daos.KitchenOrderEventDAO{}, nil
+	}
+
+	if err := r.db.Where("id IN ?", ids).Order("id ASC").Find(&events).Error; err != nil {
+		return nil, err
+	}
+
+	return mappers.FromModelArrayToDAOArrayKitchenOrderEvent(events), nil
+}
+
+func (r *GormKitchenOrderEventDataSource) FindLastID() (uint64, error) {
+	var lastID uint64
+
+	if err := r.db.Model(&models.KitchenOrderEventModel{}).Select("COALESCE(MAX(id), 0)").Scan(&lastID).Error; err != nil {
+		return 0, err
+	}
+
+	return lastID, nil
+}
+
+func (r *GormKitchenOrderEventDataSource) DeleteOlderThan(before time.Time) (int64, error) {
+	result := r.db.Where("created_at < ?", before).Delete(&models.KitchenOrderEventModel{})
+
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,214 @@
+package data_sources
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"tech_challenge/internal/daos"
+	"tech_challenge/internal/infra/database/models"
+	"tech_challenge/internal/shared/config/constants"
+)
+
+func TestGormKitchenOrderEventDataSource_RecordsOrderChanges(t *testing.T) {
+	db := setupTestDB(t)
+	orders := &GormKitchenOrderDataSource{db: db}
+	events := &GormKitchenOrderEventDataSource{db: db}
+
+	lastID, err := events.FindLastID()
+	if err != nil || lastID != 0 {
+		t.Fatalf("Expected empty feed, got %d (%v)", lastID, err)
+	}
+
+	kitchenOrder := daos.KitchenOrderDAO{
+		ID:        "order-123",
+		OrderID:   "ext-order-123",
+		Slug:      "001",
+		Status:    daos.OrderStatusDAO{ID: constants.KITCHEN_ORDER_STATUS_RECEIVED_ID, Name: "Recebido"},
+		Version:   1,
+		CreatedAt: time.Now(),
+	}
+	if err := orders.Insert(context.Background(), kitchenOrder); err != nil {
+		t.Fatalf("Insert failed: %v", err)
+	}
+
+	updatedAt := time.Now()
+	kitchenOrder.UpdatedAt = &updatedAt
+	kitchenOrder.Status = daos.OrderStatusDAO{ID: constants.KITCHEN_ORDER_STATUS_READY_ID, Name: "Pronto"}
+	if err := orders.Update(context.Background(), kitchenOrder); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+	kitchenOrder.Version++
+
+	// Atualização sem mudança de status não gera evento
+	if err := orders.Update(context.Background(), kitchenOrder); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+	kitchenOrder.Version++
+
+	kitchenOrder.Status = daos.OrderStatusDAO{ID: constants.KITCHEN_ORDER_STATUS_FINISHED_ID, Name: "Finalizado"}
+	if err := orders.Update(context.Background(), kitchenOrder); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+
+	result, err := events.FindAfter(0, 10)
+	if err != nil {
+		t.Fatalf("FindAfter failed: %v", err)
+	}
+
+	expectedTypes := []string{
+		constants.KITCHEN_ORDER_EVENT_CREATED,
+		constants.KITCHEN_ORDER_EVENT_STATUS_CHANGED,
+		constants.KITCHEN_ORDER_EVENT_REMOVED,
+	}
+	if len(result) != len(expectedTypes) {
+		t.Fatalf("Expected %d events, got %d", len(expectedTypes), len(result))
+	}
+
+	for i, event := range result {
+		if event.Type != expectedTypes[i] {
+			t.Errorf("Expected event %d to be %s, got %s", i, expectedTypes[i], event.Type)
+		}
+	}
+
+	if result[1].StatusName != "Pronto" || result[1].Slug != "001" {
+		t.Errorf("Expected snapshot of the order, got %+v", result[1])
+	}
+
+	after, _ := events.FindAfter(result[0].ID, 10)
+	if len(after) != 2 {
+		t.Errorf("Expected 2 events after the first, got %d", len(after))
+	}
+
+	lastID, _ = events.FindLastID()
+	if lastID != result[2].ID {
+		t.Errorf("Expected last ID %d, got %d", result[2].ID, lastID)
+	} else {
+		t.Log("✓ Alterações de pedidos registradas no feed")
+	}
+}
+
+func TestGormKitchenOrderEventDataSource_DeleteOlderThan(t *testing.T) {
+	db := setupTestDB(t)
+	events := &GormKitchenOrderEventDataSource{db: db}
+
+	db.Create(&models.KitchenOrderEventModel{Type: "created", KitchenOrderID: "a", OrderID: "a", Slug: "001", StatusID: "s", StatusName: "Recebido", CreatedAt: time.Now().Add(-48 * time.Hour)})
+	db.Create(&models.KitchenOrderEventModel{Type: "created", KitchenOrderID: "b", OrderID: "b", Slug: "002", StatusID: "s", StatusName: "Recebido", CreatedAt: time.Now()})
+
+	deleted, err := events.DeleteOlderThan(time.Now().Add(-24 * time.Hour))
+	if err != nil {
+		t.Fatalf("DeleteOlderThan failed: %v", err)
+	}
+
+	if deleted != 1 {
+		t.Errorf("Expected 1 event deleted, got %d", deleted)
+	} else {
+		t.Log("✓ Eventos antigos removidos")
+	}
+}
+
+func TestGormKitchenOrderEventDataSource_FindByIDs(t *testing.T) {
+	db := setupTestDB(t)
+	events := &GormKitchenOrderEventDataSource{db: db}
+
+	for _, id := range []uint64{1, 2, 3} {
+		db.Create(&models.KitchenOrderEventModel{ID: id, Type: "created", KitchenOrderID: "a", OrderID: "a", Slug: "001", StatusID: "s", StatusName: "Recebido", CreatedAt: time.Now()})
+	}
+
+	result, err := events.FindByIDs([]uint64{3, 1, 7})
+	if err != nil {
+		t.Fatalf("FindByIDs failed: %v", err)
+	}
+
+	if len(result) != 2 || result[0].ID != 1 || result[1].ID != 3 {
+		t.Errorf("Expected events 1 and 3, got %+v", result)
+	} else {
+		t.Log("✓ Eventos buscados pelos IDs faltantes")
+	}
+}
+
+func TestGormKitchenOrderEventDataSource_RecordsStatusReason(t *testing.T) {
+	db := setupTestDB(t)
+	orders := &GormKitchenOrderDataSource{db: db}
+	events := &GormKitchenOrderEventDataSource{db: db}
+
+	kitchenOrder := daos.KitchenOrderDAO{
+		ID:        "order-123",
+		OrderID:   "ext-order-123",
+		Slug:      "001",
+		Status:    daos.OrderStatusDAO{ID: constants.KITCHEN_ORDER_STATUS_FINISHED_ID, Name: "Finalizado"},
+		Version:   1,
+		CreatedAt: time.Now(),
+	}
+	if err := orders.Insert(context.Background(), kitchenOrder); err != nil {
+		t.Fatalf("Insert failed: %v", err)
+	}
+
+	reason := "Cliente devolveu o lanche frio"
+	kitchenOrder.Status = daos.OrderStatusDAO{ID: constants.KITCHEN_ORDER_STATUS_PREPARING_ID, Name: "Em preparação"}
+	kitchenOrder.StatusReason = &reason
+	if err := orders.Update(context.Background(), kitchenOrder); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+
+	var stored models.KitchenOrderModel
+	db.First(&stored, "id = ?", kitchenOrder.ID)
+	if stored.StatusReason == nil || *stored.StatusReason != reason {
+		t.Errorf("Expected status reason %q on the order, got %v", reason, stored.StatusReason)
+	}
+
+	result, err := events.FindAfter(0, 10)
+	if err != nil || len(result) != 2 {
+		t.Fatalf("Expected 2 events, got %d (%v)", len(result), err)
+	}
+
+	if result[1].Reason == nil || *result[1].Reason != reason {
+		t.Errorf("Expected status reason %q on the event, got %v", reason, result[1].Reason)
+	} else {
+		t.Log("✓ Motivo da mudança de status registrado no pedido e no feed")
+	}
+}
+
+func TestGormKitchenOrderEventDataSource_RecordsStatusActor(t *testing.T) {
+	db := setupTestDB(t)
+	orders := &GormKitchenOrderDataSource{db: db}
+	events := &GormKitchenOrderEventDataSource{db: db}
+
+	kitchenOrder := daos.KitchenOrderDAO{
+		ID:        "order-123",
+		OrderID:   "ext-order-123",
+		Slug:      "001",
+		Status:    daos.OrderStatusDAO{ID: constants.KITCHEN_ORDER_STATUS_RECEIVED_ID, Name: "Recebido"},
+		Version:   1,
+		CreatedAt: time.Now(),
+	}
+	if err := orders.Insert(context.Background(), kitchenOrder); err != nil {
+		t.Fatalf("Insert failed: %v", err)
+	}
+
+	actor := "cook-42"
+	kitchenOrder.Status = daos.OrderStatusDAO{ID: constants.KITCHEN_ORDER_STATUS_PREPARING_ID, Name: "Em preparação"}
+	kitchenOrder.StatusChangedBy = &actor
+	if err := orders.Update(context.Background(), kitchenOrder); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+
+	stored, err := orders.FindByID(context.Background(), kitchenOrder.ID)
+	if err != nil {
+		t.Fatalf("FindByID failed: %v", err)
+	}
+	if stored.StatusChangedBy == nil || *stored.StatusChangedBy != actor {
+		t.Errorf("Expected actor %q on the order, got %v", actor, stored.StatusChangedBy)
+	}
+
+	result, err := events.FindAfter(0, 10)
+	if err != nil || len(result) != 2 {
+		t.Fatalf("Expected 2 events, got %d (%v)", len(result), err)
+	}
+
+	if result[1].Actor == nil || *result[1].Actor != actor {
+		t.Errorf("Expected actor %q on the event, got %v", actor, result[1].Actor)
+	} else {
+		t.Log("✓ Ator da mudança de status registrado no pedido e no feed")
+	}
+}
@@ -1,54 +1,195 @@
 package data_sources
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
 	"gorm.io/gorm"
 
 	"tech_challenge/internal/application/dtos"
 	"tech_challenge/internal/daos"
 	"tech_challenge/internal/infra/database/mappers"
 	"tech_challenge/internal/infra/database/models"
+	"tech_challenge/internal/interfaces"
+	"tech_challenge/internal/shared/config/constants"
 	"tech_challenge/internal/shared/infra/database"
+	"tech_challenge/internal/shared/pkg/cursor"
 )
 
 type GormKitchenOrderDataSource struct {
-	db *gorm.DB
+	db       *gorm.DB
+	timeouts database.QueryTimeouts
 }
 
 func NewGormKitchenOrderDataSource() *GormKitchenOrderDataSource {
 	return &GormKitchenOrderDataSource{
-		db: database.GetDB(),
+		db:       database.GetDB(),
+		timeouts: database.GetQueryTimeouts(),
 	}
 }
 
-func (r *GormKitchenOrderDataSource) Insert(kitchenOrder daos.KitchenOrderDAO) error {
+func (r *GormKitchenOrderDataSource) Insert(ctx context.Context, kitchenOrder daos.KitchenOrderDAO) error {
+	ctx, cancel := database.WithTimeout(ctx, r.timeouts.Write)
+	defer cancel()
+
 	kitchenOrderModel := mappers.FromDAOToModelKitchenOrder(kitchenOrder)
 
-	return r.db.Transaction(func(tx *gorm.DB) error {
+	return database.Conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
 		if err := tx.Create(&kitchenOrderModel).Error; err != nil {
 			return err
 		}
 
-		return nil
+		// O evento é gravado na mesma transação para que o feed nunca divirja dos pedidos
+		event := mappers.NewKitchenOrderEventModel(constants.KITCHEN_ORDER_EVENT_CREATED, kitchenOrder, kitchenOrder.CreatedAt)
+
+		return tx.Create(event).Error
 	})
 }
 
-func (r *GormKitchenOrderDataSource) FindAll(filter dtos.KitchenOrderFilter) ([]daos.KitchenOrderDAO, error) {
+// boardStatusOrder define a ordem de exibição do quadro da cozinha
+var boardStatusOrder = []string{"Pronto", "Em preparação", "Recebido"}
+
+// kitchenOrderPosition é a posição do último pedido de uma página, codificada no cursor
+type kitchenOrderPosition struct {
+	Sort      string    `json:"s"`
+	Rank      int       `json:"r,omitempty"`
+	CreatedAt time.Time `json:"c"`
+	ID        string    `json:"i"`
+}
+
+func (r *GormKitchenOrderDataSource) FindAll(ctx context.Context, filter dtos.KitchenOrderFilter) ([]daos.KitchenOrderDAO, dtos.PageInfo, error) {
+	ctx, cancel := database.WithTimeout(ctx, r.timeouts.Read)
+	defer cancel()
+
 	var kitchenOrders []*models.KitchenOrderModel
 
-	query := r.db.
-		Joins("JOIN order_status ON kitchen_order.status_id = order_status.id").
+	sort := filter.Sort
+	if sort == "" {
+		sort = constants.KITCHEN_ORDER_SORT_BOARD
+	}
+
+	query := r.applyFilters(database.Conn(ctx, r.db).Model(&models.KitchenOrderModel{}), filter)
+
+	var total int64
+	if err := query.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, dtos.PageInfo{}, err
+	}
+
+	query = query.Session(&gorm.Session{}).
 		Preload("Status").
-		Preload("Items").
-		Where("order_status.name <> ?", "Finalizado").
-		Order(`
-            CASE order_status.name
-                WHEN 'Pronto' THEN 1
-                WHEN 'Em preparação' THEN 2
-                WHEN 'Recebido' THEN 3
-                ELSE 4
-            END
-        `).
-		Order("kitchen_order.created_at ASC")
+		Preload("Items")
+
+	if filter.Cursor != "" {
+		var position kitchenOrderPosition
+		if err := cursor.Decode(filter.Cursor, &position); err != nil || position.Sort != sort {
+			return nil, dtos.PageInfo{}, cursor.ErrInvalidCursor
+		}
+
+		query = r.applyCursor(query, sort, position)
+	}
+
+	query = r.applyOrder(query, sort)
+
+	if filter.Limit > 0 {
+		// Busca um registro a mais para saber se existe próxima página
+		query = query.Limit(filter.Limit + 1)
+	}
+
+	if err := query.Find(&kitchenOrders).Error; err != nil {
+		return nil, dtos.PageInfo{}, err
+	}
+
+	pageInfo := dtos.PageInfo{Total: total}
+
+	if filter.Limit > 0 && len(kitchenOrders) > filter.Limit {
+		kitchenOrders = kitchenOrders[:filter.Limit]
+		last := kitchenOrders[len(kitchenOrders)-1]
+
+		nextCursor, err := cursor.Encode(kitchenOrderPosition{
+			Sort:      sort,
+			Rank:      boardRank(last.Status.Name),
+			CreatedAt: last.CreatedAt,
+			ID:        last.ID,
+		})
+		if err != nil {
+			return nil, dtos.PageInfo{}, err
+		}
+
+		pageInfo.NextCursor = &nextCursor
+	}
+
+	return mappers.FromModelArrayToDAOArrayKitchenOrder(kitchenOrders), pageInfo, nil
+}
+
+// FindAllInBatches percorre os pedidos do filtro em lotes pela paginação por chave, sem carregar tudo em memória;
+// o timeout vale para a leitura de cada lote, já que a exportação inteira dura o quanto o cliente levar para baixá-la
+func (r *GormKitchenOrderDataSource) FindAllInBatches(ctx context.Context, filter dtos.KitchenOrderFilter, batchSize int, handle func([]daos.KitchenOrderDAO) error) error {
+	sort := filter.Sort
+	if sort == "" {
+		sort = constants.KITCHEN_ORDER_SORT_CREATED_AT
+	}
+
+	var position *kitchenOrderPosition
+
+	for {
+		kitchenOrders, err := r.findBatch(ctx, filter, sort, position, batchSize)
+		if err != nil {
+			return err
+		}
+
+		if len(kitchenOrders) == 0 {
+			return nil
+		}
+
+		if err := handle(mappers.FromModelArrayToDAOArrayKitchenOrder(kitchenOrders)); err != nil {
+			return err
+		}
+
+		if len(kitchenOrders) < batchSize {
+			return nil
+		}
+
+		last := kitchenOrders[len(kitchenOrders)-1]
+		position = &kitchenOrderPosition{
+			Sort:      sort,
+			Rank:      boardRank(last.Status.Name),
+			CreatedAt: last.CreatedAt,
+			ID:        last.ID,
+		}
+	}
+}
+
+func (r *GormKitchenOrderDataSource) findBatch(ctx context.Context, filter dtos.KitchenOrderFilter, sort string, position *kitchenOrderPosition, batchSize int) ([]*models.KitchenOrderModel, error) {
+	ctx, cancel := database.WithTimeout(ctx, r.timeouts.Batch)
+	defer cancel()
+
+	var kitchenOrders []*models.KitchenOrderModel
+
+	query := r.applyFilters(database.Conn(ctx, r.db).Model(&models.KitchenOrderModel{}), filter).
+		Preload("Status").
+		Preload("Items")
+
+	if position != nil {
+		query = r.applyCursor(query, sort, *position)
+	}
+
+	if err := r.applyOrder(query, sort).Limit(batchSize).Find(&kitchenOrders).Error; err != nil {
+		return nil, err
+	}
+
+	return kitchenOrders, nil
+}
+
+func (r *GormKitchenOrderDataSource) applyFilters(query *gorm.DB, filter dtos.KitchenOrderFilter) *gorm.DB {
+	query = query.Joins("JOIN order_status ON kitchen_order.status_id = order_status.id")
+
+	// Pedidos finalizados ficam fora do quadro, exceto quando pedidos explicitamente
+	if !filter.IncludeFinished && len(filter.StatusIDs) == 0 {
+		query = query.Where("order_status.name <> ?", "Finalizado")
+	}
 
 	if filter.CreatedAtFrom != nil {
 		query = query.Where("kitchen_order.created_at >= ?", *filter.CreatedAtFrom)
@@ -58,43 +199,196 @@ func (r *GormKitchenOrderDataSource) FindAll(filter dtos.KitchenOrderFilter) ([]
 		query = query.Where("kitchen_order.created_at <= ?", *filter.CreatedAtTo)
 	}
 
-	if filter.StatusID != nil {
-		query = query.Where("kitchen_order.status_id = ?", *filter.StatusID)
+	if len(filter.StatusIDs) > 0 {
+		query = query.Where("kitchen_order.status_id IN ?", filter.StatusIDs)
 	}
 
-	if err := query.Find(&kitchenOrders).Error; err != nil {
-		return nil, err
+	if filter.OrderID != nil {
+		query = query.Where("kitchen_order.order_id = ?", *filter.OrderID)
+	}
+
+	if filter.Slug != nil {
+		query = query.Where("kitchen_order.slug = ?", *filter.Slug)
+	}
+
+	if filter.CustomerID != nil {
+		query = query.Where("kitchen_order.customer_id = ?", *filter.CustomerID)
 	}
 
-	return mappers.FromModelArrayToDAOArrayKitchenOrder(kitchenOrders), nil
+	return query
 }
 
-func (r *GormKitchenOrderDataSource) FindByID(id string) (daos.KitchenOrderDAO, error) {
+func (r *GormKitchenOrderDataSource) applyCursor(query *gorm.DB, sort string, position kitchenOrderPosition) *gorm.DB {
+	switch sort {
+	case constants.KITCHEN_ORDER_SORT_CREATED_AT_DESC:
+		return query.Where(
+			"kitchen_order.created_at < ? OR (kitchen_order.created_at = ? AND kitchen_order.id < ?)",
+			position.CreatedAt, position.CreatedAt, position.ID,
+		)
+	case constants.KITCHEN_ORDER_SORT_CREATED_AT:
+		return query.Where(
+			"kitchen_order.created_at > ? OR (kitchen_order.created_at = ? AND kitchen_order.id > ?)",
+			position.CreatedAt, position.CreatedAt, position.ID,
+		)
+	default:
+		rank := boardRankExpression()
+		return query.Where(
+			rank+" > ? OR ("+rank+" = ? AND (kitchen_order.created_at > ? OR (kitchen_order.created_at = ? AND kitchen_order.id > ?)))",
+			position.Rank, position.Rank, position.CreatedAt, position.CreatedAt, position.ID,
+		)
+	}
+}
+
+func (r *GormKitchenOrderDataSource) applyOrder(query *gorm.DB, sort string) *gorm.DB {
+	switch sort {
+	case constants.KITCHEN_ORDER_SORT_CREATED_AT_DESC:
+		return query.Order("kitchen_order.created_at DESC").Order("kitchen_order.id DESC")
+	case constants.KITCHEN_ORDER_SORT_CREATED_AT:
+		return query.Order("kitchen_order.created_at ASC").Order("kitchen_order.id ASC")
+	default:
+		return query.
+			Order(boardRankExpression()).
+			Order("kitchen_order.created_at ASC").
+			Order("kitchen_order.id ASC")
+	}
+}
+
+func boardRank(statusName string) int {
+	for i, name := range boardStatusOrder {
+		if name == statusName {
+			return i + 1
+		}
+	}
+
+	return len(boardStatusOrder) + 1
+}
+
+func boardRankExpression() string {
+	var expression strings.Builder
+
+	expression.WriteString("(CASE order_status.name")
+	for _, name := range boardStatusOrder {
+		fmt.Fprintf(&expression, " WHEN '%s' THEN %d", name, boardRank(name))
+	}
+	fmt.Fprintf(&expression, " ELSE %d END)", len(boardStatusOrder)+1)
+
+	return expression.String()
+}
+
+func (r *GormKitchenOrderDataSource) FindByID(ctx context.Context, id string) (daos.KitchenOrderDAO, error) {
+	ctx, cancel := database.WithTimeout(ctx, r.timeouts.Read)
+	defer cancel()
+
 	var kitchenOrder *models.KitchenOrderModel
 
-	if err := r.db.Preload("Status").Preload("Items").First(&kitchenOrder, "id = ?", id).Error; err != nil {
+	if err := database.Conn(ctx, r.db).Preload("Status").Preload("Items").First(&kitchenOrder, "id = ?", id).Error; err != nil {
 		return daos.KitchenOrderDAO{}, err
 	}
 
 	return mappers.FromModelToDAOKitchenOrder(kitchenOrder), nil
 }
 
-func (r *GormKitchenOrderDataSource) Update(kitchenOrder daos.KitchenOrderDAO) error {
-	var existing models.KitchenOrderModel
-	if err := r.db.First(&existing, "id = ?", kitchenOrder.ID).Error; err != nil {
-		return err
-	}
+func (r *GormKitchenOrderDataSource) Update(ctx context.Context, kitchenOrder daos.KitchenOrderDAO) error {
+	ctx, cancel := database.WithTimeout(ctx, r.timeouts.Write)
+	defer cancel()
 
-	updates := map[string]interface{}{
-		"status_id":  kitchenOrder.Status.ID,
-		"updated_at": kitchenOrder.UpdatedAt,
-	}
+	return database.Conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		var existing models.KitchenOrderModel
+		if err := tx.First(&existing, "id = ?", kitchenOrder.ID).Error; err != nil {
+			return err
+		}
+
+		updates := map[string]interface{}{
+			"status_id":         kitchenOrder.Status.ID,
+			"status_reason":     kitchenOrder.StatusReason,
+			"status_changed_by": kitchenOrder.StatusChangedBy,
+			"updated_at":        kitchenOrder.UpdatedAt,
+			"version":           gorm.Expr("version + 1"),
+		}
 
-	return r.db.Model(&models.KitchenOrderModel{}).
-		Where("id = ?", kitchenOrder.ID).
-		Updates(updates).Error
+		statusChanged := existing.StatusID != kitchenOrder.Status.ID
+
+		occurredAt := time.Now()
+		if kitchenOrder.UpdatedAt != nil {
+			occurredAt = *kitchenOrder.UpdatedAt
+		}
+
+		if statusChanged {
+			for column, value := range statusTimestampUpdates(kitchenOrder.Status.ID, occurredAt) {
+				updates[column] = value
+			}
+		}
+
+		// A condição na versão impede que duas alterações concorrentes sobrescrevam uma à outra
+		result := tx.Model(&models.KitchenOrderModel{}).
+			Where("id = ? AND version = ?", kitchenOrder.ID, kitchenOrder.Version).
+			Updates(updates)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return interfaces.ErrKitchenOrderVersionConflict
+		}
+
+		if !statusChanged {
+			return nil
+		}
+
+		eventType := constants.KITCHEN_ORDER_EVENT_STATUS_CHANGED
+		if kitchenOrder.Status.ID == constants.KITCHEN_ORDER_STATUS_FINISHED_ID {
+			// Pedido finalizado sai do quadro
+			eventType = constants.KITCHEN_ORDER_EVENT_REMOVED
+		}
+
+		return tx.Create(mappers.NewKitchenOrderEventModel(eventType, kitchenOrder, occurredAt)).Error
+	})
+}
+
+// Transaction agrupa a gravação do pedido com o que depende dela, como as entregas de webhook e as comandas;
+// os data sources que usam database.Conn participam da mesma transação
+func (r *GormKitchenOrderDataSource) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	ctx, cancel := database.WithTimeout(ctx, r.timeouts.Write)
+	defer cancel()
+
+	return database.Transaction(ctx, r.db, fn)
+}
+
+// statusTimestampUpdates marca quando o pedido entrou no novo status; voltar a uma etapa anterior, como no recall, limpa as seguintes
+func statusTimestampUpdates(statusID string, occurredAt time.Time) map[string]interface{} {
+	switch statusID {
+	case constants.KITCHEN_ORDER_STATUS_RECEIVED_ID:
+		return map[string]interface{}{"preparing_at": nil, "ready_at": nil, "finished_at": nil}
+	case constants.KITCHEN_ORDER_STATUS_PREPARING_ID:
+		return map[string]interface{}{"preparing_at": occurredAt, "ready_at": nil, "finished_at": nil}
+	case constants.KITCHEN_ORDER_STATUS_READY_ID:
+		return map[string]interface{}{"ready_at": occurredAt, "finished_at": nil}
+	case constants.KITCHEN_ORDER_STATUS_FINISHED_ID:
+		return map[string]interface{}{"finished_at": occurredAt}
+	default:
+		return nil
+	}
 }
 
-func (r *GormKitchenOrderDataSource) Delete(id string) error {
-	return r.db.Delete(&models.KitchenOrderModel{}, "id = ?", id).Error
+func (r *GormKitchenOrderDataSource) Delete(ctx context.Context, id string) error {
+	ctx, cancel := database.WithTimeout(ctx, r.timeouts.Write)
+	defer cancel()
+
+	return database.Conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		var existing models.KitchenOrderModel
+		if err := tx.Preload("Status").First(&existing, "id = ?", id).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil
+			}
+			return err
+		}
+
+		if err := tx.Delete(&models.KitchenOrderModel{}, "id = ?", id).Error; err != nil {
+			return err
+		}
+
+		event := mappers.NewKitchenOrderEventModel(constants.KITCHEN_ORDER_EVENT_REMOVED, mappers.FromModelToDAOKitchenOrder(&existing), time.Now())
+
+		return tx.Create(event).Error
+	})
 }
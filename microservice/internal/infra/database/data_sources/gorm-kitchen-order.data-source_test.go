@@ -1,6 +1,9 @@
 package data_sources
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"testing"
 	"time"
 
@@ -9,7 +12,10 @@ import (
 	"tech_challenge/internal/application/dtos"
 	"tech_challenge/internal/daos"
 	"tech_challenge/internal/infra/database/models"
+	"tech_challenge/internal/interfaces"
 	"tech_challenge/internal/shared/config/constants"
+	"tech_challenge/internal/shared/infra/database"
+	"tech_challenge/internal/shared/pkg/cursor"
 )
 
 func setupTestDB(t *testing.T) *gorm.DB {
@@ -22,6 +28,7 @@ func setupTestDB(t *testing.T) *gorm.DB {
 		&models.OrderStatusModel{},
 		&models.KitchenOrderModel{},
 		&models.OrderItemModel{},
+		&models.KitchenOrderEventModel{},
 	)
 	if err != nil {
 		t.Fatalf("Failed to migrate test database: %v", err)
@@ -85,7 +92,7 @@ func TestGormKitchenOrderDataSource_Insert(t *testing.T) {
 		},
 	}
 
-	err := ds.Insert(kitchenOrder)
+	err := ds.Insert(context.Background(), kitchenOrder)
 
 	if err != nil {
 		t.Errorf("Expected no error, got: %v", err)
@@ -115,7 +122,7 @@ func TestGormKitchenOrderDataSource_Insert_Error(t *testing.T) {
 		},
 	}
 
-	err := ds.Insert(kitchenOrder)
+	err := ds.Insert(context.Background(), kitchenOrder)
 
 	if err != nil {
 		t.Logf("✓ Erro capturado corretamente: %v", err)
@@ -169,7 +176,7 @@ func TestGormKitchenOrderDataSource_FindAll(t *testing.T) {
 	}
 
 	filter := dtos.KitchenOrderFilter{}
-	result, err := ds.FindAll(filter)
+	result, _, err := ds.FindAll(context.Background(), filter)
 
 	if err != nil {
 		t.Errorf("Expected no error, got: %v", err)
@@ -216,18 +223,21 @@ func TestGormKitchenOrderDataSource_FindAll_WithFilters(t *testing.T) {
 		db.Create(&order)
 	}
 
-	t.Run("Filter by StatusID", func(t *testing.T) {
-		var statusID uint = 1
+	t.Run("Filter by StatusIDs", func(t *testing.T) {
 		filter := dtos.KitchenOrderFilter{
-			StatusID: &statusID,
+			StatusIDs: []string{constants.KITCHEN_ORDER_STATUS_PREPARING_ID},
 		}
-		result, err := ds.FindAll(filter)
+		result, _, err := ds.FindAll(context.Background(), filter)
 
 		if err != nil {
 			t.Errorf("Expected no error, got: %v", err)
 		}
 
-		t.Logf("✓ Filtro por StatusID executado (resultado: %d pedidos)", len(result))
+		if len(result) != 1 || result[0].ID != "order-2" {
+			t.Errorf("Expected only order-2, got %d orders", len(result))
+		} else {
+			t.Log("✓ Filtro por StatusIDs funcionou")
+		}
 	})
 
 	t.Run("Filter by CreatedAtFrom", func(t *testing.T) {
@@ -235,7 +245,7 @@ func TestGormKitchenOrderDataSource_FindAll_WithFilters(t *testing.T) {
 		filter := dtos.KitchenOrderFilter{
 			CreatedAtFrom: &from,
 		}
-		result, err := ds.FindAll(filter)
+		result, _, err := ds.FindAll(context.Background(), filter)
 
 		if err != nil {
 			t.Errorf("Expected no error, got: %v", err)
@@ -253,7 +263,7 @@ func TestGormKitchenOrderDataSource_FindAll_WithFilters(t *testing.T) {
 		filter := dtos.KitchenOrderFilter{
 			CreatedAtTo: &to,
 		}
-		result, err := ds.FindAll(filter)
+		result, _, err := ds.FindAll(context.Background(), filter)
 
 		if err != nil {
 			t.Errorf("Expected no error, got: %v", err)
@@ -289,7 +299,7 @@ func TestGormKitchenOrderDataSource_FindByID(t *testing.T) {
 	}
 	db.Create(&order)
 
-	result, err := ds.FindByID("order-123")
+	result, err := ds.FindByID(context.Background(), "order-123")
 
 	if err != nil {
 		t.Errorf("Expected no error, got: %v", err)
@@ -318,7 +328,7 @@ func TestGormKitchenOrderDataSource_FindByID_NotFound(t *testing.T) {
 	db := setupTestDB(t)
 	ds := &GormKitchenOrderDataSource{db: db}
 
-	_, err := ds.FindByID("non-existent-id")
+	_, err := ds.FindByID(context.Background(), "non-existent-id")
 
 	if err == nil {
 		t.Error("Expected error for non-existent ID")
@@ -327,6 +337,44 @@ func TestGormKitchenOrderDataSource_FindByID_NotFound(t *testing.T) {
 	}
 }
 
+func TestGormKitchenOrderDataSource_FindByID_CanceledContext(t *testing.T) {
+	db := setupTestDB(t)
+	ds := &GormKitchenOrderDataSource{db: db}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := ds.FindByID(ctx, "order-123")
+
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got: %v", err)
+	} else {
+		t.Log("✓ Consulta interrompida quando o contexto do chamador é cancelado")
+	}
+}
+
+func TestGormKitchenOrderDataSource_FindAllInBatches_BatchTimeout(t *testing.T) {
+	db := setupTestDB(t)
+	ds := &GormKitchenOrderDataSource{db: db, timeouts: database.QueryTimeouts{Batch: time.Nanosecond}}
+	seedPaginationOrders(t, db)
+
+	called := false
+	err := ds.FindAllInBatches(context.Background(), dtos.KitchenOrderFilter{}, 3, func([]daos.KitchenOrderDAO) error {
+		called = true
+		return nil
+	})
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected context.DeadlineExceeded, got: %v", err)
+	} else {
+		t.Log("✓ Lote que excede o timeout interrompe a exportação")
+	}
+
+	if called {
+		t.Error("Expected handler not to be called after the timeout")
+	}
+}
+
 func TestGormKitchenOrderDataSource_Update(t *testing.T) {
 	db := setupTestDB(t)
 	ds := &GormKitchenOrderDataSource{db: db}
@@ -349,9 +397,10 @@ func TestGormKitchenOrderDataSource_Update(t *testing.T) {
 			ID:   constants.KITCHEN_ORDER_STATUS_PREPARING_ID,
 			Name: "Em preparação",
 		},
+		Version: 1,
 	}
 
-	err := ds.Update(updatedOrder)
+	err := ds.Update(context.Background(), updatedOrder)
 
 	if err != nil {
 		t.Errorf("Expected no error, got: %v", err)
@@ -370,6 +419,47 @@ func TestGormKitchenOrderDataSource_Update(t *testing.T) {
 	}
 }
 
+func TestGormKitchenOrderDataSource_Update_VersionConflict(t *testing.T) {
+	db := setupTestDB(t)
+	ds := &GormKitchenOrderDataSource{db: db}
+
+	order := models.KitchenOrderModel{
+		ID:       "order-123",
+		OrderID:  "ext-123",
+		Slug:     "001",
+		StatusID: constants.KITCHEN_ORDER_STATUS_RECEIVED_ID,
+	}
+	db.Create(&order)
+
+	// Duas telas leem a versão 1 e tentam alterar o pedido
+	first := daos.KitchenOrderDAO{
+		ID:      "order-123",
+		OrderID: "ext-123",
+		Slug:    "001",
+		Status:  daos.OrderStatusDAO{ID: constants.KITCHEN_ORDER_STATUS_PREPARING_ID, Name: "Em preparação"},
+		Version: 1,
+	}
+	second := first
+	second.Status = daos.OrderStatusDAO{ID: constants.KITCHEN_ORDER_STATUS_READY_ID, Name: "Pronto"}
+
+	if err := ds.Update(context.Background(), first); err != nil {
+		t.Fatalf("Expected first update to succeed, got: %v", err)
+	}
+
+	if err := ds.Update(context.Background(), second); !errors.Is(err, interfaces.ErrKitchenOrderVersionConflict) {
+		t.Fatalf("Expected version conflict, got: %v", err)
+	}
+
+	var updated models.KitchenOrderModel
+	db.First(&updated, "id = ?", "order-123")
+
+	if updated.StatusID != constants.KITCHEN_ORDER_STATUS_PREPARING_ID || updated.Version != 2 {
+		t.Errorf("Expected first update to be kept with version 2, got status %s version %d", updated.StatusID, updated.Version)
+	} else {
+		t.Log("✓ Segunda alteração concorrente rejeitada sem sobrescrever a primeira")
+	}
+}
+
 func TestGormKitchenOrderDataSource_Delete(t *testing.T) {
 	db := setupTestDB(t)
 	ds := &GormKitchenOrderDataSource{db: db}
@@ -383,7 +473,7 @@ func TestGormKitchenOrderDataSource_Delete(t *testing.T) {
 	}
 	db.Create(&order)
 
-	err := ds.Delete("order-123")
+	err := ds.Delete(context.Background(), "order-123")
 
 	if err != nil {
 		t.Errorf("Expected no error, got: %v", err)
@@ -405,7 +495,7 @@ func TestGormKitchenOrderDataSource_Delete_NotFound(t *testing.T) {
 	db := setupTestDB(t)
 	ds := &GormKitchenOrderDataSource{db: db}
 
-	err := ds.Delete("non-existent-id")
+	err := ds.Delete(context.Background(), "non-existent-id")
 
 	if err != nil {
 		t.Logf("Error: %v", err)
@@ -451,7 +541,7 @@ func TestGormKitchenOrderDataSource_FindAll_OrderingPriority(t *testing.T) {
 	}
 
 	filter := dtos.KitchenOrderFilter{}
-	result, err := ds.FindAll(filter)
+	result, _, err := ds.FindAll(context.Background(), filter)
 
 	if err != nil {
 		t.Errorf("Expected no error, got: %v", err)
@@ -474,3 +564,408 @@ func TestGormKitchenOrderDataSource_FindAll_OrderingPriority(t *testing.T) {
 		t.Logf("  %d. %s", i+1, order.Status.Name)
 	}
 }
+
+func seedPaginationOrders(t *testing.T, db *gorm.DB) {
+	base := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	statuses := []string{
+		constants.KITCHEN_ORDER_STATUS_RECEIVED_ID,
+		constants.KITCHEN_ORDER_STATUS_READY_ID,
+		constants.KITCHEN_ORDER_STATUS_PREPARING_ID,
+		constants.KITCHEN_ORDER_STATUS_READY_ID,
+		constants.KITCHEN_ORDER_STATUS_RECEIVED_ID,
+		constants.KITCHEN_ORDER_STATUS_PREPARING_ID,
+		constants.KITCHEN_ORDER_STATUS_FINISHED_ID,
+	}
+
+	for i, statusID := range statuses {
+		order := models.KitchenOrderModel{
+			ID:       fmt.Sprintf("order-%d", i+1),
+			OrderID:  fmt.Sprintf("ext-%d", i+1),
+			Slug:     fmt.Sprintf("%03d", i+1),
+			StatusID: statusID,
+			// Dois pedidos com o mesmo created_at para exercitar o desempate por id
+			CreatedAt: base.Add(time.Duration(i/2) * time.Minute),
+		}
+		if err := db.Create(&order).Error; err != nil {
+			t.Fatalf("Failed to seed order: %v", err)
+		}
+	}
+}
+
+func collectAllPages(t *testing.T, ds *GormKitchenOrderDataSource, filter dtos.KitchenOrderFilter) ([]string, int64) {
+	var ids []string
+	var total int64
+
+	for page := 0; page < 10; page++ {
+		result, pageInfo, err := ds.FindAll(context.Background(), filter)
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+
+		if len(result) > filter.Limit {
+			t.Fatalf("Expected at most %d orders per page, got %d", filter.Limit, len(result))
+		}
+
+		for _, order := range result {
+			ids = append(ids, order.ID)
+		}
+		total = pageInfo.Total
+
+		if pageInfo.NextCursor == nil {
+			return ids, total
+		}
+		filter.Cursor = *pageInfo.NextCursor
+	}
+
+	t.Fatal("Pagination did not terminate")
+	return nil, 0
+}
+
+func TestGormKitchenOrderDataSource_FindAll_PaginatesBoardOrder(t *testing.T) {
+	db := setupTestDB(t)
+	ds := &GormKitchenOrderDataSource{db: db}
+	seedPaginationOrders(t, db)
+
+	ids, total := collectAllPages(t, ds, dtos.KitchenOrderFilter{Limit: 2})
+
+	expected := []string{"order-2", "order-4", "order-3", "order-6", "order-1", "order-5"}
+	if fmt.Sprint(ids) != fmt.Sprint(expected) {
+		t.Errorf("Expected %v, got %v", expected, ids)
+	}
+
+	if total != 6 {
+		t.Errorf("Expected total 6, got %d", total)
+	} else {
+		t.Log("✓ Paginação por cursor mantém a ordem do quadro")
+	}
+}
+
+func TestGormKitchenOrderDataSource_FindAll_PaginatesByCreatedAt(t *testing.T) {
+	db := setupTestDB(t)
+	ds := &GormKitchenOrderDataSource{db: db}
+	seedPaginationOrders(t, db)
+
+	ascending, _ := collectAllPages(t, ds, dtos.KitchenOrderFilter{Limit: 4, Sort: constants.KITCHEN_ORDER_SORT_CREATED_AT})
+	expectedAscending := []string{"order-1", "order-2", "order-3", "order-4", "order-5", "order-6"}
+	if fmt.Sprint(ascending) != fmt.Sprint(expectedAscending) {
+		t.Errorf("Expected %v, got %v", expectedAscending, ascending)
+	}
+
+	descending, _ := collectAllPages(t, ds, dtos.KitchenOrderFilter{Limit: 4, Sort: constants.KITCHEN_ORDER_SORT_CREATED_AT_DESC})
+	expectedDescending := []string{"order-6", "order-5", "order-4", "order-3", "order-2", "order-1"}
+	if fmt.Sprint(descending) != fmt.Sprint(expectedDescending) {
+		t.Errorf("Expected %v, got %v", expectedDescending, descending)
+	} else {
+		t.Log("✓ Paginação por created_at nos dois sentidos")
+	}
+}
+
+func TestGormKitchenOrderDataSource_FindAll_InvalidCursor(t *testing.T) {
+	db := setupTestDB(t)
+	ds := &GormKitchenOrderDataSource{db: db}
+	seedPaginationOrders(t, db)
+
+	_, pageInfo, _ := ds.FindAll(context.Background(), dtos.KitchenOrderFilter{Limit: 2})
+
+	// Cursor gerado para outra ordenação não pode ser reutilizado
+	_, _, err := ds.FindAll(context.Background(), dtos.KitchenOrderFilter{Limit: 2, Sort: constants.KITCHEN_ORDER_SORT_CREATED_AT, Cursor: *pageInfo.NextCursor})
+	if !errors.Is(err, cursor.ErrInvalidCursor) {
+		t.Errorf("Expected ErrInvalidCursor, got %v", err)
+	}
+
+	_, _, err = ds.FindAll(context.Background(), dtos.KitchenOrderFilter{Limit: 2, Cursor: "not-a-cursor"})
+	if !errors.Is(err, cursor.ErrInvalidCursor) {
+		t.Errorf("Expected ErrInvalidCursor, got %v", err)
+	} else {
+		t.Log("✓ Cursor inválido rejeitado")
+	}
+}
+
+func TestGormKitchenOrderDataSource_FindAll_SearchFilters(t *testing.T) {
+	db := setupTestDB(t)
+	ds := &GormKitchenOrderDataSource{db: db}
+	seedPaginationOrders(t, db)
+
+	customerID := "customer-1"
+	db.Model(&models.KitchenOrderModel{}).Where("id = ?", "order-7").Update("customer_id", customerID)
+
+	t.Run("Finished orders are excluded by default", func(t *testing.T) {
+		result, _, _ := ds.FindAll(context.Background(), dtos.KitchenOrderFilter{CustomerID: &customerID})
+
+		if len(result) != 0 {
+			t.Errorf("Expected 0 orders, got %d", len(result))
+		}
+	})
+
+	t.Run("IncludeFinished", func(t *testing.T) {
+		result, pageInfo, _ := ds.FindAll(context.Background(), dtos.KitchenOrderFilter{IncludeFinished: true})
+
+		if len(result) != 7 || pageInfo.Total != 7 {
+			t.Errorf("Expected 7 orders, got %d (total %d)", len(result), pageInfo.Total)
+		} else {
+			t.Log("✓ include_finished retorna pedidos finalizados")
+		}
+	})
+
+	t.Run("CustomerID with IncludeFinished", func(t *testing.T) {
+		result, _, _ := ds.FindAll(context.Background(), dtos.KitchenOrderFilter{CustomerID: &customerID, IncludeFinished: true})
+
+		if len(result) != 1 || result[0].ID != "order-7" {
+			t.Errorf("Expected only order-7, got %d orders", len(result))
+		}
+	})
+
+	t.Run("Explicit finished status", func(t *testing.T) {
+		result, _, _ := ds.FindAll(context.Background(), dtos.KitchenOrderFilter{StatusIDs: []string{constants.KITCHEN_ORDER_STATUS_FINISHED_ID}})
+
+		if len(result) != 1 || result[0].ID != "order-7" {
+			t.Errorf("Expected only order-7, got %d orders", len(result))
+		}
+	})
+
+	t.Run("Multiple StatusIDs", func(t *testing.T) {
+		result, _, _ := ds.FindAll(context.Background(), dtos.KitchenOrderFilter{StatusIDs: []string{
+			constants.KITCHEN_ORDER_STATUS_RECEIVED_ID,
+			constants.KITCHEN_ORDER_STATUS_READY_ID,
+		}})
+
+		if len(result) != 4 {
+			t.Errorf("Expected 4 orders, got %d", len(result))
+		}
+	})
+
+	t.Run("OrderID and Slug", func(t *testing.T) {
+		orderID := "ext-3"
+		slug := "003"
+		result, _, _ := ds.FindAll(context.Background(), dtos.KitchenOrderFilter{OrderID: &orderID, Slug: &slug})
+
+		if len(result) != 1 || result[0].ID != "order-3" {
+			t.Errorf("Expected only order-3, got %d orders", len(result))
+		} else {
+			t.Log("✓ Filtros por order_id e slug funcionaram")
+		}
+	})
+}
+
+func TestGormKitchenOrderDataSource_FindAllInBatches(t *testing.T) {
+	db := setupTestDB(t)
+	ds := &GormKitchenOrderDataSource{db: db}
+	seedPaginationOrders(t, db)
+
+	var ids []string
+	var batchSizes []int
+	err := ds.FindAllInBatches(context.Background(), dtos.KitchenOrderFilter{IncludeFinished: true}, 3, func(batch []daos.KitchenOrderDAO) error {
+		batchSizes = append(batchSizes, len(batch))
+		for _, order := range batch {
+			ids = append(ids, order.ID)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("FindAllInBatches failed: %v", err)
+	}
+
+	expected := []string{"order-1", "order-2", "order-3", "order-4", "order-5", "order-6", "order-7"}
+	if fmt.Sprint(ids) != fmt.Sprint(expected) {
+		t.Errorf("Expected %v, got %v", expected, ids)
+	}
+
+	if fmt.Sprint(batchSizes) != fmt.Sprint([]int{3, 3, 1}) {
+		t.Errorf("Expected batches of [3 3 1], got %v", batchSizes)
+	} else {
+		t.Log("✓ Pedidos percorridos em lotes, incluindo os finalizados")
+	}
+
+	stopErr := errors.New("stop")
+	err = ds.FindAllInBatches(context.Background(), dtos.KitchenOrderFilter{}, 3, func([]daos.KitchenOrderDAO) error { return stopErr })
+	if !errors.Is(err, stopErr) {
+		t.Errorf("Expected handler error to stop the iteration, got %v", err)
+	}
+}
+
+func TestGormKitchenOrderDataSource_Update_RecordsStatusTimestamps(t *testing.T) {
+	db := setupTestDB(t)
+	ds := &GormKitchenOrderDataSource{db: db}
+
+	createdAt := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+	kitchenOrder := daos.KitchenOrderDAO{
+		ID:        "order-123",
+		OrderID:   "ext-123",
+		Slug:      "001",
+		Status:    daos.OrderStatusDAO{ID: constants.KITCHEN_ORDER_STATUS_RECEIVED_ID, Name: "Recebido"},
+		Version:   1,
+		CreatedAt: createdAt,
+	}
+	if err := ds.Insert(context.Background(), kitchenOrder); err != nil {
+		t.Fatalf("Insert failed: %v", err)
+	}
+
+	changeStatus := func(statusID, name string, at time.Time) daos.KitchenOrderDAO {
+		kitchenOrder.Status = daos.OrderStatusDAO{ID: statusID, Name: name}
+		kitchenOrder.UpdatedAt = &at
+		if err := ds.Update(context.Background(), kitchenOrder); err != nil {
+			t.Fatalf("Update failed: %v", err)
+		}
+		kitchenOrder.Version++
+
+		stored, err := ds.FindByID(context.Background(), kitchenOrder.ID)
+		if err != nil {
+			t.Fatalf("FindByID failed: %v", err)
+		}
+		return stored
+	}
+
+	changeStatus(constants.KITCHEN_ORDER_STATUS_PREPARING_ID, "Em preparação", createdAt.Add(2*time.Minute))
+	stored := changeStatus(constants.KITCHEN_ORDER_STATUS_READY_ID, "Pronto", createdAt.Add(10*time.Minute))
+
+	if stored.PreparingAt == nil || !stored.PreparingAt.Equal(createdAt.Add(2*time.Minute)) {
+		t.Errorf("Expected preparing_at to be recorded, got %v", stored.PreparingAt)
+	}
+	if stored.ReadyAt == nil || !stored.ReadyAt.Equal(createdAt.Add(10*time.Minute)) {
+		t.Errorf("Expected ready_at to be recorded, got %v", stored.ReadyAt)
+	}
+
+	// O recall volta o pedido ao preparo e descarta o horário de pronto anterior
+	stored = changeStatus(constants.KITCHEN_ORDER_STATUS_PREPARING_ID, "Em preparação", createdAt.Add(15*time.Minute))
+	if stored.ReadyAt != nil || stored.PreparingAt == nil || !stored.PreparingAt.Equal(createdAt.Add(15*time.Minute)) {
+		t.Errorf("Expected recall to restart preparation, got preparing_at=%v ready_at=%v", stored.PreparingAt, stored.ReadyAt)
+	}
+
+	stored = changeStatus(constants.KITCHEN_ORDER_STATUS_FINISHED_ID, "Finalizado", createdAt.Add(20*time.Minute))
+	if stored.FinishedAt == nil || !stored.FinishedAt.Equal(createdAt.Add(20*time.Minute)) {
+		t.Errorf("Expected finished_at to be recorded, got %v", stored.FinishedAt)
+	} else {
+		t.Log("✓ Horários de cada etapa registrados nas mudanças de status")
+	}
+}
+
+func TestGormKitchenOrderDataSource_Transaction_WebhookOutbox(t *testing.T) {
+	db := setupTestDB(t)
+	if err := db.AutoMigrate(&models.WebhookOutboxModel{}); err != nil {
+		t.Fatalf("Failed to migrate outbox: %v", err)
+	}
+	ds := &GormKitchenOrderDataSource{db: db}
+	outbox := &GormWebhookOutboxDataSource{db: db}
+
+	db.Create(&models.KitchenOrderModel{
+		ID:       "order-123",
+		OrderID:  "ext-123",
+		Slug:     "001",
+		StatusID: constants.KITCHEN_ORDER_STATUS_RECEIVED_ID,
+		Version:  1,
+	})
+
+	updatedOrder := daos.KitchenOrderDAO{
+		ID:      "order-123",
+		OrderID: "ext-123",
+		Slug:    "001",
+		Status:  daos.OrderStatusDAO{ID: constants.KITCHEN_ORDER_STATUS_READY_ID, Name: "Pronto"},
+		Version: 1,
+	}
+
+	countRows := func(model interface{}) int64 {
+		var count int64
+		db.Model(model).Count(&count)
+		return count
+	}
+
+	// Falha ao gravar as entregas desfaz a mudança de status e o seu evento
+	err := ds.Transaction(context.Background(), func(ctx context.Context) error {
+		if err := ds.Update(ctx, updatedOrder); err != nil {
+			return err
+		}
+		if err := outbox.InsertAll(ctx, []daos.WebhookOutboxDAO{newTestWebhookOutboxDAO("entry-1", time.Now())}); err != nil {
+			return err
+		}
+		return errors.New("enqueue failed")
+	})
+	if err == nil {
+		t.Fatal("Expected transaction error")
+	}
+
+	var order models.KitchenOrderModel
+	db.First(&order, "id = ?", "order-123")
+	if order.StatusID != constants.KITCHEN_ORDER_STATUS_RECEIVED_ID || order.Version != 1 {
+		t.Errorf("Expected status change to be rolled back, got status %s version %d", order.StatusID, order.Version)
+	}
+	if events, entries := countRows(&models.KitchenOrderEventModel{}), countRows(&models.WebhookOutboxModel{}); events != 0 || entries != 0 {
+		t.Errorf("Expected no event and no outbox entry, got %d events and %d entries", events, entries)
+	}
+
+	// Com sucesso, pedido, evento e entregas são confirmados juntos
+	err = ds.Transaction(context.Background(), func(ctx context.Context) error {
+		if err := ds.Update(ctx, updatedOrder); err != nil {
+			return err
+		}
+		return outbox.InsertAll(ctx, []daos.WebhookOutboxDAO{newTestWebhookOutboxDAO("entry-1", time.Now())})
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	db.First(&order, "id = ?", "order-123")
+	if order.StatusID != constants.KITCHEN_ORDER_STATUS_READY_ID {
+		t.Errorf("Expected status %s, got %s", constants.KITCHEN_ORDER_STATUS_READY_ID, order.StatusID)
+	}
+	if events, entries := countRows(&models.KitchenOrderEventModel{}), countRows(&models.WebhookOutboxModel{}); events != 1 || entries != 1 {
+		t.Errorf("Expected 1 event and 1 outbox entry, got %d events and %d entries", events, entries)
+	}
+	t.Log("✓ Entregas de webhook são gravadas na mesma transação da mudança de status")
+}
+
+func TestGormKitchenOrderDataSource_Transaction_PrintJobs(t *testing.T) {
+	db := setupTestDB(t)
+	if err := db.AutoMigrate(&models.PrintJobModel{}); err != nil {
+		t.Fatalf("Failed to migrate print jobs: %v", err)
+	}
+	ds := &GormKitchenOrderDataSource{db: db}
+	printJobs := &GormPrintJobDataSource{db: db}
+
+	newOrder := daos.KitchenOrderDAO{
+		ID:      "order-123",
+		OrderID: "ext-123",
+		Slug:    "001",
+		Status:  daos.OrderStatusDAO{ID: constants.KITCHEN_ORDER_STATUS_RECEIVED_ID, Name: "Recebido"},
+	}
+
+	countRows := func(model interface{}) int64 {
+		var count int64
+		db.Model(model).Count(&count)
+		return count
+	}
+
+	// Falha ao enfileirar as comandas desfaz a criação do pedido
+	err := ds.Transaction(context.Background(), func(ctx context.Context) error {
+		if err := ds.Insert(ctx, newOrder); err != nil {
+			return err
+		}
+		if err := printJobs.InsertAll(ctx, []daos.PrintJobDAO{newTestPrintJobDAO("job-1", "order-123", time.Now())}); err != nil {
+			return err
+		}
+		return errors.New("enqueue failed")
+	})
+	if err == nil {
+		t.Fatal("Expected transaction error")
+	}
+
+	if orders, jobs := countRows(&models.KitchenOrderModel{}), countRows(&models.PrintJobModel{}); orders != 0 || jobs != 0 {
+		t.Errorf("Expected no order and no print job, got %d orders and %d jobs", orders, jobs)
+	}
+
+	// Com sucesso, pedido e comandas são confirmados juntos
+	err = ds.Transaction(context.Background(), func(ctx context.Context) error {
+		if err := ds.Insert(ctx, newOrder); err != nil {
+			return err
+		}
+		return printJobs.InsertAll(ctx, []daos.PrintJobDAO{newTestPrintJobDAO("job-1", "order-123", time.Now())})
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if orders, jobs := countRows(&models.KitchenOrderModel{}), countRows(&models.PrintJobModel{}); orders != 1 || jobs != 1 {
+		t.Errorf("Expected 1 order and 1 print job, got %d orders and %d jobs", orders, jobs)
+	} else {
+		t.Log("✓ Comandas são gravadas na mesma transação da criação do pedido")
+	}
+}
@@ -0,0 +1,304 @@
+package data_sources
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"tech_challenge/internal/application/dtos"
+	"tech_challenge/internal/daos"
+	"tech_challenge/internal/shared/config/constants"
+	"tech_challenge/internal/shared/infra/database"
+)
+
+// reportPeriodLayout é o formato em que o SQL devolve o início de cada período, igual no Postgres e no SQLite
+const reportPeriodLayout = "2006-01-02T15:04:05Z"
+
+type GormKitchenReportDataSource struct {
+	db       *gorm.DB
+	timeouts database.QueryTimeouts
+}
+
+func NewGormKitchenReportDataSource() *GormKitchenReportDataSource {
+	return &GormKitchenReportDataSource{
+		db:       database.GetDB(),
+		timeouts: database.GetQueryTimeouts(),
+	}
+}
+
+type throughputRow struct {
+	PeriodStart string
+	Received    int
+	Finished    int
+}
+
+type stageDurationRow struct {
+	PeriodStart    string
+	StatusID       string
+	StatusName     string
+	Orders         int
+	AverageSeconds float64
+	P90Seconds     float64
+}
+
+type productPrepTimeRow struct {
+	ProductID      string
+	Orders         int
+	AverageSeconds float64
+	P90Seconds     float64
+}
+
+func (r *GormKitchenReportDataSource) CountThroughput(ctx context.Context, filter dtos.KitchenReportFilter) ([]daos.ThroughputBucketDAO, error) {
+	ctx, cancel := database.WithTimeout(ctx, r.timeouts.Batch)
+	defer cancel()
+
+	query := fmt.Sprintf(`
+		SELECT period_start, SUM(received) AS received, SUM(finished) AS finished
+		FROM (
+			SELECT %s AS period_start, 1 AS received, 0 AS finished
+			FROM kitchen_order
+			WHERE created_at BETWEEN @from AND @to
+			UNION ALL
+			SELECT %s AS period_start, 0 AS received, 1 AS finished
+			FROM kitchen_order
+			WHERE finished_at BETWEEN @from AND @to
+		) AS throughput
+		GROUP BY period_start
+		ORDER BY period_start`,
+		r.periodStart("created_at", filter.GroupBy),
+		r.periodStart("finished_at", filter.GroupBy),
+	)
+
+	var rows []throughputRow
+	if err := database.Conn(ctx, r.db).Raw(query, r.rangeArgs(filter)).Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	buckets := make([]daos.ThroughputBucketDAO, len(rows))
+	for i, row := range rows {
+		periodStart, err := time.Parse(reportPeriodLayout, row.PeriodStart)
+		if err != nil {
+			return nil, err
+		}
+
+		buckets[i] = daos.ThroughputBucketDAO{
+			PeriodStart: periodStart,
+			Received:    row.Received,
+			Finished:    row.Finished,
+		}
+	}
+
+	return buckets, nil
+}
+
+// FindStageDurations calcula o tempo em cada status a partir do histórico de eventos: cada evento dura até o próximo
+// evento do mesmo pedido (LEAD), então um pedido devolvido à preparação soma todas as passagens pelo status.
+// O p90 usa o método nearest-rank com funções de janela, disponíveis tanto no Postgres quanto no SQLite
+func (r *GormKitchenReportDataSource) FindStageDurations(ctx context.Context, filter dtos.KitchenReportFilter, grouped bool) ([]daos.StageDurationDAO, error) {
+	ctx, cancel := database.WithTimeout(ctx, r.timeouts.Batch)
+	defer cancel()
+
+	periodStart := "''"
+	if grouped {
+		periodStart = r.periodStart("kitchen_order.created_at", filter.GroupBy)
+	}
+
+	query := fmt.Sprintf(`
+		WITH transitions AS (
+			SELECT %s AS period_start, kitchen_order_event.kitchen_order_id, kitchen_order_event.status_id,
+				kitchen_order_event.created_at AS started_at,
+				LEAD(kitchen_order_event.created_at) OVER (
+					PARTITION BY kitchen_order_event.kitchen_order_id
+					ORDER BY kitchen_order_event.created_at, kitchen_order_event.id
+				) AS ended_at
+			FROM kitchen_order_event
+			JOIN kitchen_order ON kitchen_order.id = kitchen_order_event.kitchen_order_id
+			WHERE kitchen_order.created_at BETWEEN @from AND @to AND kitchen_order_event.type IN @event_types
+		),
+		durations AS (
+			SELECT period_start, kitchen_order_id, status_id, SUM(%s) AS seconds
+			FROM transitions
+			WHERE ended_at IS NOT NULL AND status_id IN @status_ids
+			GROUP BY period_start, kitchen_order_id, status_id
+		),
+		ranked AS (
+			SELECT period_start, status_id, seconds,
+				ROW_NUMBER() OVER (PARTITION BY period_start, status_id ORDER BY seconds) AS rank_position,
+				COUNT(*) OVER (PARTITION BY period_start, status_id) AS total
+			FROM durations
+		)
+		SELECT ranked.period_start, ranked.status_id, COALESCE(order_status.name, '') AS status_name,
+			COUNT(*) AS orders,
+			AVG(ranked.seconds) AS average_seconds,
+			MIN(CASE WHEN ranked.rank_position >= 0.9 * ranked.total THEN ranked.seconds END) AS p90_seconds
+		FROM ranked
+		LEFT JOIN order_status ON order_status.id = ranked.status_id
+		GROUP BY ranked.period_start, ranked.status_id, order_status.name
+		ORDER BY ranked.period_start, ranked.status_id`,
+		periodStart,
+		r.secondsBetween("started_at", "ended_at"),
+	)
+
+	args := r.rangeArgs(filter)
+	// O evento de criação abre o tempo em recebido; a remoção não inicia um novo status
+	args["event_types"] = []string{constants.KITCHEN_ORDER_EVENT_CREATED, constants.KITCHEN_ORDER_EVENT_STATUS_CHANGED}
+	// Finalizado é o fim do fluxo; o tempo nele não é uma etapa da cozinha
+	args["status_ids"] = []string{
+		constants.KITCHEN_ORDER_STATUS_RECEIVED_ID,
+		constants.KITCHEN_ORDER_STATUS_PREPARING_ID,
+		constants.KITCHEN_ORDER_STATUS_READY_ID,
+	}
+
+	var rows []stageDurationRow
+	if err := database.Conn(ctx, r.db).Raw(query, args).Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	durations := make([]daos.StageDurationDAO, len(rows))
+	for i, row := range rows {
+		durations[i] = daos.StageDurationDAO{
+			StatusID:       row.StatusID,
+			StatusName:     row.StatusName,
+			Orders:         row.Orders,
+			AverageSeconds: row.AverageSeconds,
+			P90Seconds:     row.P90Seconds,
+		}
+
+		if grouped {
+			periodStart, err := time.Parse(reportPeriodLayout, row.PeriodStart)
+			if err != nil {
+				return nil, err
+			}
+			durations[i].PeriodStart = &periodStart
+		}
+	}
+
+	return durations, nil
+}
+
+// FindSlowestProducts ordena os produtos pelo tempo médio de preparo dos pedidos em que aparecem
+func (r *GormKitchenReportDataSource) FindSlowestProducts(ctx context.Context, filter dtos.KitchenReportFilter) ([]daos.ProductPrepTimeDAO, error) {
+	ctx, cancel := database.WithTimeout(ctx, r.timeouts.Batch)
+	defer cancel()
+
+	query := fmt.Sprintf(`
+		WITH durations AS (
+			SELECT DISTINCT order_item.product_id, kitchen_order.id AS kitchen_order_id, %s AS seconds
+			FROM kitchen_order
+			JOIN order_item ON order_item.kitchen_order_id = kitchen_order.id
+			WHERE kitchen_order.created_at BETWEEN @from AND @to
+				AND kitchen_order.preparing_at IS NOT NULL
+				AND kitchen_order.ready_at IS NOT NULL
+				AND kitchen_order.ready_at >= kitchen_order.preparing_at
+		),
+		ranked AS (
+			SELECT product_id, seconds,
+				ROW_NUMBER() OVER (PARTITION BY product_id ORDER BY seconds) AS rank_position,
+				COUNT(*) OVER (PARTITION BY product_id) AS total
+			FROM durations
+		)
+		SELECT product_id,
+			COUNT(*) AS orders,
+			AVG(seconds) AS average_seconds,
+			MIN(CASE WHEN rank_position >= 0.9 * total THEN seconds END) AS p90_seconds
+		FROM ranked
+		GROUP BY product_id
+		ORDER BY average_seconds DESC, product_id
+		LIMIT @limit`,
+		r.secondsBetween("kitchen_order.preparing_at", "kitchen_order.ready_at"),
+	)
+
+	args := r.rangeArgs(filter)
+	args["limit"] = filter.ProductsLimit
+
+	var rows []productPrepTimeRow
+	if err := database.Conn(ctx, r.db).Raw(query, args).Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	products := make([]daos.ProductPrepTimeDAO, len(rows))
+	for i, row := range rows {
+		products[i] = daos.ProductPrepTimeDAO(row)
+	}
+
+	return products, nil
+}
+
+// CountByStatus parte dos status para que os que estão vazios também apareçam, com zero pedidos
+func (r *GormKitchenReportDataSource) CountByStatus(ctx context.Context, statusIDs []string) ([]daos.BoardStatusCountDAO, error) {
+	ctx, cancel := database.WithTimeout(ctx, r.timeouts.Read)
+	defer cancel()
+
+	var rows []daos.BoardStatusCountDAO
+	err := database.Conn(ctx, r.db).Raw(`
+		SELECT order_status.id AS status_id, order_status.name AS status_name, COUNT(kitchen_order.id) AS orders
+		FROM order_status
+		LEFT JOIN kitchen_order ON kitchen_order.status_id = order_status.id
+		WHERE order_status.id IN @status_ids
+		GROUP BY order_status.id, order_status.name
+		ORDER BY order_status.id`,
+		map[string]any{"status_ids": statusIDs},
+	).Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
+
+func (r *GormKitchenReportDataSource) FindOldestCreatedAt(ctx context.Context, statusID string) (*time.Time, error) {
+	ctx, cancel := database.WithTimeout(ctx, r.timeouts.Read)
+	defer cancel()
+
+	var rows []struct {
+		CreatedAt time.Time
+	}
+
+	err := database.Conn(ctx, r.db).Table("kitchen_order").
+		Select("created_at").
+		Where("status_id = ?", statusID).
+		Order("created_at").
+		Limit(1).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	return &rows[0].CreatedAt, nil
+}
+
+func (r *GormKitchenReportDataSource) rangeArgs(filter dtos.KitchenReportFilter) map[string]any {
+	return map[string]any{
+		"from": filter.From,
+		"to":   filter.To,
+	}
+}
+
+// periodStart e secondsBetween isolam as funções de data, as únicas que diferem entre o Postgres e o SQLite dos testes
+func (r *GormKitchenReportDataSource) periodStart(column, groupBy string) string {
+	if r.db.Dialector.Name() == "postgres" {
+		if groupBy == constants.REPORT_GROUP_BY_HOUR {
+			return fmt.Sprintf(`to_char(date_trunc('hour', %s AT TIME ZONE 'UTC'), 'YYYY-MM-DD"T"HH24":00:00Z"')`, column)
+		}
+		return fmt.Sprintf(`to_char(date_trunc('day', %s AT TIME ZONE 'UTC'), 'YYYY-MM-DD"T00:00:00Z"')`, column)
+	}
+
+	if groupBy == constants.REPORT_GROUP_BY_HOUR {
+		return fmt.Sprintf(`strftime('%%Y-%%m-%%dT%%H:00:00Z', %s)`, column)
+	}
+	return fmt.Sprintf(`strftime('%%Y-%%m-%%dT00:00:00Z', %s)`, column)
+}
+
+func (r *GormKitchenReportDataSource) secondsBetween(start, end string) string {
+	if r.db.Dialector.Name() == "postgres" {
+		return fmt.Sprintf("EXTRACT(EPOCH FROM (%s - %s))", end, start)
+	}
+
+	return fmt.Sprintf("((julianday(%s) - julianday(%s)) * 86400.0)", end, start)
+}
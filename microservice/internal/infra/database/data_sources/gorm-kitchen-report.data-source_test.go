@@ -0,0 +1,285 @@
+package data_sources
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"tech_challenge/internal/application/dtos"
+	"tech_challenge/internal/daos"
+	"tech_challenge/internal/infra/database/models"
+	"tech_challenge/internal/shared/config/constants"
+	"tech_challenge/internal/shared/infra/database"
+)
+
+func reportTime(day, hour, minute int) time.Time {
+	return time.Date(2024, 1, day, hour, minute, 0, 0, time.UTC)
+}
+
+func reportTimePtr(day, hour, minute int) *time.Time {
+	t := reportTime(day, hour, minute)
+	return &t
+}
+
+// seedReportEvent grava uma transição no histórico de eventos, de onde saem as durações de cada etapa
+func seedReportEvent(t *testing.T, db *gorm.DB, kitchenOrderID, eventType, statusID string, createdAt time.Time) {
+	t.Helper()
+
+	require.NoError(t, db.Create(&models.KitchenOrderEventModel{
+		Type:           eventType,
+		KitchenOrderID: kitchenOrderID,
+		OrderID:        "ext-" + kitchenOrderID,
+		Slug:           kitchenOrderID,
+		StatusID:       statusID,
+		CreatedAt:      createdAt,
+	}).Error)
+}
+
+// seedReportOrders grava pedidos com os horários de cada transição e os eventos correspondentes; as durações
+// esperadas estão nos testes
+func seedReportOrders(t *testing.T, db *gorm.DB) {
+	t.Helper()
+
+	orders := []struct {
+		model    models.KitchenOrderModel
+		products []string
+	}{
+		{models.KitchenOrderModel{ID: "order-a", CreatedAt: reportTime(15, 12, 0), PreparingAt: reportTimePtr(15, 12, 2), ReadyAt: reportTimePtr(15, 12, 12), FinishedAt: reportTimePtr(15, 12, 15), StatusID: constants.KITCHEN_ORDER_STATUS_FINISHED_ID}, []string{"burger", "soda"}},
+		{models.KitchenOrderModel{ID: "order-b", CreatedAt: reportTime(15, 12, 30), PreparingAt: reportTimePtr(15, 12, 31), ReadyAt: reportTimePtr(15, 12, 51), FinishedAt: reportTimePtr(15, 13, 5), StatusID: constants.KITCHEN_ORDER_STATUS_FINISHED_ID}, []string{"burger", "burger"}},
+		{models.KitchenOrderModel{ID: "order-c", CreatedAt: reportTime(15, 13, 10), PreparingAt: reportTimePtr(15, 13, 15), StatusID: constants.KITCHEN_ORDER_STATUS_PREPARING_ID}, []string{"soda"}},
+		{models.KitchenOrderModel{ID: "order-d", CreatedAt: reportTime(16, 9, 0), PreparingAt: reportTimePtr(16, 9, 1), ReadyAt: reportTimePtr(16, 9, 4), StatusID: constants.KITCHEN_ORDER_STATUS_READY_ID}, []string{"fries"}},
+		{models.KitchenOrderModel{ID: "order-e", CreatedAt: reportTime(10, 9, 0), PreparingAt: reportTimePtr(10, 9, 30), StatusID: constants.KITCHEN_ORDER_STATUS_PREPARING_ID}, []string{"burger"}},
+	}
+
+	for _, order := range orders {
+		order.model.OrderID = "ext-" + order.model.ID
+		order.model.Slug = order.model.ID
+		require.NoError(t, db.Create(&order.model).Error)
+
+		seedReportEvent(t, db, order.model.ID, constants.KITCHEN_ORDER_EVENT_CREATED, constants.KITCHEN_ORDER_STATUS_RECEIVED_ID, order.model.CreatedAt)
+		for _, transition := range []struct {
+			statusID string
+			at       *time.Time
+		}{
+			{constants.KITCHEN_ORDER_STATUS_PREPARING_ID, order.model.PreparingAt},
+			{constants.KITCHEN_ORDER_STATUS_READY_ID, order.model.ReadyAt},
+			{constants.KITCHEN_ORDER_STATUS_FINISHED_ID, order.model.FinishedAt},
+		} {
+			if transition.at != nil {
+				seedReportEvent(t, db, order.model.ID, constants.KITCHEN_ORDER_EVENT_STATUS_CHANGED, transition.statusID, *transition.at)
+			}
+		}
+
+		for i, productID := range order.products {
+			item := models.OrderItemModel{
+				ID:             order.model.ID + "-" + productID + "-" + string(rune('a'+i)),
+				KitchenOrderID: order.model.ID,
+				OrderID:        order.model.OrderID,
+				ProductID:      productID,
+				Quantity:       1,
+			}
+			require.NoError(t, db.Create(&item).Error)
+		}
+	}
+}
+
+func reportFilter(groupBy string) dtos.KitchenReportFilter {
+	return dtos.KitchenReportFilter{
+		From:          reportTime(15, 0, 0),
+		To:            reportTime(16, 23, 59),
+		GroupBy:       groupBy,
+		ProductsLimit: 2,
+	}
+}
+
+func TestGormKitchenReportDataSource_CountThroughput(t *testing.T) {
+	db := setupTestDB(t)
+	seedReportOrders(t, db)
+	ds := &GormKitchenReportDataSource{db: db}
+
+	hourly, err := ds.CountThroughput(context.Background(), reportFilter(constants.REPORT_GROUP_BY_HOUR))
+	require.NoError(t, err)
+	require.Len(t, hourly, 3)
+	assert.Equal(t, reportTime(15, 12, 0), hourly[0].PeriodStart)
+	assert.Equal(t, 2, hourly[0].Received)
+	assert.Equal(t, 1, hourly[0].Finished)
+	assert.Equal(t, reportTime(15, 13, 0), hourly[1].PeriodStart)
+	assert.Equal(t, 1, hourly[1].Received)
+	assert.Equal(t, 1, hourly[1].Finished)
+	assert.Equal(t, reportTime(16, 9, 0), hourly[2].PeriodStart)
+
+	daily, err := ds.CountThroughput(context.Background(), reportFilter(constants.REPORT_GROUP_BY_DAY))
+	require.NoError(t, err)
+	require.Len(t, daily, 2)
+	assert.Equal(t, reportTime(15, 0, 0), daily[0].PeriodStart)
+	assert.Equal(t, 3, daily[0].Received)
+	assert.Equal(t, 2, daily[0].Finished)
+	assert.Equal(t, 1, daily[1].Received)
+	t.Log("✓ Pedidos recebidos e finalizados contados por período")
+}
+
+func TestGormKitchenReportDataSource_FindStageDurations(t *testing.T) {
+	db := setupTestDB(t)
+	seedReportOrders(t, db)
+	ds := &GormKitchenReportDataSource{db: db}
+
+	overall, err := ds.FindStageDurations(context.Background(), reportFilter(constants.REPORT_GROUP_BY_DAY), false)
+	require.NoError(t, err)
+
+	byStatus := make(map[string]daos.StageDurationDAO)
+	for _, duration := range overall {
+		assert.Nil(t, duration.PeriodStart)
+		byStatus[duration.StatusID] = duration
+	}
+
+	received := byStatus[constants.KITCHEN_ORDER_STATUS_RECEIVED_ID]
+	assert.Equal(t, 4, received.Orders)
+	assert.InDelta(t, 135, received.AverageSeconds, 0.01)
+	assert.InDelta(t, 300, received.P90Seconds, 0.01)
+
+	preparing := byStatus[constants.KITCHEN_ORDER_STATUS_PREPARING_ID]
+	assert.Equal(t, 3, preparing.Orders)
+	assert.InDelta(t, 660, preparing.AverageSeconds, 0.01)
+	assert.InDelta(t, 1200, preparing.P90Seconds, 0.01)
+
+	ready := byStatus[constants.KITCHEN_ORDER_STATUS_READY_ID]
+	assert.Equal(t, 2, ready.Orders)
+	assert.InDelta(t, 510, ready.AverageSeconds, 0.01)
+	assert.InDelta(t, 840, ready.P90Seconds, 0.01)
+
+	hourly, err := ds.FindStageDurations(context.Background(), reportFilter(constants.REPORT_GROUP_BY_HOUR), true)
+	require.NoError(t, err)
+	require.Len(t, hourly, 6)
+	for _, duration := range hourly {
+		require.NotNil(t, duration.PeriodStart)
+	}
+	assert.Equal(t, reportTime(15, 12, 0), *hourly[0].PeriodStart)
+	assert.Equal(t, reportTime(16, 9, 0), *hourly[5].PeriodStart)
+	assert.NotEmpty(t, hourly[0].StatusName)
+	t.Log("✓ Média e p90 do tempo em cada status calculados no banco")
+}
+
+func TestGormKitchenReportDataSource_FindStageDurationsWithRecall(t *testing.T) {
+	db := setupTestDB(t)
+	ds := &GormKitchenReportDataSource{db: db}
+
+	// Pedido devolvido à preparação: o tempo das duas passagens soma na mesma etapa
+	order := models.KitchenOrderModel{ID: "order-r", OrderID: "ext-order-r", Slug: "order-r", CreatedAt: reportTime(15, 12, 0), StatusID: constants.KITCHEN_ORDER_STATUS_FINISHED_ID}
+	require.NoError(t, db.Create(&order).Error)
+
+	seedReportEvent(t, db, order.ID, constants.KITCHEN_ORDER_EVENT_CREATED, constants.KITCHEN_ORDER_STATUS_RECEIVED_ID, reportTime(15, 12, 0))
+	seedReportEvent(t, db, order.ID, constants.KITCHEN_ORDER_EVENT_STATUS_CHANGED, constants.KITCHEN_ORDER_STATUS_PREPARING_ID, reportTime(15, 12, 1))
+	seedReportEvent(t, db, order.ID, constants.KITCHEN_ORDER_EVENT_STATUS_CHANGED, constants.KITCHEN_ORDER_STATUS_READY_ID, reportTime(15, 12, 11))
+	seedReportEvent(t, db, order.ID, constants.KITCHEN_ORDER_EVENT_STATUS_CHANGED, constants.KITCHEN_ORDER_STATUS_PREPARING_ID, reportTime(15, 12, 13))
+	seedReportEvent(t, db, order.ID, constants.KITCHEN_ORDER_EVENT_STATUS_CHANGED, constants.KITCHEN_ORDER_STATUS_READY_ID, reportTime(15, 12, 18))
+	seedReportEvent(t, db, order.ID, constants.KITCHEN_ORDER_EVENT_STATUS_CHANGED, constants.KITCHEN_ORDER_STATUS_FINISHED_ID, reportTime(15, 12, 20))
+
+	durations, err := ds.FindStageDurations(context.Background(), reportFilter(constants.REPORT_GROUP_BY_DAY), false)
+	require.NoError(t, err)
+
+	byStatus := make(map[string]daos.StageDurationDAO)
+	for _, duration := range durations {
+		byStatus[duration.StatusID] = duration
+	}
+
+	assert.InDelta(t, 60, byStatus[constants.KITCHEN_ORDER_STATUS_RECEIVED_ID].AverageSeconds, 0.01)
+	assert.Equal(t, 1, byStatus[constants.KITCHEN_ORDER_STATUS_PREPARING_ID].Orders)
+	assert.InDelta(t, 900, byStatus[constants.KITCHEN_ORDER_STATUS_PREPARING_ID].AverageSeconds, 0.01)
+	assert.InDelta(t, 240, byStatus[constants.KITCHEN_ORDER_STATUS_READY_ID].AverageSeconds, 0.01)
+	assert.NotContains(t, byStatus, constants.KITCHEN_ORDER_STATUS_FINISHED_ID)
+	t.Log("✓ Etapas calculadas pelo histórico de eventos, inclusive com recall")
+}
+
+func TestGormKitchenReportDataSource_FindSlowestProducts(t *testing.T) {
+	db := setupTestDB(t)
+	seedReportOrders(t, db)
+	ds := &GormKitchenReportDataSource{db: db}
+
+	products, err := ds.FindSlowestProducts(context.Background(), reportFilter(constants.REPORT_GROUP_BY_DAY))
+	require.NoError(t, err)
+
+	require.Len(t, products, 2)
+	assert.Equal(t, "burger", products[0].ProductID)
+	assert.Equal(t, 2, products[0].Orders, "a product repeated in one order counts once")
+	assert.InDelta(t, 900, products[0].AverageSeconds, 0.01)
+	assert.InDelta(t, 1200, products[0].P90Seconds, 0.01)
+	assert.Equal(t, "soda", products[1].ProductID)
+	assert.InDelta(t, 600, products[1].AverageSeconds, 0.01)
+	t.Log("✓ Produtos mais lentos ordenados pelo tempo médio de preparo")
+}
+
+func TestGormKitchenReportDataSource_CountByStatus(t *testing.T) {
+	db := setupTestDB(t)
+	seedReportOrders(t, db)
+	ds := &GormKitchenReportDataSource{db: db}
+
+	counts, err := ds.CountByStatus(context.Background(), []string{
+		constants.KITCHEN_ORDER_STATUS_RECEIVED_ID,
+		constants.KITCHEN_ORDER_STATUS_PREPARING_ID,
+		constants.KITCHEN_ORDER_STATUS_READY_ID,
+	})
+	require.NoError(t, err)
+
+	ordersByStatus := make(map[string]int)
+	for _, count := range counts {
+		ordersByStatus[count.StatusID] = count.Orders
+	}
+
+	assert.Len(t, counts, 3)
+	assert.Equal(t, 0, ordersByStatus[constants.KITCHEN_ORDER_STATUS_RECEIVED_ID], "empty statuses are returned with zero")
+	assert.Equal(t, 2, ordersByStatus[constants.KITCHEN_ORDER_STATUS_PREPARING_ID])
+	assert.Equal(t, 1, ordersByStatus[constants.KITCHEN_ORDER_STATUS_READY_ID])
+	t.Log("✓ Pedidos contados por status do quadro")
+}
+
+func TestGormKitchenReportDataSource_FindOldestCreatedAt(t *testing.T) {
+	db := setupTestDB(t)
+	seedReportOrders(t, db)
+	ds := &GormKitchenReportDataSource{db: db}
+
+	oldest, err := ds.FindOldestCreatedAt(context.Background(), constants.KITCHEN_ORDER_STATUS_RECEIVED_ID)
+	require.NoError(t, err)
+	assert.Nil(t, oldest)
+
+	for _, order := range []models.KitchenOrderModel{
+		{ID: "received-new", CreatedAt: reportTime(17, 10, 0)},
+		{ID: "received-old", CreatedAt: reportTime(17, 9, 45)},
+	} {
+		order.OrderID = "ext-" + order.ID
+		order.Slug = order.ID
+		order.StatusID = constants.KITCHEN_ORDER_STATUS_RECEIVED_ID
+		require.NoError(t, db.Create(&order).Error)
+	}
+
+	oldest, err = ds.FindOldestCreatedAt(context.Background(), constants.KITCHEN_ORDER_STATUS_RECEIVED_ID)
+	require.NoError(t, err)
+	require.NotNil(t, oldest)
+	assert.True(t, reportTime(17, 9, 45).Equal(*oldest))
+	t.Log("✓ Pedido recebido mais antigo encontrado")
+}
+
+func TestGormKitchenReportDataSource_BatchTimeout(t *testing.T) {
+	db := setupTestDB(t)
+	seedReportOrders(t, db)
+	ds := &GormKitchenReportDataSource{db: db, timeouts: database.QueryTimeouts{Batch: time.Nanosecond}}
+
+	_, err := ds.CountThroughput(context.Background(), reportFilter(constants.REPORT_GROUP_BY_DAY))
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	t.Log("✓ Relatório que excede o timeout de lote é interrompido")
+}
+
+func TestGormKitchenReportDataSource_CanceledContext(t *testing.T) {
+	db := setupTestDB(t)
+	ds := &GormKitchenReportDataSource{db: db}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := ds.CountByStatus(ctx, []string{constants.KITCHEN_ORDER_STATUS_RECEIVED_ID})
+	assert.ErrorIs(t, err, context.Canceled)
+	t.Log("✓ Contagem do quadro interrompida quando o contexto do chamador é cancelado")
+}
@@ -1,6 +1,8 @@
 package data_sources
 
 import (
+	"context"
+
 	"gorm.io/gorm"
 
 	"tech_challenge/internal/daos"
@@ -10,35 +12,46 @@ import (
 )
 
 type GormOrderStatusDataSource struct {
-	db *gorm.DB
+	db       *gorm.DB
+	timeouts database.QueryTimeouts
 }
 
 func NewGormOrderStatusDataSource() *GormOrderStatusDataSource {
 	return &GormOrderStatusDataSource{
-		db: database.GetDB(),
+		db:       database.GetDB(),
+		timeouts: database.GetQueryTimeouts(),
 	}
 }
 
-func (r *GormOrderStatusDataSource) Insert(orderStatus daos.OrderStatusDAO) error {
+func (r *GormOrderStatusDataSource) Insert(ctx context.Context, orderStatus daos.OrderStatusDAO) error {
+	ctx, cancel := database.WithTimeout(ctx, r.timeouts.Write)
+	defer cancel()
+
 	orderStatusModel := mappers.FromDAOToModelOrderStatus(orderStatus)
 
-	return r.db.Model(&daos.OrderStatusDAO{}).Create(&orderStatusModel).Error
+	return r.db.WithContext(ctx).Model(&daos.OrderStatusDAO{}).Create(&orderStatusModel).Error
 }
 
-func (r *GormOrderStatusDataSource) FindAll() ([]daos.OrderStatusDAO, error) {
+func (r *GormOrderStatusDataSource) FindAll(ctx context.Context) ([]daos.OrderStatusDAO, error) {
+	ctx, cancel := database.WithTimeout(ctx, r.timeouts.Read)
+	defer cancel()
+
 	var orderStatus []*models.OrderStatusModel
 
-	if err := r.db.Find(&orderStatus).Error; err != nil {
+	if err := r.db.WithContext(ctx).Find(&orderStatus).Error; err != nil {
 		return nil, err
 	}
 
 	return mappers.FromModelArrayToDAOArrayOrderStatus(orderStatus), nil
 }
 
-func (r *GormOrderStatusDataSource) FindByID(id string) (daos.OrderStatusDAO, error) {
+func (r *GormOrderStatusDataSource) FindByID(ctx context.Context, id string) (daos.OrderStatusDAO, error) {
+	ctx, cancel := database.WithTimeout(ctx, r.timeouts.Read)
+	defer cancel()
+
 	var orderStatus *models.OrderStatusModel
 
-	if err := r.db.First(&orderStatus, "id = ?", id).Error; err != nil {
+	if err := r.db.WithContext(ctx).First(&orderStatus, "id = ?", id).Error; err != nil {
 		return daos.OrderStatusDAO{}, err
 	}
 
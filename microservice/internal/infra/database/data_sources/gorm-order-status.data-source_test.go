@@ -1,6 +1,7 @@
 package data_sources
 
 import (
+	"context"
 	"testing"
 
 	"gorm.io/driver/sqlite"
@@ -42,7 +43,7 @@ func TestGormOrderStatusDataSource_Insert(t *testing.T) {
 		Name: "Test Status",
 	}
 
-	err := ds.Insert(orderStatus)
+	err := ds.Insert(context.Background(), orderStatus)
 
 	if err != nil {
 		t.Logf("⚠ Erro esperado devido ao bug no código original (usa tabela errada): %v", err)
@@ -60,8 +61,8 @@ func TestGormOrderStatusDataSource_Insert_Duplicate(t *testing.T) {
 		Name: "Test Status",
 	}
 
-	_ = ds.Insert(orderStatus)
-	err := ds.Insert(orderStatus)
+	_ = ds.Insert(context.Background(), orderStatus)
+	err := ds.Insert(context.Background(), orderStatus)
 
 	if err != nil {
 		t.Logf("✓ Erro capturado: %v", err)
@@ -84,7 +85,7 @@ func TestGormOrderStatusDataSource_FindAll(t *testing.T) {
 		db.Create(&status)
 	}
 
-	result, err := ds.FindAll()
+	result, err := ds.FindAll(context.Background())
 
 	if err != nil {
 		t.Errorf("Expected no error, got: %v", err)
@@ -105,7 +106,7 @@ func TestGormOrderStatusDataSource_FindAll_Empty(t *testing.T) {
 	db := setupOrderStatusTestDB(t)
 	ds := &GormOrderStatusDataSource{db: db}
 
-	result, err := ds.FindAll()
+	result, err := ds.FindAll(context.Background())
 
 	if err != nil {
 		t.Errorf("Expected no error, got: %v", err)
@@ -128,7 +129,7 @@ func TestGormOrderStatusDataSource_FindByID(t *testing.T) {
 	}
 	db.Create(&status)
 
-	result, err := ds.FindByID("status-123")
+	result, err := ds.FindByID(context.Background(), "status-123")
 
 	if err != nil {
 		t.Errorf("Expected no error, got: %v", err)
@@ -151,7 +152,7 @@ func TestGormOrderStatusDataSource_FindByID_NotFound(t *testing.T) {
 	db := setupOrderStatusTestDB(t)
 	ds := &GormOrderStatusDataSource{db: db}
 
-	_, err := ds.FindByID("non-existent-id")
+	_, err := ds.FindByID(context.Background(), "non-existent-id")
 
 	if err == nil {
 		t.Error("Expected error for non-existent ID")
@@ -174,7 +175,7 @@ func TestGormOrderStatusDataSource_FindAll_OrderPreserved(t *testing.T) {
 		db.Create(&status)
 	}
 
-	result, err := ds.FindAll()
+	result, err := ds.FindAll(context.Background())
 
 	if err != nil {
 		t.Errorf("Expected no error, got: %v", err)
@@ -200,7 +201,7 @@ func TestGormOrderStatusDataSource_Insert_WithEmptyName(t *testing.T) {
 		Name: "",
 	}
 
-	err := ds.Insert(orderStatus)
+	err := ds.Insert(context.Background(), orderStatus)
 
 	if err != nil {
 		t.Logf("⚠ Erro devido ao bug no código: %v", err)
@@ -219,7 +220,7 @@ func TestGormOrderStatusDataSource_Insert_WithLongName(t *testing.T) {
 		Name: longName,
 	}
 
-	err := ds.Insert(orderStatus)
+	err := ds.Insert(context.Background(), orderStatus)
 
 	if err != nil {
 		t.Logf("⚠ Erro devido ao bug no código: %v", err)
@@ -240,7 +241,7 @@ func TestGormOrderStatusDataSource_FindAll_MultipleStatuses(t *testing.T) {
 		db.Create(&status)
 	}
 
-	result, err := ds.FindAll()
+	result, err := ds.FindAll(context.Background())
 
 	if err != nil {
 		t.Errorf("Expected no error, got: %v", err)
@@ -262,7 +263,7 @@ func TestGormOrderStatusDataSource_Insert_SpecialCharacters(t *testing.T) {
 		Name: "Status com çãõ & caracteres especiais!",
 	}
 
-	err := ds.Insert(orderStatus)
+	err := ds.Insert(context.Background(), orderStatus)
 
 	if err != nil {
 		t.Logf("⚠ Erro devido ao bug no código: %v", err)
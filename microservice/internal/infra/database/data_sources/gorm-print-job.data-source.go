@@ -0,0 +1,133 @@
+package data_sources
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"tech_challenge/internal/application/dtos"
+	"tech_challenge/internal/daos"
+	"tech_challenge/internal/infra/database/mappers"
+	"tech_challenge/internal/infra/database/models"
+	"tech_challenge/internal/shared/config/constants"
+	"tech_challenge/internal/shared/infra/database"
+)
+
+type GormPrintJobDataSource struct {
+	db *gorm.DB
+}
+
+func NewGormPrintJobDataSource() *GormPrintJobDataSource {
+	return &GormPrintJobDataSource{
+		db: database.GetDB(),
+	}
+}
+
+func (r *GormPrintJobDataSource) InsertAll(ctx context.Context, jobs []daos.PrintJobDAO) error {
+	if len(jobs) == 0 {
+		return nil
+	}
+
+	jobModels := make([]*models.PrintJobModel, len(jobs))
+	for i, job := range jobs {
+		jobModels[i] = mappers.FromDAOToModelPrintJob(job)
+	}
+
+	return database.Conn(ctx, r.db).Create(&jobModels).Error
+}
+
+func (r *GormPrintJobDataSource) FindAll(filter dtos.PrintJobFilter) ([]daos.PrintJobDAO, error) {
+	var jobs []*models.PrintJobModel
+
+	query := r.db.Model(&models.PrintJobModel{})
+
+	if filter.KitchenOrderID != "" {
+		query = query.Where("kitchen_order_id = ?", filter.KitchenOrderID)
+	}
+
+	if filter.PrinterID != "" {
+		query = query.Where("printer_id = ?", filter.PrinterID)
+	}
+
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+
+	if err := query.Order("created_at DESC").Find(&jobs).Error; err != nil {
+		return nil, err
+	}
+
+	return mappers.FromModelArrayToDAOArrayPrintJob(jobs), nil
+}
+
+// ClaimDue também recupera trabalhos "printing" cuja reserva expirou, como os de uma réplica que caiu no meio da impressão.
+// Cada reserva incrementa attempts, que funciona como versão: só uma réplica consegue reservar a mesma tentativa
+func (r *GormPrintJobDataSource) ClaimDue(now, leaseUntil time.Time, limit int) ([]daos.PrintJobDAO, error) {
+	var candidates []*models.PrintJobModel
+
+	if err := r.db.
+		Where("status IN ? AND next_attempt_at <= ?", []string{constants.PRINT_JOB_STATUS_PENDING, constants.PRINT_JOB_STATUS_PRINTING}, now).
+		Order("next_attempt_at ASC").
+		Limit(limit).
+		Find(&candidates).Error; err != nil {
+		return nil, err
+	}
+
+	claimed := make([]*models.PrintJobModel, 0, len(candidates))
+	for _, candidate := range candidates {
+		result := r.db.Model(&models.PrintJobModel{}).
+			Where("id = ? AND attempts = ? AND status IN ?", candidate.ID, candidate.Attempts, []string{constants.PRINT_JOB_STATUS_PENDING, constants.PRINT_JOB_STATUS_PRINTING}).
+			Updates(map[string]interface{}{
+				"status":          constants.PRINT_JOB_STATUS_PRINTING,
+				"attempts":        candidate.Attempts + 1,
+				"next_attempt_at": leaseUntil,
+				"updated_at":      now,
+			})
+
+		if result.Error != nil {
+			return nil, result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			continue
+		}
+
+		candidate.Status = constants.PRINT_JOB_STATUS_PRINTING
+		candidate.Attempts++
+		candidate.NextAttemptAt = leaseUntil
+		candidate.UpdatedAt = &now
+		claimed = append(claimed, candidate)
+	}
+
+	return mappers.FromModelArrayToDAOArrayPrintJob(claimed), nil
+}
+
+// Update só grava sobre a tentativa reservada; se a reserva expirou e outra réplica assumiu o trabalho, nada é alterado
+func (r *GormPrintJobDataSource) Update(job daos.PrintJobDAO) error {
+	jobModel := mappers.FromDAOToModelPrintJob(job)
+
+	result := r.db.Model(&models.PrintJobModel{}).
+		Where("id = ? AND attempts = ?", job.ID, job.Attempts).
+		Updates(map[string]interface{}{
+			"status":          jobModel.Status,
+			"last_error":      jobModel.LastError,
+			"next_attempt_at": jobModel.NextAttemptAt,
+			"printed_at":      jobModel.PrintedAt,
+			"updated_at":      jobModel.UpdatedAt,
+		})
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,151 @@
+package data_sources
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"tech_challenge/internal/application/dtos"
+	"tech_challenge/internal/daos"
+	"tech_challenge/internal/infra/database/models"
+	"tech_challenge/internal/shared/config/constants"
+)
+
+func setupPrintingTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("Failed to connect to test database: %v", err)
+	}
+
+	err = db.AutoMigrate(&models.PrinterModel{}, &models.PrintRouteModel{}, &models.PrintJobModel{})
+	if err != nil {
+		t.Fatalf("Failed to migrate test database: %v", err)
+	}
+
+	return db
+}
+
+func newTestPrintJobDAO(id, kitchenOrderID string, createdAt time.Time) daos.PrintJobDAO {
+	return daos.PrintJobDAO{
+		ID:             id,
+		KitchenOrderID: kitchenOrderID,
+		PrinterID:      "printer-1",
+		Station:        "grill",
+		ItemIDs:        []string{"item-1", "item-2"},
+		Status:         constants.PRINT_JOB_STATUS_PENDING,
+		NextAttemptAt:  createdAt,
+		CreatedAt:      createdAt,
+	}
+}
+
+func TestGormPrintJobDataSource_ClaimDueOnlyOnce(t *testing.T) {
+	db := setupPrintingTestDB(t)
+	ds := &GormPrintJobDataSource{db: db}
+
+	now := time.Now()
+	future := newTestPrintJobDAO("job-2", "order-1", now)
+	future.NextAttemptAt = now.Add(time.Minute)
+
+	if err := ds.InsertAll(context.Background(), []daos.PrintJobDAO{newTestPrintJobDAO("job-1", "order-1", now.Add(-time.Second)), future}); err != nil {
+		t.Fatalf("InsertAll failed: %v", err)
+	}
+
+	claimed, err := ds.ClaimDue(now, now.Add(30*time.Second), 10)
+	if err != nil {
+		t.Fatalf("ClaimDue failed: %v", err)
+	}
+
+	if len(claimed) != 1 || claimed[0].ID != "job-1" {
+		t.Fatalf("Expected only the due job to be claimed, got %+v", claimed)
+	}
+
+	if claimed[0].Status != constants.PRINT_JOB_STATUS_PRINTING || claimed[0].Attempts != 1 || len(claimed[0].ItemIDs) != 2 {
+		t.Errorf("Expected claimed job on its first attempt, got %+v", claimed[0])
+	}
+
+	again, _ := ds.ClaimDue(now, now.Add(30*time.Second), 10)
+	if len(again) != 0 {
+		t.Errorf("Expected leased job not to be claimed again, got %d", len(again))
+	} else {
+		t.Log("✓ Trabalho reservado por apenas um worker")
+	}
+}
+
+func TestGormPrintJobDataSource_ReclaimsExpiredLease(t *testing.T) {
+	db := setupPrintingTestDB(t)
+	ds := &GormPrintJobDataSource{db: db}
+
+	now := time.Now()
+	if err := ds.InsertAll(context.Background(), []daos.PrintJobDAO{newTestPrintJobDAO("job-1", "order-1", now.Add(-time.Minute))}); err != nil {
+		t.Fatalf("InsertAll failed: %v", err)
+	}
+
+	first, _ := ds.ClaimDue(now.Add(-time.Minute), now.Add(-time.Second), 10)
+	if len(first) != 1 {
+		t.Fatalf("Expected job to be claimed, got %d", len(first))
+	}
+
+	second, _ := ds.ClaimDue(now, now.Add(30*time.Second), 10)
+	if len(second) != 1 || second[0].Attempts != 2 {
+		t.Fatalf("Expected expired lease to be claimed again, got %+v", second)
+	}
+
+	// O worker da reserva expirada não sobrescreve o resultado de quem assumiu o trabalho
+	stale := first[0]
+	stale.Status = constants.PRINT_JOB_STATUS_PRINTED
+	if err := ds.Update(stale); err == nil {
+		t.Errorf("Expected stale update to be rejected")
+	}
+
+	current := second[0]
+	printedAt := time.Now()
+	current.Status = constants.PRINT_JOB_STATUS_PRINTED
+	current.PrintedAt = &printedAt
+	if err := ds.Update(current); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+
+	jobs, _ := ds.FindAll(dtos.PrintJobFilter{Status: constants.PRINT_JOB_STATUS_PRINTED})
+	if len(jobs) != 1 || jobs[0].PrintedAt == nil {
+		t.Errorf("Expected printed job, got %+v", jobs)
+	} else {
+		t.Log("✓ Reserva expirada retomada sem conflito com o worker anterior")
+	}
+}
+
+func TestGormPrintJobDataSource_FindAllFilters(t *testing.T) {
+	db := setupPrintingTestDB(t)
+	ds := &GormPrintJobDataSource{db: db}
+
+	now := time.Now()
+	other := newTestPrintJobDAO("job-3", "order-2", now)
+	other.PrinterID = "printer-2"
+
+	if err := ds.InsertAll(context.Background(), []daos.PrintJobDAO{
+		newTestPrintJobDAO("job-1", "order-1", now.Add(-2*time.Second)),
+		newTestPrintJobDAO("job-2", "order-1", now.Add(-time.Second)),
+		other,
+	}); err != nil {
+		t.Fatalf("InsertAll failed: %v", err)
+	}
+
+	byOrder, _ := ds.FindAll(dtos.PrintJobFilter{KitchenOrderID: "order-1"})
+	if len(byOrder) != 2 || byOrder[0].ID != "job-2" {
+		t.Errorf("Expected newest jobs of order-1 first, got %+v", byOrder)
+	}
+
+	byPrinter, _ := ds.FindAll(dtos.PrintJobFilter{PrinterID: "printer-2"})
+	if len(byPrinter) != 1 || byPrinter[0].ID != "job-3" {
+		t.Errorf("Expected only printer-2 job, got %+v", byPrinter)
+	}
+
+	limited, _ := ds.FindAll(dtos.PrintJobFilter{Limit: 1})
+	if len(limited) != 1 {
+		t.Errorf("Expected limit to apply, got %d", len(limited))
+	} else {
+		t.Log("✓ Histórico de impressão filtrado por pedido, impressora e limite")
+	}
+}
@@ -0,0 +1,51 @@
+package data_sources
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"tech_challenge/internal/daos"
+	"tech_challenge/internal/infra/database/mappers"
+	"tech_challenge/internal/infra/database/models"
+	"tech_challenge/internal/shared/infra/database"
+)
+
+type GormPrintRouteDataSource struct {
+	db *gorm.DB
+}
+
+func NewGormPrintRouteDataSource() *GormPrintRouteDataSource {
+	return &GormPrintRouteDataSource{
+		db: database.GetDB(),
+	}
+}
+
+func (r *GormPrintRouteDataSource) FindAll(ctx context.Context) ([]daos.PrintRouteDAO, error) {
+	var routes []*models.PrintRouteModel
+
+	if err := database.Conn(ctx, r.db).Order("position ASC").Find(&routes).Error; err != nil {
+		return nil, err
+	}
+
+	return mappers.FromModelArrayToDAOArrayPrintRoute(routes), nil
+}
+
+func (r *GormPrintRouteDataSource) ReplaceAll(routes []daos.PrintRouteDAO) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("1 = 1").Delete(&models.PrintRouteModel{}).Error; err != nil {
+			return err
+		}
+
+		if len(routes) == 0 {
+			return nil
+		}
+
+		routeModels := make([]*models.PrintRouteModel, len(routes))
+		for i, route := range routes {
+			routeModels[i] = mappers.FromDAOToModelPrintRoute(route)
+		}
+
+		return tx.Create(&routeModels).Error
+	})
+}
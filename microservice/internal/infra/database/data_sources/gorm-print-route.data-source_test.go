@@ -0,0 +1,49 @@
+package data_sources
+
+import (
+	"context"
+	"testing"
+
+	"tech_challenge/internal/daos"
+)
+
+func TestGormPrintRouteDataSource_ReplaceAll(t *testing.T) {
+	db := setupPrintingTestDB(t)
+	ds := &GormPrintRouteDataSource{db: db}
+
+	if err := ds.ReplaceAll([]daos.PrintRouteDAO{
+		{ID: "r1", Position: 1, ProductPattern: "*-drink", Station: "bar"},
+		{ID: "r2", Position: 0, ProductPattern: "burger-*", Station: "grill"},
+	}); err != nil {
+		t.Fatalf("ReplaceAll failed: %v", err)
+	}
+
+	routes, err := ds.FindAll(context.Background())
+	if err != nil {
+		t.Fatalf("FindAll failed: %v", err)
+	}
+
+	if len(routes) != 2 || routes[0].ID != "r2" {
+		t.Fatalf("Expected routes ordered by position, got %+v", routes)
+	}
+
+	if err := ds.ReplaceAll([]daos.PrintRouteDAO{{ID: "r3", Position: 0, ProductPattern: "*", Station: "kitchen"}}); err != nil {
+		t.Fatalf("ReplaceAll failed: %v", err)
+	}
+
+	routes, _ = ds.FindAll(context.Background())
+	if len(routes) != 1 || routes[0].ID != "r3" {
+		t.Errorf("Expected previous routes to be replaced, got %+v", routes)
+	}
+
+	if err := ds.ReplaceAll(nil); err != nil {
+		t.Fatalf("ReplaceAll failed: %v", err)
+	}
+
+	routes, _ = ds.FindAll(context.Background())
+	if len(routes) != 0 {
+		t.Errorf("Expected routes to be cleared, got %d", len(routes))
+	} else {
+		t.Log("✓ Regras de roteamento substituídas por completo")
+	}
+}
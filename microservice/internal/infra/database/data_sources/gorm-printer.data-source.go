@@ -0,0 +1,100 @@
+package data_sources
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"tech_challenge/internal/daos"
+	"tech_challenge/internal/infra/database/mappers"
+	"tech_challenge/internal/infra/database/models"
+	"tech_challenge/internal/shared/infra/database"
+)
+
+type GormPrinterDataSource struct {
+	db *gorm.DB
+}
+
+func NewGormPrinterDataSource() *GormPrinterDataSource {
+	return &GormPrinterDataSource{
+		db: database.GetDB(),
+	}
+}
+
+func (r *GormPrinterDataSource) Insert(printer daos.PrinterDAO) error {
+	printerModel := mappers.FromDAOToModelPrinter(printer)
+
+	return r.db.Create(printerModel).Error
+}
+
+func (r *GormPrinterDataSource) FindByID(id string) (daos.PrinterDAO, error) {
+	var printer *models.PrinterModel
+
+	if err := r.db.First(&printer, "id = ?", id).Error; err != nil {
+		return daos.PrinterDAO{}, err
+	}
+
+	return mappers.FromModelToDAOPrinter(printer), nil
+}
+
+func (r *GormPrinterDataSource) FindAll() ([]daos.PrinterDAO, error) {
+	var printers []*models.PrinterModel
+
+	if err := r.db.Order("station ASC, name ASC").Find(&printers).Error; err != nil {
+		return nil, err
+	}
+
+	return mappers.FromModelArrayToDAOArrayPrinter(printers), nil
+}
+
+func (r *GormPrinterDataSource) FindAllActive(ctx context.Context) ([]daos.PrinterDAO, error) {
+	var printers []*models.PrinterModel
+
+	if err := database.Conn(ctx, r.db).Where("active = ?", true).Order("station ASC, name ASC").Find(&printers).Error; err != nil {
+		return nil, err
+	}
+
+	return mappers.FromModelArrayToDAOArrayPrinter(printers), nil
+}
+
+func (r *GormPrinterDataSource) Update(printer daos.PrinterDAO) error {
+	printerModel := mappers.FromDAOToModelPrinter(printer)
+
+	updates := map[string]interface{}{
+		"name":       printerModel.Name,
+		"host":       printerModel.Host,
+		"port":       printerModel.Port,
+		"station":    printerModel.Station,
+		"width":      printerModel.Width,
+		"active":     printerModel.Active,
+		"updated_at": printerModel.UpdatedAt,
+	}
+
+	result := r.db.Model(&models.PrinterModel{}).
+		Where("id = ?", printer.ID).
+		Updates(updates)
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+// Delete mantém os trabalhos já registrados como histórico; os pendentes falham ao não encontrar a impressora
+func (r *GormPrinterDataSource) Delete(id string) error {
+	result := r.db.Delete(&models.PrinterModel{}, "id = ?", id)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
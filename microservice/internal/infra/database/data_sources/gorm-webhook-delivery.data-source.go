@@ -0,0 +1,40 @@
+package data_sources
+
+import (
+	"gorm.io/gorm"
+
+	"tech_challenge/internal/daos"
+	"tech_challenge/internal/infra/database/mappers"
+	"tech_challenge/internal/infra/database/models"
+	"tech_challenge/internal/shared/infra/database"
+)
+
+type GormWebhookDeliveryDataSource struct {
+	db *gorm.DB
+}
+
+func NewGormWebhookDeliveryDataSource() *GormWebhookDeliveryDataSource {
+	return &GormWebhookDeliveryDataSource{
+		db: database.GetDB(),
+	}
+}
+
+func (r *GormWebhookDeliveryDataSource) Insert(delivery daos.WebhookDeliveryDAO) error {
+	deliveryModel := mappers.FromDAOToModelWebhookDelivery(delivery)
+
+	return r.db.Create(deliveryModel).Error
+}
+
+func (r *GormWebhookDeliveryDataSource) FindBySubscriptionID(subscriptionID string, limit int) ([]daos.WebhookDeliveryDAO, error) {
+	var deliveries []*models.WebhookDeliveryModel
+
+	if err := r.db.
+		Where("subscription_id = ?", subscriptionID).
+		Order("created_at DESC").
+		Limit(limit).
+		Find(&deliveries).Error; err != nil {
+		return nil, err
+	}
+
+	return mappers.FromModelArrayToDAOArrayWebhookDelivery(deliveries), nil
+}
@@ -0,0 +1,106 @@
+package data_sources
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"tech_challenge/internal/daos"
+	"tech_challenge/internal/infra/database/mappers"
+	"tech_challenge/internal/infra/database/models"
+	"tech_challenge/internal/shared/config/constants"
+	"tech_challenge/internal/shared/infra/database"
+)
+
+type GormWebhookOutboxDataSource struct {
+	db *gorm.DB
+}
+
+func NewGormWebhookOutboxDataSource() *GormWebhookOutboxDataSource {
+	return &GormWebhookOutboxDataSource{
+		db: database.GetDB(),
+	}
+}
+
+// InsertAll participa da transação em ctx, para que as entregas só existam se a mudança que as originou for confirmada
+func (r *GormWebhookOutboxDataSource) InsertAll(ctx context.Context, entries []daos.WebhookOutboxDAO) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	entryModels := make([]*models.WebhookOutboxModel, len(entries))
+	for i, entry := range entries {
+		entryModels[i] = mappers.FromDAOToModelWebhookOutbox(entry)
+	}
+
+	return database.Conn(ctx, r.db).Create(&entryModels).Error
+}
+
+// ClaimDue também recupera entregas "sending" cuja reserva expirou, como as de uma réplica encerrada no meio do envio.
+// Cada reserva incrementa attempts, que funciona como versão: só uma réplica consegue reservar a mesma tentativa
+func (r *GormWebhookOutboxDataSource) ClaimDue(now, leaseUntil time.Time, limit int) ([]daos.WebhookOutboxDAO, error) {
+	var candidates []*models.WebhookOutboxModel
+	claimable := []string{constants.WEBHOOK_OUTBOX_STATUS_PENDING, constants.WEBHOOK_OUTBOX_STATUS_SENDING}
+
+	if err := r.db.
+		Where("status IN ? AND next_attempt_at <= ?", claimable, now).
+		Order("next_attempt_at ASC").
+		Limit(limit).
+		Find(&candidates).Error; err != nil {
+		return nil, err
+	}
+
+	claimed := make([]*models.WebhookOutboxModel, 0, len(candidates))
+	for _, candidate := range candidates {
+		result := r.db.Model(&models.WebhookOutboxModel{}).
+			Where("id = ? AND attempts = ? AND status IN ?", candidate.ID, candidate.Attempts, claimable).
+			Updates(map[string]interface{}{
+				"status":          constants.WEBHOOK_OUTBOX_STATUS_SENDING,
+				"attempts":        candidate.Attempts + 1,
+				"next_attempt_at": leaseUntil,
+				"updated_at":      now,
+			})
+
+		if result.Error != nil {
+			return nil, result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			continue
+		}
+
+		candidate.Status = constants.WEBHOOK_OUTBOX_STATUS_SENDING
+		candidate.Attempts++
+		candidate.NextAttemptAt = leaseUntil
+		candidate.UpdatedAt = &now
+		claimed = append(claimed, candidate)
+	}
+
+	return mappers.FromModelArrayToDAOArrayWebhookOutbox(claimed), nil
+}
+
+// Update só grava sobre a tentativa reservada; se a reserva expirou e outra réplica assumiu a entrega, nada é alterado
+func (r *GormWebhookOutboxDataSource) Update(entry daos.WebhookOutboxDAO) error {
+	entryModel := mappers.FromDAOToModelWebhookOutbox(entry)
+
+	result := r.db.Model(&models.WebhookOutboxModel{}).
+		Where("id = ? AND attempts = ?", entry.ID, entry.Attempts).
+		Updates(map[string]interface{}{
+			"status":          entryModel.Status,
+			"last_error":      entryModel.LastError,
+			"next_attempt_at": entryModel.NextAttemptAt,
+			"delivered_at":    entryModel.DeliveredAt,
+			"updated_at":      entryModel.UpdatedAt,
+		})
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package data_sources
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"tech_challenge/internal/daos"
+	"tech_challenge/internal/shared/config/constants"
+)
+
+func newTestWebhookOutboxDAO(id string, createdAt time.Time) daos.WebhookOutboxDAO {
+	return daos.WebhookOutboxDAO{
+		ID:             id,
+		SubscriptionID: "sub-1",
+		EventID:        "evt-1",
+		Event:          constants.WEBHOOK_EVENT_KITCHEN_ORDER_READY,
+		Payload:        []byte(`{"id":"evt-1"}`),
+		Status:         constants.WEBHOOK_OUTBOX_STATUS_PENDING,
+		NextAttemptAt:  createdAt,
+		CreatedAt:      createdAt,
+	}
+}
+
+func TestGormWebhookOutboxDataSource_ClaimDueOnlyOnce(t *testing.T) {
+	db := setupWebhookTestDB(t)
+	ds := &GormWebhookOutboxDataSource{db: db}
+
+	now := time.Now()
+	future := newTestWebhookOutboxDAO("entry-2", now)
+	future.NextAttemptAt = now.Add(time.Minute)
+
+	if err := ds.InsertAll(context.Background(), []daos.WebhookOutboxDAO{newTestWebhookOutboxDAO("entry-1", now.Add(-time.Second)), future}); err != nil {
+		t.Fatalf("InsertAll failed: %v", err)
+	}
+
+	claimed, err := ds.ClaimDue(now, now.Add(30*time.Second), 10)
+	if err != nil {
+		t.Fatalf("ClaimDue failed: %v", err)
+	}
+
+	if len(claimed) != 1 || claimed[0].ID != "entry-1" {
+		t.Fatalf("Expected only the due entry to be claimed, got %+v", claimed)
+	}
+
+	if claimed[0].Status != constants.WEBHOOK_OUTBOX_STATUS_SENDING || claimed[0].Attempts != 1 || string(claimed[0].Payload) != `{"id":"evt-1"}` {
+		t.Errorf("Expected claimed entry on its first attempt, got %+v", claimed[0])
+	}
+
+	again, _ := ds.ClaimDue(now, now.Add(30*time.Second), 10)
+	if len(again) != 0 {
+		t.Errorf("Expected leased entry not to be claimed again, got %d", len(again))
+	} else {
+		t.Log("✓ Entrega reservada por apenas um worker")
+	}
+}
+
+func TestGormWebhookOutboxDataSource_ReclaimsExpiredLease(t *testing.T) {
+	db := setupWebhookTestDB(t)
+	ds := &GormWebhookOutboxDataSource{db: db}
+
+	now := time.Now()
+	if err := ds.InsertAll(context.Background(), []daos.WebhookOutboxDAO{newTestWebhookOutboxDAO("entry-1", now.Add(-time.Minute))}); err != nil {
+		t.Fatalf("InsertAll failed: %v", err)
+	}
+
+	// A réplica que reservou a entrega foi encerrada antes de concluir o envio
+	first, _ := ds.ClaimDue(now.Add(-time.Minute), now.Add(-time.Second), 10)
+	if len(first) != 1 {
+		t.Fatalf("Expected entry to be claimed, got %d", len(first))
+	}
+
+	second, _ := ds.ClaimDue(now, now.Add(30*time.Second), 10)
+	if len(second) != 1 || second[0].Attempts != 2 {
+		t.Fatalf("Expected expired lease to be claimed again, got %+v", second)
+	}
+
+	stale := first[0]
+	stale.Status = constants.WEBHOOK_OUTBOX_STATUS_DELIVERED
+	if err := ds.Update(stale); err == nil {
+		t.Errorf("Expected stale update to be rejected")
+	}
+
+	current := second[0]
+	deliveredAt := time.Now()
+	current.Status = constants.WEBHOOK_OUTBOX_STATUS_DELIVERED
+	current.DeliveredAt = &deliveredAt
+	if err := ds.Update(current); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+
+	remaining, _ := ds.ClaimDue(now.Add(time.Hour), now.Add(time.Hour), 10)
+	if len(remaining) != 0 {
+		t.Errorf("Expected delivered entry to leave the queue, got %+v", remaining)
+	} else {
+		t.Log("✓ Entrega retomada após a reserva expirar e removida da fila ao ser entregue")
+	}
+}
@@ -0,0 +1,120 @@
+package data_sources
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"tech_challenge/internal/daos"
+	"tech_challenge/internal/infra/database/mappers"
+	"tech_challenge/internal/infra/database/models"
+	"tech_challenge/internal/shared/infra/database"
+)
+
+type GormWebhookSubscriptionDataSource struct {
+	db *gorm.DB
+}
+
+func NewGormWebhookSubscriptionDataSource() *GormWebhookSubscriptionDataSource {
+	return &GormWebhookSubscriptionDataSource{
+		db: database.GetDB(),
+	}
+}
+
+func (r *GormWebhookSubscriptionDataSource) Insert(subscription daos.WebhookSubscriptionDAO) error {
+	subscriptionModel := mappers.FromDAOToModelWebhookSubscription(subscription)
+
+	return r.db.Create(subscriptionModel).Error
+}
+
+func (r *GormWebhookSubscriptionDataSource) FindByID(id string) (daos.WebhookSubscriptionDAO, error) {
+	var subscription *models.WebhookSubscriptionModel
+
+	if err := r.db.First(&subscription, "id = ?", id).Error; err != nil {
+		return daos.WebhookSubscriptionDAO{}, err
+	}
+
+	return mappers.FromModelToDAOWebhookSubscription(subscription), nil
+}
+
+func (r *GormWebhookSubscriptionDataSource) FindAll() ([]daos.WebhookSubscriptionDAO, error) {
+	var subscriptions []*models.WebhookSubscriptionModel
+
+	if err := r.db.Order("created_at ASC").Find(&subscriptions).Error; err != nil {
+		return nil, err
+	}
+
+	return mappers.FromModelArrayToDAOArrayWebhookSubscription(subscriptions), nil
+}
+
+func (r *GormWebhookSubscriptionDataSource) FindAllActive() ([]daos.WebhookSubscriptionDAO, error) {
+	var subscriptions []*models.WebhookSubscriptionModel
+
+	if err := r.db.Where("active = ?", true).Find(&subscriptions).Error; err != nil {
+		return nil, err
+	}
+
+	return mappers.FromModelArrayToDAOArrayWebhookSubscription(subscriptions), nil
+}
+
+func (r *GormWebhookSubscriptionDataSource) Update(subscription daos.WebhookSubscriptionDAO) error {
+	subscriptionModel := mappers.FromDAOToModelWebhookSubscription(subscription)
+
+	updates := map[string]interface{}{
+		"url":                  subscriptionModel.URL,
+		"events":               subscriptionModel.Events,
+		"active":               subscriptionModel.Active,
+		"consecutive_failures": subscriptionModel.ConsecutiveFailures,
+		"updated_at":           subscriptionModel.UpdatedAt,
+	}
+
+	result := r.db.Model(&models.WebhookSubscriptionModel{}).
+		Where("id = ?", subscription.ID).
+		Updates(updates)
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+func (r *GormWebhookSubscriptionDataSource) Delete(id string) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(&models.WebhookDeliveryModel{}, "subscription_id = ?", id).Error; err != nil {
+			return err
+		}
+
+		result := tx.Delete(&models.WebhookSubscriptionModel{}, "id = ?", id)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		return nil
+	})
+}
+
+// IncrementFailures incrementa o contador no próprio banco para não perder falhas de entregas concorrentes
+func (r *GormWebhookSubscriptionDataSource) IncrementFailures(id string, disableAfter int) error {
+	return r.db.Model(&models.WebhookSubscriptionModel{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"consecutive_failures": gorm.Expr("consecutive_failures + 1"),
+			"active":               gorm.Expr("CASE WHEN consecutive_failures + 1 >= ? THEN ? ELSE active END", disableAfter, false),
+			"updated_at":           time.Now(),
+		}).Error
+}
+
+func (r *GormWebhookSubscriptionDataSource) ResetFailures(id string) error {
+	return r.db.Model(&models.WebhookSubscriptionModel{}).
+		Where("id = ? AND consecutive_failures > 0", id).
+		Update("consecutive_failures", 0).Error
+}
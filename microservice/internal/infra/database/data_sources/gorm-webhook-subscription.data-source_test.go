@@ -0,0 +1,127 @@
+package data_sources
+
+import (
+	"testing"
+	"time"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"tech_challenge/internal/daos"
+	"tech_challenge/internal/infra/database/models"
+)
+
+func setupWebhookTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("Failed to connect to test database: %v", err)
+	}
+
+	err = db.AutoMigrate(&models.WebhookSubscriptionModel{}, &models.WebhookDeliveryModel{}, &models.WebhookOutboxModel{})
+	if err != nil {
+		t.Fatalf("Failed to migrate test database: %v", err)
+	}
+
+	return db
+}
+
+func newTestWebhookSubscriptionDAO(id string) daos.WebhookSubscriptionDAO {
+	return daos.WebhookSubscriptionDAO{
+		ID:        id,
+		URL:       "https://partner.example.com/hooks",
+		Secret:    "0123456789abcdef",
+		Events:    []string{"kitchen_order.ready", "kitchen_order.finished"},
+		Active:    true,
+		CreatedAt: time.Now(),
+	}
+}
+
+func TestGormWebhookSubscriptionDataSource_InsertAndFind(t *testing.T) {
+	db := setupWebhookTestDB(t)
+	ds := &GormWebhookSubscriptionDataSource{db: db}
+
+	if err := ds.Insert(newTestWebhookSubscriptionDAO("sub-1")); err != nil {
+		t.Fatalf("Insert failed: %v", err)
+	}
+
+	found, err := ds.FindByID("sub-1")
+	if err != nil {
+		t.Fatalf("FindByID failed: %v", err)
+	}
+
+	if len(found.Events) != 2 || found.Events[1] != "kitchen_order.finished" {
+		t.Errorf("Expected events to round-trip, got %v", found.Events)
+	}
+
+	t.Log("✓ Assinatura inserida e encontrada com eventos preservados")
+}
+
+func TestGormWebhookSubscriptionDataSource_IncrementFailuresDisables(t *testing.T) {
+	db := setupWebhookTestDB(t)
+	ds := &GormWebhookSubscriptionDataSource{db: db}
+	_ = ds.Insert(newTestWebhookSubscriptionDAO("sub-1"))
+
+	for i := 0; i < 2; i++ {
+		if err := ds.IncrementFailures("sub-1", 3); err != nil {
+			t.Fatalf("IncrementFailures failed: %v", err)
+		}
+	}
+
+	found, _ := ds.FindByID("sub-1")
+	if !found.Active || found.ConsecutiveFailures != 2 {
+		t.Errorf("Expected active subscription with 2 failures, got active=%v failures=%d", found.Active, found.ConsecutiveFailures)
+	}
+
+	_ = ds.IncrementFailures("sub-1", 3)
+
+	found, _ = ds.FindByID("sub-1")
+	if found.Active {
+		t.Error("Expected subscription to be disabled after reaching the threshold")
+	}
+
+	active, _ := ds.FindAllActive()
+	if len(active) != 0 {
+		t.Errorf("Expected no active subscriptions, got %d", len(active))
+	}
+
+	if err := ds.ResetFailures("sub-1"); err != nil {
+		t.Fatalf("ResetFailures failed: %v", err)
+	}
+
+	found, _ = ds.FindByID("sub-1")
+	if found.ConsecutiveFailures != 0 {
+		t.Errorf("Expected failures to be reset, got %d", found.ConsecutiveFailures)
+	}
+
+	t.Log("✓ Assinatura desativada após falhas consecutivas")
+}
+
+func TestGormWebhookSubscriptionDataSource_DeleteRemovesDeliveries(t *testing.T) {
+	db := setupWebhookTestDB(t)
+	ds := &GormWebhookSubscriptionDataSource{db: db}
+	deliveries := &GormWebhookDeliveryDataSource{db: db}
+	_ = ds.Insert(newTestWebhookSubscriptionDAO("sub-1"))
+	_ = deliveries.Insert(daos.WebhookDeliveryDAO{
+		ID:             "delivery-1",
+		SubscriptionID: "sub-1",
+		EventID:        "evt-1",
+		Event:          "kitchen_order.ready",
+		Attempt:        1,
+		StatusCode:     500,
+		CreatedAt:      time.Now(),
+	})
+
+	if err := ds.Delete("sub-1"); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+
+	remaining, _ := deliveries.FindBySubscriptionID("sub-1", 10)
+	if len(remaining) != 0 {
+		t.Errorf("Expected deliveries to be removed, got %d", len(remaining))
+	}
+
+	if err := ds.Delete("sub-1"); err != gorm.ErrRecordNotFound {
+		t.Errorf("Expected ErrRecordNotFound, got %v", err)
+	}
+
+	t.Log("✓ Assinatura e entregas removidas")
+}
@@ -0,0 +1,51 @@
+package mappers
+
+import (
+	"strings"
+
+	"tech_challenge/internal/daos"
+	"tech_challenge/internal/infra/database/models"
+)
+
+const apiKeyScopesSeparator = ","
+
+func FromDAOToModelAPIKey(apiKey daos.APIKeyDAO) *models.APIKeyModel {
+	return &models.APIKeyModel{
+		ID:         apiKey.ID,
+		Name:       apiKey.Name,
+		Prefix:     apiKey.Prefix,
+		KeyHash:    apiKey.KeyHash,
+		Scopes:     strings.Join(apiKey.Scopes, apiKeyScopesSeparator),
+		CreatedBy:  apiKey.CreatedBy,
+		CreatedAt:  apiKey.CreatedAt,
+		LastUsedAt: apiKey.LastUsedAt,
+		RevokedAt:  apiKey.RevokedAt,
+	}
+}
+
+func FromModelToDAOAPIKey(apiKey *models.APIKeyModel) daos.APIKeyDAO {
+	scopes := []string{}
+	if apiKey.Scopes != "" {
+		scopes = strings.Split(apiKey.Scopes, apiKeyScopesSeparator)
+	}
+
+	return daos.APIKeyDAO{
+		ID:         apiKey.ID,
+		Name:       apiKey.Name,
+		Prefix:     apiKey.Prefix,
+		KeyHash:    apiKey.KeyHash,
+		Scopes:     scopes,
+		CreatedBy:  apiKey.CreatedBy,
+		CreatedAt:  apiKey.CreatedAt,
+		LastUsedAt: apiKey.LastUsedAt,
+		RevokedAt:  apiKey.RevokedAt,
+	}
+}
+
+func FromModelArrayToDAOArrayAPIKey(models []*models.APIKeyModel) []daos.APIKeyDAO {
+	daos := make([]daos.APIKeyDAO, len(models))
+	for i, model := range models {
+		daos[i] = FromModelToDAOAPIKey(model)
+	}
+	return daos
+}
@@ -0,0 +1,26 @@
+package mappers
+
+import (
+	"tech_challenge/internal/daos"
+	"tech_challenge/internal/infra/database/models"
+)
+
+func FromDAOToModelIdempotencyKey(idempotencyKey daos.IdempotencyKeyDAO) *models.IdempotencyKeyModel {
+	return &models.IdempotencyKeyModel{
+		Scope:       idempotencyKey.Scope,
+		Key:         idempotencyKey.Key,
+		Fingerprint: idempotencyKey.Fingerprint,
+		ResourceID:  idempotencyKey.ResourceID,
+		CreatedAt:   idempotencyKey.CreatedAt,
+	}
+}
+
+func FromModelToDAOIdempotencyKey(idempotencyKey *models.IdempotencyKeyModel) daos.IdempotencyKeyDAO {
+	return daos.IdempotencyKeyDAO{
+		Scope:       idempotencyKey.Scope,
+		Key:         idempotencyKey.Key,
+		Fingerprint: idempotencyKey.Fingerprint,
+		ResourceID:  idempotencyKey.ResourceID,
+		CreatedAt:   idempotencyKey.CreatedAt,
+	}
+}
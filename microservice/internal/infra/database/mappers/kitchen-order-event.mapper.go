@@ -0,0 +1,46 @@
+package mappers
+
+import (
+	"time"
+
+	"tech_challenge/internal/daos"
+	"tech_challenge/internal/infra/database/models"
+)
+
+// NewKitchenOrderEventModel cria o registro do feed a partir do estado do pedido após a alteração
+func NewKitchenOrderEventModel(eventType string, kitchenOrder daos.KitchenOrderDAO, occurredAt time.Time) *models.KitchenOrderEventModel {
+	return &models.KitchenOrderEventModel{
+		Type:           eventType,
+		KitchenOrderID: kitchenOrder.ID,
+		OrderID:        kitchenOrder.OrderID,
+		Slug:           kitchenOrder.Slug,
+		StatusID:       kitchenOrder.Status.ID,
+		StatusName:     kitchenOrder.Status.Name,
+		Reason:         kitchenOrder.StatusReason,
+		Actor:          kitchenOrder.StatusChangedBy,
+		CreatedAt:      occurredAt,
+	}
+}
+
+func FromModelToDAOKitchenOrderEvent(event *models.KitchenOrderEventModel) daos.KitchenOrderEventDAO {
+	return daos.KitchenOrderEventDAO{
+		ID:             event.ID,
+		Type:           event.Type,
+		KitchenOrderID: event.KitchenOrderID,
+		OrderID:        event.OrderID,
+		Slug:           event.Slug,
+		StatusID:       event.StatusID,
+		StatusName:     event.StatusName,
+		Reason:         event.Reason,
+		Actor:          event.Actor,
+		CreatedAt:      event.CreatedAt,
+	}
+}
+
+func FromModelArrayToDAOArrayKitchenOrderEvent(events []*models.KitchenOrderEventModel) []daos.KitchenOrderEventDAO {
+	result := make([]daos.KitchenOrderEventDAO, len(events))
+	for i, event := range events {
+		result[i] = FromModelToDAOKitchenOrderEvent(event)
+	}
+	return result
+}
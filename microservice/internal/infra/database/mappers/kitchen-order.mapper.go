@@ -19,15 +19,21 @@ func FromDAOToModelKitchenOrder(kitchenOrder daos.KitchenOrderDAO) *models.Kitch
 	}
 
 	return &models.KitchenOrderModel{
-		ID:         kitchenOrder.ID,
-		OrderID:    kitchenOrder.OrderID,
-		CustomerID: kitchenOrder.CustomerID,
-		Amount:     kitchenOrder.Amount,
-		StatusID:   kitchenOrder.Status.ID,
-		Slug:       kitchenOrder.Slug,
-		Items:      items,
-		CreatedAt:  kitchenOrder.CreatedAt,
-		UpdatedAt:  kitchenOrder.UpdatedAt,
+		ID:              kitchenOrder.ID,
+		OrderID:         kitchenOrder.OrderID,
+		CustomerID:      kitchenOrder.CustomerID,
+		Amount:          kitchenOrder.Amount,
+		StatusID:        kitchenOrder.Status.ID,
+		Slug:            kitchenOrder.Slug,
+		Items:           items,
+		Version:         kitchenOrder.Version,
+		StatusReason:    kitchenOrder.StatusReason,
+		StatusChangedBy: kitchenOrder.StatusChangedBy,
+		PreparingAt:     kitchenOrder.PreparingAt,
+		ReadyAt:         kitchenOrder.ReadyAt,
+		FinishedAt:      kitchenOrder.FinishedAt,
+		CreatedAt:       kitchenOrder.CreatedAt,
+		UpdatedAt:       kitchenOrder.UpdatedAt,
 	}
 }
 
@@ -50,15 +56,21 @@ func FromModelToDAOKitchenOrder(kitchenOrder *models.KitchenOrderModel) daos.Kit
 	}
 
 	return daos.KitchenOrderDAO{
-		ID:         kitchenOrder.ID,
-		OrderID:    kitchenOrder.OrderID,
-		CustomerID: kitchenOrder.CustomerID,
-		Amount:     kitchenOrder.Amount,
-		Status:     statusDAO,
-		Slug:       kitchenOrder.Slug,
-		Items:      items,
-		CreatedAt:  kitchenOrder.CreatedAt,
-		UpdatedAt:  kitchenOrder.UpdatedAt,
+		ID:              kitchenOrder.ID,
+		OrderID:         kitchenOrder.OrderID,
+		CustomerID:      kitchenOrder.CustomerID,
+		Amount:          kitchenOrder.Amount,
+		Status:          statusDAO,
+		Slug:            kitchenOrder.Slug,
+		Items:           items,
+		Version:         kitchenOrder.Version,
+		StatusReason:    kitchenOrder.StatusReason,
+		StatusChangedBy: kitchenOrder.StatusChangedBy,
+		PreparingAt:     kitchenOrder.PreparingAt,
+		ReadyAt:         kitchenOrder.ReadyAt,
+		FinishedAt:      kitchenOrder.FinishedAt,
+		CreatedAt:       kitchenOrder.CreatedAt,
+		UpdatedAt:       kitchenOrder.UpdatedAt,
 	}
 }
 
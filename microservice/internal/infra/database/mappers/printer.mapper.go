@@ -0,0 +1,123 @@
+package mappers
+
+import (
+	"strings"
+
+	"tech_challenge/internal/daos"
+	"tech_challenge/internal/infra/database/models"
+)
+
+const printJobItemsSeparator = ","
+
+func FromDAOToModelPrinter(printer daos.PrinterDAO) *models.PrinterModel {
+	return &models.PrinterModel{
+		ID:        printer.ID,
+		Name:      printer.Name,
+		Host:      printer.Host,
+		Port:      printer.Port,
+		Station:   printer.Station,
+		Width:     printer.Width,
+		Active:    printer.Active,
+		CreatedAt: printer.CreatedAt,
+		UpdatedAt: printer.UpdatedAt,
+	}
+}
+
+func FromModelToDAOPrinter(printer *models.PrinterModel) daos.PrinterDAO {
+	return daos.PrinterDAO{
+		ID:        printer.ID,
+		Name:      printer.Name,
+		Host:      printer.Host,
+		Port:      printer.Port,
+		Station:   printer.Station,
+		Width:     printer.Width,
+		Active:    printer.Active,
+		CreatedAt: printer.CreatedAt,
+		UpdatedAt: printer.UpdatedAt,
+	}
+}
+
+func FromModelArrayToDAOArrayPrinter(models []*models.PrinterModel) []daos.PrinterDAO {
+	daos := make([]daos.PrinterDAO, len(models))
+	for i, model := range models {
+		daos[i] = FromModelToDAOPrinter(model)
+	}
+	return daos
+}
+
+func FromDAOToModelPrintRoute(route daos.PrintRouteDAO) *models.PrintRouteModel {
+	return &models.PrintRouteModel{
+		ID:             route.ID,
+		Position:       route.Position,
+		ProductPattern: route.ProductPattern,
+		Station:        route.Station,
+	}
+}
+
+func FromModelToDAOPrintRoute(route *models.PrintRouteModel) daos.PrintRouteDAO {
+	return daos.PrintRouteDAO{
+		ID:             route.ID,
+		Position:       route.Position,
+		ProductPattern: route.ProductPattern,
+		Station:        route.Station,
+	}
+}
+
+func FromModelArrayToDAOArrayPrintRoute(models []*models.PrintRouteModel) []daos.PrintRouteDAO {
+	daos := make([]daos.PrintRouteDAO, len(models))
+	for i, model := range models {
+		daos[i] = FromModelToDAOPrintRoute(model)
+	}
+	return daos
+}
+
+func FromDAOToModelPrintJob(job daos.PrintJobDAO) *models.PrintJobModel {
+	return &models.PrintJobModel{
+		ID:             job.ID,
+		KitchenOrderID: job.KitchenOrderID,
+		PrinterID:      job.PrinterID,
+		Station:        job.Station,
+		ItemIDs:        strings.Join(job.ItemIDs, printJobItemsSeparator),
+		Status:         job.Status,
+		Attempts:       job.Attempts,
+		LastError:      job.LastError,
+		Reprint:        job.Reprint,
+		RequestedBy:    job.RequestedBy,
+		NextAttemptAt:  job.NextAttemptAt,
+		PrintedAt:      job.PrintedAt,
+		CreatedAt:      job.CreatedAt,
+		UpdatedAt:      job.UpdatedAt,
+	}
+}
+
+func FromModelToDAOPrintJob(job *models.PrintJobModel) daos.PrintJobDAO {
+	itemIDs := []string{}
+	if job.ItemIDs != "" {
+		itemIDs = strings.Split(job.ItemIDs, printJobItemsSeparator)
+	}
+
+	return daos.PrintJobDAO{
+		ID:             job.ID,
+		KitchenOrderID: job.KitchenOrderID,
+		PrinterID:      job.PrinterID,
+		Station:        job.Station,
+		ItemIDs:        itemIDs,
+		Status:         job.Status,
+		Attempts:       job.Attempts,
+		LastError:      job.LastError,
+		Reprint:        job.Reprint,
+		RequestedBy:    job.RequestedBy,
+		NextAttemptAt:  job.NextAttemptAt,
+		PrintedAt:      job.PrintedAt,
+		CreatedAt:      job.CreatedAt,
+		UpdatedAt:      job.UpdatedAt,
+	}
+}
+
+func FromModelArrayToDAOArrayPrintJob(models []*models.PrintJobModel) []daos.PrintJobDAO {
+	daos := make([]daos.PrintJobDAO, len(models))
+	for i, model := range models {
+		daos[i] = FromModelToDAOPrintJob(model)
+	}
+	return daos
+}
@@ -0,0 +1,129 @@
+package mappers
+
+import (
+	"strings"
+
+	"tech_challenge/internal/daos"
+	"tech_challenge/internal/infra/database/models"
+)
+
+const webhookEventsSeparator = ","
+
+func FromDAOToModelWebhookSubscription(subscription daos.WebhookSubscriptionDAO) *models.WebhookSubscriptionModel {
+	return &models.WebhookSubscriptionModel{
+		ID:                  subscription.ID,
+		URL:                 subscription.URL,
+		Secret:              subscription.Secret,
+		Events:              strings.Join(subscription.Events, webhookEventsSeparator),
+		Active:              subscription.Active,
+		ConsecutiveFailures: subscription.ConsecutiveFailures,
+		CreatedAt:           subscription.CreatedAt,
+		UpdatedAt:           subscription.UpdatedAt,
+	}
+}
+
+func FromModelToDAOWebhookSubscription(subscription *models.WebhookSubscriptionModel) daos.WebhookSubscriptionDAO {
+	events := []string{}
+	if subscription.Events != "" {
+		events = strings.Split(subscription.Events, webhookEventsSeparator)
+	}
+
+	return daos.WebhookSubscriptionDAO{
+		ID:                  subscription.ID,
+		URL:                 subscription.URL,
+		Secret:              subscription.Secret,
+		Events:              events,
+		Active:              subscription.Active,
+		ConsecutiveFailures: subscription.ConsecutiveFailures,
+		CreatedAt:           subscription.CreatedAt,
+		UpdatedAt:           subscription.UpdatedAt,
+	}
+}
+
+func FromModelArrayToDAOArrayWebhookSubscription(models []*models.WebhookSubscriptionModel) []daos.WebhookSubscriptionDAO {
+	daos := make([]daos.WebhookSubscriptionDAO, len(models))
+	for i, model := range models {
+		daos[i] = FromModelToDAOWebhookSubscription(model)
+	}
+	return daos
+}
+
+func FromDAOToModelWebhookDelivery(delivery daos.WebhookDeliveryDAO) *models.WebhookDeliveryModel {
+	return &models.WebhookDeliveryModel{
+		ID:             delivery.ID,
+		SubscriptionID: delivery.SubscriptionID,
+		EventID:        delivery.EventID,
+		Event:          delivery.Event,
+		Attempt:        delivery.Attempt,
+		StatusCode:     delivery.StatusCode,
+		Success:        delivery.Success,
+		Error:          delivery.Error,
+		DurationMs:     delivery.DurationMs,
+		CreatedAt:      delivery.CreatedAt,
+	}
+}
+
+func FromModelToDAOWebhookDelivery(delivery *models.WebhookDeliveryModel) daos.WebhookDeliveryDAO {
+	return daos.WebhookDeliveryDAO{
+		ID:             delivery.ID,
+		SubscriptionID: delivery.SubscriptionID,
+		EventID:        delivery.EventID,
+		Event:          delivery.Event,
+		Attempt:        delivery.Attempt,
+		StatusCode:     delivery.StatusCode,
+		Success:        delivery.Success,
+		Error:          delivery.Error,
+		DurationMs:     delivery.DurationMs,
+		CreatedAt:      delivery.CreatedAt,
+	}
+}
+
+func FromModelArrayToDAOArrayWebhookDelivery(models []*models.WebhookDeliveryModel) []daos.WebhookDeliveryDAO {
+	daos := make([]daos.WebhookDeliveryDAO, len(models))
+	for i, model := range models {
+		daos[i] = FromModelToDAOWebhookDelivery(model)
+	}
+	return daos
+}
+
+func FromDAOToModelWebhookOutbox(entry daos.WebhookOutboxDAO) *models.WebhookOutboxModel {
+	return &models.WebhookOutboxModel{
+		ID:             entry.ID,
+		SubscriptionID: entry.SubscriptionID,
+		EventID:        entry.EventID,
+		Event:          entry.Event,
+		Payload:        string(entry.Payload),
+		Status:         entry.Status,
+		Attempts:       entry.Attempts,
+		LastError:      entry.LastError,
+		NextAttemptAt:  entry.NextAttemptAt,
+		DeliveredAt:    entry.DeliveredAt,
+		CreatedAt:      entry.CreatedAt,
+		UpdatedAt:      entry.UpdatedAt,
+	}
+}
+
+func FromModelToDAOWebhookOutbox(entry *models.WebhookOutboxModel) daos.WebhookOutboxDAO {
+	return daos.WebhookOutboxDAO{
+		ID:             entry.ID,
+		SubscriptionID: entry.SubscriptionID,
+		EventID:        entry.EventID,
+		Event:          entry.Event,
+		Payload:        []byte(entry.Payload),
+		Status:         entry.Status,
+		Attempts:       entry.Attempts,
+		LastError:      entry.LastError,
+		NextAttemptAt:  entry.NextAttemptAt,
+		DeliveredAt:    entry.DeliveredAt,
+		CreatedAt:      entry.CreatedAt,
+		UpdatedAt:      entry.UpdatedAt,
+	}
+}
+
+func FromModelArrayToDAOArrayWebhookOutbox(models []*models.WebhookOutboxModel) []daos.WebhookOutboxDAO {
+	daos := make([]daos.WebhookOutboxDAO, len(models))
+	for i, model := range models {
+		daos[i] = FromModelToDAOWebhookOutbox(model)
+	}
+	return daos
+}
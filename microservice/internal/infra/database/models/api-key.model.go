@@ -0,0 +1,20 @@
+package models
+
+import "time"
+
+type APIKeyModel struct {
+	ID        string  `gorm:"primaryKey; size:36"`
+	Name      string  `gorm:"not null;size:100"`
+	Prefix    string  `gorm:"not null;size:32;uniqueIndex"`
+	KeyHash   string  `gorm:"not null;size:64"`
+	Scopes    string  `gorm:"not null;size:500"`
+	CreatedBy *string `gorm:"size:255"`
+
+	CreatedAt  time.Time  `gorm:"not null"`
+	LastUsedAt *time.Time `gorm:""`
+	RevokedAt  *time.Time `gorm:""`
+}
+
+func (APIKeyModel) TableName() string {
+	return "api_key"
+}
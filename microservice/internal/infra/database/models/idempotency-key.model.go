@@ -0,0 +1,17 @@
+package models
+
+import "time"
+
+// IdempotencyKeyModel registra as chaves Idempotency-Key recebidas; a chave primária composta impede reservas duplicadas entre réplicas
+type IdempotencyKeyModel struct {
+	Scope       string  `gorm:"primaryKey;size:100"`
+	Key         string  `gorm:"column:idempotency_key;primaryKey;size:255"`
+	Fingerprint string  `gorm:"not null;size:64"`
+	ResourceID  *string `gorm:"size:36"`
+
+	CreatedAt time.Time `gorm:"not null;index"`
+}
+
+func (IdempotencyKeyModel) TableName() string {
+	return "idempotency_key"
+}
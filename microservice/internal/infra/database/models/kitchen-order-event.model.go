@@ -0,0 +1,21 @@
+package models
+
+import "time"
+
+// KitchenOrderEventModel é o feed de alterações compartilhado entre as réplicas da API
+type KitchenOrderEventModel struct {
+	ID             uint64    `gorm:"primaryKey;autoIncrement"`
+	Type           string    `gorm:"not null;size:30"`
+	KitchenOrderID string    `gorm:"not null;size:36;index"`
+	OrderID        string    `gorm:"not null;size:36"`
+	Slug           string    `gorm:"not null;size:100"`
+	StatusID       string    `gorm:"not null;size:36"`
+	StatusName     string    `gorm:"not null;size:100"`
+	Reason         *string   `gorm:"size:255"`
+	Actor          *string   `gorm:"size:255"`
+	CreatedAt      time.Time `gorm:"not null;index"`
+}
+
+func (KitchenOrderEventModel) TableName() string {
+	return "kitchen_order_event"
+}
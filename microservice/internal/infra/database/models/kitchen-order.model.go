@@ -18,12 +18,18 @@ func (OrderItemModel) TableName() string {
 type KitchenOrderModel struct {
 	ID         string           `gorm:"primaryKey; size:36"`
 	OrderID    string           `gorm:"not null;size:36;index"`
-	CustomerID *string          `gorm:"size:36"`
+	CustomerID *string          `gorm:"size:36;index"`
 	Amount     float64          `gorm:"not null;type:decimal(10,2)"`
 	Slug       string           `gorm:"not null;size:100;"`
 	StatusID   string           `json:"statusId" gorm:"not null; size:36; index"`
 	Status     OrderStatusModel `json:"status" gorm:"foreignKey:StatusID;references:ID"`
 	Items      []OrderItemModel `gorm:"foreignKey:KitchenOrderID;references:ID"`
+	Version    uint64           `gorm:"not null;default:1"`
+	StatusReason *string        `gorm:"size:255"`
+	StatusChangedBy *string     `gorm:"size:255"`
+	PreparingAt     *time.Time  `gorm:""`
+	ReadyAt         *time.Time  `gorm:""`
+	FinishedAt      *time.Time  `gorm:""`
 
 	CreatedAt time.Time  `gorm:"not null; index"`
 	UpdatedAt *time.Time `gorm:""`
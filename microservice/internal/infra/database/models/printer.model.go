@@ -0,0 +1,53 @@
+package models
+
+import "time"
+
+type PrinterModel struct {
+	ID      string `gorm:"primaryKey; size:36"`
+	Name    string `gorm:"not null;size:100"`
+	Host    string `gorm:"not null;size:255"`
+	Port    int    `gorm:"not null;default:9100"`
+	Station string `gorm:"not null;size:50;index"`
+	Width   int    `gorm:"not null;default:0"`
+	Active  bool   `gorm:"not null;default:true"`
+
+	CreatedAt time.Time  `gorm:"not null"`
+	UpdatedAt *time.Time `gorm:""`
+}
+
+func (PrinterModel) TableName() string {
+	return "printer"
+}
+
+type PrintRouteModel struct {
+	ID             string `gorm:"primaryKey; size:36"`
+	Position       int    `gorm:"not null"`
+	ProductPattern string `gorm:"not null;size:255"`
+	Station        string `gorm:"not null;size:50"`
+}
+
+func (PrintRouteModel) TableName() string {
+	return "print_route"
+}
+
+type PrintJobModel struct {
+	ID             string  `gorm:"primaryKey; size:36"`
+	KitchenOrderID string  `gorm:"not null;size:36;index"`
+	PrinterID      string  `gorm:"not null;size:36;index"`
+	Station        string  `gorm:"not null;size:50"`
+	ItemIDs        string  `gorm:"not null;type:text"`
+	Status         string  `gorm:"not null;size:20;index:idx_print_job_queue,priority:1"`
+	Attempts       int     `gorm:"not null;default:0"`
+	LastError      string  `gorm:"size:1000"`
+	Reprint        bool    `gorm:"not null;default:false"`
+	RequestedBy    *string `gorm:"size:255"`
+
+	NextAttemptAt time.Time  `gorm:"not null;index:idx_print_job_queue,priority:2"`
+	PrintedAt     *time.Time `gorm:""`
+	CreatedAt     time.Time  `gorm:"not null;index"`
+	UpdatedAt     *time.Time `gorm:""`
+}
+
+func (PrintJobModel) TableName() string {
+	return "print_job"
+}
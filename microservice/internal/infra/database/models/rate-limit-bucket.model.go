@@ -0,0 +1,14 @@
+package models
+
+import "time"
+
+type RateLimitBucketModel struct {
+	BucketKey string    `gorm:"primaryKey;size:255"`
+	Tokens    float64   `gorm:"not null"`
+	UpdatedAt time.Time `gorm:"not null;index"`
+	Allowed   bool      `gorm:"not null;default:true"`
+}
+
+func (RateLimitBucketModel) TableName() string {
+	return "rate_limit_bucket"
+}
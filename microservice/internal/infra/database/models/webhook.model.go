@@ -0,0 +1,57 @@
+package models
+
+import "time"
+
+type WebhookSubscriptionModel struct {
+	ID                  string `gorm:"primaryKey; size:36"`
+	URL                 string `gorm:"not null;size:2048"`
+	Secret              string `gorm:"not null;size:255"`
+	Events              string `gorm:"not null;size:500"`
+	Active              bool   `gorm:"not null;default:true;index"`
+	ConsecutiveFailures int    `gorm:"not null;default:0"`
+
+	CreatedAt time.Time  `gorm:"not null"`
+	UpdatedAt *time.Time `gorm:""`
+}
+
+func (WebhookSubscriptionModel) TableName() string {
+	return "webhook_subscription"
+}
+
+type WebhookDeliveryModel struct {
+	ID             string `gorm:"primaryKey; size:36"`
+	SubscriptionID string `gorm:"not null;size:36;index"`
+	EventID        string `gorm:"not null;size:36;index"`
+	Event          string `gorm:"not null;size:100"`
+	Attempt        int    `gorm:"not null"`
+	StatusCode     int    `gorm:"not null"`
+	Success        bool   `gorm:"not null"`
+	Error          string `gorm:"size:1000"`
+	DurationMs     int64  `gorm:"not null"`
+
+	CreatedAt time.Time `gorm:"not null; index"`
+}
+
+func (WebhookDeliveryModel) TableName() string {
+	return "webhook_delivery"
+}
+
+type WebhookOutboxModel struct {
+	ID             string `gorm:"primaryKey; size:36"`
+	SubscriptionID string `gorm:"not null;size:36;index"`
+	EventID        string `gorm:"not null;size:36"`
+	Event          string `gorm:"not null;size:100"`
+	Payload        string `gorm:"not null;type:text"`
+	Status         string `gorm:"not null;size:20;index:idx_webhook_outbox_queue,priority:1"`
+	Attempts       int    `gorm:"not null;default:0"`
+	LastError      string `gorm:"size:1000"`
+
+	NextAttemptAt time.Time  `gorm:"not null;index:idx_webhook_outbox_queue,priority:2"`
+	DeliveredAt   *time.Time `gorm:""`
+	CreatedAt     time.Time  `gorm:"not null;index"`
+	UpdatedAt     *time.Time `gorm:""`
+}
+
+func (WebhookOutboxModel) TableName() string {
+	return "webhook_outbox"
+}
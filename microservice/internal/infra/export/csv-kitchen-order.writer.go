@@ -0,0 +1,116 @@
+package export
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"tech_challenge/internal/application/dtos"
+)
+
+var kitchenOrderCSVHeader = []string{
+	"id",
+	"order_id",
+	"slug",
+	"customer_id",
+	"status",
+	"amount",
+	"items",
+	"status_reason",
+	"created_at",
+	"preparing_at",
+	"ready_at",
+	"finished_at",
+	"waiting_seconds",
+	"preparation_seconds",
+	"total_seconds",
+}
+
+// CSVKitchenOrderWriter grava um pedido por linha; os itens ficam em uma coluna no formato "produto:quantidade;..."
+type CSVKitchenOrderWriter struct {
+	writer        *csv.Writer
+	headerWritten bool
+}
+
+func NewCSVKitchenOrderWriter(w io.Writer) *CSVKitchenOrderWriter {
+	return &CSVKitchenOrderWriter{
+		writer: csv.NewWriter(w),
+	}
+}
+
+func (w *CSVKitchenOrderWriter) Write(row dtos.KitchenOrderExportDTO) error {
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+
+	schema := toKitchenOrderExportSchema(row)
+
+	items := make([]string, len(schema.Items))
+	for i, item := range schema.Items {
+		items[i] = item.ProductID + ":" + strconv.Itoa(item.Quantity)
+	}
+
+	return w.writer.Write([]string{
+		schema.ID,
+		schema.OrderID,
+		schema.Slug,
+		optionalString(schema.CustomerID),
+		schema.Status,
+		strconv.FormatFloat(schema.Amount, 'f', 2, 64),
+		strings.Join(items, ";"),
+		optionalString(schema.StatusReason),
+		schema.CreatedAt.UTC().Format(time.RFC3339),
+		optionalTime(schema.PreparingAt),
+		optionalTime(schema.ReadyAt),
+		optionalTime(schema.FinishedAt),
+		optionalSeconds(schema.WaitingSeconds),
+		optionalSeconds(schema.PreparationSeconds),
+		optionalSeconds(schema.TotalSeconds),
+	})
+}
+
+// Close garante o cabeçalho mesmo quando o período não tem pedidos
+func (w *CSVKitchenOrderWriter) Close() error {
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+
+	w.writer.Flush()
+	return w.writer.Error()
+}
+
+// O cabeçalho só é gravado junto da primeira linha para que erros de validação ainda possam virar uma resposta de erro
+func (w *CSVKitchenOrderWriter) writeHeader() error {
+	if w.headerWritten {
+		return nil
+	}
+
+	w.headerWritten = true
+	return w.writer.Write(kitchenOrderCSVHeader)
+}
+
+func optionalString(value *string) string {
+	if value == nil {
+		return ""
+	}
+
+	return *value
+}
+
+func optionalTime(value *time.Time) string {
+	if value == nil {
+		return ""
+	}
+
+	return value.UTC().Format(time.RFC3339)
+}
+
+func optionalSeconds(value *float64) string {
+	if value == nil {
+		return ""
+	}
+
+	return strconv.FormatFloat(*value, 'f', 0, 64)
+}
@@ -0,0 +1,70 @@
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"tech_challenge/internal/application/dtos"
+)
+
+func exportRow() dtos.KitchenOrderExportDTO {
+	createdAt := time.Date(2024, 1, 15, 9, 0, 0, 0, time.FixedZone("BRT", -3*60*60))
+	preparingAt := createdAt.Add(90 * time.Second)
+	waiting := 90 * time.Second
+	reason := "Cliente devolveu o lanche, estava frio"
+
+	return dtos.KitchenOrderExportDTO{
+		ID:           "550e8400-e29b-41d4-a716-446655440000",
+		OrderID:      "order-001",
+		Slug:         "001",
+		Status:       dtos.OrderStatusDTO{Name: "Em preparação"},
+		Amount:       63,
+		StatusReason: &reason,
+		Items: []dtos.OrderItemDTO{
+			{ProductID: "burger", Quantity: 2, UnitPrice: 25.5},
+			{ProductID: "fries", Quantity: 1, UnitPrice: 12},
+		},
+		CreatedAt:       createdAt,
+		PreparingAt:     &preparingAt,
+		WaitingDuration: &waiting,
+	}
+}
+
+func TestCSVKitchenOrderWriter_WritesRows(t *testing.T) {
+	var buffer bytes.Buffer
+	writer := NewCSVKitchenOrderWriter(&buffer)
+
+	assert.NoError(t, writer.Write(exportRow()))
+	assert.NoError(t, writer.Close())
+
+	records, err := csv.NewReader(&buffer).ReadAll()
+	assert.NoError(t, err)
+	assert.Len(t, records, 2)
+	assert.Equal(t, kitchenOrderCSVHeader, records[0])
+
+	row := records[1]
+	assert.Equal(t, "63.00", row[5])
+	assert.Equal(t, "burger:2;fries:1", row[6])
+	assert.Equal(t, "Cliente devolveu o lanche, estava frio", row[7])
+	assert.Equal(t, "2024-01-15T12:00:00Z", row[8])
+	assert.Equal(t, "2024-01-15T12:01:30Z", row[9])
+	assert.Equal(t, []string{"", ""}, row[10:12])
+	assert.Equal(t, []string{"90", "", ""}, row[12:])
+	t.Log("✓ Horários em UTC, durações em segundos e etapas pendentes em branco")
+}
+
+func TestCSVKitchenOrderWriter_HeaderOnlyWhenEmpty(t *testing.T) {
+	var buffer bytes.Buffer
+	writer := NewCSVKitchenOrderWriter(&buffer)
+
+	assert.Empty(t, buffer.String())
+	assert.NoError(t, writer.Close())
+
+	records, err := csv.NewReader(&buffer).ReadAll()
+	assert.NoError(t, err)
+	assert.Equal(t, [][]string{kitchenOrderCSVHeader}, records)
+}
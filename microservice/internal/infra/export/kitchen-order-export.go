@@ -0,0 +1,48 @@
+package export
+
+import (
+	"time"
+
+	"tech_challenge/internal/application/dtos"
+	"tech_challenge/internal/infra/api/schemas"
+)
+
+func toKitchenOrderExportSchema(row dtos.KitchenOrderExportDTO) schemas.KitchenOrderExportSchema {
+	items := make([]schemas.OrderItemResponseSchema, len(row.Items))
+	for i, item := range row.Items {
+		items[i] = schemas.OrderItemResponseSchema{
+			ID:        item.ID,
+			OrderID:   item.OrderID,
+			ProductID: item.ProductID,
+			Quantity:  item.Quantity,
+			UnitPrice: item.UnitPrice,
+		}
+	}
+
+	return schemas.KitchenOrderExportSchema{
+		ID:                 row.ID,
+		OrderID:            row.OrderID,
+		CustomerID:         row.CustomerID,
+		Slug:               row.Slug,
+		Status:             row.Status.Name,
+		Amount:             row.Amount,
+		Items:              items,
+		StatusReason:       row.StatusReason,
+		CreatedAt:          row.CreatedAt,
+		PreparingAt:        row.PreparingAt,
+		ReadyAt:            row.ReadyAt,
+		FinishedAt:         row.FinishedAt,
+		WaitingSeconds:     durationSeconds(row.WaitingDuration),
+		PreparationSeconds: durationSeconds(row.PreparationDuration),
+		TotalSeconds:       durationSeconds(row.TotalDuration),
+	}
+}
+
+func durationSeconds(duration *time.Duration) *float64 {
+	if duration == nil {
+		return nil
+	}
+
+	seconds := duration.Seconds()
+	return &seconds
+}
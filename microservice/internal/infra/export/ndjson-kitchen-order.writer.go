@@ -0,0 +1,27 @@
+package export
+
+import (
+	"encoding/json"
+	"io"
+
+	"tech_challenge/internal/application/dtos"
+)
+
+// NDJSONKitchenOrderWriter grava um objeto JSON por linha, no mesmo formato de KitchenOrderExportSchema
+type NDJSONKitchenOrderWriter struct {
+	encoder *json.Encoder
+}
+
+func NewNDJSONKitchenOrderWriter(w io.Writer) *NDJSONKitchenOrderWriter {
+	return &NDJSONKitchenOrderWriter{
+		encoder: json.NewEncoder(w),
+	}
+}
+
+func (w *NDJSONKitchenOrderWriter) Write(row dtos.KitchenOrderExportDTO) error {
+	return w.encoder.Encode(toKitchenOrderExportSchema(row))
+}
+
+func (w *NDJSONKitchenOrderWriter) Close() error {
+	return nil
+}
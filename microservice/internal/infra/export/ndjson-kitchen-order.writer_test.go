@@ -0,0 +1,38 @@
+package export
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"tech_challenge/internal/infra/api/schemas"
+)
+
+func TestNDJSONKitchenOrderWriter_WritesOneObjectPerLine(t *testing.T) {
+	var buffer bytes.Buffer
+	writer := NewNDJSONKitchenOrderWriter(&buffer)
+
+	assert.NoError(t, writer.Write(exportRow()))
+	assert.NoError(t, writer.Write(exportRow()))
+	assert.NoError(t, writer.Close())
+
+	scanner := bufio.NewScanner(&buffer)
+	lines := 0
+	for scanner.Scan() {
+		lines++
+
+		var row schemas.KitchenOrderExportSchema
+		assert.NoError(t, json.Unmarshal(scanner.Bytes(), &row))
+		assert.Equal(t, "001", row.Slug)
+		assert.Len(t, row.Items, 2)
+		if assert.NotNil(t, row.WaitingSeconds) {
+			assert.Equal(t, 90.0, *row.WaitingSeconds)
+		}
+		assert.Nil(t, row.TotalSeconds)
+	}
+
+	assert.Equal(t, 2, lines)
+}
@@ -3,13 +3,15 @@ package consumers
 import (
 	"context"
 	"encoding/json"
-	"log"
+	"log/slog"
 
 	"tech_challenge/internal/application/controllers"
 	"tech_challenge/internal/application/dtos"
 	"tech_challenge/internal/factories"
 	"tech_challenge/internal/shared/config/env"
+	"tech_challenge/internal/shared/infra/metrics"
 	"tech_challenge/internal/shared/interfaces"
+	"tech_challenge/internal/shared/pkg/logger"
 )
 
 type KitchenOrderConsumer struct {
@@ -20,7 +22,7 @@ type KitchenOrderConsumer struct {
 func NewKitchenOrderConsumer(broker interfaces.MessageBroker) *KitchenOrderConsumer {
 	kitchenOrderDataSource := factories.NewKitchenOrderDataSource()
 	orderStatusDataSource := factories.NewOrderStatusDataSource()
-	kitchenOrderController := controllers.NewKitchenOrderController(kitchenOrderDataSource, orderStatusDataSource, broker)
+	kitchenOrderController := controllers.NewKitchenOrderController(kitchenOrderDataSource, orderStatusDataSource, broker, factories.NewWebhookDispatcher(), factories.NewPrintDispatcher())
 
 	return &KitchenOrderConsumer{
 		broker:                 broker,
@@ -46,22 +48,21 @@ func (c *KitchenOrderConsumer) Start(ctx context.Context) error {
 		return err
 	}
 
-	log.Printf("Kitchen order consumer started listening on SQS queue: %s", queueName)
+	slog.Info("Kitchen order consumer started", logger.QueueKey, metrics.QueueLabel(queueName))
 	return nil
 }
 
 func (c *KitchenOrderConsumer) handleCreate(ctx context.Context, msg interfaces.Message) error {
-
-	log.Printf("Message Body (raw): %s", string(msg.Body))
 	var createMsg CreateKitchenOrderMessage
 	if err := json.Unmarshal(msg.Body, &createMsg); err != nil {
-		log.Printf("Error unmarshaling create message: %v", err)
+		logger.FromContext(ctx).Error("Error unmarshaling create message", logger.ErrorKey, err)
 		return err
 	}
 
-	log.Printf("Received kitchen order creation request for order: %s", createMsg.OrderID)
+	ctx = logger.With(ctx, logger.OrderIDKey, createMsg.OrderID)
+	logger.FromContext(ctx).Info("Received kitchen order creation request")
 
-	kitchenOrder, err := c.kitchenOrderController.Create(dtos.CreateKitchenOrderDTO{
+	kitchenOrder, err := c.kitchenOrderController.Create(ctx, dtos.CreateKitchenOrderDTO{
 		OrderID: createMsg.OrderID,
 	})
 
@@ -71,15 +72,15 @@ func (c *KitchenOrderConsumer) handleCreate(ctx context.Context, msg interfaces.
 
 	if err != nil {
 		response.Error = err.Error()
-		log.Printf("Error creating kitchen order: %v", err)
+		logger.FromContext(ctx).Error("Error creating kitchen order", logger.ErrorKey, err)
 	} else {
 		response.Data = kitchenOrder
-		log.Printf("Kitchen order created successfully: %s (Slug: %s)", kitchenOrder.ID, kitchenOrder.Slug)
+		logger.FromContext(ctx).Info("Kitchen order created", logger.KitchenOrderIDKey, kitchenOrder.ID, "slug", kitchenOrder.Slug)
 	}
 
 	responseBody, marshalErr := json.Marshal(response)
 	if marshalErr != nil {
-		log.Printf("Error marshaling response: %v", marshalErr)
+		logger.FromContext(ctx).Error("Error marshaling response", logger.ErrorKey, marshalErr)
 		return err
 	}
 	responseMsg := interfaces.Message{
@@ -90,7 +91,7 @@ func (c *KitchenOrderConsumer) handleCreate(ctx context.Context, msg interfaces.
 
 	if responseQueue, ok := msg.Headers["reply-to"]; ok {
 		if publishErr := c.broker.Publish(ctx, responseQueue, responseMsg); publishErr != nil {
-			log.Printf("Error publishing response message: %v", publishErr)
+			logger.FromContext(ctx).Error("Error publishing response message", logger.ErrorKey, publishErr)
 		}
 	}
 
@@ -61,9 +61,9 @@ func (m *MockKitchenOrderController) Create(dto dtos.CreateKitchenOrderDTO) (dto
 	return args.Get(0).(dtos.KitchenOrderResponseDTO), args.Error(1)
 }
 
-func (m *MockKitchenOrderController) FindAll(filter dtos.KitchenOrderFilter) ([]dtos.KitchenOrderResponseDTO, error) {
+func (m *MockKitchenOrderController) FindAll(filter dtos.KitchenOrderFilter) (dtos.KitchenOrderListResponseDTO, error) {
 	args := m.Called(filter)
-	return args.Get(0).([]dtos.KitchenOrderResponseDTO), args.Error(1)
+	return args.Get(0).(dtos.KitchenOrderListResponseDTO), args.Error(1)
 }
 
 func (m *MockKitchenOrderController) FindByID(id string) (dtos.KitchenOrderResponseDTO, error) {
@@ -79,7 +79,7 @@ func (m *MockKitchenOrderController) Update(dto dtos.UpdateKitchenOrderDTO) (dto
 // Interface para permitir injeção do controller
 type KitchenOrderControllerInterface interface {
 	Create(dto dtos.CreateKitchenOrderDTO) (dtos.KitchenOrderResponseDTO, error)
-	FindAll(filter dtos.KitchenOrderFilter) ([]dtos.KitchenOrderResponseDTO, error)
+	FindAll(filter dtos.KitchenOrderFilter) (dtos.KitchenOrderListResponseDTO, error)
 	FindByID(id string) (dtos.KitchenOrderResponseDTO, error)
 	Update(dto dtos.UpdateKitchenOrderDTO) (dtos.KitchenOrderResponseDTO, error)
 }
@@ -0,0 +1,91 @@
+package metrics
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"tech_challenge/internal/application/dtos"
+	"tech_challenge/internal/shared/pkg/logger"
+)
+
+type KitchenBoardStatsSource interface {
+	BoardStats(ctx context.Context) (dtos.KitchenBoardStatsDTO, error)
+}
+
+// KitchenBoardCollector consulta o quadro no scrape e reaproveita o resultado por cacheTTL, para que vários
+// scrapers ou réplicas do Prometheus não repitam as consultas de contagem a cada coleta
+type KitchenBoardCollector struct {
+	source   KitchenBoardStatsSource
+	cacheTTL time.Duration
+	now      func() time.Time
+
+	mu       sync.Mutex
+	stats    dtos.KitchenBoardStatsDTO
+	cachedAt time.Time
+
+	ordersOnBoard     *prometheus.Desc
+	oldestReceivedAge *prometheus.Desc
+}
+
+func NewKitchenBoardCollector(source KitchenBoardStatsSource, cacheTTL time.Duration) *KitchenBoardCollector {
+	return &KitchenBoardCollector{
+		source:   source,
+		cacheTTL: cacheTTL,
+		now:      time.Now,
+		ordersOnBoard: prometheus.NewDesc(
+			"kitchen_orders_on_board",
+			"Kitchen orders currently in each board status.",
+			[]string{"status_id", "status"}, nil,
+		),
+		oldestReceivedAge: prometheus.NewDesc(
+			"kitchen_oldest_received_order_age_seconds",
+			"Age of the oldest order still waiting to be prepared; zero when the queue is empty.",
+			nil, nil,
+		),
+	}
+}
+
+func (c *KitchenBoardCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.ordersOnBoard
+	ch <- c.oldestReceivedAge
+}
+
+// Collect não publica as séries quando a consulta falha, para que um valor antigo não pareça atual
+func (c *KitchenBoardCollector) Collect(ch chan<- prometheus.Metric) {
+	stats, err := c.boardStats()
+	if err != nil {
+		slog.Error("Error collecting kitchen board metrics", logger.ErrorKey, err)
+		return
+	}
+
+	for _, status := range stats.Statuses {
+		ch <- prometheus.MustNewConstMetric(c.ordersOnBoard, prometheus.GaugeValue, float64(status.Orders), status.StatusID, status.StatusName)
+	}
+
+	ch <- prometheus.MustNewConstMetric(c.oldestReceivedAge, prometheus.GaugeValue, stats.OldestReceivedAgeSeconds)
+}
+
+// boardStats só guarda consultas bem-sucedidas; depois de uma falha o próximo scrape consulta de novo
+func (c *KitchenBoardCollector) boardStats() (dtos.KitchenBoardStatsDTO, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	if !c.cachedAt.IsZero() && now.Sub(c.cachedAt) < c.cacheTTL {
+		return c.stats, nil
+	}
+
+	stats, err := c.source.BoardStats(context.Background())
+	if err != nil {
+		return dtos.KitchenBoardStatsDTO{}, err
+	}
+
+	c.stats = stats
+	c.cachedAt = now
+
+	return stats, nil
+}
@@ -0,0 +1,83 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tech_challenge/internal/application/dtos"
+)
+
+type mockBoardStatsSource struct {
+	stats dtos.KitchenBoardStatsDTO
+	err   error
+	calls int
+}
+
+func (s *mockBoardStatsSource) BoardStats(ctx context.Context) (dtos.KitchenBoardStatsDTO, error) {
+	s.calls++
+	return s.stats, s.err
+}
+
+func gatherText(t *testing.T, collector prometheus.Collector) string {
+	registry := prometheus.NewRegistry()
+	require.NoError(t, registry.Register(collector))
+
+	w := httptest.NewRecorder()
+	promhttp.HandlerFor(registry, promhttp.HandlerOpts{}).ServeHTTP(w, httptest.NewRequest("GET", "/metrics", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+
+	return w.Body.String()
+}
+
+func TestKitchenBoardCollector_Collect(t *testing.T) {
+	collector := NewKitchenBoardCollector(&mockBoardStatsSource{
+		stats: dtos.KitchenBoardStatsDTO{
+			Statuses: []dtos.BoardStatusCountDTO{
+				{StatusID: "received-id", StatusName: "Recebido", Orders: 3},
+				{StatusID: "preparing-id", StatusName: "Em preparação", Orders: 0},
+			},
+			OldestReceivedAgeSeconds: 420,
+		},
+	}, time.Minute)
+
+	body := gatherText(t, collector)
+
+	assert.Contains(t, body, `kitchen_orders_on_board{status="Recebido",status_id="received-id"} 3`)
+	assert.Contains(t, body, `kitchen_orders_on_board{status="Em preparação",status_id="preparing-id"} 0`)
+	assert.Contains(t, body, "kitchen_oldest_received_order_age_seconds 420")
+	t.Log("✓ Pedidos por status e idade do recebido mais antigo expostos")
+}
+
+func TestKitchenBoardCollector_SourceError(t *testing.T) {
+	collector := NewKitchenBoardCollector(&mockBoardStatsSource{err: errors.New("database unavailable")}, time.Minute)
+
+	assert.Empty(t, gatherText(t, collector))
+	t.Log("✓ Falha na consulta não publica valores")
+}
+
+func TestKitchenBoardCollector_CachesBetweenScrapes(t *testing.T) {
+	source := &mockBoardStatsSource{stats: dtos.KitchenBoardStatsDTO{OldestReceivedAgeSeconds: 10}}
+	collector := NewKitchenBoardCollector(source, 15*time.Second)
+	clock := time.Now()
+	collector.now = func() time.Time { return clock }
+
+	gatherText(t, collector)
+	clock = clock.Add(10 * time.Second)
+	assert.Contains(t, gatherText(t, collector), "kitchen_oldest_received_order_age_seconds 10")
+	assert.Equal(t, 1, source.calls)
+
+	source.stats.OldestReceivedAgeSeconds = 30
+	clock = clock.Add(10 * time.Second)
+	assert.Contains(t, gatherText(t, collector), "kitchen_oldest_received_order_age_seconds 30")
+	assert.Equal(t, 2, source.calls)
+	t.Log("✓ Quadro consultado no máximo uma vez por intervalo de cache")
+}
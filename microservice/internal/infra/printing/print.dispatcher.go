@@ -0,0 +1,32 @@
+package printing
+
+import (
+	"context"
+
+	"tech_challenge/internal/application/controllers"
+	"tech_challenge/internal/application/dtos"
+)
+
+type PrintDispatcher struct {
+	printerController controllers.PrinterController
+	queue             *PrintQueue
+}
+
+func NewPrintDispatcher(printerController *controllers.PrinterController, queue *PrintQueue) *PrintDispatcher {
+	return &PrintDispatcher{
+		printerController: *printerController,
+		queue:             queue,
+	}
+}
+
+// Dispatch grava as comandas na transação em ctx; se a gravação falhar, o erro desfaz a criação do pedido.
+// O envio às impressoras e as retentativas ficam com a PrintQueue
+func (d *PrintDispatcher) Dispatch(ctx context.Context, kitchenOrderID string) error {
+	_, err := d.printerController.Enqueue(ctx, dtos.PrintKitchenOrderDTO{KitchenOrderID: kitchenOrderID})
+	return err
+}
+
+// Notify acorda a fila para imprimir as comandas recém-confirmadas sem esperar o próximo polling
+func (d *PrintDispatcher) Notify() {
+	d.queue.Notify()
+}
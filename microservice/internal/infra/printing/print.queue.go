@@ -0,0 +1,102 @@
+package printing
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"tech_challenge/internal/shared/pkg/logger"
+)
+
+type PrintJobSource interface {
+	ProcessDueJobs(ctx context.Context) (int, error)
+}
+
+// PrintQueue processa a fila de impressão em segundo plano; a fila fica no banco, então qualquer réplica pode imprimir
+type PrintQueue struct {
+	source       PrintJobSource
+	pollInterval time.Duration
+
+	mu      sync.Mutex
+	running bool
+	closed  bool
+	cancel  context.CancelFunc
+	done    chan struct{}
+	wake    chan struct{}
+}
+
+func NewPrintQueue(source PrintJobSource, pollInterval time.Duration) *PrintQueue {
+	return &PrintQueue{
+		source:       source,
+		pollInterval: pollInterval,
+		wake:         make(chan struct{}, 1),
+	}
+}
+
+func (q *PrintQueue) Start() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.running || q.closed {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	q.cancel = cancel
+	q.done = make(chan struct{})
+	q.running = true
+
+	go q.run(ctx)
+}
+
+// Close aguarda o lote em andamento; trabalhos interrompidos voltam para a fila quando a reserva expira
+func (q *PrintQueue) Close() {
+	q.mu.Lock()
+	if q.closed {
+		q.mu.Unlock()
+		return
+	}
+
+	q.closed = true
+	cancel, done := q.cancel, q.done
+	q.mu.Unlock()
+
+	if cancel != nil {
+		cancel()
+		<-done
+	}
+}
+
+// Notify antecipa a próxima leitura da fila; usado logo após enfileirar novas comandas
+func (q *PrintQueue) Notify() {
+	select {
+	case q.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (q *PrintQueue) run(ctx context.Context) {
+	defer close(q.done)
+
+	ticker := time.NewTicker(q.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-q.wake:
+			q.process(ctx)
+		case <-ticker.C:
+			q.process(ctx)
+		}
+	}
+}
+
+// process não é interrompido pelo Close: o lote em andamento termina antes de a fila parar
+func (q *PrintQueue) process(ctx context.Context) {
+	if _, err := q.source.ProcessDueJobs(context.WithoutCancel(ctx)); err != nil {
+		slog.Error("Error processing print jobs", logger.ErrorKey, err)
+	}
+}
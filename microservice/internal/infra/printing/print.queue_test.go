@@ -0,0 +1,67 @@
+package printing
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type countingJobSource struct {
+	calls atomic.Int32
+	ran   chan struct{}
+}
+
+func (s *countingJobSource) ProcessDueJobs(ctx context.Context) (int, error) {
+	s.calls.Add(1)
+	select {
+	case s.ran <- struct{}{}:
+	default:
+	}
+	return 0, nil
+}
+
+func TestPrintQueue_NotifyTriggersProcessing(t *testing.T) {
+	source := &countingJobSource{ran: make(chan struct{}, 1)}
+	queue := NewPrintQueue(source, time.Hour)
+	queue.Start()
+	defer queue.Close()
+
+	queue.Notify()
+
+	select {
+	case <-source.ran:
+		t.Log("✓ Notify antecipa o processamento da fila")
+	case <-time.After(2 * time.Second):
+		t.Fatal("Expected the queue to process after Notify")
+	}
+}
+
+func TestPrintQueue_PollsAndStopsOnClose(t *testing.T) {
+	source := &countingJobSource{ran: make(chan struct{}, 1)}
+	queue := NewPrintQueue(source, 10*time.Millisecond)
+	queue.Start()
+
+	select {
+	case <-source.ran:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Expected the queue to poll")
+	}
+
+	queue.Close()
+	calls := source.calls.Load()
+	time.Sleep(50 * time.Millisecond)
+
+	if source.calls.Load() != calls {
+		t.Errorf("Expected no processing after Close")
+	}
+
+	// Start depois de Close não reabre a fila
+	queue.Start()
+	time.Sleep(30 * time.Millisecond)
+	if source.calls.Load() != calls {
+		t.Errorf("Expected a closed queue to stay closed")
+	} else {
+		t.Log("✓ Fila encerrada não volta a processar")
+	}
+}
@@ -0,0 +1,57 @@
+package printing
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	"tech_challenge/internal/application/dtos"
+	"tech_challenge/internal/infra/tickets"
+	"tech_challenge/internal/shared/config/constants"
+)
+
+// TCPPrinterSender envia a comanda em ESC/POS pela porta raw da impressora (JetDirect/9100)
+type TCPPrinterSender struct {
+	options        tickets.Options
+	connectTimeout time.Duration
+	writeTimeout   time.Duration
+}
+
+func NewTCPPrinterSender(options tickets.Options, connectTimeout, writeTimeout time.Duration) *TCPPrinterSender {
+	return &TCPPrinterSender{
+		options:        options,
+		connectTimeout: connectTimeout,
+		writeTimeout:   writeTimeout,
+	}
+}
+
+func (s *TCPPrinterSender) Print(printer dtos.PrinterTargetDTO, ticket dtos.KitchenOrderTicketDTO) error {
+	options := s.options
+	if printer.Width > 0 {
+		options.Width = printer.Width
+	}
+
+	renderer, _ := tickets.NewRenderer(constants.KITCHEN_TICKET_FORMAT_ESCPOS, options)
+
+	payload, err := renderer.Render(ticket)
+	if err != nil {
+		return fmt.Errorf("failed to render ticket: %w", err)
+	}
+
+	conn, err := net.DialTimeout("tcp", printer.Address, s.connectTimeout)
+	if err != nil {
+		return fmt.Errorf("failed to connect to printer: %w", err)
+	}
+	defer conn.Close()
+
+	if err := conn.SetWriteDeadline(time.Now().Add(s.writeTimeout)); err != nil {
+		return fmt.Errorf("failed to set printer write deadline: %w", err)
+	}
+
+	// A porta raw não confirma a impressão; a comanda é considerada entregue quando a impressora aceita todos os bytes
+	if _, err := conn.Write(payload); err != nil {
+		return fmt.Errorf("failed to send ticket to printer: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package printing
+
+import (
+	"bytes"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tech_challenge/internal/application/dtos"
+	"tech_challenge/internal/infra/tickets"
+	"tech_challenge/internal/shared/config/constants"
+)
+
+func ticketFixture() dtos.KitchenOrderTicketDTO {
+	return dtos.KitchenOrderTicketDTO{
+		ID:        "550e8400-e29b-41d4-a716-446655440000",
+		OrderID:   "123e4567-e89b-12d3-a456-426614174000",
+		Slug:      "042",
+		Status:    dtos.OrderStatusDTO{ID: constants.KITCHEN_ORDER_STATUS_RECEIVED_ID, Name: "Recebido"},
+		Items:     []dtos.OrderItemDTO{{ProductID: "x-burger-duplo", Quantity: 2}},
+		CreatedAt: time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC),
+	}
+}
+
+// listenPrinter simula a porta raw de uma impressora e devolve tudo o que ela recebeu
+func listenPrinter(t *testing.T) (string, <-chan []byte) {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	received := make(chan []byte, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		payload, _ := io.ReadAll(conn)
+		received <- payload
+	}()
+
+	return listener.Addr().String(), received
+}
+
+func TestTCPPrinterSender_SendsESCPOS(t *testing.T) {
+	address, received := listenPrinter(t)
+	sender := NewTCPPrinterSender(tickets.Options{Location: time.UTC}, time.Second, time.Second)
+
+	err := sender.Print(dtos.PrinterTargetDTO{ID: "printer-1", Address: address}, ticketFixture())
+	require.NoError(t, err)
+
+	select {
+	case payload := <-received:
+		assert.True(t, bytes.HasPrefix(payload, []byte{0x1b, 0x40}), "payload starts by resetting the printer")
+		assert.Contains(t, string(payload), "042")
+		assert.Contains(t, string(payload), "x-burger-duplo")
+	case <-time.After(2 * time.Second):
+		t.Fatal("printer did not receive the ticket")
+	}
+	t.Log("✓ Comanda enviada em ESC/POS pela porta raw")
+}
+
+func TestTCPPrinterSender_UnreachablePrinter(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	address := listener.Addr().String()
+	listener.Close()
+
+	sender := NewTCPPrinterSender(tickets.Options{}, time.Second, time.Second)
+
+	err = sender.Print(dtos.PrinterTargetDTO{ID: "printer-1", Address: address}, ticketFixture())
+	assert.ErrorContains(t, err, "failed to connect to printer")
+}
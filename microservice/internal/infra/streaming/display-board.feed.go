@@ -0,0 +1,244 @@
+package streaming
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"sync"
+	"time"
+
+	"tech_challenge/internal/application/dtos"
+	"tech_challenge/internal/domain/exceptions"
+	"tech_challenge/internal/shared/pkg/logger"
+)
+
+const (
+	DisplayBoardEventBoard = "board"
+	DisplayBoardEventChime = "chime"
+
+	displayBoardSubscriberBufferSize = 16
+)
+
+// DisplayBoardRenderer monta as mensagens públicas do quadro; o feed só conhece o payload já pronto para serializar
+type DisplayBoardRenderer interface {
+	Board(ctx context.Context) (any, error)
+	// Chime devolve o aviso sonoro do evento, quando houver
+	Chime(event dtos.KitchenOrderEventDTO) (any, bool)
+}
+
+// DisplayBoardMessage é um evento SSE já serializado, compartilhado por todas as conexões
+type DisplayBoardMessage struct {
+	Event   string
+	Payload []byte
+}
+
+// DisplayBoardFeed recalcula o quadro uma única vez por alteração e distribui o mesmo payload para todas as telas abertas
+type DisplayBoardFeed struct {
+	hub             *KitchenOrderEventHub
+	renderer        DisplayBoardRenderer
+	refreshInterval time.Duration
+	maxSubscribers  int
+
+	mu          sync.Mutex
+	subscribers map[*DisplayBoardSubscription]struct{}
+	board       []byte
+	// stop identifica a execução atual do feed; é fechado quando a última tela desconecta
+	stop chan struct{}
+}
+
+type DisplayBoardSubscription struct {
+	messages chan DisplayBoardMessage
+	feed     *DisplayBoardFeed
+	once     sync.Once
+}
+
+func NewDisplayBoardFeed(hub *KitchenOrderEventHub, renderer DisplayBoardRenderer, refreshInterval time.Duration, maxSubscribers int) *DisplayBoardFeed {
+	return &DisplayBoardFeed{
+		hub:             hub,
+		renderer:        renderer,
+		refreshInterval: refreshInterval,
+		maxSubscribers:  maxSubscribers,
+		subscribers:     make(map[*DisplayBoardSubscription]struct{}),
+	}
+}
+
+// Subscribe registra uma tela e devolve o quadro atual serializado
+func (f *DisplayBoardFeed) Subscribe(ctx context.Context) (*DisplayBoardSubscription, []byte, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.maxSubscribers > 0 && len(f.subscribers) >= f.maxSubscribers {
+		return nil, nil, &exceptions.DisplayBoardStreamLimitException{}
+	}
+
+	if f.stop == nil {
+		// A assinatura do hub vem antes do quadro para que nenhuma alteração feita entre os dois se perca
+		events, _, err := f.hub.Subscribe()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		board, err := f.renderBoard(ctx)
+		if err != nil {
+			events.Close()
+			return nil, nil, err
+		}
+
+		f.board = board
+		f.stop = make(chan struct{})
+
+		go f.run(events, f.stop)
+	}
+
+	subscription := &DisplayBoardSubscription{
+		messages: make(chan DisplayBoardMessage, displayBoardSubscriberBufferSize),
+		feed:     f,
+	}
+	f.subscribers[subscription] = struct{}{}
+
+	return subscription, f.board, nil
+}
+
+func (f *DisplayBoardFeed) run(events *KitchenOrderEventSubscription, stop chan struct{}) {
+	defer events.Close()
+
+	// Além dos eventos, o recálculo periódico tira do quadro os pedidos prontos que expiraram
+	ticker := time.NewTicker(f.refreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+
+		case event, ok := <-events.Events():
+			if !ok {
+				// O hub encerrou ou descartou o feed; as telas reconectam e iniciam uma nova execução
+				f.reset(stop)
+				return
+			}
+
+			// Agrupa os eventos já disponíveis para recalcular o quadro uma única vez
+			batch := []dtos.KitchenOrderEventDTO{event}
+			for drained := false; !drained; {
+				select {
+				case next, ok := <-events.Events():
+					if !ok {
+						f.reset(stop)
+						return
+					}
+					batch = append(batch, next)
+				default:
+					drained = true
+				}
+			}
+
+			for _, event := range batch {
+				chime, ok := f.renderer.Chime(event)
+				if !ok {
+					continue
+				}
+
+				payload, err := json.Marshal(chime)
+				if err != nil {
+					slog.Error("Error serializing display board chime", logger.ErrorKey, err)
+					continue
+				}
+
+				f.mu.Lock()
+				if f.stop == stop {
+					f.broadcastLocked(DisplayBoardMessage{Event: DisplayBoardEventChime, Payload: payload})
+				}
+				f.mu.Unlock()
+			}
+
+			f.refresh(stop)
+
+		case <-ticker.C:
+			f.refresh(stop)
+		}
+	}
+}
+
+// refresh recalcula o quadro e só repassa às telas quando ele mudou
+func (f *DisplayBoardFeed) refresh(stop chan struct{}) {
+	board, err := f.renderBoard(context.Background())
+	if err != nil {
+		// As telas continuam com o último quadro; o próximo evento ou recálculo tenta novamente
+		slog.Error("Error refreshing display board", logger.ErrorKey, err)
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.stop != stop || bytes.Equal(board, f.board) {
+		return
+	}
+
+	f.board = board
+	f.broadcastLocked(DisplayBoardMessage{Event: DisplayBoardEventBoard, Payload: board})
+}
+
+func (f *DisplayBoardFeed) renderBoard(ctx context.Context) ([]byte, error) {
+	board, err := f.renderer.Board(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(board)
+}
+
+func (f *DisplayBoardFeed) broadcastLocked(message DisplayBoardMessage) {
+	for subscription := range f.subscribers {
+		select {
+		case subscription.messages <- message:
+		default:
+			// Tela lenta: a conexão é encerrada e o navegador reconecta com o quadro atualizado
+			f.removeLocked(subscription)
+		}
+	}
+}
+
+func (f *DisplayBoardFeed) reset(stop chan struct{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.stop != stop {
+		return
+	}
+
+	for subscription := range f.subscribers {
+		f.removeLocked(subscription)
+	}
+}
+
+func (f *DisplayBoardFeed) removeLocked(subscription *DisplayBoardSubscription) {
+	if _, ok := f.subscribers[subscription]; !ok {
+		return
+	}
+
+	delete(f.subscribers, subscription)
+	close(subscription.messages)
+
+	// Sem telas abertas o feed para de consultar o banco até a próxima conexão
+	if len(f.subscribers) == 0 && f.stop != nil {
+		close(f.stop)
+		f.stop = nil
+	}
+}
+
+// Messages é fechado quando o hub encerra ou quando a tela não acompanha o ritmo das mensagens
+func (s *DisplayBoardSubscription) Messages() <-chan DisplayBoardMessage {
+	return s.messages
+}
+
+func (s *DisplayBoardSubscription) Close() {
+	s.once.Do(func() {
+		s.feed.mu.Lock()
+		defer s.feed.mu.Unlock()
+
+		s.feed.removeLocked(s)
+	})
+}
@@ -0,0 +1,129 @@
+package streaming
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tech_challenge/internal/application/dtos"
+	"tech_challenge/internal/domain/exceptions"
+)
+
+type countingBoardRenderer struct {
+	mu      sync.Mutex
+	renders int
+	board   string
+}
+
+func (r *countingBoardRenderer) Board(ctx context.Context) (any, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.renders++
+	return map[string]string{"board": r.board}, nil
+}
+
+func (r *countingBoardRenderer) Chime(event dtos.KitchenOrderEventDTO) (any, bool) {
+	return map[string]uint64{"event": event.ID}, event.Type == "ready"
+}
+
+func (r *countingBoardRenderer) set(board string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.board = board
+}
+
+func (r *countingBoardRenderer) count() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.renders
+}
+
+func receiveBoardMessage(t *testing.T, subscription *DisplayBoardSubscription) DisplayBoardMessage {
+	t.Helper()
+
+	select {
+	case message, ok := <-subscription.Messages():
+		require.True(t, ok, "subscription closed unexpectedly")
+		return message
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for board message")
+		return DisplayBoardMessage{}
+	}
+}
+
+func TestDisplayBoardFeed_RendersOncePerChange(t *testing.T) {
+	source := &fakeEventSource{}
+	hub := NewKitchenOrderEventHub(source, 5*time.Millisecond, 0)
+	defer hub.Close()
+
+	renderer := &countingBoardRenderer{board: "initial"}
+	feed := NewDisplayBoardFeed(hub, renderer, time.Hour, 0)
+
+	var subscriptions []*DisplayBoardSubscription
+	for i := 0; i < 3; i++ {
+		subscription, board, err := feed.Subscribe(context.Background())
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"board":"initial"}`, string(board))
+		subscriptions = append(subscriptions, subscription)
+	}
+	assert.Equal(t, 1, renderer.count(), "new screens reuse the cached board")
+
+	renderer.set("changed")
+	source.mu.Lock()
+	source.events = append(source.events, dtos.KitchenOrderEventDTO{ID: 1, Type: "ready"})
+	source.mu.Unlock()
+
+	for _, subscription := range subscriptions {
+		chime := receiveBoardMessage(t, subscription)
+		assert.Equal(t, DisplayBoardEventChime, chime.Event)
+
+		board := receiveBoardMessage(t, subscription)
+		assert.Equal(t, DisplayBoardEventBoard, board.Event)
+		assert.JSONEq(t, `{"board":"changed"}`, string(board.Payload))
+	}
+	assert.Equal(t, 2, renderer.count())
+	t.Log("✓ Quadro calculado uma vez e repassado a todas as telas")
+}
+
+func TestDisplayBoardFeed_LimitsSubscribers(t *testing.T) {
+	hub := NewKitchenOrderEventHub(&fakeEventSource{}, time.Hour, 0)
+	defer hub.Close()
+
+	feed := NewDisplayBoardFeed(hub, &countingBoardRenderer{}, time.Hour, 1)
+
+	subscription, _, err := feed.Subscribe(context.Background())
+	require.NoError(t, err)
+
+	_, _, err = feed.Subscribe(context.Background())
+	var limitErr *exceptions.DisplayBoardStreamLimitException
+	assert.ErrorAs(t, err, &limitErr)
+
+	subscription.Close()
+
+	_, _, err = feed.Subscribe(context.Background())
+	assert.NoError(t, err)
+}
+
+func TestDisplayBoardFeed_HubCloseEndsSubscriptions(t *testing.T) {
+	hub := NewKitchenOrderEventHub(&fakeEventSource{}, time.Hour, 0)
+	feed := NewDisplayBoardFeed(hub, &countingBoardRenderer{}, time.Hour, 0)
+
+	subscription, _, err := feed.Subscribe(context.Background())
+	require.NoError(t, err)
+
+	hub.Close()
+
+	select {
+	case _, ok := <-subscription.Messages():
+		assert.False(t, ok)
+	case <-time.After(time.Second):
+		t.Fatal("expected subscription to be closed")
+	}
+}
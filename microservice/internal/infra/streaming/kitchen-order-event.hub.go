@@ -0,0 +1,271 @@
+package streaming
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"tech_challenge/internal/application/dtos"
+	"tech_challenge/internal/shared/pkg/logger"
+)
+
+const (
+	subscriberBufferSize = 64
+	pollBatchSize        = 500
+	pruneInterval        = time.Hour
+	// Por quanto tempo, medido pelo relógio do hub, um ID faltante no feed continua sendo procurado.
+	// Depois disso ele é tratado como sequência consumida por um rollback
+	defaultGapTimeout = time.Minute
+	maxTrackedGaps    = pollBatchSize
+)
+
+var ErrHubClosed = errors.New("kitchen order event hub is closed")
+
+type KitchenOrderEventSource interface {
+	FindAfter(afterID uint64, limit int) ([]dtos.KitchenOrderEventDTO, error)
+	FindByIDs(ids []uint64) ([]dtos.KitchenOrderEventDTO, error)
+	LastID() (uint64, error)
+	Prune(retention time.Duration) (int64, error)
+}
+
+// KitchenOrderEventHub lê o feed de eventos do banco uma única vez por réplica e distribui para todas as conexões abertas
+type KitchenOrderEventHub struct {
+	source       KitchenOrderEventSource
+	pollInterval time.Duration
+	retention    time.Duration
+	gapTimeout   time.Duration
+	// IDs pulados pelo feed e quando o hub percebeu cada um; acessado apenas pelo goroutine de polling
+	gaps map[uint64]time.Time
+
+	mu          sync.Mutex
+	subscribers map[*KitchenOrderEventSubscription]struct{}
+	lastID      uint64
+	running     bool
+	closed      bool
+	cancel      context.CancelFunc
+	done        chan struct{}
+	wake        chan struct{}
+}
+
+type KitchenOrderEventSubscription struct {
+	events chan dtos.KitchenOrderEventDTO
+	hub    *KitchenOrderEventHub
+	once   sync.Once
+}
+
+func NewKitchenOrderEventHub(source KitchenOrderEventSource, pollInterval, retention time.Duration) *KitchenOrderEventHub {
+	return &KitchenOrderEventHub{
+		source:       source,
+		pollInterval: pollInterval,
+		retention:    retention,
+		gapTimeout:   defaultGapTimeout,
+		gaps:         make(map[uint64]time.Time),
+		subscribers:  make(map[*KitchenOrderEventSubscription]struct{}),
+		wake:         make(chan struct{}, 1),
+	}
+}
+
+// Subscribe registra uma nova conexão e retorna a posição do feed a partir da qual os eventos serão entregues
+func (h *KitchenOrderEventHub) Subscribe() (*KitchenOrderEventSubscription, uint64, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closed {
+		return nil, 0, ErrHubClosed
+	}
+
+	if !h.running {
+		lastID, err := h.source.LastID()
+		if err != nil {
+			return nil, 0, err
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		h.lastID = lastID
+		h.cancel = cancel
+		h.done = make(chan struct{})
+		h.running = true
+
+		go h.run(ctx)
+	}
+
+	subscription := &KitchenOrderEventSubscription{
+		events: make(chan dtos.KitchenOrderEventDTO, subscriberBufferSize),
+		hub:    h,
+	}
+	h.subscribers[subscription] = struct{}{}
+
+	return subscription, h.lastID, nil
+}
+
+// Close encerra o polling e fecha todas as assinaturas, liberando as conexões SSE para o shutdown
+func (h *KitchenOrderEventHub) Close() {
+	h.mu.Lock()
+	if h.closed {
+		h.mu.Unlock()
+		return
+	}
+
+	h.closed = true
+	for subscription := range h.subscribers {
+		h.removeLocked(subscription)
+	}
+
+	cancel, done := h.cancel, h.done
+	h.mu.Unlock()
+
+	if cancel != nil {
+		cancel()
+		<-done
+	}
+}
+
+// Notify antecipa a próxima leitura do feed; usado quando a própria réplica acabou de alterar um pedido
+func (h *KitchenOrderEventHub) Notify() {
+	select {
+	case h.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (h *KitchenOrderEventHub) run(ctx context.Context) {
+	defer close(h.done)
+
+	ticker := time.NewTicker(h.pollInterval)
+	defer ticker.Stop()
+
+	lastPrune := time.Now()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-h.wake:
+			h.poll()
+		case <-ticker.C:
+			h.poll()
+
+			if h.retention > 0 && time.Since(lastPrune) >= pruneInterval {
+				lastPrune = time.Now()
+				if _, err := h.source.Prune(h.retention); err != nil {
+					slog.Error("Error pruning kitchen order events", logger.ErrorKey, err)
+				}
+			}
+		}
+	}
+}
+
+func (h *KitchenOrderEventHub) poll() {
+	h.recoverGaps()
+
+	for {
+		h.mu.Lock()
+		lastID := h.lastID
+		h.mu.Unlock()
+
+		events, err := h.source.FindAfter(lastID, pollBatchSize)
+		if err != nil {
+			slog.Error("Error polling kitchen order events", logger.ErrorKey, err)
+			return
+		}
+
+		for _, event := range events {
+			// IDs fora de sequência podem ser uma transação concorrente ainda não visível;
+			// o hub segue adiante e volta a procurar esses IDs nas próximas leituras
+			h.trackGaps(lastID, event.ID)
+
+			h.broadcast(event)
+			lastID = event.ID
+		}
+
+		if len(events) < pollBatchSize {
+			return
+		}
+	}
+}
+
+func (h *KitchenOrderEventHub) trackGaps(lastID, nextID uint64) {
+	now := time.Now()
+
+	for id := lastID + 1; id < nextID; id++ {
+		if len(h.gaps) >= maxTrackedGaps {
+			slog.Warn("Too many missing kitchen order events, skipping gap", "from_id", id, "to_id", nextID-1)
+			return
+		}
+
+		h.gaps[id] = now
+	}
+}
+
+// recoverGaps entrega os eventos que ficaram visíveis depois dos seguintes e descarta os IDs que expiraram
+func (h *KitchenOrderEventHub) recoverGaps() {
+	if len(h.gaps) == 0 {
+		return
+	}
+
+	ids := make([]uint64, 0, len(h.gaps))
+	for id := range h.gaps {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+
+	events, err := h.source.FindByIDs(ids)
+	if err != nil {
+		slog.Error("Error polling missing kitchen order events", logger.ErrorKey, err)
+		return
+	}
+
+	for _, event := range events {
+		delete(h.gaps, event.ID)
+		h.broadcast(event)
+	}
+
+	for id, seenAt := range h.gaps {
+		if time.Since(seenAt) >= h.gapTimeout {
+			delete(h.gaps, id)
+		}
+	}
+}
+
+// broadcast entrega o evento a todas as assinaturas; eventos atrasados chegam com ID menor que os já entregues
+func (h *KitchenOrderEventHub) broadcast(event dtos.KitchenOrderEventDTO) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.lastID = max(h.lastID, event.ID)
+
+	for subscription := range h.subscribers {
+		select {
+		case subscription.events <- event:
+		default:
+			// Cliente lento: a conexão é encerrada e ele retoma pelo Last-Event-ID
+			h.removeLocked(subscription)
+		}
+	}
+}
+
+func (h *KitchenOrderEventHub) removeLocked(subscription *KitchenOrderEventSubscription) {
+	if _, ok := h.subscribers[subscription]; !ok {
+		return
+	}
+
+	delete(h.subscribers, subscription)
+	close(subscription.events)
+}
+
+// Events é fechado quando o hub encerra ou quando o cliente não acompanha o ritmo dos eventos
+func (s *KitchenOrderEventSubscription) Events() <-chan dtos.KitchenOrderEventDTO {
+	return s.events
+}
+
+func (s *KitchenOrderEventSubscription) Close() {
+	s.once.Do(func() {
+		s.hub.mu.Lock()
+		defer s.hub.mu.Unlock()
+
+		s.hub.removeLocked(s)
+	})
+}
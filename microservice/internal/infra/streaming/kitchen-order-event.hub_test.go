@@ -0,0 +1,220 @@
+package streaming
+
+import (
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tech_challenge/internal/application/dtos"
+)
+
+type fakeEventSource struct {
+	mu     sync.Mutex
+	events []dtos.KitchenOrderEventDTO
+	pruned int
+}
+
+func (f *fakeEventSource) append(id uint64, occurredAt time.Time) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.events = append(f.events, dtos.KitchenOrderEventDTO{ID: id, Type: "created", OccurredAt: occurredAt})
+}
+
+func (f *fakeEventSource) FindAfter(afterID uint64, limit int) ([]dtos.KitchenOrderEventDTO, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var result []dtos.KitchenOrderEventDTO
+	for _, event := range f.events {
+		if event.ID > afterID && len(result) < limit {
+			result = append(result, event)
+		}
+	}
+	return result, nil
+}
+
+func (f *fakeEventSource) FindByIDs(ids []uint64) ([]dtos.KitchenOrderEventDTO, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var result []dtos.KitchenOrderEventDTO
+	for _, event := range f.events {
+		if slices.Contains(ids, event.ID) {
+			result = append(result, event)
+		}
+	}
+	return result, nil
+}
+
+func (f *fakeEventSource) LastID() (uint64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if len(f.events) == 0 {
+		return 0, nil
+	}
+	return f.events[len(f.events)-1].ID, nil
+}
+
+func (f *fakeEventSource) Prune(retention time.Duration) (int64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.pruned++
+	return 0, nil
+}
+
+func receiveEvent(t *testing.T, subscription *KitchenOrderEventSubscription) dtos.KitchenOrderEventDTO {
+	t.Helper()
+
+	select {
+	case event, ok := <-subscription.Events():
+		require.True(t, ok, "subscription closed unexpectedly")
+		return event
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for event")
+		return dtos.KitchenOrderEventDTO{}
+	}
+}
+
+func TestKitchenOrderEventHub_BroadcastsNewEvents(t *testing.T) {
+	source := &fakeEventSource{}
+	source.append(1, time.Now())
+
+	hub := NewKitchenOrderEventHub(source, 5*time.Millisecond, 0)
+	defer hub.Close()
+
+	first, position, err := hub.Subscribe()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), position, "existing events are not replayed by the hub")
+
+	second, _, err := hub.Subscribe()
+	require.NoError(t, err)
+
+	source.append(2, time.Now())
+	source.append(3, time.Now())
+
+	assert.Equal(t, uint64(2), receiveEvent(t, first).ID)
+	assert.Equal(t, uint64(3), receiveEvent(t, first).ID)
+	assert.Equal(t, uint64(2), receiveEvent(t, second).ID)
+	assert.Equal(t, uint64(3), receiveEvent(t, second).ID)
+}
+
+func TestKitchenOrderEventHub_DeliversEventsCommittedLate(t *testing.T) {
+	source := &fakeEventSource{}
+	hub := NewKitchenOrderEventHub(source, 5*time.Millisecond, 0)
+	defer hub.Close()
+
+	subscription, _, err := hub.Subscribe()
+	require.NoError(t, err)
+
+	// O evento 1 ainda não está visível (transação em andamento em outra réplica) e o timestamp
+	// gravado pela aplicação é antigo; o hub não pode depender dele para desistir do ID
+	source.append(2, time.Now().Add(-time.Hour))
+	assert.Equal(t, uint64(2), receiveEvent(t, subscription).ID)
+
+	source.mu.Lock()
+	source.events = append([]dtos.KitchenOrderEventDTO{{ID: 1, OccurredAt: time.Now().Add(-time.Hour)}}, source.events...)
+	source.mu.Unlock()
+
+	assert.Equal(t, uint64(1), receiveEvent(t, subscription).ID)
+
+	source.append(3, time.Now())
+	assert.Equal(t, uint64(3), receiveEvent(t, subscription).ID)
+	t.Log("✓ Evento commitado depois dos seguintes é entregue quando fica visível")
+}
+
+func TestKitchenOrderEventHub_ForgetsGapsAfterTimeout(t *testing.T) {
+	source := &fakeEventSource{}
+	hub := NewKitchenOrderEventHub(source, 5*time.Millisecond, 0)
+	hub.gapTimeout = 20 * time.Millisecond
+	defer hub.Close()
+
+	subscription, _, err := hub.Subscribe()
+	require.NoError(t, err)
+
+	// O ID 1 nunca aparece (rollback)
+	source.append(2, time.Now())
+	assert.Equal(t, uint64(2), receiveEvent(t, subscription).ID)
+
+	assert.Eventually(t, func() bool {
+		hub.Notify()
+		hub.mu.Lock()
+		defer hub.mu.Unlock()
+		return hub.lastID == 2
+	}, time.Second, 5*time.Millisecond)
+
+	time.Sleep(50 * time.Millisecond)
+
+	// Depois do timeout, um evento com o ID abandonado não é mais procurado
+	source.mu.Lock()
+	source.events = append([]dtos.KitchenOrderEventDTO{{ID: 1, OccurredAt: time.Now()}}, source.events...)
+	source.mu.Unlock()
+	source.append(3, time.Now())
+
+	assert.Equal(t, uint64(3), receiveEvent(t, subscription).ID)
+	select {
+	case event := <-subscription.Events():
+		t.Fatalf("expected expired gap to be forgotten, got %d", event.ID)
+	case <-time.After(30 * time.Millisecond):
+	}
+	t.Log("✓ IDs faltantes são descartados após o timeout medido pelo hub")
+}
+
+func TestKitchenOrderEventHub_DropsSlowSubscribers(t *testing.T) {
+	source := &fakeEventSource{}
+	hub := NewKitchenOrderEventHub(source, time.Hour, 0)
+	defer hub.Close()
+
+	subscription, _, err := hub.Subscribe()
+	require.NoError(t, err)
+
+	for i := uint64(1); i <= subscriberBufferSize+1; i++ {
+		hub.broadcast(dtos.KitchenOrderEventDTO{ID: i})
+	}
+
+	received := 0
+	for range subscription.Events() {
+		received++
+	}
+
+	assert.Equal(t, subscriberBufferSize, received)
+}
+
+func TestKitchenOrderEventHub_CloseEndsSubscriptions(t *testing.T) {
+	hub := NewKitchenOrderEventHub(&fakeEventSource{}, 5*time.Millisecond, 0)
+
+	subscription, _, err := hub.Subscribe()
+	require.NoError(t, err)
+
+	hub.Close()
+
+	_, ok := <-subscription.Events()
+	assert.False(t, ok)
+
+	subscription.Close()
+
+	_, _, err = hub.Subscribe()
+	assert.ErrorIs(t, err, ErrHubClosed)
+}
+
+func TestKitchenOrderEventHub_NotifyPollsImmediately(t *testing.T) {
+	source := &fakeEventSource{}
+
+	hub := NewKitchenOrderEventHub(source, time.Hour, 0)
+	defer hub.Close()
+
+	subscription, _, err := hub.Subscribe()
+	require.NoError(t, err)
+
+	source.append(1, time.Now())
+	hub.Notify()
+
+	assert.Equal(t, uint64(1), receiveEvent(t, subscription).ID)
+	t.Log("✓ Notify entrega o evento sem esperar o intervalo de polling")
+}
@@ -0,0 +1,47 @@
+package streaming
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+func SetSSEHeaders(w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	// Desativa o buffer de proxies como o nginx
+	w.Header().Set("X-Accel-Buffering", "no")
+}
+
+func WriteSSEEvent(w io.Writer, id string, event string, data any) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	return WriteSSEPayload(w, id, event, payload)
+}
+
+// WriteSSEPayload escreve um evento já serializado, para payloads compartilhados entre várias conexões
+func WriteSSEPayload(w io.Writer, id string, event string, payload []byte) error {
+	if id != "" {
+		if _, err := fmt.Fprintf(w, "id: %s\n", id); err != nil {
+			return err
+		}
+	}
+
+	_, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, payload)
+	return err
+}
+
+func WriteSSEComment(w io.Writer, comment string) error {
+	_, err := fmt.Fprintf(w, ": %s\n\n", comment)
+	return err
+}
+
+func WriteSSERetry(w io.Writer, milliseconds int64) error {
+	_, err := fmt.Fprintf(w, "retry: %d\n\n", milliseconds)
+	return err
+}
@@ -0,0 +1,130 @@
+package tickets
+
+import (
+	"bytes"
+	"fmt"
+
+	"golang.org/x/text/encoding/charmap"
+
+	"tech_challenge/internal/application/dtos"
+)
+
+// Comandos ESC/POS usados na comanda; a referência é o conjunto de comandos das impressoras Epson TM
+var (
+	escposInitialize         = []byte{0x1b, 0x40}
+	escposCodePagePC860      = []byte{0x1b, 0x74, 0x03}
+	escposAlignLeft          = []byte{0x1b, 0x61, 0x00}
+	escposAlignCenter        = []byte{0x1b, 0x61, 0x01}
+	escposBoldOn             = []byte{0x1b, 0x45, 0x01}
+	escposBoldOff            = []byte{0x1b, 0x45, 0x00}
+	escposDoubleSize         = []byte{0x1d, 0x21, 0x11}
+	escposNormalSize         = []byte{0x1d, 0x21, 0x00}
+	escposFeedAndCut         = []byte{0x1d, 0x56, 0x42, 0x03}
+	escposQRCodeModel2       = []byte{0x1d, 0x28, 0x6b, 0x04, 0x00, 0x31, 0x41, 0x32, 0x00}
+	escposQRCodeSize         = []byte{0x1d, 0x28, 0x6b, 0x03, 0x00, 0x31, 0x43, 0x06}
+	escposQRCodeLevelM       = []byte{0x1d, 0x28, 0x6b, 0x03, 0x00, 0x31, 0x45, 0x31}
+	escposQRCodePrint        = []byte{0x1d, 0x28, 0x6b, 0x03, 0x00, 0x31, 0x51, 0x30}
+	escposLineFeed      byte = 0x0a
+)
+
+// Limite de dados do QR code no comando de armazenamento (modelo 2)
+const escposQRCodeMaxData = 7089
+
+// ESCPOSRenderer gera a sequência de bytes para impressoras térmicas ESC/POS; o texto sai na página de código PC860 (português)
+type ESCPOSRenderer struct {
+	options Options
+}
+
+func (r *ESCPOSRenderer) ContentType() string {
+	return "application/octet-stream"
+}
+
+func (r *ESCPOSRenderer) Render(ticket dtos.KitchenOrderTicketDTO) ([]byte, error) {
+	var output bytes.Buffer
+
+	output.Write(escposInitialize)
+	output.Write(escposCodePagePC860)
+
+	for _, line := range layout(ticket, r.options) {
+		switch line.kind {
+		case lineRule:
+			for range r.options.Width {
+				output.Write(encodePC860(line.text))
+			}
+			output.WriteByte(escposLineFeed)
+		case lineQRCode:
+			if err := writeQRCode(&output, line.text); err != nil {
+				return nil, err
+			}
+		default:
+			writeStyledLine(&output, line)
+		}
+	}
+
+	output.Write(escposFeedAndCut)
+
+	return output.Bytes(), nil
+}
+
+func writeStyledLine(output *bytes.Buffer, line line) {
+	if line.center {
+		output.Write(escposAlignCenter)
+	}
+	if line.bold {
+		output.Write(escposBoldOn)
+	}
+	if line.large {
+		output.Write(escposDoubleSize)
+	}
+
+	output.Write(encodePC860(line.text))
+	output.WriteByte(escposLineFeed)
+
+	if line.large {
+		output.Write(escposNormalSize)
+	}
+	if line.bold {
+		output.Write(escposBoldOff)
+	}
+	if line.center {
+		output.Write(escposAlignLeft)
+	}
+}
+
+// writeQRCode usa o gerador de QR code da própria impressora (GS ( k), sem rasterizar a imagem
+func writeQRCode(output *bytes.Buffer, data string) error {
+	payload := encodePC860(data)
+	if len(payload) > escposQRCodeMaxData {
+		return fmt.Errorf("qr code data too long: %d bytes", len(payload))
+	}
+
+	// O tamanho informado inclui os três bytes de função (cn, fn, m)
+	size := len(payload) + 3
+
+	output.Write(escposAlignCenter)
+	output.Write(escposQRCodeModel2)
+	output.Write(escposQRCodeSize)
+	output.Write(escposQRCodeLevelM)
+	output.Write([]byte{0x1d, 0x28, 0x6b, byte(size % 256), byte(size / 256), 0x31, 0x50, 0x30})
+	output.Write(payload)
+	output.Write(escposQRCodePrint)
+	output.WriteByte(escposLineFeed)
+	output.Write(escposAlignLeft)
+
+	return nil
+}
+
+// encodePC860 converte o texto para a página de código selecionada; caracteres sem equivalente viram "?"
+func encodePC860(text string) []byte {
+	encoded := make([]byte, 0, len(text))
+
+	for _, r := range text {
+		b, ok := charmap.CodePage860.EncodeRune(r)
+		if !ok {
+			b = '?'
+		}
+		encoded = append(encoded, b)
+	}
+
+	return encoded
+}
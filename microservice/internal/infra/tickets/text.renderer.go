@@ -0,0 +1,41 @@
+package tickets
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"tech_challenge/internal/application/dtos"
+)
+
+// TextRenderer gera a comanda em texto de largura fixa, para impressoras genéricas ou pré-visualização
+type TextRenderer struct {
+	options Options
+}
+
+func (r *TextRenderer) ContentType() string {
+	return "text/plain; charset=utf-8"
+}
+
+func (r *TextRenderer) Render(ticket dtos.KitchenOrderTicketDTO) ([]byte, error) {
+	var output strings.Builder
+
+	for _, line := range layout(ticket, r.options) {
+		switch line.kind {
+		case lineRule:
+			output.WriteString(strings.Repeat(line.text, r.options.Width))
+		case lineQRCode:
+			// Texto puro não desenha QR code; o id do pedido já está impresso logo acima
+			continue
+		default:
+			text := line.text
+			if line.center {
+				text = strings.Repeat(" ", max(r.options.Width-utf8.RuneCountInString(text), 0)/2) + text
+			}
+			output.WriteString(strings.TrimRight(text, " "))
+		}
+
+		output.WriteByte('\n')
+	}
+
+	return []byte(output.String()), nil
+}
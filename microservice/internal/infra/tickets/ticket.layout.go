@@ -0,0 +1,163 @@
+package tickets
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"tech_challenge/internal/application/dtos"
+)
+
+type lineKind int
+
+const (
+	lineText lineKind = iota
+	lineRule
+	lineQRCode
+)
+
+// line é uma linha da comanda independente do formato; cada renderizador decide como aplicar o estilo
+type line struct {
+	kind   lineKind
+	text   string
+	bold   bool
+	large  bool
+	center bool
+}
+
+const ticketTimeLayout = "02/01/2006 15:04"
+
+func layout(ticket dtos.KitchenOrderTicketDTO, options Options) []line {
+	width := options.Width
+	var lines []line
+
+	lines = append(lines, line{kind: lineRule, text: "="})
+	// O texto em tamanho duplo ocupa duas colunas por caractere
+	for _, text := range wrap("PEDIDO "+sanitize(ticket.Slug), width/2) {
+		lines = append(lines, line{kind: lineText, text: text, bold: true, large: true, center: true})
+	}
+	lines = append(lines, line{kind: lineRule, text: "="})
+
+	for _, text := range wrapIndented("Status: ", sanitize(ticket.Status.Name), width) {
+		lines = append(lines, line{kind: lineText, text: text, bold: true})
+	}
+
+	timestamps := []struct {
+		label string
+		at    *time.Time
+	}{
+		{"Recebido:", &ticket.CreatedAt},
+		{"Em preparo:", ticket.PreparingAt},
+		{"Pronto:", ticket.ReadyAt},
+		{"Finalizado:", ticket.FinishedAt},
+	}
+	for _, timestamp := range timestamps {
+		if timestamp.at == nil {
+			continue
+		}
+
+		text := fmt.Sprintf("%-12s%s", timestamp.label, timestamp.at.In(options.Location).Format(ticketTimeLayout))
+		lines = append(lines, line{kind: lineText, text: text})
+	}
+
+	lines = append(lines, line{kind: lineRule, text: "-"})
+	lines = append(lines, line{kind: lineText, text: "ITENS", bold: true})
+
+	if len(ticket.Items) == 0 {
+		lines = append(lines, line{kind: lineText, text: "(sem itens)"})
+	}
+	for _, item := range ticket.Items {
+		prefix := fmt.Sprintf("%3dx ", item.Quantity)
+		for _, text := range wrapIndented(prefix, sanitize(item.ProductID), width) {
+			lines = append(lines, line{kind: lineText, text: text})
+		}
+	}
+
+	if ticket.Notes != nil && strings.TrimSpace(*ticket.Notes) != "" {
+		lines = append(lines, line{kind: lineRule, text: "-"})
+		for _, text := range wrapIndented("OBS: ", sanitize(*ticket.Notes), width) {
+			lines = append(lines, line{kind: lineText, text: text, bold: true})
+		}
+	}
+
+	lines = append(lines, line{kind: lineRule, text: "-"})
+	for _, text := range wrapIndented("Pedido: ", sanitize(ticket.OrderID), width) {
+		lines = append(lines, line{kind: lineText, text: text})
+	}
+
+	if options.QRCode && ticket.OrderID != "" {
+		lines = append(lines, line{kind: lineQRCode, text: ticket.OrderID, center: true})
+	}
+
+	return lines
+}
+
+// sanitize troca caracteres de controle por espaço para que textos do pedido não virem comandos da impressora
+func sanitize(text string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) {
+			return ' '
+		}
+		return r
+	}, text)
+}
+
+// wrapIndented quebra o texto após o prefixo e alinha as linhas seguintes com o início do texto
+func wrapIndented(prefix, text string, width int) []string {
+	indent := strings.Repeat(" ", utf8.RuneCountInString(prefix))
+
+	wrapped := wrap(text, width-len(indent))
+	for i := range wrapped {
+		if i == 0 {
+			wrapped[i] = prefix + wrapped[i]
+		} else {
+			wrapped[i] = indent + wrapped[i]
+		}
+	}
+
+	return wrapped
+}
+
+// wrap quebra nas palavras; palavras maiores que a linha, como ids, são cortadas
+func wrap(text string, width int) []string {
+	var lines []string
+	var current []rune
+
+	flush := func() {
+		lines = append(lines, string(current))
+		current = nil
+	}
+
+	for _, field := range strings.Fields(text) {
+		word := []rune(field)
+
+		for len(word) > width {
+			if len(current) > 0 {
+				flush()
+			}
+			lines = append(lines, string(word[:width]))
+			word = word[width:]
+		}
+
+		if len(word) == 0 {
+			continue
+		}
+
+		if len(current) > 0 && len(current)+1+len(word) > width {
+			flush()
+		}
+
+		if len(current) > 0 {
+			current = append(current, ' ')
+		}
+		current = append(current, word...)
+	}
+
+	if len(current) > 0 || len(lines) == 0 {
+		flush()
+	}
+
+	return lines
+}
@@ -0,0 +1,50 @@
+package tickets
+
+import (
+	"time"
+
+	"tech_challenge/internal/application/dtos"
+	"tech_challenge/internal/shared/config/constants"
+)
+
+// DefaultWidth corresponde à fonte padrão de uma impressora térmica de 80mm
+const DefaultWidth = 48
+
+type Options struct {
+	// Width é o número de colunas da impressora
+	Width    int
+	Location *time.Location
+	// QRCode imprime o id do pedido em QR code; só o ESC/POS tem como desenhá-lo
+	QRCode bool
+}
+
+type Renderer interface {
+	Render(ticket dtos.KitchenOrderTicketDTO) ([]byte, error)
+	ContentType() string
+}
+
+// NewRenderer devolve o renderizador do formato pedido; ok é falso quando o formato não é suportado
+func NewRenderer(format string, options Options) (renderer Renderer, ok bool) {
+	options = options.withDefaults()
+
+	switch format {
+	case constants.KITCHEN_TICKET_FORMAT_TEXT:
+		return &TextRenderer{options: options}, true
+	case constants.KITCHEN_TICKET_FORMAT_ESCPOS:
+		return &ESCPOSRenderer{options: options}, true
+	default:
+		return nil, false
+	}
+}
+
+func (o Options) withDefaults() Options {
+	if o.Width <= 0 {
+		o.Width = DefaultWidth
+	}
+
+	if o.Location == nil {
+		o.Location = time.UTC
+	}
+
+	return o
+}
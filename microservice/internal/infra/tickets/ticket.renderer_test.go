@@ -0,0 +1,147 @@
+package tickets
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tech_challenge/internal/application/dtos"
+	"tech_challenge/internal/shared/config/constants"
+)
+
+// Rode com -update para regravar os arquivos em testdata depois de uma mudança intencional no layout
+var update = flag.Bool("update", false, "update golden files")
+
+func ticketFixture() dtos.KitchenOrderTicketDTO {
+	createdAt := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+	preparingAt := createdAt.Add(2 * time.Minute)
+	notes := "Cliente devolveu o lanche frio, refazer sem cebola e com o pão bem tostado"
+
+	return dtos.KitchenOrderTicketDTO{
+		ID:      "550e8400-e29b-41d4-a716-446655440000",
+		OrderID: "123e4567-e89b-12d3-a456-426614174000",
+		Slug:    "042",
+		Status:  dtos.OrderStatusDTO{ID: constants.KITCHEN_ORDER_STATUS_PREPARING_ID, Name: "Em preparação"},
+		Items: []dtos.OrderItemDTO{
+			{ProductID: "x-burger-duplo", Quantity: 2},
+			{ProductID: "batata-frita-grande-com-cheddar-e-bacon-extra-crocante", Quantity: 1},
+			{ProductID: "refrigerante-lata", Quantity: 12},
+		},
+		Notes:       &notes,
+		CreatedAt:   createdAt,
+		PreparingAt: &preparingAt,
+	}
+}
+
+func saoPaulo(t *testing.T) *time.Location {
+	location, err := time.LoadLocation("America/Sao_Paulo")
+	require.NoError(t, err)
+	return location
+}
+
+func assertGolden(t *testing.T, name string, actual []byte) {
+	t.Helper()
+
+	path := filepath.Join("testdata", name)
+	if *update {
+		require.NoError(t, os.WriteFile(path, actual, 0o644))
+	}
+
+	expected, err := os.ReadFile(path)
+	require.NoError(t, err, "golden file missing; run the tests with -update")
+
+	if !bytes.Equal(expected, actual) {
+		t.Errorf("%s does not match the golden file\nexpected:\n%q\nactual:\n%q", name, expected, actual)
+	}
+}
+
+func render(t *testing.T, format string, options Options, ticket dtos.KitchenOrderTicketDTO) []byte {
+	t.Helper()
+
+	renderer, ok := NewRenderer(format, options)
+	require.True(t, ok)
+
+	output, err := renderer.Render(ticket)
+	require.NoError(t, err)
+	return output
+}
+
+func TestTextRenderer_Golden(t *testing.T) {
+	options := Options{Width: 48, Location: saoPaulo(t), QRCode: true}
+
+	assertGolden(t, "ticket-48.txt", render(t, constants.KITCHEN_TICKET_FORMAT_TEXT, options, ticketFixture()))
+	t.Log("✓ Comanda em texto com horários no fuso local")
+}
+
+func TestTextRenderer_NarrowPrinter_Golden(t *testing.T) {
+	options := Options{Width: 32, Location: saoPaulo(t)}
+
+	assertGolden(t, "ticket-32.txt", render(t, constants.KITCHEN_TICKET_FORMAT_TEXT, options, ticketFixture()))
+}
+
+func TestTextRenderer_WithoutItemsAndNotes_Golden(t *testing.T) {
+	ticket := ticketFixture()
+	ticket.Items = nil
+	ticket.Notes = nil
+	ticket.PreparingAt = nil
+
+	assertGolden(t, "ticket-empty.txt", render(t, constants.KITCHEN_TICKET_FORMAT_TEXT, Options{}, ticket))
+}
+
+func TestESCPOSRenderer_Golden(t *testing.T) {
+	options := Options{Width: 48, Location: saoPaulo(t), QRCode: true}
+
+	assertGolden(t, "ticket-48.escpos", render(t, constants.KITCHEN_TICKET_FORMAT_ESCPOS, options, ticketFixture()))
+	t.Log("✓ Comanda ESC/POS com cabeçalho em destaque e QR code")
+}
+
+func TestESCPOSRenderer_WithoutQRCode_Golden(t *testing.T) {
+	options := Options{Width: 32, Location: saoPaulo(t)}
+
+	assertGolden(t, "ticket-32.escpos", render(t, constants.KITCHEN_TICKET_FORMAT_ESCPOS, options, ticketFixture()))
+}
+
+func TestESCPOSRenderer_CommandsAndEncoding(t *testing.T) {
+	output := render(t, constants.KITCHEN_TICKET_FORMAT_ESCPOS, Options{QRCode: true}, ticketFixture())
+
+	assert.True(t, bytes.HasPrefix(output, append(escposInitialize, escposCodePagePC860...)))
+	assert.True(t, bytes.HasSuffix(output, escposFeedAndCut))
+	assert.True(t, bytes.Contains(output, append(append([]byte{}, escposDoubleSize...), []byte("PEDIDO 042")...)))
+
+	// "Em preparação" na página PC860: ç = 0x87, ã = 0x84
+	assert.True(t, bytes.Contains(output, []byte{'p', 'r', 'e', 'p', 'a', 'r', 'a', 0x87, 0x84, 'o'}))
+
+	qrStore := []byte{0x1d, 0x28, 0x6b, 36 + 3, 0x00, 0x31, 0x50, 0x30}
+	assert.True(t, bytes.Contains(output, append(qrStore, []byte(ticketFixture().OrderID)...)))
+}
+
+func TestESCPOSRenderer_StripsControlCharacters(t *testing.T) {
+	ticket := ticketFixture()
+	notes := "sem cebola\x1d\x56\x00"
+	ticket.Notes = &notes
+
+	output := render(t, constants.KITCHEN_TICKET_FORMAT_ESCPOS, Options{}, ticket)
+
+	assert.False(t, bytes.Contains(output, []byte{0x1d, 0x56, 0x00}))
+	assert.Contains(t, string(output), "OBS: sem cebola")
+	t.Log("✓ Textos do pedido não injetam comandos na impressora")
+}
+
+func TestNewRenderer_UnknownFormat(t *testing.T) {
+	_, ok := NewRenderer("pdf", Options{})
+
+	assert.False(t, ok)
+}
+
+func TestWrap(t *testing.T) {
+	assert.Equal(t, []string{"abc def", "ghi"}, wrap("abc def ghi", 7))
+	assert.Equal(t, []string{"abcde", "fghij", "k"}, wrap("abcdefghijk", 5))
+	assert.Equal(t, []string{"ab", "cdefg", "hi"}, wrap("ab cdefghi", 5))
+	assert.Equal(t, []string{""}, wrap("   ", 5))
+}
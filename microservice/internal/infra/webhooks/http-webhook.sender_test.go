@@ -0,0 +1,76 @@
+package webhooks
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"tech_challenge/internal/shared/pkg/netguard"
+)
+
+func TestHTTPWebhookSender_Send(t *testing.T) {
+	var receivedBody []byte
+	var receivedSignature string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		receivedBody, _ = io.ReadAll(r.Body)
+		receivedSignature = r.Header.Get("X-Webhook-Signature")
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	sender := NewHTTPWebhookSender(time.Second, true)
+	statusCode, err := sender.Send(server.URL, map[string]string{"X-Webhook-Signature": "sha256=abc"}, []byte(`{"ok":true}`))
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, statusCode)
+	assert.Equal(t, `{"ok":true}`, string(receivedBody))
+	assert.Equal(t, "sha256=abc", receivedSignature)
+	t.Log("✓ Webhook enviado com headers e corpo")
+}
+
+func TestHTTPWebhookSender_Timeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+	}))
+	defer server.Close()
+
+	sender := NewHTTPWebhookSender(20*time.Millisecond, true)
+	_, err := sender.Send(server.URL, nil, []byte(`{}`))
+
+	assert.Error(t, err)
+	t.Log("✓ Timeout respeitado")
+}
+
+func TestHTTPWebhookSender_BlocksPrivateNetworks(t *testing.T) {
+	called := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	defer server.Close()
+
+	sender := NewHTTPWebhookSender(time.Second, false)
+	_, err := sender.Send(server.URL, nil, []byte(`{}`))
+
+	assert.ErrorIs(t, err, netguard.ErrNonPublicAddress)
+	assert.False(t, called)
+	t.Log("✓ Envio para loopback bloqueado na conexão")
+}
+
+func TestHTTPWebhookSender_DoesNotFollowRedirects(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "http://169.254.169.254/latest/meta-data", http.StatusFound)
+	}))
+	defer server.Close()
+
+	sender := NewHTTPWebhookSender(time.Second, true)
+	statusCode, err := sender.Send(server.URL, nil, []byte(`{}`))
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusFound, statusCode)
+	t.Log("✓ Redirecionamento devolvido como falha, sem seguir o destino")
+}
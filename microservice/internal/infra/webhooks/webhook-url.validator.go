@@ -0,0 +1,58 @@
+package webhooks
+
+import (
+	"context"
+	"net"
+	"net/url"
+	"time"
+
+	"tech_challenge/internal/domain/entities"
+	"tech_challenge/internal/domain/exceptions"
+	"tech_challenge/internal/shared/pkg/netguard"
+)
+
+const webhookURLResolveTimeout = 5 * time.Second
+
+// WebhookURLValidator impede inscrições que façam o servidor chamar a rede interna, como o metadata da instância
+type WebhookURLValidator struct {
+	requireHTTPS         bool
+	allowPrivateNetworks bool
+	resolver             netguard.Resolver
+}
+
+func NewWebhookURLValidator(requireHTTPS, allowPrivateNetworks bool) *WebhookURLValidator {
+	return &WebhookURLValidator{
+		requireHTTPS:         requireHTTPS,
+		allowPrivateNetworks: allowPrivateNetworks,
+		resolver:             net.DefaultResolver,
+	}
+}
+
+func (v *WebhookURLValidator) Validate(callbackURL string) error {
+	if err := entities.ValidateWebhookURL(callbackURL); err != nil {
+		return err
+	}
+
+	parsed, _ := url.Parse(callbackURL)
+
+	if v.requireHTTPS && parsed.Scheme != "https" {
+		return &exceptions.InvalidWebhookSubscriptionDataException{
+			Message: "Webhook URL must use https",
+		}
+	}
+
+	if v.allowPrivateNetworks {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), webhookURLResolveTimeout)
+	defer cancel()
+
+	if err := netguard.CheckHost(ctx, v.resolver, parsed.Hostname()); err != nil {
+		return &exceptions.InvalidWebhookSubscriptionDataException{
+			Message: "Webhook URL must resolve to a public address",
+		}
+	}
+
+	return nil
+}
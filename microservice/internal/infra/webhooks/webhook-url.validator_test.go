@@ -0,0 +1,60 @@
+package webhooks
+
+import (
+	"context"
+	"errors"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"tech_challenge/internal/domain/exceptions"
+)
+
+type fakeResolver map[string][]netip.Addr
+
+func (r fakeResolver) LookupNetIP(ctx context.Context, network, host string) ([]netip.Addr, error) {
+	addrs, ok := r[host]
+	if !ok {
+		return nil, errors.New("no such host")
+	}
+	return addrs, nil
+}
+
+func newTestWebhookURLValidator(requireHTTPS, allowPrivateNetworks bool) *WebhookURLValidator {
+	validator := NewWebhookURLValidator(requireHTTPS, allowPrivateNetworks)
+	validator.resolver = fakeResolver{
+		"partner.example.com":  {netip.MustParseAddr("93.184.216.34")},
+		"metadata.example.com": {netip.MustParseAddr("169.254.169.254")},
+	}
+	return validator
+}
+
+func TestWebhookURLValidator_Validate(t *testing.T) {
+	validator := newTestWebhookURLValidator(true, false)
+
+	assert.NoError(t, validator.Validate("https://partner.example.com/hooks"))
+
+	rejected := []string{
+		"http://partner.example.com/hooks",
+		"https://metadata.example.com/latest",
+		"https://169.254.169.254/latest/meta-data",
+		"https://127.0.0.1:8080/hooks",
+		"https://[::1]/hooks",
+		"https://10.0.0.4/hooks",
+		"https://unknown.example.com/hooks",
+		"not-a-url",
+	}
+	for _, callbackURL := range rejected {
+		assert.IsType(t, &exceptions.InvalidWebhookSubscriptionDataException{}, validator.Validate(callbackURL), callbackURL)
+	}
+	t.Log("✓ URLs sem https ou apontando para a rede interna recusadas")
+}
+
+func TestWebhookURLValidator_Development(t *testing.T) {
+	validator := newTestWebhookURLValidator(false, true)
+
+	assert.NoError(t, validator.Validate("http://localhost:9000/hooks"))
+	assert.NoError(t, validator.Validate("http://partner.example.com/hooks"))
+	t.Log("✓ Em desenvolvimento http e endereços locais são aceitos")
+}
@@ -0,0 +1,32 @@
+package webhooks
+
+import (
+	"context"
+
+	"tech_challenge/internal/application/controllers"
+	"tech_challenge/internal/application/dtos"
+)
+
+type WebhookDispatcher struct {
+	webhookController controllers.WebhookController
+	queue             *WebhookQueue
+}
+
+func NewWebhookDispatcher(webhookController *controllers.WebhookController, queue *WebhookQueue) *WebhookDispatcher {
+	return &WebhookDispatcher{
+		webhookController: *webhookController,
+		queue:             queue,
+	}
+}
+
+// Dispatch grava as entregas na transação em ctx; se a gravação falhar, o erro desfaz a mudança de status que originou o evento.
+// O envio e as retentativas ficam com a WebhookQueue
+func (d *WebhookDispatcher) Dispatch(ctx context.Context, event dtos.WebhookEventDTO) error {
+	_, err := d.webhookController.Enqueue(ctx, event)
+	return err
+}
+
+// Notify acorda a fila para enviar as entregas recém-confirmadas sem esperar o próximo polling
+func (d *WebhookDispatcher) Notify() {
+	d.queue.Notify()
+}
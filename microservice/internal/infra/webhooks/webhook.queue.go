@@ -0,0 +1,102 @@
+package webhooks
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"tech_challenge/internal/shared/pkg/logger"
+)
+
+type WebhookDeliverySource interface {
+	ProcessDueDeliveries(ctx context.Context) (int, error)
+}
+
+// WebhookQueue drena a fila de entregas em segundo plano; a fila fica no banco, então um restart não perde retentativas
+type WebhookQueue struct {
+	source       WebhookDeliverySource
+	pollInterval time.Duration
+
+	mu      sync.Mutex
+	running bool
+	closed  bool
+	cancel  context.CancelFunc
+	done    chan struct{}
+	wake    chan struct{}
+}
+
+func NewWebhookQueue(source WebhookDeliverySource, pollInterval time.Duration) *WebhookQueue {
+	return &WebhookQueue{
+		source:       source,
+		pollInterval: pollInterval,
+		wake:         make(chan struct{}, 1),
+	}
+}
+
+func (q *WebhookQueue) Start() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.running || q.closed {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	q.cancel = cancel
+	q.done = make(chan struct{})
+	q.running = true
+
+	go q.run(ctx)
+}
+
+// Close aguarda o lote em andamento; entregas interrompidas voltam para a fila quando a reserva expira
+func (q *WebhookQueue) Close() {
+	q.mu.Lock()
+	if q.closed {
+		q.mu.Unlock()
+		return
+	}
+
+	q.closed = true
+	cancel, done := q.cancel, q.done
+	q.mu.Unlock()
+
+	if cancel != nil {
+		cancel()
+		<-done
+	}
+}
+
+// Notify antecipa a próxima leitura da fila; usado logo após gravar novas entregas
+func (q *WebhookQueue) Notify() {
+	select {
+	case q.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (q *WebhookQueue) run(ctx context.Context) {
+	defer close(q.done)
+
+	ticker := time.NewTicker(q.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-q.wake:
+			q.process(ctx)
+		case <-ticker.C:
+			q.process(ctx)
+		}
+	}
+}
+
+// process não é interrompido pelo Close: o lote em andamento termina antes de a fila parar
+func (q *WebhookQueue) process(ctx context.Context) {
+	if _, err := q.source.ProcessDueDeliveries(context.WithoutCancel(ctx)); err != nil {
+		slog.Error("Error processing webhook deliveries", logger.ErrorKey, err)
+	}
+}
@@ -0,0 +1,71 @@
+package webhooks
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type countingDeliverySource struct {
+	calls   atomic.Int32
+	ran     chan struct{}
+	release chan struct{}
+}
+
+func (s *countingDeliverySource) ProcessDueDeliveries(ctx context.Context) (int, error) {
+	s.calls.Add(1)
+	select {
+	case s.ran <- struct{}{}:
+	default:
+	}
+	if s.release != nil {
+		<-s.release
+	}
+	return 0, nil
+}
+
+func TestWebhookQueue_NotifyTriggersProcessing(t *testing.T) {
+	source := &countingDeliverySource{ran: make(chan struct{}, 1)}
+	queue := NewWebhookQueue(source, time.Hour)
+	queue.Start()
+	defer queue.Close()
+
+	queue.Notify()
+
+	select {
+	case <-source.ran:
+		t.Log("✓ Notify antecipa o envio das entregas")
+	case <-time.After(2 * time.Second):
+		t.Fatal("Expected the queue to process after Notify")
+	}
+}
+
+func TestWebhookQueue_CloseWaitsForInFlightBatch(t *testing.T) {
+	source := &countingDeliverySource{ran: make(chan struct{}, 1), release: make(chan struct{})}
+	queue := NewWebhookQueue(source, time.Hour)
+	queue.Start()
+	queue.Notify()
+	<-source.ran
+
+	closed := make(chan struct{})
+	go func() {
+		queue.Close()
+		close(closed)
+	}()
+
+	select {
+	case <-closed:
+		t.Fatal("Expected Close to wait for the batch in progress")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(source.release)
+
+	select {
+	case <-closed:
+		t.Log("✓ Shutdown aguarda o lote de entregas em andamento")
+	case <-time.After(2 * time.Second):
+		t.Fatal("Expected Close to return after the batch finished")
+	}
+}
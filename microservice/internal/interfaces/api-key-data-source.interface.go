@@ -0,0 +1,20 @@
+package interfaces
+
+import (
+	"errors"
+	"time"
+
+	"tech_challenge/internal/daos"
+)
+
+// ErrAPIKeyNotFound indica que nenhuma chave tem o prefixo informado; qualquer outro erro de FindByPrefix é falha na consulta
+var ErrAPIKeyNotFound = errors.New("api key not found")
+
+type IAPIKeyDataSource interface {
+	Insert(apiKey daos.APIKeyDAO) error
+	FindByID(id string) (daos.APIKeyDAO, error)
+	FindByPrefix(prefix string) (daos.APIKeyDAO, error)
+	FindAll() ([]daos.APIKeyDAO, error)
+	Revoke(id string, revokedAt time.Time) error
+	TouchLastUsed(id string, usedAt time.Time) error
+}
@@ -0,0 +1,17 @@
+package interfaces
+
+import (
+	"time"
+
+	"tech_challenge/internal/daos"
+)
+
+type IIdempotencyKeyDataSource interface {
+	Reserve(idempotencyKey daos.IdempotencyKeyDAO) (bool, error)
+	Reclaim(idempotencyKey daos.IdempotencyKeyDAO, staleBefore time.Time) (bool, error)
+	ReclaimExpired(idempotencyKey daos.IdempotencyKeyDAO, expiredBefore time.Time) (bool, error)
+	FindByKey(scope, key string) (daos.IdempotencyKeyDAO, error)
+	Complete(scope, key, resourceID string) error
+	Release(scope, key string) error
+	DeleteOlderThan(before time.Time) (int64, error)
+}
@@ -1,18 +1,27 @@
 package interfaces
 
 import (
+	"context"
+	"errors"
+
 	"tech_challenge/internal/application/dtos"
 	"tech_challenge/internal/daos"
 )
 
+// ErrKitchenOrderVersionConflict indica que o pedido foi alterado depois de lido; Update só grava sobre a versão informada
+var ErrKitchenOrderVersionConflict = errors.New("kitchen order version conflict")
+
 type IKitchenOrderDataSource interface {
-	Insert(kitchenOrder daos.KitchenOrderDAO) error
-	FindByID(id string) (daos.KitchenOrderDAO, error)
-	FindAll(filter dtos.KitchenOrderFilter) ([]daos.KitchenOrderDAO, error)
-	Update(kitchenOrder daos.KitchenOrderDAO) error
+	Insert(ctx context.Context, kitchenOrder daos.KitchenOrderDAO) error
+	FindByID(ctx context.Context, id string) (daos.KitchenOrderDAO, error)
+	FindAll(ctx context.Context, filter dtos.KitchenOrderFilter) ([]daos.KitchenOrderDAO, dtos.PageInfo, error)
+	FindAllInBatches(ctx context.Context, filter dtos.KitchenOrderFilter, batchSize int, handle func([]daos.KitchenOrderDAO) error) error
+	Update(ctx context.Context, kitchenOrder daos.KitchenOrderDAO) error
+	// Transaction executa fn em uma transação; o ctx recebido por fn deve ser repassado às gravações que fazem parte dela
+	Transaction(ctx context.Context, fn func(ctx context.Context) error) error
 }
 
 type IOrderStatusDataSource interface {
-	FindByID(id string) (daos.OrderStatusDAO, error)
-	FindAll() ([]daos.OrderStatusDAO, error)
+	FindByID(ctx context.Context, id string) (daos.OrderStatusDAO, error)
+	FindAll(ctx context.Context) ([]daos.OrderStatusDAO, error)
 }
@@ -0,0 +1,14 @@
+package interfaces
+
+import (
+	"time"
+
+	"tech_challenge/internal/daos"
+)
+
+type IKitchenOrderEventDataSource interface {
+	FindAfter(afterID uint64, limit int) ([]daos.KitchenOrderEventDAO, error)
+	FindByIDs(ids []uint64) ([]daos.KitchenOrderEventDAO, error)
+	FindLastID() (uint64, error)
+	DeleteOlderThan(before time.Time) (int64, error)
+}
@@ -0,0 +1,9 @@
+package interfaces
+
+import "tech_challenge/internal/application/dtos"
+
+// IKitchenOrderExportWriter grava as linhas da exportação à medida que são lidas; Close finaliza o arquivo
+type IKitchenOrderExportWriter interface {
+	Write(row dtos.KitchenOrderExportDTO) error
+	Close() error
+}
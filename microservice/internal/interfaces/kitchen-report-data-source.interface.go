@@ -0,0 +1,20 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"tech_challenge/internal/application/dtos"
+	"tech_challenge/internal/daos"
+)
+
+// IKitchenReportDataSource agrega os pedidos no banco; nenhum pedido é carregado para o cálculo dos relatórios
+type IKitchenReportDataSource interface {
+	CountThroughput(ctx context.Context, filter dtos.KitchenReportFilter) ([]daos.ThroughputBucketDAO, error)
+	// FindStageDurations agrupa por período quando grouped é true, ou resume o período inteiro em uma linha por status
+	FindStageDurations(ctx context.Context, filter dtos.KitchenReportFilter, grouped bool) ([]daos.StageDurationDAO, error)
+	FindSlowestProducts(ctx context.Context, filter dtos.KitchenReportFilter) ([]daos.ProductPrepTimeDAO, error)
+	// CountByStatus devolve uma linha por status informado, com zero quando não há pedidos nele
+	CountByStatus(ctx context.Context, statusIDs []string) ([]daos.BoardStatusCountDAO, error)
+	FindOldestCreatedAt(ctx context.Context, statusID string) (*time.Time, error)
+}
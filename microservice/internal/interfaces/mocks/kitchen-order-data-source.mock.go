@@ -4,6 +4,7 @@
 package mock_interfaces
 
 import (
+	context "context"
 	reflect "reflect"
 	dtos "tech_challenge/internal/application/dtos"
 	daos "tech_challenge/internal/daos"
@@ -35,59 +36,88 @@ func (m *MockIKitchenOrderDataSource) EXPECT() *MockIKitchenOrderDataSourceMockR
 }
 
 // Insert mocks base method.
-func (m *MockIKitchenOrderDataSource) Insert(arg0 daos.KitchenOrderDAO) error {
+func (m *MockIKitchenOrderDataSource) Insert(arg0 context.Context, arg1 daos.KitchenOrderDAO) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Insert", arg0)
+	ret := m.ctrl.Call(m, "Insert", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Insert indicates an expected call of Insert.
-func (mr *MockIKitchenOrderDataSourceMockRecorder) Insert(arg0 interface{}) *gomock.Call {
+func (mr *MockIKitchenOrderDataSourceMockRecorder) Insert(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockIKitchenOrderDataSource)(nil).Insert), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockIKitchenOrderDataSource)(nil).Insert), arg0, arg1)
 }
 
 // FindByID mocks base method.
-func (m *MockIKitchenOrderDataSource) FindByID(arg0 string) (daos.KitchenOrderDAO, error) {
+func (m *MockIKitchenOrderDataSource) FindByID(arg0 context.Context, arg1 string) (daos.KitchenOrderDAO, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindByID", arg0)
+	ret := m.ctrl.Call(m, "FindByID", arg0, arg1)
 	ret0, _ := ret[0].(daos.KitchenOrderDAO)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindByID indicates an expected call of FindByID.
-func (mr *MockIKitchenOrderDataSourceMockRecorder) FindByID(arg0 interface{}) *gomock.Call {
+func (mr *MockIKitchenOrderDataSourceMockRecorder) FindByID(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockIKitchenOrderDataSource)(nil).FindByID), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockIKitchenOrderDataSource)(nil).FindByID), arg0, arg1)
 }
 
 // FindAll mocks base method.
-func (m *MockIKitchenOrderDataSource) FindAll(arg0 dtos.KitchenOrderFilter) ([]daos.KitchenOrderDAO, error) {
+func (m *MockIKitchenOrderDataSource) FindAll(arg0 context.Context, arg1 dtos.KitchenOrderFilter) ([]daos.KitchenOrderDAO, dtos.PageInfo, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindAll", arg0)
+	ret := m.ctrl.Call(m, "FindAll", arg0, arg1)
 	ret0, _ := ret[0].([]daos.KitchenOrderDAO)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(dtos.PageInfo)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // FindAll indicates an expected call of FindAll.
-func (mr *MockIKitchenOrderDataSourceMockRecorder) FindAll(arg0 interface{}) *gomock.Call {
+func (mr *MockIKitchenOrderDataSourceMockRecorder) FindAll(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockIKitchenOrderDataSource)(nil).FindAll), arg0, arg1)
+}
+
+// FindAllInBatches mocks base method.
+func (m *MockIKitchenOrderDataSource) FindAllInBatches(arg0 context.Context, arg1 dtos.KitchenOrderFilter, arg2 int, arg3 func([]daos.KitchenOrderDAO) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllInBatches", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FindAllInBatches indicates an expected call of FindAllInBatches.
+func (mr *MockIKitchenOrderDataSourceMockRecorder) FindAllInBatches(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllInBatches", reflect.TypeOf((*MockIKitchenOrderDataSource)(nil).FindAllInBatches), arg0, arg1, arg2, arg3)
+}
+
+// Transaction mocks base method.
+func (m *MockIKitchenOrderDataSource) Transaction(arg0 context.Context, arg1 func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Transaction", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Transaction indicates an expected call of Transaction.
+func (mr *MockIKitchenOrderDataSourceMockRecorder) Transaction(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockIKitchenOrderDataSource)(nil).FindAll), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transaction", reflect.TypeOf((*MockIKitchenOrderDataSource)(nil).Transaction), arg0, arg1)
 }
 
 // Update mocks base method.
-func (m *MockIKitchenOrderDataSource) Update(arg0 daos.KitchenOrderDAO) error {
+func (m *MockIKitchenOrderDataSource) Update(arg0 context.Context, arg1 daos.KitchenOrderDAO) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", arg0)
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Update indicates an expected call of Update.
-func (mr *MockIKitchenOrderDataSourceMockRecorder) Update(arg0 interface{}) *gomock.Call {
+func (mr *MockIKitchenOrderDataSourceMockRecorder) Update(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIKitchenOrderDataSource)(nil).Update), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIKitchenOrderDataSource)(nil).Update), arg0, arg1)
 }
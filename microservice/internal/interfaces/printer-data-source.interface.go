@@ -0,0 +1,47 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"tech_challenge/internal/application/dtos"
+	"tech_challenge/internal/daos"
+)
+
+type IPrinterDataSource interface {
+	Insert(printer daos.PrinterDAO) error
+	FindByID(id string) (daos.PrinterDAO, error)
+	FindAll() ([]daos.PrinterDAO, error)
+	// FindAllActive participa da transação em ctx, como a gravação das comandas de um pedido recém-criado
+	FindAllActive(ctx context.Context) ([]daos.PrinterDAO, error)
+	Update(printer daos.PrinterDAO) error
+	Delete(id string) error
+}
+
+type IPrintRouteDataSource interface {
+	FindAll(ctx context.Context) ([]daos.PrintRouteDAO, error)
+	// ReplaceAll troca todas as regras de uma vez, já que a ordem entre elas define o roteamento
+	ReplaceAll(routes []daos.PrintRouteDAO) error
+}
+
+type IPrintJobDataSource interface {
+	// InsertAll grava as comandas na transação em ctx, junto com o pedido que as originou
+	InsertAll(ctx context.Context, jobs []daos.PrintJobDAO) error
+	FindAll(filter dtos.PrintJobFilter) ([]daos.PrintJobDAO, error)
+	// ClaimDue reserva até limit trabalhos vencidos até leaseUntil, para que só uma réplica imprima cada comanda
+	ClaimDue(now, leaseUntil time.Time, limit int) ([]daos.PrintJobDAO, error)
+	Update(job daos.PrintJobDAO) error
+}
+
+// IPrinterSender envia a comanda à impressora de rede
+type IPrinterSender interface {
+	Print(printer dtos.PrinterTargetDTO, ticket dtos.KitchenOrderTicketDTO) error
+}
+
+// IPrintDispatcher grava as comandas de um pedido recém-criado; a impressão acontece em segundo plano
+type IPrintDispatcher interface {
+	// Dispatch grava as comandas na transação em ctx, junto com o pedido que as originou
+	Dispatch(ctx context.Context, kitchenOrderID string) error
+	// Notify antecipa a impressão depois que a transação foi confirmada
+	Notify()
+}
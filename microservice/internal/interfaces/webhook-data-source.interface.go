@@ -0,0 +1,50 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"tech_challenge/internal/application/dtos"
+	"tech_challenge/internal/daos"
+)
+
+type IWebhookSubscriptionDataSource interface {
+	Insert(subscription daos.WebhookSubscriptionDAO) error
+	FindByID(id string) (daos.WebhookSubscriptionDAO, error)
+	FindAll() ([]daos.WebhookSubscriptionDAO, error)
+	FindAllActive() ([]daos.WebhookSubscriptionDAO, error)
+	Update(subscription daos.WebhookSubscriptionDAO) error
+	Delete(id string) error
+	IncrementFailures(id string, disableAfter int) error
+	ResetFailures(id string) error
+}
+
+type IWebhookDeliveryDataSource interface {
+	Insert(delivery daos.WebhookDeliveryDAO) error
+	FindBySubscriptionID(subscriptionID string, limit int) ([]daos.WebhookDeliveryDAO, error)
+}
+
+type IWebhookOutboxDataSource interface {
+	InsertAll(ctx context.Context, entries []daos.WebhookOutboxDAO) error
+	// ClaimDue reserva até limit entregas vencidas até leaseUntil, para que só uma réplica envie cada uma
+	ClaimDue(now, leaseUntil time.Time, limit int) ([]daos.WebhookOutboxDAO, error)
+	Update(entry daos.WebhookOutboxDAO) error
+}
+
+// IWebhookURLValidator confere se a URL de uma inscrição pode receber eventos, resolvendo o host
+type IWebhookURLValidator interface {
+	Validate(callbackURL string) error
+}
+
+// IWebhookSender entrega o payload assinado ao endpoint inscrito, retornando o status HTTP recebido
+type IWebhookSender interface {
+	Send(url string, headers map[string]string, body []byte) (int, error)
+}
+
+// IWebhookDispatcher grava o evento na fila de entregas; o envio acontece em segundo plano
+type IWebhookDispatcher interface {
+	// Dispatch grava as entregas na transação em ctx, junto com a mudança de status que as originou
+	Dispatch(ctx context.Context, event dtos.WebhookEventDTO) error
+	// Notify antecipa o envio depois que a transação foi confirmada
+	Notify()
+}
@@ -6,6 +6,94 @@ const (
 	KITCHEN_ORDER_STATUS_READY_ID     = "5a8b2b16-9b47-4e35-ae27-28f7994ef456"
 	KITCHEN_ORDER_STATUS_FINISHED_ID  = "bd91a1ee-1234-4cde-9c2a-efb1d2a3a789"
 
+	WEBHOOK_EVENT_KITCHEN_ORDER_RECEIVED  = "kitchen_order.received"
+	WEBHOOK_EVENT_KITCHEN_ORDER_PREPARING = "kitchen_order.preparing"
+	WEBHOOK_EVENT_KITCHEN_ORDER_READY     = "kitchen_order.ready"
+	WEBHOOK_EVENT_KITCHEN_ORDER_FINISHED  = "kitchen_order.finished"
+
+	WEBHOOK_OUTBOX_STATUS_PENDING   = "pending"
+	WEBHOOK_OUTBOX_STATUS_SENDING   = "sending"
+	WEBHOOK_OUTBOX_STATUS_DELIVERED = "delivered"
+	WEBHOOK_OUTBOX_STATUS_FAILED    = "failed"
+
+	WEBHOOK_OUTBOX_CLAIM_BATCH = 20
+
+	KITCHEN_ORDER_EVENT_CREATED        = "created"
+	KITCHEN_ORDER_EVENT_STATUS_CHANGED = "status-changed"
+	KITCHEN_ORDER_EVENT_REMOVED        = "removed"
+
+	KITCHEN_ORDER_COMMAND_START  = "start"
+	KITCHEN_ORDER_COMMAND_READY  = "ready"
+	KITCHEN_ORDER_COMMAND_FINISH = "finish"
+	KITCHEN_ORDER_COMMAND_RECALL = "recall"
+
+	KITCHEN_ORDER_STATUS_REASON_MAX_LENGTH = 255
+
+	ROLE_COOK    = "cook"
+	ROLE_EXPO    = "expo"
+	ROLE_MANAGER = "manager"
+	ROLE_SERVICE = "service"
+
+	AUTH_PRINCIPAL_CONTEXT_KEY = "auth_principal"
+
+	API_KEY_HEADER                      = "X-API-Key"
+	API_KEY_SCOPE_KITCHEN_ORDERS_READ   = "kitchen-orders:read"
+	API_KEY_SCOPE_KITCHEN_ORDERS_CREATE = "kitchen-orders:create"
+	API_KEY_SCOPE_WEBHOOKS_MANAGE       = "webhooks:manage"
+	API_KEY_SCOPE_PRINTERS_MANAGE       = "printers:manage"
+	API_KEY_SCOPE_REPORTS_READ          = "reports:read"
+	API_KEY_NAME_MAX_LENGTH             = 100
+
+	TRACE_ID_CONTEXT_KEY = "trace_id"
+	TRACE_ID_HEADER      = "X-Request-ID"
+
+	IDEMPOTENCY_SCOPE_CREATE_KITCHEN_ORDER = "kitchen-order.create"
+	IDEMPOTENCY_KEY_MAX_LENGTH             = 255
+	IDEMPOTENCY_SCOPE_MAX_LENGTH           = 100
+
+	KITCHEN_ORDER_SORT_BOARD           = "board"
+	KITCHEN_ORDER_SORT_CREATED_AT      = "created_at"
+	KITCHEN_ORDER_SORT_CREATED_AT_DESC = "-created_at"
+
+	KITCHEN_ORDER_DEFAULT_PAGE_LIMIT = 50
+	KITCHEN_ORDER_MAX_PAGE_LIMIT     = 200
+
+	KITCHEN_ORDER_BULK_MAX_ITEMS  = 200
+	KITCHEN_ORDER_BULK_BATCH_SIZE = 10
+
+	KITCHEN_ORDER_EXPORT_FORMAT_CSV    = "csv"
+	KITCHEN_ORDER_EXPORT_FORMAT_NDJSON = "ndjson"
+	KITCHEN_ORDER_EXPORT_BATCH_SIZE    = 500
+
+	KITCHEN_TICKET_FORMAT_TEXT   = "text"
+	KITCHEN_TICKET_FORMAT_ESCPOS = "escpos"
+	KITCHEN_TICKET_MIN_WIDTH     = 24
+	KITCHEN_TICKET_MAX_WIDTH     = 64
+
+	PRINTER_DEFAULT_PORT       = 9100
+	PRINTER_NAME_MAX_LENGTH    = 100
+	PRINTER_STATION_MAX_LENGTH = 50
+	PRINT_STATION_DEFAULT      = "kitchen"
+	PRINT_STATION_EXPO         = "expo"
+
+	PRINT_JOB_STATUS_PENDING  = "pending"
+	PRINT_JOB_STATUS_PRINTING = "printing"
+	PRINT_JOB_STATUS_PRINTED  = "printed"
+	PRINT_JOB_STATUS_FAILED   = "failed"
+
+	PRINT_JOB_DEFAULT_LIMIT = 50
+	PRINT_JOB_MAX_LIMIT     = 200
+	PRINT_JOB_CLAIM_BATCH   = 20
+
+	REPORT_GROUP_BY_HOUR = "hour"
+	REPORT_GROUP_BY_DAY  = "day"
+
+	REPORT_MAX_HOURLY_RANGE_DAYS = 31
+	REPORT_MAX_DAILY_RANGE_DAYS  = 366
+
+	REPORT_SLOWEST_PRODUCTS_DEFAULT_LIMIT = 10
+	REPORT_SLOWEST_PRODUCTS_MAX_LIMIT     = 50
+
 	PIX_PAYMENT_METHOD = "pix"
 
 	PAYMENT_STATUS_PENDING = "pending"
@@ -3,16 +3,24 @@ package env
 import (
 	"log"
 	"os"
+	"strconv"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/joho/godotenv"
 )
 
 type Config struct {
-	GoEnv        string
-	APIPort      string
-	APIHost      string
-	APIUrl       string
+	GoEnv           string
+	APIPort         string
+	APIHost         string
+	APIUrl          string
+	ShutdownTimeout time.Duration
+	// Proxies (CIDRs do ALB) autorizados a informar o IP do cliente em X-Forwarded-For; vazio ignora o cabeçalho
+	TrustedProxies []string
+	// Cabeçalho de IP definido pela plataforma (ex.: CF-Connecting-IP); tem precedência sobre os proxies
+	TrustedPlatform string
 	Database     struct {
 		RunMigrations bool
 		Host          string
@@ -20,6 +28,10 @@ type Config struct {
 		Port          string
 		Username      string
 		Password      string
+		// Limites por operação; uma query travada não segura a conexão do pool indefinidamente
+		ReadTimeout  time.Duration
+		WriteTimeout time.Duration
+		BatchTimeout time.Duration
 	}
 	PaymentGateway struct {
 		AccessToken   string
@@ -30,6 +42,11 @@ type Config struct {
 	AWS struct {
 		Region      string
 		EndpointURL string
+		S3          struct {
+			BucketName        string
+			Endpoint          string
+			PresignExpiration time.Duration
+		}
 	}
 	MessageBroker struct {
 		Type string
@@ -41,7 +58,96 @@ type Config struct {
 			KitchenOrderFinishedTopicARN string
 			OrderErrorTopicARN           string
 		}
+		ClaimCheck struct {
+			Enabled   bool
+			Threshold int
+		}
+	}
+	Webhook struct {
+		Timeout              time.Duration
+		MaxAttempts          int
+		RetryBaseDelay       time.Duration
+		DisableAfterFailures int
+		PollInterval         time.Duration
+		Lease                time.Duration
+		AllowPrivateNetworks bool
+	}
+	Stream struct {
+		PollInterval      time.Duration
+		HeartbeatInterval time.Duration
+		Retention         time.Duration
+	}
+	Report struct {
+		// Os relatórios de etapas leem o histórico de eventos; ele precisa durar mais que o replay do stream
+		EventRetention time.Duration
+	}
+	KitchenDisplay struct {
+		AllowedOrigins []string
+	}
+	DisplayBoard struct {
+		ReadyTTL   time.Duration
+		MaxStreams int
+	}
+	Idempotency struct {
+		KeyTTL time.Duration
+	}
+	Auth struct {
+		JWKSUrl       string
+		PublicKeyFile string
+		Issuer        string
+		Audience      string
+		RolesClaim    string
+		JWKSRefresh   time.Duration
+		JWKSTimeout   time.Duration
+		ClockSkew     time.Duration
+	}
+	APIKey struct {
+		LastUsedInterval time.Duration
+	}
+	Ticket struct {
+		Width    int
+		Timezone string
+		QRCode   bool
+	}
+	Printing struct {
+		Enabled        bool
+		PollInterval   time.Duration
+		MaxAttempts    int
+		RetryBaseDelay time.Duration
+		Lease          time.Duration
+		ConnectTimeout time.Duration
+		WriteTimeout   time.Duration
+	}
+	RateLimit struct {
+		Enabled       bool
+		Store         string
+		PerIP         RateLimitPolicy
+		KitchenOrders RateLimitPolicy
+		Webhooks      RateLimitPolicy
+		APIKeys       RateLimitPolicy
+		DisplayBoard  RateLimitPolicy
+		Printers      RateLimitPolicy
+		Reports       RateLimitPolicy
+	}
+	Metrics struct {
+		Enabled       bool
+		Token         string
+		BoardCacheTTL time.Duration
+	}
+	Log struct {
+		Level string
+		JSON  bool
 	}
+	Health struct {
+		CheckTimeout   time.Duration
+		PollStaleAfter time.Duration
+	}
+}
+
+// RateLimitPolicy define o limite de um grupo de rotas; o burst absorve picos curtos acima da taxa média
+type RateLimitPolicy struct {
+	RequestsPerMinute int
+	Burst             int
 }
 
 var (
@@ -57,6 +163,7 @@ func GetConfig() *Config {
 	return instance
 }
 
+// Os erros de configuração usam o pacote log porque o logger estruturado depende da configuração carregada
 func getEnv(key string) string {
 	value := os.Getenv(key)
 	if value == "" {
@@ -65,6 +172,49 @@ func getEnv(key string) string {
 	return value
 }
 
+func getEnvInt(key string, defaultValue int) int {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+
+	parsed, err := strconv.Atoi(value)
+	if err != nil {
+		log.Fatalf("Environment variable %s must be an integer: %v", key, err)
+	}
+	return parsed
+}
+
+func getEnvDuration(key string, defaultValue time.Duration) time.Duration {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+
+	parsed, err := time.ParseDuration(value)
+	if err != nil {
+		log.Fatalf("Environment variable %s must be a duration: %v", key, err)
+	}
+	return parsed
+}
+
+func getEnvList(key string) []string {
+	var values []string
+	for _, value := range strings.Split(os.Getenv(key), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+func getEnvRateLimitPolicy(prefix string, defaultPerMinute, defaultBurst int) RateLimitPolicy {
+	return RateLimitPolicy{
+		RequestsPerMinute: getEnvInt(prefix+"_PER_MINUTE", defaultPerMinute),
+		Burst:             getEnvInt(prefix+"_BURST", defaultBurst),
+	}
+}
+
 func (c *Config) Load() {
 	dotEnvPath := ".env"
 	_, err := os.Stat(dotEnvPath)
@@ -81,6 +231,9 @@ func (c *Config) Load() {
 	c.APIPort = getEnv("API_PORT")
 	c.APIHost = getEnv("API_HOST")
 	c.APIUrl = c.APIHost + ":" + c.APIPort
+	c.ShutdownTimeout = getEnvDuration("API_SHUTDOWN_TIMEOUT", 25*time.Second)
+	c.TrustedProxies = getEnvList("API_TRUSTED_PROXIES")
+	c.TrustedPlatform = os.Getenv("API_TRUSTED_PLATFORM")
 
 	c.Database.RunMigrations = getEnv("DB_RUN_MIGRATIONS") == "true"
 	c.Database.Host = getEnv("DB_HOST")
@@ -88,9 +241,16 @@ func (c *Config) Load() {
 	c.Database.Port = getEnv("DB_PORT")
 	c.Database.Username = getEnv("DB_USERNAME")
 	c.Database.Password = getEnv("DB_PASSWORD")
+	c.Database.ReadTimeout = getEnvDuration("DB_READ_TIMEOUT", 5*time.Second)
+	c.Database.WriteTimeout = getEnvDuration("DB_WRITE_TIMEOUT", 10*time.Second)
+	// Vale para cada lote de uma exportação, não para a exportação inteira
+	c.Database.BatchTimeout = getEnvDuration("DB_BATCH_TIMEOUT", 30*time.Second)
 
 	c.AWS.Region = getEnv("AWS_REGION")
 	c.AWS.EndpointURL = os.Getenv("AWS_ENDPOINT_URL")
+	c.AWS.S3.BucketName = os.Getenv("AWS_S3_BUCKET_NAME")
+	c.AWS.S3.Endpoint = os.Getenv("AWS_S3_ENDPOINT")
+	c.AWS.S3.PresignExpiration = getEnvDuration("AWS_S3_PRESIGN_EXPIRATION", 5*time.Minute)
 	
 	c.MessageBroker.Type = getEnv("MESSAGE_BROKER_TYPE")
 
@@ -104,6 +264,97 @@ func (c *Config) Load() {
 		c.MessageBroker.SNS.KitchenOrderFinishedTopicARN = os.Getenv("AWS_SNS_KITCHEN_ORDER_FINISHED_TOPIC_ARN")
 		c.MessageBroker.SNS.OrderErrorTopicARN = os.Getenv("AWS_SNS_ORDER_ERROR_TOPIC_ARN")
 	}
+
+	c.MessageBroker.ClaimCheck.Enabled = os.Getenv("MESSAGE_BROKER_CLAIM_CHECK_ENABLED") == "true"
+	c.MessageBroker.ClaimCheck.Threshold = getEnvInt("MESSAGE_BROKER_CLAIM_CHECK_THRESHOLD", 200*1024)
+
+	c.Webhook.Timeout = getEnvDuration("WEBHOOK_TIMEOUT", 5*time.Second)
+	c.Webhook.MaxAttempts = getEnvInt("WEBHOOK_MAX_ATTEMPTS", 5)
+	c.Webhook.RetryBaseDelay = getEnvDuration("WEBHOOK_RETRY_BASE_DELAY", 2*time.Second)
+	c.Webhook.DisableAfterFailures = getEnvInt("WEBHOOK_DISABLE_AFTER_FAILURES", 10)
+	c.Webhook.PollInterval = getEnvDuration("WEBHOOK_POLL_INTERVAL", time.Second)
+	c.Webhook.Lease = getEnvDuration("WEBHOOK_LEASE", 30*time.Second)
+	c.Webhook.AllowPrivateNetworks = os.Getenv("WEBHOOK_ALLOW_PRIVATE_NETWORKS") == "true"
+
+	c.Stream.PollInterval = getEnvDuration("STREAM_POLL_INTERVAL", time.Second)
+	c.Stream.HeartbeatInterval = getEnvDuration("STREAM_HEARTBEAT_INTERVAL", 15*time.Second)
+	c.Stream.Retention = getEnvDuration("STREAM_EVENT_RETENTION", 24*time.Hour)
+	c.Report.EventRetention = getEnvDuration("REPORT_EVENT_RETENTION", 90*24*time.Hour)
+
+	// Origens de navegador aceitas no WebSocket do KDS; a própria origem da API é sempre aceita
+	c.KitchenDisplay.AllowedOrigins = getEnvList("KITCHEN_DISPLAY_ALLOWED_ORIGINS")
+
+	c.DisplayBoard.ReadyTTL = getEnvDuration("DISPLAY_BOARD_READY_TTL", 10*time.Minute)
+	// A rota é pública; o limite protege a instância de um número ilimitado de conexões abertas
+	c.DisplayBoard.MaxStreams = getEnvInt("DISPLAY_BOARD_MAX_STREAMS", 500)
+
+	c.Idempotency.KeyTTL = getEnvDuration("IDEMPOTENCY_KEY_TTL", 24*time.Hour)
+
+	c.Auth.JWKSUrl = os.Getenv("AUTH_JWKS_URL")
+	c.Auth.PublicKeyFile = os.Getenv("AUTH_PUBLIC_KEY_FILE")
+	c.Auth.Issuer = os.Getenv("AUTH_ISSUER")
+	c.Auth.Audience = os.Getenv("AUTH_AUDIENCE")
+	c.Auth.RolesClaim = os.Getenv("AUTH_ROLES_CLAIM")
+	if c.Auth.RolesClaim == "" {
+		c.Auth.RolesClaim = "roles"
+	}
+	c.Auth.JWKSRefresh = getEnvDuration("AUTH_JWKS_REFRESH_INTERVAL", 10*time.Minute)
+	c.Auth.JWKSTimeout = getEnvDuration("AUTH_JWKS_TIMEOUT", 5*time.Second)
+	c.Auth.ClockSkew = getEnvDuration("AUTH_CLOCK_SKEW", 30*time.Second)
+
+	c.APIKey.LastUsedInterval = getEnvDuration("API_KEY_LAST_USED_INTERVAL", time.Minute)
+
+	c.Ticket.Width = getEnvInt("TICKET_WIDTH", 48)
+	c.Ticket.Timezone = os.Getenv("TICKET_TIMEZONE")
+	if c.Ticket.Timezone == "" {
+		c.Ticket.Timezone = "America/Sao_Paulo"
+	}
+	c.Ticket.QRCode = os.Getenv("TICKET_QR_CODE") != "false"
+
+	c.Printing.Enabled = os.Getenv("PRINTING_ENABLED") != "false"
+	c.Printing.PollInterval = getEnvDuration("PRINTING_POLL_INTERVAL", time.Second)
+	c.Printing.MaxAttempts = getEnvInt("PRINTING_MAX_ATTEMPTS", 5)
+	c.Printing.RetryBaseDelay = getEnvDuration("PRINTING_RETRY_BASE_DELAY", 2*time.Second)
+	c.Printing.Lease = getEnvDuration("PRINTING_LEASE", 30*time.Second)
+	c.Printing.ConnectTimeout = getEnvDuration("PRINTING_CONNECT_TIMEOUT", 3*time.Second)
+	c.Printing.WriteTimeout = getEnvDuration("PRINTING_WRITE_TIMEOUT", 5*time.Second)
+
+	c.RateLimit.Enabled = os.Getenv("RATE_LIMIT_ENABLED") != "false"
+	c.RateLimit.Store = os.Getenv("RATE_LIMIT_STORE")
+	if c.RateLimit.Store == "" {
+		c.RateLimit.Store = "postgres"
+	}
+	// Limite por IP aplicado antes da autenticação, para que credenciais inválidas também sejam contidas
+	c.RateLimit.PerIP = getEnvRateLimitPolicy("RATE_LIMIT_PER_IP", 1200, 200)
+	c.RateLimit.KitchenOrders = getEnvRateLimitPolicy("RATE_LIMIT_KITCHEN_ORDERS", 600, 100)
+	c.RateLimit.Webhooks = getEnvRateLimitPolicy("RATE_LIMIT_WEBHOOKS", 60, 20)
+	c.RateLimit.APIKeys = getEnvRateLimitPolicy("RATE_LIMIT_API_KEYS", 30, 10)
+	c.RateLimit.DisplayBoard = getEnvRateLimitPolicy("RATE_LIMIT_DISPLAY_BOARD", 240, 60)
+	c.RateLimit.Printers = getEnvRateLimitPolicy("RATE_LIMIT_PRINTERS", 60, 20)
+	// Os relatórios agregam o período inteiro no banco, então o limite é mais baixo
+	c.RateLimit.Reports = getEnvRateLimitPolicy("RATE_LIMIT_REPORTS", 30, 5)
+
+	c.Metrics.Enabled = os.Getenv("METRICS_ENABLED") != "false"
+	c.Metrics.Token = os.Getenv("METRICS_TOKEN")
+	c.Metrics.BoardCacheTTL = getEnvDuration("METRICS_BOARD_CACHE_TTL", 15*time.Second)
+
+	c.Log.Level = os.Getenv("LOG_LEVEL")
+	if c.Log.Level == "" {
+		c.Log.Level = "info"
+	}
+	// Sem LOG_FORMAT, produção usa JSON para o coletor de logs e os demais ambientes usam texto
+	switch os.Getenv("LOG_FORMAT") {
+	case "json":
+		c.Log.JSON = true
+	case "text":
+		c.Log.JSON = false
+	default:
+		c.Log.JSON = c.IsProduction()
+	}
+
+	c.Health.CheckTimeout = getEnvDuration("HEALTH_CHECK_TIMEOUT", 2*time.Second)
+	// O long polling do SQS espera até 20s por resposta; o limite cobre algumas voltas antes de tirar a instância do balanceador
+	c.Health.PollStaleAfter = getEnvDuration("HEALTH_POLL_STALE_AFTER", 2*time.Minute)
 }
 
 func (c *Config) IsProduction() bool {
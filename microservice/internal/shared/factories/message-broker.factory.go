@@ -4,7 +4,10 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+
 	"tech_challenge/internal/shared/config/env"
+	file_service "tech_challenge/internal/shared/infra/file_provider"
 	"tech_challenge/internal/shared/infra/messaging/sqs"
 	"tech_challenge/internal/shared/interfaces"
 )
@@ -22,16 +25,45 @@ func NewMessageBroker(ctx context.Context) (interfaces.MessageBroker, error) {
 	switch brokerType {
 	case MessageBrokerSQS:
 		broker := sqs.NewSQSBroker(sqs.SQSConfig{
-			Region:      config.AWS.Region,
-			QueueURL:    config.MessageBroker.SQS.QueueURL,
-			EndpointURL: config.AWS.EndpointURL,
+			Region:              config.AWS.Region,
+			QueueURL:            config.MessageBroker.SQS.QueueURL,
+			EndpointURL:         config.AWS.EndpointURL,
+			ClaimCheckThreshold: config.MessageBroker.ClaimCheck.Threshold,
+			DrainTimeout:        config.ShutdownTimeout,
 		})
 		if err := broker.Connect(ctx); err != nil {
 			return nil, fmt.Errorf("failed to connect to SQS: %w", err)
 		}
+		if config.MessageBroker.ClaimCheck.Enabled {
+			// O S3 reaproveita a configuração do SQS: mesma região, credenciais e política de retry
+			fileProvider, err := newClaimCheckFileProvider(broker.AWSConfig(), config)
+			if err != nil {
+				return nil, err
+			}
+			broker.SetFileProvider(fileProvider)
+		}
 		return broker, nil
 
 	default:
 		return nil, fmt.Errorf("unsupported message broker type: %s", brokerType)
 	}
 }
+
+// newClaimCheckFileProvider exige um storage compartilhado: o LocalFileProvider grava em disco local,
+// inacessível para as demais réplicas e para os consumidores da fila, e os payloads grandes seriam perdidos
+func newClaimCheckFileProvider(awsConfig aws.Config, config *env.Config) (*file_service.S3FileProvider, error) {
+	if config.AWS.S3.BucketName == "" {
+		return nil, fmt.Errorf("claim-check requires AWS_S3_BUCKET_NAME: the local file provider is not shared with the queue consumers")
+	}
+
+	fileProvider, err := file_service.NewS3FileProvider(awsConfig, file_service.S3FileProviderConfig{
+		Bucket:            config.AWS.S3.BucketName,
+		Endpoint:          config.AWS.S3.Endpoint,
+		PresignExpiration: config.AWS.S3.PresignExpiration,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create claim-check file provider: %w", err)
+	}
+
+	return fileProvider, nil
+}
@@ -196,3 +196,29 @@ func TestNewMessageBroker_UnsupportedType(t *testing.T) {
 	
 	t.Log("Teste executado (resultado pode variar dependendo do ambiente)")
 }
+
+func TestNewMessageBroker_ClaimCheckRequiresSharedStorage(t *testing.T) {
+	if os.Getenv("TEST_CLAIM_CHECK_WITHOUT_BUCKET") == "1" {
+		setupTestEnv(t)
+		os.Setenv("MESSAGE_BROKER_CLAIM_CHECK_ENABLED", "true")
+		os.Unsetenv("AWS_S3_BUCKET_NAME")
+
+		_, err := NewMessageBroker(context.Background())
+
+		if err != nil && strings.Contains(err.Error(), "claim-check requires AWS_S3_BUCKET_NAME") {
+			os.Exit(44)
+		}
+		os.Exit(0)
+	}
+
+	cmd := exec.Command(os.Args[0], "-test.run=TestNewMessageBroker_ClaimCheckRequiresSharedStorage")
+	cmd.Env = append(os.Environ(), "TEST_CLAIM_CHECK_WITHOUT_BUCKET=1")
+	err := cmd.Run()
+
+	if e, ok := err.(*exec.ExitError); ok && e.ExitCode() == 44 {
+		t.Log("✓ Claim-check recusado sem storage compartilhado")
+		return
+	}
+
+	t.Errorf("Expected NewMessageBroker to refuse claim-check without a shared bucket, got: %v", err)
+}
@@ -0,0 +1,36 @@
+package factories
+
+import (
+	"fmt"
+
+	"tech_challenge/internal/shared/config/env"
+	"tech_challenge/internal/shared/infra/database"
+	"tech_challenge/internal/shared/infra/ratelimit"
+)
+
+func NewRateLimiter() (ratelimit.Limiter, error) {
+	config := env.GetConfig()
+
+	switch config.RateLimit.Store {
+	case "memory":
+		return ratelimit.NewMemoryLimiter(), nil
+
+	case "postgres":
+		return ratelimit.NewFallbackLimiter(ratelimit.NewPostgresLimiter(database.GetDB()), ratelimit.NewMemoryLimiter()), nil
+
+	default:
+		return nil, fmt.Errorf("unsupported rate limit store: %s", config.RateLimit.Store)
+	}
+}
+
+// NewRateLimitPolicy devolve uma política desabilitada quando o rate limiting está desligado
+func NewRateLimitPolicy(policy env.RateLimitPolicy) ratelimit.Policy {
+	if !env.GetConfig().RateLimit.Enabled {
+		return ratelimit.Policy{}
+	}
+
+	return ratelimit.Policy{
+		RequestsPerMinute: policy.RequestsPerMinute,
+		Burst:             policy.Burst,
+	}
+}
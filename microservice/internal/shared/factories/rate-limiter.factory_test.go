@@ -0,0 +1,57 @@
+package factories
+
+import (
+	"testing"
+
+	"tech_challenge/internal/shared/config/env"
+	"tech_challenge/internal/shared/infra/ratelimit"
+)
+
+func TestNewRateLimiter(t *testing.T) {
+	setupTestEnv(t)
+	config := env.GetConfig()
+	original := config.RateLimit
+	defer func() { config.RateLimit = original }()
+
+	config.RateLimit.Store = "memory"
+	if limiter, err := NewRateLimiter(); err != nil {
+		t.Errorf("Expected memory limiter, got %v", err)
+	} else if _, ok := limiter.(*ratelimit.MemoryLimiter); !ok {
+		t.Errorf("Expected *ratelimit.MemoryLimiter, got %T", limiter)
+	}
+
+	config.RateLimit.Store = "postgres"
+	if limiter, err := NewRateLimiter(); err != nil {
+		t.Errorf("Expected postgres limiter, got %v", err)
+	} else if _, ok := limiter.(*ratelimit.FallbackLimiter); !ok {
+		t.Errorf("Expected *ratelimit.FallbackLimiter, got %T", limiter)
+	}
+
+	config.RateLimit.Store = "redis"
+	if _, err := NewRateLimiter(); err == nil {
+		t.Error("Expected error for unsupported store")
+	} else {
+		t.Log("✓ Limitador criado conforme RATE_LIMIT_STORE")
+	}
+}
+
+func TestNewRateLimitPolicy(t *testing.T) {
+	setupTestEnv(t)
+	config := env.GetConfig()
+	original := config.RateLimit
+	defer func() { config.RateLimit = original }()
+
+	policy := env.RateLimitPolicy{RequestsPerMinute: 60, Burst: 10}
+
+	config.RateLimit.Enabled = true
+	if got := NewRateLimitPolicy(policy); got.RequestsPerMinute != 60 || got.Burst != 10 {
+		t.Errorf("Expected configured policy, got %+v", got)
+	}
+
+	config.RateLimit.Enabled = false
+	if got := NewRateLimitPolicy(policy); !got.Disabled() {
+		t.Errorf("Expected disabled policy, got %+v", got)
+	} else {
+		t.Log("✓ Política desabilitada quando RATE_LIMIT_ENABLED=false")
+	}
+}
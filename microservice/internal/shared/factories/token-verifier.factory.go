@@ -0,0 +1,37 @@
+package factories
+
+import (
+	"errors"
+	"fmt"
+
+	"tech_challenge/internal/shared/config/env"
+	"tech_challenge/internal/shared/infra/auth"
+)
+
+func NewTokenVerifier() (auth.TokenVerifier, error) {
+	config := env.GetConfig()
+
+	var keys auth.KeySource
+
+	switch {
+	case config.Auth.JWKSUrl != "":
+		keys = auth.NewJWKSKeySource(config.Auth.JWKSUrl, config.Auth.JWKSRefresh, config.Auth.JWKSTimeout)
+
+	case config.Auth.PublicKeyFile != "":
+		source, err := auth.NewStaticKeySourceFromFile(config.Auth.PublicKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load auth public key: %w", err)
+		}
+		keys = source
+
+	default:
+		return nil, errors.New("AUTH_JWKS_URL or AUTH_PUBLIC_KEY_FILE must be set")
+	}
+
+	return auth.NewJWTVerifier(keys, auth.JWTVerifierConfig{
+		Issuer:     config.Auth.Issuer,
+		Audience:   config.Auth.Audience,
+		RolesClaim: config.Auth.RolesClaim,
+		ClockSkew:  config.Auth.ClockSkew,
+	}), nil
+}
@@ -0,0 +1,34 @@
+package factories
+
+import (
+	"strings"
+	"testing"
+
+	"tech_challenge/internal/shared/config/env"
+)
+
+func TestNewTokenVerifier(t *testing.T) {
+	setupTestEnv(t)
+	config := env.GetConfig()
+	original := config.Auth
+	defer func() { config.Auth = original }()
+
+	config.Auth.JWKSUrl = ""
+	config.Auth.PublicKeyFile = ""
+	if _, err := NewTokenVerifier(); err == nil || !strings.Contains(err.Error(), "AUTH_JWKS_URL") {
+		t.Errorf("Expected missing key source error, got %v", err)
+	}
+
+	config.Auth.PublicKeyFile = "/nonexistent/public.pem"
+	if _, err := NewTokenVerifier(); err == nil {
+		t.Error("Expected error for missing public key file")
+	}
+
+	config.Auth.JWKSUrl = "https://auth.example.com/.well-known/jwks.json"
+	verifier, err := NewTokenVerifier()
+	if err != nil || verifier == nil {
+		t.Errorf("Expected JWKS verifier, got %v", err)
+	} else {
+		t.Log("✓ Verificador de tokens criado a partir do JWKS")
+	}
+}
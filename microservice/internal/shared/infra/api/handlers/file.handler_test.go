@@ -18,6 +18,14 @@ func (m *MockFileProvider) UploadFile(fileName string, fileContent []byte) error
 	return args.Error(0)
 }
 
+func (m *MockFileProvider) DownloadFile(fileName string) ([]byte, error) {
+	args := m.Called(fileName)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]byte), args.Error(1)
+}
+
 func (m *MockFileProvider) DeleteFile(fileName string) error {
 	args := m.Called(fileName)
 	return args.Error(0)
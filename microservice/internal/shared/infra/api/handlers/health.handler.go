@@ -2,17 +2,33 @@ package handlers
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
+
+	"tech_challenge/internal/shared/infra/health"
+	"tech_challenge/internal/shared/interfaces"
 )
 
-type HealthHandler struct{}
+const serviceName = "kitchen-order-microservice"
+
+type HealthHandler struct {
+	checkers  []interfaces.IHealthChecker
+	timeout   time.Duration
+	startedAt time.Time
+}
 
-func NewHealthHandler() *HealthHandler {
-	return &HealthHandler{}
+// NewHealthHandler recebe os checkers da readiness; cada um é limitado pelo timeout
+func NewHealthHandler(timeout time.Duration, checkers ...interfaces.IHealthChecker) *HealthHandler {
+	return &HealthHandler{
+		checkers:  checkers,
+		timeout:   timeout,
+		startedAt: time.Now(),
+	}
 }
 
 // @Summary Health check endpoint
+// @Description Kept for compatibility; prefer /health/live and /health/ready.
 // @Tags Health
 // @Produce json
 // @Success 200 {object} map[string]string
@@ -20,6 +36,39 @@ func NewHealthHandler() *HealthHandler {
 func (h *HealthHandler) Health(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, gin.H{
 		"status":  "healthy",
-		"service": "kitchen-order-microservice",
+		"service": serviceName,
+	})
+}
+
+// @Summary Liveness probe
+// @Description Answers while the process is able to serve requests. Dependencies are not checked, so a database outage does not restart the container.
+// @Tags Health
+// @Produce json
+// @Success 200 {object} map[string]any
+// @Router /health/live [get]
+func (h *HealthHandler) Live(ctx *gin.Context) {
+	ctx.JSON(http.StatusOK, gin.H{
+		"status":         health.StatusUp,
+		"service":        serviceName,
+		"uptime_seconds": int64(time.Since(h.startedAt).Seconds()),
 	})
-}
\ No newline at end of file
+}
+
+// @Summary Readiness probe
+// @Description Checks the database, the message broker, the last successful poll of each subscription and the migration state.
+// @Description Answers 503 with the per-component report when any of them is down.
+// @Tags Health
+// @Produce json
+// @Success 200 {object} health.Report
+// @Failure 503 {object} health.Report
+// @Router /health/ready [get]
+func (h *HealthHandler) Ready(ctx *gin.Context) {
+	report := health.Run(ctx.Request.Context(), h.timeout, h.checkers)
+
+	status := http.StatusOK
+	if !report.Healthy() {
+		status = http.StatusServiceUnavailable
+	}
+
+	ctx.JSON(status, report)
+}
@@ -1,19 +1,25 @@
 package handlers
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"tech_challenge/internal/shared/infra/health"
 )
 
 func TestNewHealthHandler(t *testing.T) {
-	handler := NewHealthHandler()
+	handler := NewHealthHandler(time.Second)
 
 	if handler == nil {
-		t.Error("NewHealthHandler() returned nil")
+		t.Error("NewHealthHandler(time.Second) returned nil")
 	}
 }
 
@@ -21,7 +27,7 @@ func TestHealthHandler_Health(t *testing.T) {
 	w := httptest.NewRecorder()
 	_, router := gin.CreateTestContext(w)
 
-	handler := NewHealthHandler()
+	handler := NewHealthHandler(time.Second)
 	router.GET("/health", handler.Health)
 
 	req := httptest.NewRequest("GET", "/health", nil)
@@ -42,4 +48,70 @@ func TestHealthHandler_Health(t *testing.T) {
 	if response["service"] != "kitchen-order-microservice" {
 		t.Errorf("Health() service = %v, want kitchen-order-microservice", response["service"])
 	}
-}
\ No newline at end of file
+}
+
+func TestHealthHandler_Live(t *testing.T) {
+	w := httptest.NewRecorder()
+	_, router := gin.CreateTestContext(w)
+
+	failing := health.NewCheck("database", func(ctx context.Context) (map[string]any, error) {
+		return nil, errors.New("connection refused")
+	})
+	handler := NewHealthHandler(time.Second, failing)
+	router.GET("/health/live", handler.Live)
+
+	router.ServeHTTP(w, httptest.NewRequest("GET", "/health/live", nil))
+
+	// A liveness não consulta dependências: o banco fora não deve reiniciar o container
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response map[string]any
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, health.StatusUp, response["status"])
+	assert.Contains(t, response, "uptime_seconds")
+	t.Log("✓ Liveness responde 200 sem consultar as dependências")
+}
+
+func TestHealthHandler_Ready(t *testing.T) {
+	up := health.NewCheck("database", func(ctx context.Context) (map[string]any, error) {
+		return map[string]any{"open_connections": 1}, nil
+	})
+	down := health.NewCheck("message_broker", func(ctx context.Context) (map[string]any, error) {
+		return nil, errors.New("queue does not exist")
+	})
+
+	t.Run("all components up", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		_, router := gin.CreateTestContext(w)
+		router.GET("/health/ready", NewHealthHandler(time.Second, up).Ready)
+
+		router.ServeHTTP(w, httptest.NewRequest("GET", "/health/ready", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var report health.Report
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &report))
+		assert.Equal(t, health.StatusUp, report.Status)
+		assert.Equal(t, health.StatusUp, report.Components["database"].Status)
+		assert.Equal(t, float64(1), report.Components["database"].Details["open_connections"])
+		t.Log("✓ Readiness responde 200 com os componentes no ar")
+	})
+
+	t.Run("component down", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		_, router := gin.CreateTestContext(w)
+		router.GET("/health/ready", NewHealthHandler(time.Second, up, down).Ready)
+
+		router.ServeHTTP(w, httptest.NewRequest("GET", "/health/ready", nil))
+
+		assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+
+		var report health.Report
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &report))
+		assert.Equal(t, health.StatusDown, report.Status)
+		assert.Equal(t, health.StatusUp, report.Components["database"].Status)
+		assert.Equal(t, health.StatusDown, report.Components["message_broker"].Status)
+		assert.Equal(t, "queue does not exist", report.Components["message_broker"].Error)
+		t.Log("✓ Readiness responde 503 indicando o componente fora")
+	})
+}
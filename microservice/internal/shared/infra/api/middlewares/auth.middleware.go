@@ -0,0 +1,131 @@
+package middlewares
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"tech_challenge/internal/domain/exceptions"
+	"tech_challenge/internal/shared/config/constants"
+	"tech_challenge/internal/shared/infra/auth"
+	"tech_challenge/internal/shared/pkg/logger"
+)
+
+const accessTokenQueryParam = "access_token"
+
+// AuthenticationMiddleware exige um JWT válido de usuário ou uma API key de serviço e guarda o ator no contexto da requisição
+func AuthenticationMiddleware(verifier auth.TokenVerifier, apiKeys auth.APIKeyVerifier) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		principal, ok := authenticate(ctx, verifier, apiKeys)
+		if !ok {
+			return
+		}
+
+		ctx.Set(constants.AUTH_PRINCIPAL_CONTEXT_KEY, principal)
+		ctx.Next()
+	}
+}
+
+// authenticate dá preferência ao token de usuário quando a requisição traz as duas credenciais
+func authenticate(ctx *gin.Context, verifier auth.TokenVerifier, apiKeys auth.APIKeyVerifier) (auth.Principal, bool) {
+	if token := bearerToken(ctx); token != "" {
+		principal, err := verifier.Verify(ctx.Request.Context(), token)
+		if err != nil {
+			logger.FromContext(ctx).Info("Rejected access token", logger.ErrorKey, err)
+			abortUnauthorized(ctx, &exceptions.UnauthorizedException{Message: "Invalid or expired access token"})
+			return auth.Principal{}, false
+		}
+
+		return principal, true
+	}
+
+	if key := strings.TrimSpace(ctx.GetHeader(constants.API_KEY_HEADER)); key != "" {
+		principal, err := apiKeys.VerifyAPIKey(ctx.Request.Context(), key)
+
+		var unauthorized *exceptions.UnauthorizedException
+		if errors.As(err, &unauthorized) {
+			logger.FromContext(ctx).Info("Rejected API key", logger.ErrorKey, err)
+			abortUnauthorized(ctx, &exceptions.UnauthorizedException{Message: "Invalid or revoked API key"})
+			return auth.Principal{}, false
+		}
+
+		// Falha ao consultar as chaves é registrada e respondida como erro interno pelo ErrorHandlerMiddleware
+		if err != nil {
+			abortWithError(ctx, err)
+			return auth.Principal{}, false
+		}
+
+		return principal, true
+	}
+
+	abortUnauthorized(ctx, &exceptions.UnauthorizedException{})
+	return auth.Principal{}, false
+}
+
+// RequireRoles libera a rota apenas para atores com ao menos um dos papéis
+func RequireRoles(roles ...string) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		principal, ok := auth.PrincipalFromContext(ctx)
+		if !ok {
+			abortUnauthorized(ctx, &exceptions.UnauthorizedException{})
+			return
+		}
+
+		if !principal.HasAnyRole(roles...) {
+			abortWithError(ctx, &exceptions.ForbiddenException{})
+			return
+		}
+
+		ctx.Next()
+	}
+}
+
+// RequirePermission libera a rota para usuários com um dos papéis ou para API keys com o escopo da permissão
+func RequirePermission(permission auth.Permission) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		principal, ok := auth.PrincipalFromContext(ctx)
+		if !ok {
+			abortUnauthorized(ctx, &exceptions.UnauthorizedException{})
+			return
+		}
+
+		if !principal.Can(permission) {
+			abortWithError(ctx, &exceptions.ForbiddenException{})
+			return
+		}
+
+		ctx.Next()
+	}
+}
+
+func bearerToken(ctx *gin.Context) string {
+	scheme, token, found := strings.Cut(strings.TrimSpace(ctx.GetHeader("Authorization")), " ")
+	if found && strings.EqualFold(scheme, "Bearer") {
+		return strings.TrimSpace(token)
+	}
+
+	// EventSource e WebSocket do navegador não enviam cabeçalhos, então os streams aceitam o token na query
+	if ctx.Request.Method == http.MethodGet && isStreamingPath(ctx.Request.URL.Path) {
+		return strings.TrimSpace(ctx.Query(accessTokenQueryParam))
+	}
+
+	return ""
+}
+
+func isStreamingPath(path string) bool {
+	return strings.HasSuffix(path, "/stream") || strings.HasSuffix(path, "/ws")
+}
+
+func abortUnauthorized(ctx *gin.Context, err error) {
+	ctx.Header("WWW-Authenticate", `Bearer realm="kitchen-orders"`)
+	abortWithError(ctx, err)
+}
+
+func abortWithError(ctx *gin.Context, err error) {
+	if ctxErr := ctx.Error(err); ctxErr != nil {
+		logger.FromContext(ctx).Error("Error setting context error", logger.ErrorKey, ctxErr)
+	}
+	ctx.Abort()
+}
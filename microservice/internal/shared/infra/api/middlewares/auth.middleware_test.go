@@ -0,0 +1,197 @@
+package middlewares
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"tech_challenge/internal/domain/exceptions"
+	"tech_challenge/internal/shared/config/constants"
+	"tech_challenge/internal/shared/infra/auth"
+)
+
+// fakeTokenVerifier aceita apenas os tokens cadastrados no mapa
+type fakeTokenVerifier map[string]auth.Principal
+
+func (f fakeTokenVerifier) Verify(_ context.Context, token string) (auth.Principal, error) {
+	principal, ok := f[token]
+	if !ok {
+		return auth.Principal{}, errors.New("invalid token")
+	}
+	return principal, nil
+}
+
+// fakeAPIKeyVerifier aceita apenas as chaves cadastradas no mapa; a chave failingAPIKey simula o banco indisponível
+type fakeAPIKeyVerifier map[string]auth.Principal
+
+const failingAPIKey = "failing-key"
+
+func (f fakeAPIKeyVerifier) VerifyAPIKey(_ context.Context, key string) (auth.Principal, error) {
+	if key == failingAPIKey {
+		return auth.Principal{}, errors.New("database unavailable")
+	}
+
+	principal, ok := f[key]
+	if !ok {
+		return auth.Principal{}, &exceptions.UnauthorizedException{Message: "Invalid API key"}
+	}
+	return principal, nil
+}
+
+func setupAuthRouter() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	verifier := fakeTokenVerifier{
+		"cook-token":    {Subject: "cook-1", Roles: []string{constants.ROLE_COOK}},
+		"manager-token": {Subject: "manager-1", Roles: []string{constants.ROLE_MANAGER}},
+	}
+
+	apiKeys := fakeAPIKeyVerifier{
+		"orders-key":   {Subject: "api-key:orders-service", Scopes: []string{constants.API_KEY_SCOPE_KITCHEN_ORDERS_CREATE}},
+		"reporter-key": {Subject: "api-key:reporter", Scopes: []string{constants.API_KEY_SCOPE_KITCHEN_ORDERS_READ}},
+	}
+
+	router := gin.New()
+	router.Use(ErrorHandlerMiddleware())
+
+	group := router.Group("/kitchen-orders", AuthenticationMiddleware(verifier, apiKeys))
+	group.GET("/", RequireRoles(constants.ROLE_COOK, constants.ROLE_MANAGER), func(c *gin.Context) {
+		principal, _ := auth.PrincipalFromContext(c)
+		c.String(http.StatusOK, principal.Subject)
+	})
+	group.GET("/stream", RequireRoles(constants.ROLE_COOK), func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+	group.POST("/bulk-status", RequireRoles(constants.ROLE_MANAGER), func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+	group.POST("/", RequirePermission(auth.CreateKitchenOrders), func(c *gin.Context) {
+		principal, _ := auth.PrincipalFromContext(c)
+		c.String(http.StatusCreated, principal.Subject)
+	})
+
+	return router
+}
+
+func serveAuth(router *gin.Engine, method, path, authorization string) *httptest.ResponseRecorder {
+	return serveAuthWithAPIKey(router, method, path, authorization, "")
+}
+
+func serveAuthWithAPIKey(router *gin.Engine, method, path, authorization, apiKey string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, nil)
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+	if apiKey != "" {
+		req.Header.Set(constants.API_KEY_HEADER, apiKey)
+	}
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestAuthenticationMiddleware_ValidToken(t *testing.T) {
+	router := setupAuthRouter()
+
+	w := serveAuth(router, "GET", "/kitchen-orders/", "Bearer cook-token")
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "cook-1", w.Body.String())
+	t.Log("✓ Ator do token disponível para o handler")
+}
+
+func TestAuthenticationMiddleware_MissingOrInvalidToken(t *testing.T) {
+	router := setupAuthRouter()
+
+	for _, authorization := range []string{"", "Bearer unknown-token", "Basic Y29vazpzZWNyZXQ=", "Bearer"} {
+		w := serveAuth(router, "GET", "/kitchen-orders/", authorization)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code, "authorization %q", authorization)
+		assert.Contains(t, w.Header().Get("WWW-Authenticate"), "Bearer")
+		assert.Contains(t, w.Body.String(), `"code":"unauthorized"`)
+	}
+	t.Log("✓ Requisições sem token válido rejeitadas com 401")
+}
+
+func TestAuthenticationMiddleware_QueryTokenOnlyOnStreams(t *testing.T) {
+	router := setupAuthRouter()
+
+	assert.Equal(t, http.StatusOK, serveAuth(router, "GET", "/kitchen-orders/stream?access_token=cook-token", "").Code)
+	assert.Equal(t, http.StatusUnauthorized, serveAuth(router, "GET", "/kitchen-orders/?access_token=cook-token", "").Code)
+	assert.Equal(t, http.StatusUnauthorized, serveAuth(router, "POST", "/kitchen-orders/bulk-status?access_token=manager-token", "").Code)
+}
+
+func TestRequireRoles_Forbidden(t *testing.T) {
+	router := setupAuthRouter()
+
+	w := serveAuth(router, "POST", "/kitchen-orders/bulk-status", "Bearer cook-token")
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	assert.Contains(t, w.Body.String(), `"code":"forbidden"`)
+
+	w = serveAuth(router, "POST", "/kitchen-orders/bulk-status", "Bearer manager-token")
+	assert.Equal(t, http.StatusOK, w.Code)
+	t.Log("✓ Operações em massa restritas ao gerente")
+}
+
+func TestRequireRoles_WithoutAuthentication(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.Use(ErrorHandlerMiddleware())
+	router.GET("/test", RequireRoles(constants.ROLE_MANAGER), func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	assert.Equal(t, http.StatusUnauthorized, serveAuth(router, "GET", "/test", "").Code)
+}
+
+func TestAuthenticationMiddleware_APIKey(t *testing.T) {
+	router := setupAuthRouter()
+
+	w := serveAuthWithAPIKey(router, "POST", "/kitchen-orders/", "", "orders-key")
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.Equal(t, "api-key:orders-service", w.Body.String())
+
+	w = serveAuthWithAPIKey(router, "POST", "/kitchen-orders/", "", "unknown-key")
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	assert.Contains(t, w.Body.String(), "Invalid or revoked API key")
+	t.Log("✓ Serviços autenticados pela API key")
+}
+
+func TestAuthenticationMiddleware_APIKeyLookupFailure(t *testing.T) {
+	router := setupAuthRouter()
+
+	w := serveAuthWithAPIKey(router, "POST", "/kitchen-orders/", "", failingAPIKey)
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.Empty(t, w.Header().Get("WWW-Authenticate"))
+	assert.NotContains(t, w.Body.String(), "database unavailable")
+	t.Log("✓ Falha ao consultar a API key responde 500 em vez de 401")
+}
+
+func TestAuthenticationMiddleware_BearerTakesPrecedenceOverAPIKey(t *testing.T) {
+	router := setupAuthRouter()
+
+	w := serveAuthWithAPIKey(router, "GET", "/kitchen-orders/", "Bearer cook-token", "orders-key")
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "cook-1", w.Body.String())
+}
+
+func TestRequirePermission_ScopesAndRoles(t *testing.T) {
+	router := setupAuthRouter()
+
+	// Escopo de leitura não permite criar pedidos
+	assert.Equal(t, http.StatusForbidden, serveAuthWithAPIKey(router, "POST", "/kitchen-orders/", "", "reporter-key").Code)
+	// API keys não recebem papéis, então rotas exclusivas de papéis continuam fechadas
+	assert.Equal(t, http.StatusForbidden, serveAuthWithAPIKey(router, "POST", "/kitchen-orders/bulk-status", "", "orders-key").Code)
+	assert.Equal(t, http.StatusForbidden, serveAuth(router, "POST", "/kitchen-orders/", "Bearer cook-token").Code)
+	assert.Equal(t, http.StatusCreated, serveAuth(router, "POST", "/kitchen-orders/", "Bearer manager-token").Code)
+	t.Log("✓ Permissões aceitam papéis de usuários ou escopos de API keys")
+}
@@ -1,13 +1,18 @@
 package middlewares
 
 import (
-	"net/http"
+	"context"
+	"errors"
 
 	"github.com/gin-gonic/gin"
 
 	kitchen_order_http_errors "tech_challenge/internal/infra/api/http_errors"
+	"tech_challenge/internal/shared/pkg/logger"
 )
 
+// statusClientClosedRequest segue a convenção do nginx para registrar nos logs as requisições abandonadas pelo cliente
+const statusClientClosedRequest = 499
+
 func ErrorHandlerMiddleware() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		ctx.Next()
@@ -15,13 +20,39 @@ func ErrorHandlerMiddleware() gin.HandlerFunc {
 		if len(ctx.Errors) > 0 {
 			err := ctx.Errors.Last().Err
 
-			errorHasBinHandled := kitchen_order_http_errors.HandleDomainErrors(err, ctx)
+			// O cliente desconectou: não há para quem responder e não é uma falha da API
+			if errors.Is(err, context.Canceled) {
+				logger.FromContext(ctx).Debug("Request canceled by client", logger.ErrorKey, err)
+				ctx.Status(statusClientClosedRequest)
+				ctx.Abort()
+				return
+			}
+
+			if errors.Is(err, context.DeadlineExceeded) {
+				logger.FromContext(ctx).Warn("Request timed out", logger.ErrorKey, err)
+				kitchen_order_http_errors.WriteTimeoutProblem(ctx)
+				ctx.Abort()
+				return
+			}
+
+			errorHasBinHandled := kitchen_order_http_errors.HandleDomainErrors(err, ctx) ||
+				kitchen_order_http_errors.HandleBindingErrors(err, ctx)
 
 			if !errorHasBinHandled {
-				ctx.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+				logger.FromContext(ctx).Error("Unhandled error", logger.ErrorKey, err)
+				kitchen_order_http_errors.WriteInternalProblem(ctx)
 			}
 
 			ctx.Abort()
 		}
 	}
 }
+
+// RecoveryMiddleware responde aos panics com o mesmo problema genérico dos erros não mapeados
+func RecoveryMiddleware() gin.HandlerFunc {
+	return gin.CustomRecovery(func(ctx *gin.Context, recovered any) {
+		logger.FromContext(ctx).Error("Recovered from panic", "panic", recovered)
+		kitchen_order_http_errors.WriteInternalProblem(ctx)
+		ctx.Abort()
+	})
+}
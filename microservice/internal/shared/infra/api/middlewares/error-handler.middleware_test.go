@@ -1,9 +1,12 @@
 package middlewares
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/gin-gonic/gin"
@@ -108,7 +111,57 @@ func TestErrorHandlerMiddleware_WithUnknownError(t *testing.T) {
 
 	// Assert
 	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.Equal(t, "application/problem+json", w.Header().Get("Content-Type"))
+	assert.Contains(t, w.Body.String(), `"code":"internal-error"`)
 	assert.Contains(t, w.Body.String(), "Internal server error")
+	assert.NotContains(t, w.Body.String(), "unknown error")
+}
+
+func TestErrorHandlerMiddleware_BindingError(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	// Arrange
+	router := gin.New()
+	router.Use(ErrorHandlerMiddleware())
+	router.POST("/test", func(c *gin.Context) {
+		var body struct {
+			Name string `json:"name" binding:"required"`
+		}
+		if err := c.ShouldBindJSON(&body); err != nil {
+			_ = c.Error(err)
+		}
+	})
+
+	// Act
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("POST", "/test", strings.NewReader("{")))
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), `"code":"malformed-request-body"`)
+}
+
+func TestRecoveryMiddleware_RespondsWithProblem(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	// Arrange
+	router := gin.New()
+	router.Use(TraceIDMiddleware(), RecoveryMiddleware())
+	router.GET("/test", func(c *gin.Context) {
+		panic("boom")
+	})
+
+	// Act
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("GET", "/test", nil)
+	req.Header.Set("X-Request-ID", "req-42")
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.Equal(t, "application/problem+json", w.Header().Get("Content-Type"))
+	assert.Contains(t, w.Body.String(), `"trace_id":"req-42"`)
+	assert.NotContains(t, w.Body.String(), "boom")
 }
 
 func TestErrorHandlerMiddleware_MultipleErrors(t *testing.T) {
@@ -191,4 +244,29 @@ func TestErrorHandlerMiddleware_ChainedHandlers(t *testing.T) {
 	// Assert
 	assert.True(t, executed)
 	assert.Equal(t, http.StatusNotFound, w.Code)
-}
\ No newline at end of file
+}
+
+func TestErrorHandlerMiddleware_ContextErrors(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	serve := func(err error) *httptest.ResponseRecorder {
+		router := gin.New()
+		router.Use(ErrorHandlerMiddleware())
+		router.GET("/test", func(c *gin.Context) {
+			_ = c.Error(fmt.Errorf("finding kitchen order: %w", err))
+		})
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest("GET", "/test", nil))
+		return w
+	}
+
+	timedOut := serve(context.DeadlineExceeded)
+	assert.Equal(t, http.StatusGatewayTimeout, timedOut.Code)
+	assert.Contains(t, timedOut.Body.String(), `"code":"request-timeout"`)
+
+	canceled := serve(context.Canceled)
+	assert.Equal(t, 499, canceled.Code)
+	assert.Empty(t, canceled.Body.String())
+	t.Log("✓ Timeout vira 504 e cancelamento do cliente não gera corpo")
+}
@@ -0,0 +1,49 @@
+package middlewares
+
+import (
+	"crypto/subtle"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"tech_challenge/internal/domain/exceptions"
+	"tech_challenge/internal/shared/infra/metrics"
+)
+
+// unmatchedRoute agrupa as requisições sem rota para que caminhos arbitrários não criem séries novas
+const unmatchedRoute = "unmatched"
+
+// MetricsMiddleware registra a duração e o status de cada requisição pelo template da rota
+func MetricsMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		start := time.Now()
+
+		ctx.Next()
+
+		route := ctx.FullPath()
+		if route == "" {
+			route = unmatchedRoute
+		}
+
+		metrics.ObserveHTTPRequest(ctx.Request.Method, route, ctx.Writer.Status(), time.Since(start))
+	}
+}
+
+// MetricsTokenMiddleware protege o endpoint de métricas com um token fixo do scraper; sem token, permitido só fora de produção, o acesso é livre
+func MetricsTokenMiddleware(token string) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if token == "" {
+			ctx.Next()
+			return
+		}
+
+		provided, ok := strings.CutPrefix(ctx.GetHeader("Authorization"), "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(provided), []byte(token)) != 1 {
+			abortUnauthorized(ctx, &exceptions.UnauthorizedException{})
+			return
+		}
+
+		ctx.Next()
+	}
+}
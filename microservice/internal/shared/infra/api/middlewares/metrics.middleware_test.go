@@ -0,0 +1,66 @@
+package middlewares
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"tech_challenge/internal/shared/infra/metrics"
+)
+
+func scrapeMetrics(t *testing.T) string {
+	w := httptest.NewRecorder()
+	metrics.Handler().ServeHTTP(w, httptest.NewRequest("GET", "/metrics", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	return w.Body.String()
+}
+
+func TestMetricsMiddleware_ObservesRouteTemplate(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.Use(MetricsMiddleware())
+	router.GET("/orders/:id", func(c *gin.Context) {
+		c.Status(http.StatusAccepted)
+	})
+
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/orders/123", nil))
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/missing/456", nil))
+
+	body := scrapeMetrics(t)
+
+	assert.Contains(t, body, `kitchen_http_request_duration_seconds_count{method="GET",route="/orders/:id",status="202"}`)
+	assert.Contains(t, body, `kitchen_http_request_duration_seconds_count{method="GET",route="unmatched",status="404"}`)
+	assert.False(t, strings.Contains(body, "/orders/123"), "path values must not become labels")
+	t.Log("✓ Métricas HTTP usam o template da rota")
+}
+
+func serveMetricsWithToken(token, authorization string) *httptest.ResponseRecorder {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.Use(ErrorHandlerMiddleware())
+	router.GET("/metrics", MetricsTokenMiddleware(token), gin.WrapH(metrics.Handler()))
+
+	req := httptest.NewRequest("GET", "/metrics", nil)
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestMetricsTokenMiddleware(t *testing.T) {
+	assert.Equal(t, http.StatusOK, serveMetricsWithToken("", "").Code, "no token configured")
+	assert.Equal(t, http.StatusOK, serveMetricsWithToken("scrape-secret", "Bearer scrape-secret").Code)
+	assert.Equal(t, http.StatusUnauthorized, serveMetricsWithToken("scrape-secret", "").Code)
+	assert.Equal(t, http.StatusUnauthorized, serveMetricsWithToken("scrape-secret", "Bearer wrong").Code)
+	t.Log("✓ Endpoint de métricas exige o token quando configurado")
+}
@@ -0,0 +1,51 @@
+package middlewares
+
+import (
+	"math"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"tech_challenge/internal/domain/exceptions"
+	"tech_challenge/internal/shared/infra/auth"
+	"tech_challenge/internal/shared/infra/ratelimit"
+	"tech_challenge/internal/shared/pkg/logger"
+)
+
+// RateLimitMiddleware aplica o limite do grupo de rotas por cliente: API key ou usuário autenticado e, sem autenticação, o IP
+func RateLimitMiddleware(limiter ratelimit.Limiter, group string, policy ratelimit.Policy) gin.HandlerFunc {
+	if policy.Disabled() {
+		return func(ctx *gin.Context) {
+			ctx.Next()
+		}
+	}
+
+	return func(ctx *gin.Context) {
+		result, err := limiter.Allow(ctx.Request.Context(), group+":"+rateLimitClientKey(ctx), policy)
+		if err != nil {
+			// Uma falha do limitador não deve derrubar a API
+			logger.FromContext(ctx).Error("Error checking rate limit", logger.ErrorKey, err)
+			ctx.Next()
+			return
+		}
+
+		ctx.Header("X-RateLimit-Limit", strconv.Itoa(result.Limit))
+		ctx.Header("X-RateLimit-Remaining", strconv.Itoa(result.Remaining))
+
+		if !result.Allowed {
+			ctx.Header("Retry-After", strconv.Itoa(int(math.Max(1, math.Ceil(result.RetryAfter.Seconds())))))
+			abortWithError(ctx, &exceptions.RateLimitExceededException{})
+			return
+		}
+
+		ctx.Next()
+	}
+}
+
+func rateLimitClientKey(ctx *gin.Context) string {
+	if principal, ok := auth.PrincipalFromContext(ctx); ok {
+		return "subject:" + principal.Subject
+	}
+
+	return "ip:" + ctx.ClientIP()
+}
@@ -0,0 +1,80 @@
+package middlewares
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"tech_challenge/internal/shared/config/constants"
+	"tech_challenge/internal/shared/infra/auth"
+	"tech_challenge/internal/shared/infra/ratelimit"
+)
+
+func setupRateLimitRouter(policy ratelimit.Policy) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.Use(ErrorHandlerMiddleware())
+	router.Use(func(c *gin.Context) {
+		if subject := c.GetHeader("X-Test-Subject"); subject != "" {
+			c.Set(constants.AUTH_PRINCIPAL_CONTEXT_KEY, auth.Principal{Subject: subject})
+		}
+	})
+	router.GET("/test", RateLimitMiddleware(ratelimit.NewMemoryLimiter(), "test", policy), func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	return router
+}
+
+func serveRateLimited(router *gin.Engine, subject, remoteAddr string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest("GET", "/test", nil)
+	req.RemoteAddr = remoteAddr
+	if subject != "" {
+		req.Header.Set("X-Test-Subject", subject)
+	}
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestRateLimitMiddleware_TooManyRequests(t *testing.T) {
+	router := setupRateLimitRouter(ratelimit.Policy{RequestsPerMinute: 30, Burst: 2})
+
+	first := serveRateLimited(router, "", "10.0.0.1:1234")
+	assert.Equal(t, http.StatusOK, first.Code)
+	assert.Equal(t, "2", first.Header().Get("X-RateLimit-Limit"))
+	assert.Equal(t, "1", first.Header().Get("X-RateLimit-Remaining"))
+
+	assert.Equal(t, http.StatusOK, serveRateLimited(router, "", "10.0.0.1:1234").Code)
+
+	w := serveRateLimited(router, "", "10.0.0.1:1234")
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.Equal(t, "2", w.Header().Get("Retry-After"))
+	assert.Contains(t, w.Body.String(), `"code":"rate-limit-exceeded"`)
+	t.Log("✓ Cliente acima do limite recebe 429 com Retry-After")
+}
+
+func TestRateLimitMiddleware_KeysBySubjectOrIP(t *testing.T) {
+	router := setupRateLimitRouter(ratelimit.Policy{RequestsPerMinute: 60, Burst: 1})
+
+	assert.Equal(t, http.StatusOK, serveRateLimited(router, "api-key:orders-service", "10.0.0.1:1234").Code)
+	// Mesmo IP, outro ator autenticado
+	assert.Equal(t, http.StatusOK, serveRateLimited(router, "cook-1", "10.0.0.1:1234").Code)
+	// Sem autenticação o limite é pelo IP
+	assert.Equal(t, http.StatusOK, serveRateLimited(router, "", "10.0.0.1:1234").Code)
+	assert.Equal(t, http.StatusTooManyRequests, serveRateLimited(router, "", "10.0.0.1:1234").Code)
+	assert.Equal(t, http.StatusTooManyRequests, serveRateLimited(router, "cook-1", "10.0.0.2:1234").Code)
+}
+
+func TestRateLimitMiddleware_DisabledPolicy(t *testing.T) {
+	router := setupRateLimitRouter(ratelimit.Policy{})
+
+	for i := 0; i < 5; i++ {
+		assert.Equal(t, http.StatusOK, serveRateLimited(router, "", "10.0.0.1:1234").Code)
+	}
+}
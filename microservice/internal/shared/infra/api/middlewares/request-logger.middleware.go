@@ -0,0 +1,51 @@
+package middlewares
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"tech_challenge/internal/shared/config/constants"
+	"tech_challenge/internal/shared/pkg/logger"
+)
+
+// quietRoutes são consultadas por probes e scrapers a cada poucos segundos e só aparecem em nível debug
+var quietRoutes = map[string]bool{
+	"/health":       true,
+	"/health/live":  true,
+	"/health/ready": true,
+	"/metrics":      true,
+}
+
+// RequestLoggerMiddleware deixa no contexto um logger com o request_id e registra cada requisição ao final;
+// deve vir depois do TraceIDMiddleware
+func RequestLoggerMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		start := time.Now()
+		requestLogger := slog.Default().With(logger.RequestIDKey, ctx.GetString(constants.TRACE_ID_CONTEXT_KEY))
+
+		ctx.Set(logger.ContextKey, requestLogger)
+		ctx.Request = ctx.Request.WithContext(logger.WithContext(ctx.Request.Context(), requestLogger))
+
+		ctx.Next()
+
+		status := ctx.Writer.Status()
+		level := slog.LevelInfo
+		switch {
+		case status >= 500:
+			level = slog.LevelError
+		case quietRoutes[ctx.FullPath()]:
+			level = slog.LevelDebug
+		}
+
+		requestLogger.Log(ctx.Request.Context(), level, "HTTP request",
+			"method", ctx.Request.Method,
+			"route", ctx.FullPath(),
+			"path", ctx.Request.URL.Path,
+			"status", status,
+			"duration_ms", time.Since(start).Milliseconds(),
+			"client_ip", ctx.ClientIP(),
+		)
+	}
+}
@@ -0,0 +1,72 @@
+package middlewares
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tech_challenge/internal/shared/pkg/logger"
+)
+
+func TestRequestLoggerMiddleware_CorrelatesLogs(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	var buffer bytes.Buffer
+	previous := slog.Default()
+	slog.SetDefault(logger.New(&buffer, logger.Options{JSON: true}))
+	defer slog.SetDefault(previous)
+
+	router := gin.New()
+	router.Use(TraceIDMiddleware(), RequestLoggerMiddleware())
+	router.GET("/orders/:id", func(c *gin.Context) {
+		logger.FromContext(c).Info("from gin context")
+		logger.FromContext(c.Request.Context()).Info("from request context")
+		c.Status(http.StatusNoContent)
+	})
+
+	req := httptest.NewRequest("GET", "/orders/123", nil)
+	req.Header.Set("X-Request-ID", "req-42")
+	router.ServeHTTP(httptest.NewRecorder(), req)
+
+	lines := bytes.Split(bytes.TrimSpace(buffer.Bytes()), []byte("\n"))
+	require.Len(t, lines, 3)
+
+	for _, line := range lines {
+		var entry map[string]any
+		require.NoError(t, json.Unmarshal(line, &entry))
+		assert.Equal(t, "req-42", entry[logger.RequestIDKey])
+	}
+
+	var access map[string]any
+	require.NoError(t, json.Unmarshal(lines[2], &access))
+	assert.Equal(t, "HTTP request", access["msg"])
+	assert.Equal(t, "/orders/:id", access["route"])
+	assert.Equal(t, float64(http.StatusNoContent), access["status"])
+	t.Log("✓ Logs da requisição carregam o request_id")
+}
+
+func TestRequestLoggerMiddleware_QuietRoutes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	var buffer bytes.Buffer
+	previous := slog.Default()
+	slog.SetDefault(logger.New(&buffer, logger.Options{Level: "info"}))
+	defer slog.SetDefault(previous)
+
+	router := gin.New()
+	router.Use(TraceIDMiddleware(), RequestLoggerMiddleware())
+	router.GET("/health", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/health", nil))
+
+	assert.Empty(t, buffer.String())
+}
@@ -0,0 +1,45 @@
+package middlewares
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"tech_challenge/internal/shared/config/constants"
+	identity_manager "tech_challenge/internal/shared/pkg/identity"
+)
+
+const traceIDMaxLength = 128
+
+var (
+	// traceparent do W3C Trace Context: versão-traceid-parentid-flags
+	traceparentPattern = regexp.MustCompile(`^[0-9a-f]{2}-([0-9a-f]{32})-[0-9a-f]{16}-[0-9a-f]{2}$`)
+	requestIDPattern   = regexp.MustCompile(`^[A-Za-z0-9._:-]+$`)
+)
+
+// TraceIDMiddleware identifica a requisição para correlacionar logs e respostas de erro
+func TraceIDMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		traceID := resolveTraceID(ctx)
+
+		ctx.Set(constants.TRACE_ID_CONTEXT_KEY, traceID)
+		ctx.Header(constants.TRACE_ID_HEADER, traceID)
+
+		ctx.Next()
+	}
+}
+
+func resolveTraceID(ctx *gin.Context) string {
+	if match := traceparentPattern.FindStringSubmatch(strings.TrimSpace(ctx.GetHeader("traceparent"))); match != nil {
+		return match[1]
+	}
+
+	// O identificador enviado pelo cliente só é aceito se não puder poluir logs e cabeçalhos
+	requestID := strings.TrimSpace(ctx.GetHeader(constants.TRACE_ID_HEADER))
+	if requestID != "" && len(requestID) <= traceIDMaxLength && requestIDPattern.MatchString(requestID) {
+		return requestID
+	}
+
+	return identity_manager.NewUUIDV4()
+}
@@ -0,0 +1,61 @@
+package middlewares
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"tech_challenge/internal/shared/config/constants"
+	identity_manager "tech_challenge/internal/shared/pkg/identity"
+)
+
+func serveWithTraceID(headers map[string]string) (*httptest.ResponseRecorder, string) {
+	gin.SetMode(gin.TestMode)
+
+	var traceID string
+	router := gin.New()
+	router.Use(TraceIDMiddleware())
+	router.GET("/test", func(c *gin.Context) {
+		traceID = c.GetString(constants.TRACE_ID_CONTEXT_KEY)
+		c.Status(http.StatusOK)
+	})
+
+	req := httptest.NewRequest("GET", "/test", nil)
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w, traceID
+}
+
+func TestTraceIDMiddleware_UsesTraceparent(t *testing.T) {
+	w, traceID := serveWithTraceID(map[string]string{
+		"traceparent":  "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		"X-Request-ID": "req-1",
+	})
+
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", traceID)
+	assert.Equal(t, traceID, w.Header().Get(constants.TRACE_ID_HEADER))
+}
+
+func TestTraceIDMiddleware_UsesRequestID(t *testing.T) {
+	w, traceID := serveWithTraceID(map[string]string{"X-Request-ID": "req-1"})
+
+	assert.Equal(t, "req-1", traceID)
+	assert.Equal(t, "req-1", w.Header().Get(constants.TRACE_ID_HEADER))
+}
+
+func TestTraceIDMiddleware_GeneratesWhenMissingOrInvalid(t *testing.T) {
+	for _, requestID := range []string{"", "has spaces", strings.Repeat("a", 200)} {
+		_, traceID := serveWithTraceID(map[string]string{"X-Request-ID": requestID})
+
+		assert.True(t, identity_manager.IsValidUUID(traceID), "request id %q", requestID)
+	}
+	t.Log("✓ Trace id gerado quando o cliente não envia um identificador válido")
+}
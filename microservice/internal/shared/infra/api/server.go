@@ -2,15 +2,20 @@ package api
 
 import (
 	"context"
-	"log"
+	"errors"
+	"log/slog"
+	"net/http"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
 
+	internal_factories "tech_challenge/internal/factories"
 	"tech_challenge/internal/infra/api/routes"
 	"tech_challenge/internal/infra/messaging/consumers"
 	"tech_challenge/internal/shared/config/env"
@@ -19,76 +24,211 @@ import (
 	"tech_challenge/internal/shared/infra/api/middlewares"
 	_ "tech_challenge/internal/shared/infra/api/swagger"
 	"tech_challenge/internal/shared/infra/database"
+	"tech_challenge/internal/shared/infra/health"
+	"tech_challenge/internal/shared/infra/metrics"
+	"tech_challenge/internal/shared/interfaces"
+	"tech_challenge/internal/shared/pkg/logger"
 )
 
 func Init() {
 	config := env.GetConfig()
+	logger.Configure(logger.Options{Level: config.Log.Level, JSON: config.Log.JSON})
 
 	if config.IsProduction() {
-		log.Printf("Running in production mode on [%s] with message broker: %s", config.APIUrl, config.MessageBroker.Type)
+		slog.Info("Running in production mode", "address", config.APIUrl, "message_broker", config.MessageBroker.Type)
 		gin.SetMode(gin.ReleaseMode)
 	}
 
 	database.Connect()
 
+	// Com DB_RUN_MIGRATIONS desligado as migrations ficam a cargo do "migrate up" no deploy
 	if config.Database.RunMigrations {
-		database.RunMigrations()
+		if err := database.RunMigrations(context.Background()); err != nil {
+			logger.Fatal("Failed to run migrations", logger.ErrorKey, err)
+		}
 	}
 
-	database.SeedDefaults()
+	// gin.New em vez de gin.Default: o log de acesso e a recuperação de panics são os middlewares da aplicação
+	ginRouter := gin.New()
 
-	ginRouter := gin.Default()
+	// Sem proxies confiáveis o gin aceitaria qualquer X-Forwarded-For e o limite por IP seria contornado
+	if err := ginRouter.SetTrustedProxies(config.TrustedProxies); err != nil {
+		logger.Fatal("Invalid trusted proxies", logger.ErrorKey, err)
+	}
+	ginRouter.TrustedPlatform = config.TrustedPlatform
 
 	ginRouter.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 
-	ginRouter.Use(gin.Logger())
-	ginRouter.Use(gin.Recovery())
+	if config.Metrics.Enabled {
+		ginRouter.Use(middlewares.MetricsMiddleware())
+	}
+	ginRouter.Use(middlewares.TraceIDMiddleware())
+	ginRouter.Use(middlewares.RequestLoggerMiddleware())
+	ginRouter.Use(middlewares.RecoveryMiddleware())
 	ginRouter.Use(middlewares.ErrorHandlerMiddleware())
 
-	// Health check endpoint
-	healthHandler := handlers.NewHealthHandler()
-	ginRouter.GET("/health", healthHandler.Health)
+	if config.Metrics.Enabled {
+		// Em produção as métricas expõem volume e filas da cozinha; sem token o endpoint ficaria aberto no balanceador
+		if config.IsProduction() && config.Metrics.Token == "" {
+			logger.Fatal("METRICS_TOKEN is required in production when metrics are enabled")
+		}
+		registerMetrics(ginRouter, config.Metrics.Token)
+	}
+
+	tokenVerifier, err := factories.NewTokenVerifier()
+	if err != nil {
+		logger.Fatal("Failed to initialize token verifier", logger.ErrorKey, err)
+	}
+	authenticated := middlewares.AuthenticationMiddleware(tokenVerifier, internal_factories.NewAPIKeyVerifier())
 
-	v1Routes := ginRouter.Group("/v1")
+	rateLimiter, err := factories.NewRateLimiter()
+	if err != nil {
+		logger.Fatal("Failed to initialize rate limiter", logger.ErrorKey, err)
+	}
+	rateLimit := func(group string, policy env.RateLimitPolicy) gin.HandlerFunc {
+		return middlewares.RateLimitMiddleware(rateLimiter, group, factories.NewRateLimitPolicy(policy))
+	}
+
+	// O limite por IP vem antes da autenticação e contém tentativas com credenciais inválidas
+	v1Routes := ginRouter.Group("/v1", rateLimit("ip", config.RateLimit.PerIP))
 
-	routes.RegisterKitchenOrderRoutes(v1Routes.Group("/kitchen-orders"))
+	// O limite de cada grupo vem depois da autenticação para contar as requisições por API key ou usuário
+	routes.RegisterKitchenOrderRoutes(v1Routes.Group("/kitchen-orders", authenticated, rateLimit("kitchen-orders", config.RateLimit.KitchenOrders)))
+	routes.RegisterWebhookRoutes(v1Routes.Group("/webhooks", authenticated, rateLimit("webhooks", config.RateLimit.Webhooks)))
+	routes.RegisterAPIKeyRoutes(v1Routes.Group("/api-keys", authenticated, rateLimit("api-keys", config.RateLimit.APIKeys)))
+	routes.RegisterPrinterRoutes(v1Routes.Group("/printers", authenticated, rateLimit("printers", config.RateLimit.Printers)))
+	routes.RegisterKitchenReportRoutes(v1Routes.Group("/reports", authenticated, rateLimit("reports", config.RateLimit.Reports)))
+	// O painel de retirada fica nas TVs do salão e expõe apenas a senha e o status, sem exigir token
+	routes.RegisterDisplayBoardRoutes(v1Routes.Group("/display-board", rateLimit("display-board", config.RateLimit.DisplayBoard)))
 
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
 	broker, err := factories.NewMessageBroker(ctx)
 	if err != nil {
-		log.Fatalf("Failed to initialize message broker: %v", err)
+		logger.Fatal("Failed to initialize message broker", logger.ErrorKey, err)
 	}
-	defer broker.Close()
+
+	registerHealth(ginRouter, broker, config)
 
 	kitchenOrderConsumer := consumers.NewKitchenOrderConsumer(broker)
 	if err := kitchenOrderConsumer.Start(ctx); err != nil {
-		log.Fatalf("Failed to start kitchen order consumer: %v", err)
+		logger.Fatal("Failed to start kitchen order consumer", logger.ErrorKey, err)
 	}
 
 	if err := broker.Start(ctx); err != nil {
-		log.Fatalf("Failed to start message broker: %v", err)
+		logger.Fatal("Failed to start message broker", logger.ErrorKey, err)
 	}
 
-	log.Println("Message broker consumers started successfully")
+	slog.Info("Message broker consumers started successfully")
+
+	httpServer := &http.Server{
+		Addr:    config.APIUrl,
+		Handler: ginRouter,
+	}
+
+	// Conexões SSE não terminam sozinhas; fechar o hub libera os handlers para o Shutdown concluir
+	httpServer.RegisterOnShutdown(internal_factories.GetKitchenOrderEventHub().Close)
+
+	webhookQueue := internal_factories.GetWebhookQueue()
+	webhookQueue.Start()
+	httpServer.RegisterOnShutdown(webhookQueue.Close)
+
+	if config.Printing.Enabled {
+		printQueue := internal_factories.GetPrintQueue()
+		printQueue.Start()
+		httpServer.RegisterOnShutdown(printQueue.Close)
+	}
 
 	go func() {
-		if err := ginRouter.Run(config.APIUrl); err != nil {
-			log.Fatalf("Failed to start HTTP server: %v", err)
+		if err := httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Fatal("Failed to start HTTP server", logger.ErrorKey, err)
 		}
 	}()
 
-	log.Printf("HTTP server started on %s", config.APIUrl)
+	slog.Info("HTTP server started", "address", config.APIUrl)
 
 	sigChan := make(chan os.Signal, 1)
 	signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
 
 	<-sigChan
-	log.Println("Shutting down...")
+	slog.Info("Shutting down, waiting for in-flight work", "timeout", config.ShutdownTimeout.String())
 
-	if err := broker.Stop(); err != nil {
-		log.Printf("Error stopping broker: %v", err)
-	}
+	shutdown(httpServer, broker, config.ShutdownTimeout)
 	cancel()
+
+	if err := broker.Close(); err != nil {
+		slog.Error("Error closing broker", logger.ErrorKey, err)
+	}
+
+	database.Close()
+	slog.Info("Shutdown complete")
+}
+
+// registerMetrics expõe /metrics fora do /v1 e sem limite de requisições, para que o scraper nunca seja bloqueado
+func registerMetrics(ginRouter *gin.Engine, token string) {
+	sqlDB, err := database.GetDB().DB()
+	if err != nil {
+		logger.Fatal("Failed to access database pool for metrics", logger.ErrorKey, err)
+	}
+
+	if err := metrics.RegisterDBStats(sqlDB); err != nil {
+		logger.Fatal("Failed to register database metrics", logger.ErrorKey, err)
+	}
+
+	if err := metrics.Register(internal_factories.NewKitchenBoardCollector()); err != nil {
+		logger.Fatal("Failed to register kitchen board metrics", logger.ErrorKey, err)
+	}
+
+	ginRouter.GET("/metrics", middlewares.MetricsTokenMiddleware(token), gin.WrapH(metrics.Handler()))
+}
+
+// registerHealth expõe a liveness e a readiness; a readiness só considera o broker quando a implementação informa a própria saúde
+func registerHealth(ginRouter *gin.Engine, broker interfaces.MessageBroker, config *env.Config) {
+	sqlDB, err := database.GetDB().DB()
+	if err != nil {
+		logger.Fatal("Failed to access database pool for health checks", logger.ErrorKey, err)
+	}
+
+	checkers := []interfaces.IHealthChecker{
+		health.NewDatabaseChecker(sqlDB),
+		health.NewCheck("migrations", database.CheckMigrations),
+	}
+
+	if brokerHealth, ok := broker.(interfaces.IBrokerHealth); ok {
+		checkers = append(checkers,
+			health.NewBrokerChecker(brokerHealth),
+			health.NewPollingChecker(brokerHealth, config.Health.PollStaleAfter),
+		)
+	}
+
+	healthHandler := handlers.NewHealthHandler(config.Health.CheckTimeout, checkers...)
+	ginRouter.GET("/health", healthHandler.Health)
+	ginRouter.GET("/health/live", healthHandler.Live)
+	ginRouter.GET("/health/ready", healthHandler.Ready)
+}
+
+// shutdown drena requisições HTTP e mensagens em processamento em paralelo, dentro do mesmo prazo
+func shutdown(httpServer *http.Server, broker interfaces.MessageBroker, timeout time.Duration) {
+	shutdownCtx, cancelShutdown := context.WithTimeout(context.Background(), timeout)
+	defer cancelShutdown()
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+
+	go func() {
+		defer wg.Done()
+		if err := httpServer.Shutdown(shutdownCtx); err != nil {
+			slog.Error("Error shutting down HTTP server", logger.ErrorKey, err)
+		}
+	}()
+
+	go func() {
+		defer wg.Done()
+		if err := broker.Stop(); err != nil {
+			slog.Error("Error stopping broker", logger.ErrorKey, err)
+		}
+	}()
+
+	wg.Wait()
 }
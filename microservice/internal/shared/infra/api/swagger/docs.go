@@ -19,47 +19,137 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/kitchen-orders/": {
+        "/api-keys/": {
             "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Revoked keys are kept for auditing",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "KitchenOrders"
+                    "API Keys"
                 ],
-                "summary": "List all kitchenOrders",
+                "summary": "List API keys",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/schemas.KitchenOrderResponseSchema"
+                                "$ref": "#/definitions/schemas.APIKeyResponseSchema"
                             }
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/schemas.ErrorMessageSchema"
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "The key is only returned on creation and must be sent in the X-API-Key header. Scopes: kitchen-orders:read, kitchen-orders:create, webhooks:manage, printers:manage, reports:read",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "API Keys"
+                ],
+                "summary": "Create an API key for another service",
+                "parameters": [
+                    {
+                        "description": "API key",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schemas.CreateAPIKeyRequestSchema"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.APIKeyResponseSchema"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
                         }
                     }
                 }
             }
         },
-        "/kitchen-orders/{id}": {
+        "/api-keys/{id}": {
             "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "KitchenOrders"
+                    "API Keys"
                 ],
-                "summary": "Get a kitchenOrder by ID",
+                "summary": "Get an API key by ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "KitchenOrder ID",
+                        "description": "API key ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -69,77 +159,3793 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/schemas.KitchenOrderResponseSchema"
+                            "$ref": "#/definitions/schemas.APIKeyResponseSchema"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/schemas.InvalidKitchenOrderDataErrorSchema"
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/schemas.KitchenOrderNotFoundErrorSchema"
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "schemas.ErrorMessageSchema": {
-            "type": "object",
-            "properties": {
-                "error": {
-                    "type": "string",
-                    "example": "Internal server error"
+        },
+        "/api-keys/{id}/revoke": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Requests with a revoked key are rejected immediately; revoking twice keeps the original date",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "API Keys"
+                ],
+                "summary": "Revoke an API key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "API key ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.APIKeyResponseSchema"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    }
                 }
             }
         },
-        "schemas.InvalidKitchenOrderDataErrorSchema": {
-            "type": "object",
-            "properties": {
-                "error": {
-                    "type": "string",
-                    "example": "Invalid kitchen order data"
+        "/display-board": {
+            "get": {
+                "description": "Public, read-only board with the slugs being prepared and the slugs ready for pickup.\nReady slugs leave the board after DISPLAY_BOARD_READY_TTL.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "DisplayBoard"
+                ],
+                "summary": "Customer display board",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.DisplayBoardResponseSchema"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    }
                 }
             }
         },
-        "schemas.KitchenOrderNotFoundErrorSchema": {
-            "type": "object",
-            "properties": {
-                "error": {
-                    "type": "string",
-                    "example": "Kitchen order not found"
+        "/display-board/stream": {
+            "get": {
+                "description": "Server-Sent Events stream. A \"board\" event carries the full board on connect and whenever it changes;\na \"chime\" event is sent when a slug becomes ready for pickup.\nThe number of open streams per instance is capped by DISPLAY_BOARD_MAX_STREAMS.",
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "DisplayBoard"
+                ],
+                "summary": "Stream the customer display board",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.DisplayBoardResponseSchema"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    }
                 }
             }
         },
-        "schemas.KitchenOrderResponseSchema": {
-            "type": "object",
-            "properties": {
-                "created_at": {
-                    "type": "string",
-                    "example": "2023-10-01T12:00:00Z"
-                },
-                "order_id": {
-                    "type": "string",
-                    "example": "123e4567-e89b-12d3-a456-426614174000"
-                },
-                "slug": {
-                    "type": "string",
-                    "example": "001"
-                },
-                "status": {
-                    "type": "string",
-                    "example": "Pronto"
-                },
-                "updated_at": {
-                    "type": "string",
-                    "example": "2023-10-01T12:00:00Z"
+        "/health": {
+            "get": {
+                "description": "Kept for compatibility; prefer /health/live and /health/ready.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Health"
+                ],
+                "summary": "Health check endpoint",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/health/live": {
+            "get": {
+                "description": "Answers while the process is able to serve requests. Dependencies are not checked, so a database outage does not restart the container.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Health"
+                ],
+                "summary": "Liveness probe",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/health/ready": {
+            "get": {
+                "description": "Checks the database, the message broker, the last successful poll of each subscription and the migration state.\nAnswers 503 with the per-component report when any of them is down.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Health"
+                ],
+                "summary": "Readiness probe",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/health.Report"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/health.Report"
+                        }
+                    }
                 }
             }
+        },
+        "/kitchen-orders/": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Cursor-paginated list; pass next_cursor back as cursor to fetch the next page",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "KitchenOrders"
+                ],
+                "summary": "List kitchenOrders",
+                "parameters": [
+                    {
+                        "enum": [
+                            "board",
+                            "created_at",
+                            "-created_at"
+                        ],
+                        "type": "string",
+                        "default": "board",
+                        "description": "Sort order",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 50,
+                        "description": "Page size (max 200)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Opaque cursor returned as next_cursor",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "RFC3339 lower bound for created_at",
+                        "name": "created_at_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "RFC3339 upper bound for created_at",
+                        "name": "created_at_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Status IDs (repeat or comma-separate)",
+                        "name": "status_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Order ID",
+                        "name": "order_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Slug",
+                        "name": "slug",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Customer ID",
+                        "name": "customer_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Include orders with status Finalizado",
+                        "name": "include_finished",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.KitchenOrderListResponseSchema"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Creates a kitchen order with its items. Send an Idempotency-Key header to make retries safe:\na retry with the same key and body returns the order created by the first request (Idempotent-Replayed: true),\nwhile the same key with a different body is rejected. Keys are scoped to the authenticated client.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "KitchenOrders"
+                ],
+                "summary": "Create a kitchenOrder",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Client-generated key (max 255 characters)",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Create request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schemas.CreateKitchenOrderRequestSchema"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.KitchenOrderResponseSchema"
+                        },
+                        "headers": {
+                            "Idempotent-Replayed": {
+                                "type": "string",
+                                "description": "true when the response is a replay of a previous request"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    }
+                }
+            }
+        },
+        "/kitchen-orders/bulk-status": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Applies the status to each order with the same notifications and transition rules as PUT /kitchen-orders/{id}.\nOrders are updated independently in small batches; the response reports the outcome of each ID.\nOrders already in the requested status are reported as skipped and send no notification.\nReady or finished orders can only go back to preparation through POST /kitchen-orders/{id}/recall; in a bulk request\nthey fail individually with error_code invalid-kitchen-order-transition.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "KitchenOrders"
+                ],
+                "summary": "Update the status of several kitchenOrders",
+                "parameters": [
+                    {
+                        "description": "Bulk status request (max 200 IDs)",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schemas.BulkUpdateKitchenOrderStatusRequestSchema"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.BulkUpdateKitchenOrderStatusResponseSchema"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    }
+                }
+            }
+        },
+        "/kitchen-orders/export": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Streams every order created in the period, finished ones included, with items, status timestamps and prep durations in seconds.\nAccepts the same filters as the list endpoint; limit and cursor are ignored.",
+                "produces": [
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "KitchenOrders"
+                ],
+                "summary": "Export kitchenOrders",
+                "parameters": [
+                    {
+                        "enum": [
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "default": "csv",
+                        "description": "Export format",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Start of the period (RFC3339 or YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "End of the period (RFC3339 or YYYY-MM-DD, inclusive)",
+                        "name": "to",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "board",
+                            "created_at",
+                            "-created_at"
+                        ],
+                        "type": "string",
+                        "default": "created_at",
+                        "description": "Sort order",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Status IDs (repeat or comma-separate)",
+                        "name": "status_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Order ID",
+                        "name": "order_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Slug",
+                        "name": "slug",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Customer ID",
+                        "name": "customer_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": true,
+                        "description": "Include orders with status Finalizado",
+                        "name": "include_finished",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "One object per line when format=ndjson",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/schemas.KitchenOrderExportSchema"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    }
+                }
+            }
+        },
+        "/kitchen-orders/stream": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Server-Sent Events stream with \"created\", \"status-changed\" and \"removed\" events.\nReconnect with the Last-Event-ID header (or last_event_id query) to resume without losing events.",
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "KitchenOrders"
+                ],
+                "summary": "Stream kitchen order changes",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Last event ID received",
+                        "name": "Last-Event-ID",
+                        "in": "header"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Alternative to the Last-Event-ID header",
+                        "name": "last_event_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Access token, for clients that cannot send the Authorization header",
+                        "name": "access_token",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.KitchenOrderStreamEventSchema"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    }
+                }
+            }
+        },
+        "/kitchen-orders/ws": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Upgrades to a WebSocket. The device receives a \"snapshot\" of its board followed by \"event\" messages for every change.\nCommands are sent as JSON {\"request_id\",\"command\",\"kitchen_order_id\",\"version\",\"reason\"} with command start, ready, finish or recall, and each one is answered on the same socket with an \"ack\" (updated order) or an \"error\" message carrying the same request_id.\nThe optional version rejects the command when the order was changed since the device last saw it, and recall requires a reason.\nEach command is authorized against the roles of the token used to connect, as in the matching POST endpoint.\nBrowsers can send the token in the access_token query parameter; their Origin must be the API itself or listed in KITCHEN_DISPLAY_ALLOWED_ORIGINS.",
+                "tags": [
+                    "KitchenOrders"
+                ],
+                "summary": "Kitchen display WebSocket",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Station label echoed in the snapshot",
+                        "name": "station",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Board columns shown by the device (repeat or comma-separate)",
+                        "name": "status_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Access token, for clients that cannot send the Authorization header",
+                        "name": "access_token",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "101": {
+                        "description": "Switching Protocols",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.KitchenDisplaySnapshotSchema"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    }
+                }
+            }
+        },
+        "/kitchen-orders/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "KitchenOrders"
+                ],
+                "summary": "Get a kitchenOrder by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "KitchenOrder ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.KitchenOrderResponseSchema"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Current version of the kitchenOrder, to be sent back in If-Match"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Send the ETag from a previous response in If-Match to only apply the change if nobody else changed the order meanwhile.\nReady or finished orders can only go back to preparation through POST /kitchen-orders/{id}/recall, which requires a reason.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "KitchenOrders"
+                ],
+                "summary": "Update a kitchenOrder status",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "KitchenOrder ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version being changed",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Update request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schemas.UpdateKitchenOrderRequestSchema"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.KitchenOrderResponseSchema"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "New version of the kitchenOrder"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    }
+                }
+            }
+        },
+        "/kitchen-orders/{id}/finish": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Moves a ready order to finished once it was picked up.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "KitchenOrders"
+                ],
+                "summary": "Finish a kitchenOrder",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "KitchenOrder ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version being changed",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.KitchenOrderResponseSchema"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "New version of the kitchenOrder"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    }
+                }
+            }
+        },
+        "/kitchen-orders/{id}/ready": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Moves a received or preparing order to ready for pickup.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "KitchenOrders"
+                ],
+                "summary": "Mark a kitchenOrder as ready",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "KitchenOrder ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version being changed",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.KitchenOrderResponseSchema"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "New version of the kitchenOrder"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    }
+                }
+            }
+        },
+        "/kitchen-orders/{id}/recall": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Moves a ready or finished order back to preparation. The reason is stored on the order and in the change feed.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "KitchenOrders"
+                ],
+                "summary": "Recall a kitchenOrder",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "KitchenOrder ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version being changed",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Recall request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schemas.RecallKitchenOrderRequestSchema"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.KitchenOrderResponseSchema"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "New version of the kitchenOrder"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    }
+                }
+            }
+        },
+        "/kitchen-orders/{id}/reprint": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Without a body the tickets are routed to the stations again; with printer_id the ticket goes to that printer only",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Kitchen Orders"
+                ],
+                "summary": "Reprint the tickets of a kitchen order",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Kitchen Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Target printer",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ReprintKitchenOrderRequestSchema"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/schemas.PrintJobResponseSchema"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    }
+                }
+            }
+        },
+        "/kitchen-orders/{id}/start": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Moves a received order to preparation.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "KitchenOrders"
+                ],
+                "summary": "Start preparing a kitchenOrder",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "KitchenOrder ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version being changed",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.KitchenOrderResponseSchema"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "New version of the kitchenOrder"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    }
+                }
+            }
+        },
+        "/kitchen-orders/{id}/ticket": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Renders the kitchen ticket as fixed-width text or as an ESC/POS byte stream ready to be sent to a thermal printer.\nThe ESC/POS ticket includes a QR code with the order id unless qr=false; width and qr default to the service configuration.",
+                "produces": [
+                    "text/plain",
+                    "application/octet-stream"
+                ],
+                "tags": [
+                    "KitchenOrders"
+                ],
+                "summary": "Print a kitchenOrder ticket",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "KitchenOrder ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "text",
+                            "escpos"
+                        ],
+                        "type": "string",
+                        "default": "text",
+                        "description": "Ticket format",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Printer columns (24-64)",
+                        "name": "width",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Print a QR code with the order id (ESC/POS only)",
+                        "name": "qr",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Rendered ticket",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    }
+                }
+            }
+        },
+        "/printers/": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Printers"
+                ],
+                "summary": "List printers",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/schemas.PrinterResponseSchema"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Printers receive ESC/POS tickets on their raw TCP port (9100 when omitted). A width of 0 uses the default ticket width",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Printers"
+                ],
+                "summary": "Register a network printer",
+                "parameters": [
+                    {
+                        "description": "Printer",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schemas.CreatePrinterRequestSchema"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.PrinterResponseSchema"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    }
+                }
+            }
+        },
+        "/printers/jobs": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Newest jobs first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Printers"
+                ],
+                "summary": "List the print job log",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Kitchen order ID",
+                        "name": "kitchen_order_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Printer ID",
+                        "name": "printer_id",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "pending",
+                            "printing",
+                            "printed",
+                            "failed"
+                        ],
+                        "type": "string",
+                        "description": "Job status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of jobs (default 50, max 200)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/schemas.PrintJobResponseSchema"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    }
+                }
+            }
+        },
+        "/printers/routes": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Rules are evaluated in order and the first product pattern that matches sends the item to its station. Unmatched items go to the \"kitchen\" station and \"expo\" printers always receive the full ticket",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Printers"
+                ],
+                "summary": "List the print routing rules",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/schemas.PrintRouteResponseSchema"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Replaces every rule at once, keeping the order sent. Product patterns use glob syntax, such as \"burger-*\"",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Printers"
+                ],
+                "summary": "Replace the print routing rules",
+                "parameters": [
+                    {
+                        "description": "Routing rules",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ReplacePrintRoutesRequestSchema"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/schemas.PrintRouteResponseSchema"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    }
+                }
+            }
+        },
+        "/printers/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Printers"
+                ],
+                "summary": "Get a printer by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Printer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.PrinterResponseSchema"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Inactive printers stop receiving new tickets and their pending jobs fail",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Printers"
+                ],
+                "summary": "Update a printer",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Printer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Printer",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schemas.UpdatePrinterRequestSchema"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.PrinterResponseSchema"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "The print jobs of the printer are kept in the job log",
+                "tags": [
+                    "Printers"
+                ],
+                "summary": "Delete a printer",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Printer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    }
+                }
+            }
+        },
+        "/reports/prep-times": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Average and p90 time, in seconds, that orders created in the period spent in Recebido, Em preparação and Pronto,\nfor the whole period and per hour or day (UTC), plus the products with the slowest average preparation.\nStage times come from the status history, so a recalled order adds up every pass through a status.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reports"
+                ],
+                "summary": "Kitchen preparation time report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Start of the period (RFC3339 or YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "End of the period (RFC3339 or YYYY-MM-DD, inclusive)",
+                        "name": "to",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "hour",
+                            "day"
+                        ],
+                        "type": "string",
+                        "default": "day",
+                        "description": "Period size",
+                        "name": "group_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of slowest products (default 10, max 50)",
+                        "name": "products_limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.PrepTimeReportResponseSchema"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    }
+                }
+            }
+        },
+        "/reports/throughput": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Orders received and finished per hour or per day, in UTC. Periods without orders are reported with zero.\nThe per-hour rates only count the part of each period inside the requested range.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reports"
+                ],
+                "summary": "Kitchen throughput report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Start of the period (RFC3339 or YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "End of the period (RFC3339 or YYYY-MM-DD, inclusive)",
+                        "name": "to",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "hour",
+                            "day"
+                        ],
+                        "type": "string",
+                        "default": "day",
+                        "description": "Period size",
+                        "name": "group_by",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ThroughputReportResponseSchema"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/kitchen-orders/status": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get all available order status",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "order-status"
+                ],
+                "summary": "Get all order status",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dtos.OrderStatusResponseDTO"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "List webhook subscriptions",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/schemas.WebhookSubscriptionResponseSchema"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "The secret is only returned on creation; it is generated when omitted",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Register a webhook subscription",
+                "parameters": [
+                    {
+                        "description": "Subscription",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schemas.CreateWebhookSubscriptionRequestSchema"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.WebhookSubscriptionResponseSchema"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Get a webhook subscription by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Subscription ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.WebhookSubscriptionResponseSchema"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Re-activating a subscription disabled by failures resets its failure counter",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Update a webhook subscription",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Subscription ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Subscription",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schemas.UpdateWebhookSubscriptionRequestSchema"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.WebhookSubscriptionResponseSchema"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Delete a webhook subscription",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Subscription ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/{id}/deliveries": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "List the latest delivery attempts of a webhook subscription",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Subscription ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/schemas.WebhookDeliveryResponseSchema"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/schemas.ProblemDetailsSchema"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "dtos.OrderStatusResponseDTO": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "health.ComponentReport": {
+            "type": "object",
+            "properties": {
+                "details": {
+                    "type": "object",
+                    "additionalProperties": {}
+                },
+                "duration_ms": {
+                    "type": "integer"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "health.Report": {
+            "type": "object",
+            "properties": {
+                "components": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/health.ComponentReport"
+                    }
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "schemas.APIKeyResponseSchema": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-10-01T12:00:00Z"
+                },
+                "created_by": {
+                    "type": "string",
+                    "example": "manager-1"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "key": {
+                    "type": "string",
+                    "example": "ko_3f9a1c987b2f_4f9c2b..."
+                },
+                "last_used_at": {
+                    "type": "string",
+                    "example": "2023-10-01T12:00:00Z"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "orders-service"
+                },
+                "prefix": {
+                    "type": "string",
+                    "example": "3f9a1c987b2f"
+                },
+                "revoked_at": {
+                    "type": "string",
+                    "example": "2023-10-01T12:00:00Z"
+                },
+                "scopes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "kitchen-orders:read",
+                        "kitchen-orders:create"
+                    ]
+                }
+            }
+        },
+        "schemas.BulkUpdateKitchenOrderStatusItemSchema": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "Kitchen order cannot move from its current status to the requested status"
+                },
+                "error_code": {
+                    "type": "string",
+                    "example": "invalid-kitchen-order-transition"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "kitchen_order": {
+                    "$ref": "#/definitions/schemas.KitchenOrderResponseSchema"
+                },
+                "skipped": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "schemas.BulkUpdateKitchenOrderStatusRequestSchema": {
+            "type": "object",
+            "required": [
+                "ids",
+                "status_id"
+            ],
+            "properties": {
+                "ids": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "123e4567-e89b-12d3-a456-426614174000"
+                    ]
+                },
+                "status_id": {
+                    "type": "string",
+                    "example": "bd91a1ee-1234-4cde-9c2a-efb1d2a3a789"
+                }
+            }
+        },
+        "schemas.BulkUpdateKitchenOrderStatusResponseSchema": {
+            "type": "object",
+            "properties": {
+                "failed": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schemas.BulkUpdateKitchenOrderStatusItemSchema"
+                    }
+                },
+                "skipped": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "succeeded": {
+                    "type": "integer",
+                    "example": 8
+                }
+            }
+        },
+        "schemas.CreateAPIKeyRequestSchema": {
+            "type": "object",
+            "required": [
+                "name",
+                "scopes"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "example": "orders-service"
+                },
+                "scopes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "kitchen-orders:read",
+                        "kitchen-orders:create"
+                    ]
+                }
+            }
+        },
+        "schemas.CreateKitchenOrderRequestSchema": {
+            "type": "object",
+            "required": [
+                "order_id"
+            ],
+            "properties": {
+                "customer_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schemas.CreateOrderItemRequestSchema"
+                    }
+                },
+                "order_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                }
+            }
+        },
+        "schemas.CreateOrderItemRequestSchema": {
+            "type": "object",
+            "required": [
+                "product_id",
+                "quantity"
+            ],
+            "properties": {
+                "product_id": {
+                    "type": "string",
+                    "example": "product-123"
+                },
+                "quantity": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "unit_price": {
+                    "type": "number",
+                    "minimum": 0,
+                    "example": 25.9
+                }
+            }
+        },
+        "schemas.CreatePrinterRequestSchema": {
+            "type": "object",
+            "required": [
+                "host",
+                "name",
+                "station"
+            ],
+            "properties": {
+                "host": {
+                    "type": "string",
+                    "example": "192.168.0.50"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Chapa"
+                },
+                "port": {
+                    "type": "integer",
+                    "example": 9100
+                },
+                "station": {
+                    "type": "string",
+                    "example": "grill"
+                },
+                "width": {
+                    "type": "integer",
+                    "example": 48
+                }
+            }
+        },
+        "schemas.CreateWebhookSubscriptionRequestSchema": {
+            "type": "object",
+            "required": [
+                "url"
+            ],
+            "properties": {
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "kitchen_order.ready",
+                        "kitchen_order.finished"
+                    ]
+                },
+                "secret": {
+                    "type": "string",
+                    "example": "a-shared-secret-with-16-chars-or-more"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://partner.example.com/webhooks/kitchen"
+                }
+            }
+        },
+        "schemas.DisplayBoardPreparingEntrySchema": {
+            "type": "object",
+            "properties": {
+                "slug": {
+                    "type": "string",
+                    "example": "001"
+                }
+            }
+        },
+        "schemas.DisplayBoardReadyEntrySchema": {
+            "type": "object",
+            "properties": {
+                "ready_at": {
+                    "type": "string",
+                    "example": "2023-10-01T12:00:00Z"
+                },
+                "slug": {
+                    "type": "string",
+                    "example": "002"
+                }
+            }
+        },
+        "schemas.DisplayBoardResponseSchema": {
+            "type": "object",
+            "properties": {
+                "preparing": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schemas.DisplayBoardPreparingEntrySchema"
+                    }
+                },
+                "ready": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schemas.DisplayBoardReadyEntrySchema"
+                    }
+                }
+            }
+        },
+        "schemas.KitchenDisplaySnapshotSchema": {
+            "type": "object",
+            "properties": {
+                "kitchen_orders": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schemas.KitchenOrderResponseSchema"
+                    }
+                },
+                "last_event_id": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "station": {
+                    "type": "string",
+                    "example": "grill"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "snapshot"
+                }
+            }
+        },
+        "schemas.KitchenOrderExportSchema": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number",
+                    "example": 51.8
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-10-01T12:00:00Z"
+                },
+                "customer_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "finished_at": {
+                    "type": "string",
+                    "example": "2023-10-01T12:12:00Z"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schemas.OrderItemResponseSchema"
+                    }
+                },
+                "order_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "preparation_seconds": {
+                    "type": "number",
+                    "example": 480
+                },
+                "preparing_at": {
+                    "type": "string",
+                    "example": "2023-10-01T12:02:00Z"
+                },
+                "ready_at": {
+                    "type": "string",
+                    "example": "2023-10-01T12:10:00Z"
+                },
+                "slug": {
+                    "type": "string",
+                    "example": "001"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "Finalizado"
+                },
+                "status_reason": {
+                    "type": "string",
+                    "example": "Cliente devolveu o lanche frio"
+                },
+                "total_seconds": {
+                    "type": "number",
+                    "example": 720
+                },
+                "waiting_seconds": {
+                    "type": "number",
+                    "example": 120
+                }
+            }
+        },
+        "schemas.KitchenOrderListResponseSchema": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schemas.KitchenOrderResponseSchema"
+                    }
+                },
+                "next_cursor": {
+                    "type": "string",
+                    "example": "eyJzIjoiYm9hcmQiLCJyIjoxfQ"
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 42
+                }
+            }
+        },
+        "schemas.KitchenOrderResponseSchema": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-10-01T12:00:00Z"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "order_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "slug": {
+                    "type": "string",
+                    "example": "001"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "Pronto"
+                },
+                "status_changed_by": {
+                    "type": "string",
+                    "example": "user-42"
+                },
+                "status_reason": {
+                    "type": "string",
+                    "example": "Cliente devolveu o lanche frio"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2023-10-01T12:00:00Z"
+                },
+                "version": {
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "schemas.KitchenOrderStreamEventSchema": {
+            "type": "object",
+            "properties": {
+                "actor": {
+                    "type": "string",
+                    "example": "user-42"
+                },
+                "event_id": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "kitchen_order_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "occurred_at": {
+                    "type": "string",
+                    "example": "2023-10-01T12:00:00Z"
+                },
+                "order_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "Cliente devolveu o lanche frio"
+                },
+                "slug": {
+                    "type": "string",
+                    "example": "001"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "Pronto"
+                },
+                "status_id": {
+                    "type": "string",
+                    "example": "5a8b2b16-9b47-4e35-ae27-28f7994ef456"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "status-changed"
+                }
+            }
+        },
+        "schemas.OrderItemResponseSchema": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "order_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "product_id": {
+                    "type": "string",
+                    "example": "product-123"
+                },
+                "quantity": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "unit_price": {
+                    "type": "number",
+                    "example": 25.9
+                }
+            }
+        },
+        "schemas.PrepTimeBucketSchema": {
+            "type": "object",
+            "properties": {
+                "period_start": {
+                    "type": "string",
+                    "example": "2024-01-15T00:00:00Z"
+                },
+                "stages": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schemas.StageDurationSchema"
+                    }
+                }
+            }
+        },
+        "schemas.PrepTimeReportResponseSchema": {
+            "type": "object",
+            "properties": {
+                "buckets": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schemas.PrepTimeBucketSchema"
+                    }
+                },
+                "from": {
+                    "type": "string",
+                    "example": "2024-01-15T00:00:00Z"
+                },
+                "group_by": {
+                    "type": "string",
+                    "example": "day"
+                },
+                "slowest_products": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schemas.ProductPrepTimeSchema"
+                    }
+                },
+                "stages": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schemas.StageDurationSchema"
+                    }
+                },
+                "to": {
+                    "type": "string",
+                    "example": "2024-01-21T23:59:59Z"
+                }
+            }
+        },
+        "schemas.PrintJobResponseSchema": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-10-01T12:00:00Z"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "item_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "123e4567-e89b-12d3-a456-426614174000"
+                    ]
+                },
+                "kitchen_order_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "last_error": {
+                    "type": "string",
+                    "example": "failed to connect to printer: connection refused"
+                },
+                "next_attempt_at": {
+                    "type": "string",
+                    "example": "2023-10-01T12:00:00Z"
+                },
+                "printed_at": {
+                    "type": "string",
+                    "example": "2023-10-01T12:00:00Z"
+                },
+                "printer_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "reprint": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "requested_by": {
+                    "type": "string",
+                    "example": "user-42"
+                },
+                "station": {
+                    "type": "string",
+                    "example": "grill"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "printed"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2023-10-01T12:00:00Z"
+                }
+            }
+        },
+        "schemas.PrintRouteRequestSchema": {
+            "type": "object",
+            "required": [
+                "product_pattern",
+                "station"
+            ],
+            "properties": {
+                "product_pattern": {
+                    "type": "string",
+                    "example": "burger-*"
+                },
+                "station": {
+                    "type": "string",
+                    "example": "grill"
+                }
+            }
+        },
+        "schemas.PrintRouteResponseSchema": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "position": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "product_pattern": {
+                    "type": "string",
+                    "example": "burger-*"
+                },
+                "station": {
+                    "type": "string",
+                    "example": "grill"
+                }
+            }
+        },
+        "schemas.PrinterResponseSchema": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-10-01T12:00:00Z"
+                },
+                "host": {
+                    "type": "string",
+                    "example": "192.168.0.50"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Chapa"
+                },
+                "port": {
+                    "type": "integer",
+                    "example": 9100
+                },
+                "station": {
+                    "type": "string",
+                    "example": "grill"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2023-10-01T12:00:00Z"
+                },
+                "width": {
+                    "type": "integer",
+                    "example": 48
+                }
+            }
+        },
+        "schemas.ProblemDetailsSchema": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "example": "kitchen-order-not-found"
+                },
+                "detail": {
+                    "type": "string",
+                    "example": "Kitchen Order not found"
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schemas.ProblemFieldErrorSchema"
+                    }
+                },
+                "instance": {
+                    "type": "string",
+                    "example": "/v1/kitchen-orders/123e4567-e89b-12d3-a456-426614174000"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 404
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Kitchen order not found"
+                },
+                "trace_id": {
+                    "type": "string",
+                    "example": "4bf92f3577b34da6a3ce929d0e0e4736"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "/problems/kitchen-order-not-found"
+                }
+            }
+        },
+        "schemas.ProblemFieldErrorSchema": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "example": "required"
+                },
+                "field": {
+                    "type": "string",
+                    "example": "items[0].quantity"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "is required"
+                }
+            }
+        },
+        "schemas.ProductPrepTimeSchema": {
+            "type": "object",
+            "properties": {
+                "average_seconds": {
+                    "type": "number",
+                    "example": 640
+                },
+                "orders": {
+                    "type": "integer",
+                    "example": 35
+                },
+                "p90_seconds": {
+                    "type": "number",
+                    "example": 910
+                },
+                "product_id": {
+                    "type": "string",
+                    "example": "x-burger-duplo"
+                }
+            }
+        },
+        "schemas.RecallKitchenOrderRequestSchema": {
+            "type": "object",
+            "required": [
+                "reason"
+            ],
+            "properties": {
+                "reason": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "Cliente devolveu o lanche frio"
+                }
+            }
+        },
+        "schemas.ReplacePrintRoutesRequestSchema": {
+            "type": "object",
+            "required": [
+                "routes"
+            ],
+            "properties": {
+                "routes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schemas.PrintRouteRequestSchema"
+                    }
+                }
+            }
+        },
+        "schemas.ReprintKitchenOrderRequestSchema": {
+            "type": "object",
+            "properties": {
+                "printer_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                }
+            }
+        },
+        "schemas.StageDurationSchema": {
+            "type": "object",
+            "properties": {
+                "average_seconds": {
+                    "type": "number",
+                    "example": 412.5
+                },
+                "orders": {
+                    "type": "integer",
+                    "example": 120
+                },
+                "p90_seconds": {
+                    "type": "number",
+                    "example": 780
+                },
+                "status_id": {
+                    "type": "string",
+                    "example": "3f6c2b1e-8f1a-4c2d-9b7e-1a2b3c4d5e6f"
+                },
+                "status_name": {
+                    "type": "string",
+                    "example": "Em preparação"
+                }
+            }
+        },
+        "schemas.ThroughputBucketSchema": {
+            "type": "object",
+            "properties": {
+                "finished": {
+                    "type": "integer",
+                    "example": 40
+                },
+                "finished_per_hour": {
+                    "type": "number",
+                    "example": 40
+                },
+                "period_start": {
+                    "type": "string",
+                    "example": "2024-01-15T12:00:00Z"
+                },
+                "received": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "received_per_hour": {
+                    "type": "number",
+                    "example": 42
+                }
+            }
+        },
+        "schemas.ThroughputReportResponseSchema": {
+            "type": "object",
+            "properties": {
+                "buckets": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schemas.ThroughputBucketSchema"
+                    }
+                },
+                "finished": {
+                    "type": "integer",
+                    "example": 305
+                },
+                "finished_per_hour": {
+                    "type": "number",
+                    "example": 12.71
+                },
+                "from": {
+                    "type": "string",
+                    "example": "2024-01-15T00:00:00Z"
+                },
+                "group_by": {
+                    "type": "string",
+                    "example": "hour"
+                },
+                "received": {
+                    "type": "integer",
+                    "example": 310
+                },
+                "received_per_hour": {
+                    "type": "number",
+                    "example": 12.92
+                },
+                "to": {
+                    "type": "string",
+                    "example": "2024-01-15T23:59:59Z"
+                }
+            }
+        },
+        "schemas.UpdateKitchenOrderRequestSchema": {
+            "type": "object",
+            "required": [
+                "status_id"
+            ],
+            "properties": {
+                "status_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "schemas.UpdatePrinterRequestSchema": {
+            "type": "object",
+            "required": [
+                "active",
+                "host",
+                "name",
+                "station"
+            ],
+            "properties": {
+                "active": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "host": {
+                    "type": "string",
+                    "example": "192.168.0.50"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Chapa"
+                },
+                "port": {
+                    "type": "integer",
+                    "example": 9100
+                },
+                "station": {
+                    "type": "string",
+                    "example": "grill"
+                },
+                "width": {
+                    "type": "integer",
+                    "example": 48
+                }
+            }
+        },
+        "schemas.UpdateWebhookSubscriptionRequestSchema": {
+            "type": "object",
+            "required": [
+                "active",
+                "url"
+            ],
+            "properties": {
+                "active": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "kitchen_order.ready"
+                    ]
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://partner.example.com/webhooks/kitchen"
+                }
+            }
+        },
+        "schemas.WebhookDeliveryResponseSchema": {
+            "type": "object",
+            "properties": {
+                "attempt": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-10-01T12:00:00Z"
+                },
+                "duration_ms": {
+                    "type": "integer",
+                    "example": 120
+                },
+                "error": {
+                    "type": "string",
+                    "example": "unexpected status code 500"
+                },
+                "event": {
+                    "type": "string",
+                    "example": "kitchen_order.ready"
+                },
+                "event_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "status_code": {
+                    "type": "integer",
+                    "example": 200
+                },
+                "subscription_id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "schemas.WebhookSubscriptionResponseSchema": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "consecutive_failures": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-10-01T12:00:00Z"
+                },
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "kitchen_order.ready"
+                    ]
+                },
+                "id": {
+                    "type": "string",
+                    "example": "123e4567-e89b-12d3-a456-426614174000"
+                },
+                "secret": {
+                    "type": "string",
+                    "example": "4f9c2b..."
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2023-10-01T12:00:00Z"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://partner.example.com/webhooks/kitchen"
+                }
+            }
+        }
+    },
+    "securityDefinitions": {
+        "ApiKeyAuth": {
+            "description": "API key for service-to-service calls, limited to the scopes granted on creation",
+            "type": "apiKey",
+            "name": "X-API-Key",
+            "in": "header"
+        },
+        "BearerAuth": {
+            "description": "JWT access token in the format \"Bearer {token}\"",
+            "type": "apiKey",
+            "name": "Authorization",
+            "in": "header"
         }
     }
 }`
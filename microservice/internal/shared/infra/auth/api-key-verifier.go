@@ -0,0 +1,8 @@
+package auth
+
+import "context"
+
+// APIKeyVerifier valida a chave enviada por outro serviço e devolve o ator com os escopos da chave
+type APIKeyVerifier interface {
+	VerifyAPIKey(ctx context.Context, key string) (Principal, error)
+}
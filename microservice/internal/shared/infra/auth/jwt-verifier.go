@@ -0,0 +1,79 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var ErrMissingSubject = errors.New("token has no subject")
+
+// TokenVerifier valida o token de acesso e devolve o ator autenticado
+type TokenVerifier interface {
+	Verify(ctx context.Context, token string) (Principal, error)
+}
+
+type JWTVerifierConfig struct {
+	Issuer     string
+	Audience   string
+	RolesClaim string
+	ClockSkew  time.Duration
+}
+
+type JWTVerifier struct {
+	keys   KeySource
+	config JWTVerifierConfig
+	parser *jwt.Parser
+}
+
+func NewJWTVerifier(keys KeySource, config JWTVerifierConfig) *JWTVerifier {
+	options := []jwt.ParserOption{
+		// Apenas algoritmos assimétricos: a API nunca conhece o segredo de quem emite os tokens
+		jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(config.ClockSkew),
+	}
+
+	if config.Issuer != "" {
+		options = append(options, jwt.WithIssuer(config.Issuer))
+	}
+
+	if config.Audience != "" {
+		options = append(options, jwt.WithAudience(config.Audience))
+	}
+
+	if config.RolesClaim == "" {
+		config.RolesClaim = "roles"
+	}
+
+	return &JWTVerifier{
+		keys:   keys,
+		config: config,
+		parser: jwt.NewParser(options...),
+	}
+}
+
+func (v *JWTVerifier) Verify(ctx context.Context, token string) (Principal, error) {
+	claims := jwt.MapClaims{}
+
+	_, err := v.parser.ParseWithClaims(token, claims, func(parsed *jwt.Token) (any, error) {
+		kid, _ := parsed.Header["kid"].(string)
+		return v.keys.Key(ctx, kid)
+	})
+	if err != nil {
+		return Principal{}, fmt.Errorf("invalid token: %w", err)
+	}
+
+	subject, err := claims.GetSubject()
+	if err != nil || subject == "" {
+		return Principal{}, ErrMissingSubject
+	}
+
+	return Principal{
+		Subject: subject,
+		Roles:   rolesFromClaim(claims[v.config.RolesClaim]),
+	}, nil
+}
@@ -0,0 +1,307 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tech_challenge/internal/shared/config/constants"
+)
+
+func generateRSAKey(t *testing.T) *rsa.PrivateKey {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	return key
+}
+
+func signToken(t *testing.T, key *rsa.PrivateKey, kid string, claims jwt.MapClaims) string {
+	t.Helper()
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = kid
+
+	signed, err := token.SignedString(key)
+	require.NoError(t, err)
+	return signed
+}
+
+func validClaims() jwt.MapClaims {
+	return jwt.MapClaims{
+		"sub":   "user-1",
+		"iss":   "https://auth.example.com",
+		"aud":   "kitchen-api",
+		"exp":   time.Now().Add(time.Hour).Unix(),
+		"roles": []string{"cook"},
+	}
+}
+
+func writePublicKeyFile(t *testing.T, key any) string {
+	t.Helper()
+
+	der, err := x509.MarshalPKIXPublicKey(key)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "public.pem")
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0o600))
+	return path
+}
+
+func newStaticVerifier(t *testing.T, key *rsa.PrivateKey) *JWTVerifier {
+	t.Helper()
+
+	keys, err := NewStaticKeySourceFromFile(writePublicKeyFile(t, &key.PublicKey))
+	require.NoError(t, err)
+
+	return NewJWTVerifier(keys, JWTVerifierConfig{
+		Issuer:   "https://auth.example.com",
+		Audience: "kitchen-api",
+	})
+}
+
+func TestJWTVerifier_ValidToken(t *testing.T) {
+	key := generateRSAKey(t)
+	verifier := newStaticVerifier(t, key)
+
+	principal, err := verifier.Verify(context.Background(), signToken(t, key, "", validClaims()))
+
+	require.NoError(t, err)
+	assert.Equal(t, "user-1", principal.Subject)
+	assert.Equal(t, []string{constants.ROLE_COOK}, principal.Roles)
+	t.Log("✓ Token válido autenticado com a chave local")
+}
+
+func TestJWTVerifier_RejectsInvalidTokens(t *testing.T) {
+	key := generateRSAKey(t)
+	verifier := newStaticVerifier(t, key)
+
+	withClaim := func(name string, value any) jwt.MapClaims {
+		claims := validClaims()
+		if value == nil {
+			delete(claims, name)
+		} else {
+			claims[name] = value
+		}
+		return claims
+	}
+
+	hmacToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256, validClaims()).SignedString([]byte("secret"))
+	require.NoError(t, err)
+
+	testCases := map[string]string{
+		"expired":         signToken(t, key, "", withClaim("exp", time.Now().Add(-time.Hour).Unix())),
+		"without exp":     signToken(t, key, "", withClaim("exp", nil)),
+		"wrong issuer":    signToken(t, key, "", withClaim("iss", "https://evil.example.com")),
+		"wrong audience":  signToken(t, key, "", withClaim("aud", "other-api")),
+		"without subject": signToken(t, key, "", withClaim("sub", nil)),
+		"other key":       signToken(t, generateRSAKey(t), "", validClaims()),
+		"hmac":            hmacToken,
+		"garbage":         "not-a-token",
+	}
+
+	for name, token := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := verifier.Verify(context.Background(), token)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestJWTVerifier_ClockSkew(t *testing.T) {
+	key := generateRSAKey(t)
+	keys, err := NewStaticKeySourceFromFile(writePublicKeyFile(t, &key.PublicKey))
+	require.NoError(t, err)
+
+	verifier := NewJWTVerifier(keys, JWTVerifierConfig{ClockSkew: time.Minute})
+
+	claims := validClaims()
+	claims["exp"] = time.Now().Add(-30 * time.Second).Unix()
+
+	_, err = verifier.Verify(context.Background(), signToken(t, key, "", claims))
+	assert.NoError(t, err)
+}
+
+func TestStaticKeySource_ECKey(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	keys, err := NewStaticKeySourceFromFile(writePublicKeyFile(t, &key.PublicKey))
+	require.NoError(t, err)
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodES256, validClaims()).SignedString(key)
+	require.NoError(t, err)
+
+	principal, err := NewJWTVerifier(keys, JWTVerifierConfig{}).Verify(context.Background(), token)
+	require.NoError(t, err)
+	assert.Equal(t, "user-1", principal.Subject)
+}
+
+func TestStaticKeySource_InvalidFile(t *testing.T) {
+	_, err := NewStaticKeySourceFromFile(filepath.Join(t.TempDir(), "missing.pem"))
+	assert.Error(t, err)
+
+	path := filepath.Join(t.TempDir(), "invalid.pem")
+	require.NoError(t, os.WriteFile(path, []byte("not a key"), 0o600))
+
+	_, err = NewStaticKeySourceFromFile(path)
+	assert.Error(t, err)
+}
+
+func rsaJWK(kid string, key *rsa.PublicKey) map[string]string {
+	return map[string]string{
+		"kid": kid,
+		"kty": "RSA",
+		"use": "sig",
+		"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+	}
+}
+
+func TestJWKSKeySource_FetchesAndRotatesKeys(t *testing.T) {
+	oldKey := generateRSAKey(t)
+	newKey := generateRSAKey(t)
+
+	var requests atomic.Int32
+	var rotated atomic.Bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+
+		keys := []map[string]string{rsaJWK("old", &oldKey.PublicKey)}
+		if rotated.Load() {
+			keys = append(keys, rsaJWK("new", &newKey.PublicKey))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"keys": keys})
+	}))
+	defer server.Close()
+
+	source := NewJWKSKeySource(server.URL, time.Hour, time.Second)
+	source.minRefreshInterval = 0
+	verifier := NewJWTVerifier(source, JWTVerifierConfig{})
+
+	_, err := verifier.Verify(context.Background(), signToken(t, oldKey, "old", validClaims()))
+	require.NoError(t, err)
+
+	_, err = verifier.Verify(context.Background(), signToken(t, oldKey, "old", validClaims()))
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), requests.Load(), "known kid should be served from cache")
+
+	// Um kid novo força a releitura do JWKS antes do intervalo de atualização
+	rotated.Store(true)
+	principal, err := verifier.Verify(context.Background(), signToken(t, newKey, "new", validClaims()))
+	require.NoError(t, err)
+	assert.Equal(t, "user-1", principal.Subject)
+	assert.Equal(t, int32(2), requests.Load())
+
+	_, err = verifier.Verify(context.Background(), signToken(t, newKey, "unknown", validClaims()))
+	assert.ErrorIs(t, err, ErrUnknownSigningKey)
+	t.Log("✓ Chaves do JWKS mantidas em cache e atualizadas na rotação")
+}
+
+func TestJWKSKeySource_UnknownKidRefreshIsRateLimited(t *testing.T) {
+	key := generateRSAKey(t)
+
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		_ = json.NewEncoder(w).Encode(map[string]any{"keys": []map[string]string{rsaJWK("current", &key.PublicKey)}})
+	}))
+	defer server.Close()
+
+	verifier := NewJWTVerifier(NewJWKSKeySource(server.URL, time.Hour, time.Second), JWTVerifierConfig{})
+
+	for i := 0; i < 5; i++ {
+		_, err := verifier.Verify(context.Background(), signToken(t, key, "forged", validClaims()))
+		assert.Error(t, err)
+	}
+
+	assert.Equal(t, int32(1), requests.Load())
+}
+
+func TestJWKSKeySource_ServesCachedKeysWhileRefreshing(t *testing.T) {
+	key := generateRSAKey(t)
+
+	var requests atomic.Int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Só a primeira busca responde na hora; a atualização fica presa até o teste liberar
+		if requests.Add(1) > 1 {
+			<-release
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"keys": []map[string]string{rsaJWK("current", &key.PublicKey)}})
+	}))
+	defer server.Close()
+	defer close(release)
+
+	source := NewJWKSKeySource(server.URL, 10*time.Millisecond, time.Second)
+
+	_, err := source.Key(context.Background(), "current")
+	require.NoError(t, err)
+
+	time.Sleep(20 * time.Millisecond)
+
+	for i := 0; i < 5; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		publicKey, err := source.Key(ctx, "current")
+		cancel()
+
+		require.NoError(t, err)
+		assert.Equal(t, &key.PublicKey, publicKey)
+	}
+
+	assert.Eventually(t, func() bool { return requests.Load() == 2 }, time.Second, 5*time.Millisecond)
+	assert.Equal(t, int32(2), requests.Load(), "concurrent refreshes should be coalesced")
+	t.Log("✓ Chave em cache servida enquanto a única atualização do JWKS está em andamento")
+}
+
+func TestJWKSKeySource_WaitingCallerHonorsContext(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	source := NewJWKSKeySource(server.URL, time.Hour, time.Second)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err := source.Key(ctx, "any")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	t.Log("✓ Requisição sem a chave em cache desiste no próprio prazo sem cancelar a busca")
+}
+
+func TestJWKSKeySource_Unavailable(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	key := generateRSAKey(t)
+	verifier := NewJWTVerifier(NewJWKSKeySource(server.URL, time.Hour, time.Second), JWTVerifierConfig{})
+
+	_, err := verifier.Verify(context.Background(), signToken(t, key, "any", validClaims()))
+	assert.Error(t, err)
+}
@@ -0,0 +1,257 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var ErrUnknownSigningKey = errors.New("unknown signing key")
+
+// KeySource resolve a chave pública que assinou o token a partir do kid do cabeçalho
+type KeySource interface {
+	Key(ctx context.Context, kid string) (crypto.PublicKey, error)
+}
+
+// StaticKeySource usa uma única chave PEM, pensada para desenvolvimento local
+type StaticKeySource struct {
+	key crypto.PublicKey
+}
+
+func NewStaticKeySourceFromFile(path string) (*StaticKeySource, error) {
+	pemBytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read public key file: %w", err)
+	}
+
+	if key, err := jwt.ParseRSAPublicKeyFromPEM(pemBytes); err == nil {
+		return &StaticKeySource{key: key}, nil
+	}
+
+	key, err := jwt.ParseECPublicKeyFromPEM(pemBytes)
+	if err != nil {
+		return nil, fmt.Errorf("public key file must contain an RSA or EC public key: %w", err)
+	}
+
+	return &StaticKeySource{key: key}, nil
+}
+
+func (s *StaticKeySource) Key(_ context.Context, _ string) (crypto.PublicKey, error) {
+	return s.key, nil
+}
+
+type jsonWebKey struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+type jsonWebKeySet struct {
+	Keys []jsonWebKey `json:"keys"`
+}
+
+// JWKSKeySource mantém em cache as chaves publicadas pelo provedor de identidade.
+// A busca roda uma por vez, fora do lock e com o próprio timeout; enquanto ela não termina as chaves em cache continuam sendo servidas
+type JWKSKeySource struct {
+	url             string
+	client          *http.Client
+	refreshInterval time.Duration
+	// minRefreshInterval limita as buscas disparadas por kids desconhecidos
+	minRefreshInterval time.Duration
+	timeout            time.Duration
+
+	mu          sync.Mutex
+	keys        map[string]crypto.PublicKey
+	refreshedAt time.Time
+	// refreshing é fechado quando a busca em andamento termina; nil quando nenhuma está rodando
+	refreshing chan struct{}
+	refreshErr error
+}
+
+func NewJWKSKeySource(url string, refreshInterval time.Duration, timeout time.Duration) *JWKSKeySource {
+	return &JWKSKeySource{
+		url:                url,
+		client:             &http.Client{},
+		refreshInterval:    refreshInterval,
+		minRefreshInterval: time.Minute,
+		timeout:            timeout,
+		keys:               map[string]crypto.PublicKey{},
+	}
+}
+
+func (s *JWKSKeySource) Key(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	s.mu.Lock()
+	key, known := s.keys[kid]
+
+	// Kid desconhecido pode indicar rotação de chaves no provedor
+	stale := s.refreshedAt.IsZero() || time.Since(s.refreshedAt) >= s.refreshInterval
+	if stale || (!known && time.Since(s.refreshedAt) >= s.minRefreshInterval) {
+		s.startRefresh()
+	}
+	refreshing := s.refreshing
+	s.mu.Unlock()
+
+	if known {
+		return key, nil
+	}
+
+	if refreshing == nil {
+		return nil, ErrUnknownSigningKey
+	}
+
+	// Só espera a busca quem precisa de uma chave que ainda não está em cache
+	select {
+	case <-refreshing:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if key, ok := s.keys[kid]; ok {
+		return key, nil
+	}
+
+	if s.refreshErr != nil {
+		return nil, s.refreshErr
+	}
+
+	return nil, ErrUnknownSigningKey
+}
+
+// startRefresh dispara a busca do JWKS se nenhuma estiver em andamento; deve ser chamado com s.mu travado
+func (s *JWKSKeySource) startRefresh() {
+	if s.refreshing != nil {
+		return
+	}
+
+	// Mesmo com falha a busca só é repetida após o intervalo mínimo
+	s.refreshedAt = time.Now()
+
+	done := make(chan struct{})
+	s.refreshing = done
+
+	go func() {
+		defer close(done)
+
+		// A busca não depende da requisição que a disparou: as demais podem estar esperando por ela
+		ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+		defer cancel()
+
+		keys, err := s.fetch(ctx)
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		if err == nil {
+			s.keys = keys
+		}
+		s.refreshErr = err
+		s.refreshing = nil
+	}()
+}
+
+func (s *JWKSKeySource) fetch(ctx context.Context) (map[string]crypto.PublicKey, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build JWKS request: %w", err)
+	}
+
+	response, err := s.client.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch JWKS: %w", err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch JWKS: unexpected status code %d", response.StatusCode)
+	}
+
+	var keySet jsonWebKeySet
+	if err := json.NewDecoder(response.Body).Decode(&keySet); err != nil {
+		return nil, fmt.Errorf("failed to decode JWKS: %w", err)
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(keySet.Keys))
+	for _, jwk := range keySet.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+
+		key, err := jwk.publicKey()
+		if err != nil {
+			// Uma chave malformada não invalida as demais
+			continue
+		}
+		keys[jwk.Kid] = key
+	}
+
+	return keys, nil
+}
+
+func (k jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBase64URLInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBase64URLInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+
+		x, err := decodeBase64URLInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBase64URLInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+}
+
+func decodeBase64URLInt(value string) (*big.Int, error) {
+	bytes, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(bytes), nil
+}
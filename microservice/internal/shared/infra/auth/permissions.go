@@ -0,0 +1,48 @@
+package auth
+
+import "tech_challenge/internal/shared/config/constants"
+
+var (
+	// ReadRoles podem consultar pedidos, status e acompanhar o quadro da cozinha
+	ReadRoles = []string{constants.ROLE_COOK, constants.ROLE_EXPO, constants.ROLE_MANAGER, constants.ROLE_SERVICE}
+
+	// WriteRoles podem criar pedidos e definir qualquer status pelo PUT
+	WriteRoles = []string{constants.ROLE_MANAGER, constants.ROLE_SERVICE}
+
+	// ManagerRoles cobrem as operações em massa e a administração da API
+	ManagerRoles = []string{constants.ROLE_MANAGER}
+
+	// WebhookRoles administram as assinaturas de webhooks
+	WebhookRoles = []string{constants.ROLE_MANAGER, constants.ROLE_SERVICE}
+
+	// ReprintRoles cobrem a linha de produção, que pede de novo a comanda perdida ou borrada
+	ReprintRoles = []string{constants.ROLE_COOK, constants.ROLE_EXPO, constants.ROLE_MANAGER}
+)
+
+// Permission combina os papéis de usuários com o escopo de API key que dão acesso à mesma operação
+type Permission struct {
+	Roles []string
+	Scope string
+}
+
+var (
+	ReadKitchenOrders   = Permission{Roles: ReadRoles, Scope: constants.API_KEY_SCOPE_KITCHEN_ORDERS_READ}
+	CreateKitchenOrders = Permission{Roles: WriteRoles, Scope: constants.API_KEY_SCOPE_KITCHEN_ORDERS_CREATE}
+	ManageWebhooks      = Permission{Roles: WebhookRoles, Scope: constants.API_KEY_SCOPE_WEBHOOKS_MANAGE}
+	ManagePrinters      = Permission{Roles: ManagerRoles, Scope: constants.API_KEY_SCOPE_PRINTERS_MANAGE}
+	ReadReports         = Permission{Roles: ManagerRoles, Scope: constants.API_KEY_SCOPE_REPORTS_READ}
+)
+
+// kitchenOrderCommandRoles segue a divisão da cozinha: a produção inicia e finaliza o preparo, a expedição entrega e faz o recall
+var kitchenOrderCommandRoles = map[string][]string{
+	constants.KITCHEN_ORDER_COMMAND_START:  {constants.ROLE_COOK, constants.ROLE_MANAGER},
+	constants.KITCHEN_ORDER_COMMAND_READY:  {constants.ROLE_COOK, constants.ROLE_MANAGER},
+	constants.KITCHEN_ORDER_COMMAND_FINISH: {constants.ROLE_EXPO, constants.ROLE_MANAGER},
+	constants.KITCHEN_ORDER_COMMAND_RECALL: {constants.ROLE_EXPO, constants.ROLE_MANAGER},
+}
+
+// KitchenOrderCommandRoles devolve os papéis que podem executar o comando
+func KitchenOrderCommandRoles(command string) ([]string, bool) {
+	roles, ok := kitchenOrderCommandRoles[command]
+	return roles, ok
+}
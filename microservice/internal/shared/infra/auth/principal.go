@@ -0,0 +1,88 @@
+package auth
+
+import (
+	"slices"
+	"strings"
+
+	"tech_challenge/internal/shared/config/constants"
+)
+
+// knownRoles são os papéis reconhecidos pela API; valores desconhecidos nas claims são ignorados
+var knownRoles = []string{
+	constants.ROLE_COOK,
+	constants.ROLE_EXPO,
+	constants.ROLE_MANAGER,
+	constants.ROLE_SERVICE,
+}
+
+// Principal é o ator autenticado da requisição; usuários recebem papéis pelo token e serviços recebem escopos pela API key
+type Principal struct {
+	Subject string
+	// Name é o nome legível do ator, apenas para exibição; identidade, escopo de chaves e limites usam o Subject
+	Name   string
+	Roles  []string
+	Scopes []string
+}
+
+func (p Principal) HasAnyRole(roles ...string) bool {
+	for _, role := range roles {
+		if slices.Contains(p.Roles, role) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (p Principal) HasScope(scope string) bool {
+	return scope != "" && slices.Contains(p.Scopes, scope)
+}
+
+// Can libera o ator que tenha um dos papéis ou o escopo da permissão
+func (p Principal) Can(permission Permission) bool {
+	return p.HasAnyRole(permission.Roles...) || p.HasScope(permission.Scope)
+}
+
+// rolesFromClaim aceita a claim como lista ou como string separada por espaços, no formato do scope do OAuth
+func rolesFromClaim(value any) []string {
+	var values []string
+
+	switch claim := value.(type) {
+	case string:
+		values = strings.Fields(claim)
+	case []any:
+		for _, item := range claim {
+			if role, ok := item.(string); ok {
+				values = append(values, role)
+			}
+		}
+	case []string:
+		values = claim
+	}
+
+	roles := make([]string, 0, len(values))
+	for _, value := range values {
+		role := strings.ToLower(strings.TrimSpace(value))
+		if slices.Contains(knownRoles, role) && !slices.Contains(roles, role) {
+			roles = append(roles, role)
+		}
+	}
+
+	return roles
+}
+
+// contextGetter é satisfeito pelo *gin.Context sem acoplar o pacote ao framework HTTP
+type contextGetter interface {
+	Get(key string) (any, bool)
+}
+
+// PrincipalFromContext devolve o ator guardado pelo middleware de autenticação
+func PrincipalFromContext(ctx contextGetter) (Principal, bool) {
+	value, ok := ctx.Get(constants.AUTH_PRINCIPAL_CONTEXT_KEY)
+	if !ok {
+		return Principal{}, false
+	}
+
+	principal, ok := value.(Principal)
+	return principal, ok
+}
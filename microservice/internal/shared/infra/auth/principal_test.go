@@ -0,0 +1,70 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"tech_challenge/internal/shared/config/constants"
+)
+
+func TestRolesFromClaim(t *testing.T) {
+	testCases := []struct {
+		name     string
+		claim    any
+		expected []string
+	}{
+		{"array", []any{"cook", "EXPO"}, []string{constants.ROLE_COOK, constants.ROLE_EXPO}},
+		{"string list", []string{"manager"}, []string{constants.ROLE_MANAGER}},
+		{"space separated", "service  cook", []string{constants.ROLE_SERVICE, constants.ROLE_COOK}},
+		{"unknown and repeated roles", []any{"admin", "cook", "cook", 42}, []string{constants.ROLE_COOK}},
+		{"missing claim", nil, []string{}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, rolesFromClaim(tc.claim))
+		})
+	}
+
+	t.Log("✓ Papéis extraídos das claims do token")
+}
+
+func TestPrincipal_HasAnyRole(t *testing.T) {
+	principal := Principal{Subject: "user-1", Roles: []string{constants.ROLE_EXPO}}
+
+	assert.True(t, principal.HasAnyRole(constants.ROLE_COOK, constants.ROLE_EXPO))
+	assert.False(t, principal.HasAnyRole(ManagerRoles...))
+	assert.False(t, Principal{}.HasAnyRole(ReadRoles...))
+}
+
+func TestKitchenOrderCommandRoles(t *testing.T) {
+	cook := Principal{Roles: []string{constants.ROLE_COOK}}
+	expo := Principal{Roles: []string{constants.ROLE_EXPO}}
+
+	startRoles, ok := KitchenOrderCommandRoles(constants.KITCHEN_ORDER_COMMAND_START)
+	assert.True(t, ok)
+	assert.True(t, cook.HasAnyRole(startRoles...))
+	assert.False(t, expo.HasAnyRole(startRoles...))
+
+	finishRoles, ok := KitchenOrderCommandRoles(constants.KITCHEN_ORDER_COMMAND_FINISH)
+	assert.True(t, ok)
+	assert.True(t, expo.HasAnyRole(finishRoles...))
+	assert.False(t, cook.HasAnyRole(finishRoles...))
+
+	_, ok = KitchenOrderCommandRoles("explode")
+	assert.False(t, ok)
+	t.Log("✓ Comandos liberados conforme a função na cozinha")
+}
+
+func TestPrincipal_Can(t *testing.T) {
+	user := Principal{Subject: "cook-1", Roles: []string{constants.ROLE_COOK}}
+	service := Principal{Subject: "api-key:orders-service", Scopes: []string{constants.API_KEY_SCOPE_KITCHEN_ORDERS_CREATE}}
+
+	assert.True(t, user.Can(ReadKitchenOrders))
+	assert.False(t, user.Can(CreateKitchenOrders))
+	assert.True(t, service.Can(CreateKitchenOrders))
+	assert.False(t, service.Can(ReadKitchenOrders))
+	assert.False(t, service.Can(Permission{Roles: ManagerRoles}))
+	t.Log("✓ Permissões avaliadas por papel ou escopo")
+}
@@ -0,0 +1,132 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"text/tabwriter"
+	"time"
+
+	"tech_challenge/internal/shared/config/env"
+	"tech_challenge/internal/shared/infra/database"
+	"tech_challenge/internal/shared/pkg/logger"
+)
+
+const migrateUsage = `usage: main migrate <command>
+
+commands:
+  up          apply all pending migrations
+  down [N]    revert the last N applied migrations (default 1)
+  status      list migrations and when they were applied`
+
+type migrateCommand struct {
+	name  string
+	steps int
+}
+
+func parseMigrateArgs(args []string) (migrateCommand, error) {
+	if len(args) == 0 {
+		return migrateCommand{}, fmt.Errorf("missing migrate command\n%s", migrateUsage)
+	}
+
+	command := migrateCommand{name: args[0], steps: 1}
+
+	switch command.name {
+	case "up", "status":
+		if len(args) > 1 {
+			return migrateCommand{}, fmt.Errorf("%s takes no arguments\n%s", command.name, migrateUsage)
+		}
+	case "down":
+		if len(args) > 2 {
+			return migrateCommand{}, fmt.Errorf("down takes at most one argument\n%s", migrateUsage)
+		}
+		if len(args) == 2 {
+			steps, err := strconv.Atoi(args[1])
+			if err != nil || steps < 1 {
+				return migrateCommand{}, fmt.Errorf("down expects a positive number of migrations, got %q", args[1])
+			}
+			command.steps = steps
+		}
+	default:
+		return migrateCommand{}, fmt.Errorf("unknown migrate command %q\n%s", command.name, migrateUsage)
+	}
+
+	return command, nil
+}
+
+// Migrate executa o subcomando "migrate" com a configuração de banco do ambiente e devolve o código de saída
+func Migrate(args []string, out io.Writer) int {
+	command, err := parseMigrateArgs(args)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 2
+	}
+
+	config := env.GetConfig()
+	logger.Configure(logger.Options{Level: config.Log.Level, JSON: config.Log.JSON})
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	database.Connect()
+	defer database.Close()
+
+	migrator, err := database.NewDefaultMigrator()
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to load migrations", logger.ErrorKey, err)
+		return 1
+	}
+
+	if err := runMigrateCommand(ctx, migrator, command, out); err != nil {
+		logger.FromContext(ctx).Error("Migrate command failed", "command", command.name, logger.ErrorKey, err)
+		return 1
+	}
+
+	return 0
+}
+
+func runMigrateCommand(ctx context.Context, migrator *database.Migrator, command migrateCommand, out io.Writer) error {
+	switch command.name {
+	case "up":
+		applied, err := migrator.Up(ctx)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "%d migrations applied\n", applied)
+
+	case "down":
+		reverted, err := migrator.Down(ctx, command.steps)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "%d migrations reverted\n", reverted)
+
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+		writeMigrationStatus(out, statuses)
+	}
+
+	return nil
+}
+
+func writeMigrationStatus(out io.Writer, statuses []database.MigrationStatus) {
+	writer := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, "VERSION\tNAME\tAPPLIED AT")
+
+	for _, status := range statuses {
+		appliedAt := "pending"
+		if status.AppliedAt != nil {
+			appliedAt = status.AppliedAt.UTC().Format(time.RFC3339)
+		}
+		fmt.Fprintf(writer, "%06d\t%s\t%s\n", status.Version, status.Name, appliedAt)
+	}
+
+	writer.Flush()
+}
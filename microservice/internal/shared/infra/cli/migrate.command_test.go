@@ -0,0 +1,104 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
+
+	"tech_challenge/internal/shared/infra/database"
+)
+
+func newTestMigrator(t *testing.T) *database.Migrator {
+	t.Helper()
+
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{Logger: gormlogger.Discard})
+	require.NoError(t, err)
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { sqlDB.Close() })
+
+	migrator, err := database.NewMigrator(db, fstest.MapFS{
+		"000001_create_note.up.sql":     {Data: []byte("CREATE TABLE note (id text);")},
+		"000001_create_note.down.sql":   {Data: []byte("DROP TABLE note;")},
+		"000002_add_note_body.up.sql":   {Data: []byte("ALTER TABLE note ADD COLUMN body text;")},
+		"000002_add_note_body.down.sql": {Data: []byte("ALTER TABLE note DROP COLUMN body;")},
+	})
+	require.NoError(t, err)
+
+	return migrator
+}
+
+func TestParseMigrateArgs(t *testing.T) {
+	testCases := []struct {
+		name    string
+		args    []string
+		want    migrateCommand
+		wantErr bool
+	}{
+		{"up", []string{"up"}, migrateCommand{name: "up", steps: 1}, false},
+		{"status", []string{"status"}, migrateCommand{name: "status", steps: 1}, false},
+		{"down defaults to one", []string{"down"}, migrateCommand{name: "down", steps: 1}, false},
+		{"down with steps", []string{"down", "3"}, migrateCommand{name: "down", steps: 3}, false},
+		{"missing command", nil, migrateCommand{}, true},
+		{"unknown command", []string{"redo"}, migrateCommand{}, true},
+		{"down with zero", []string{"down", "0"}, migrateCommand{}, true},
+		{"down with text", []string{"down", "all"}, migrateCommand{}, true},
+		{"up with arguments", []string{"up", "2"}, migrateCommand{}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			command, err := parseMigrateArgs(tc.args)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, command)
+		})
+	}
+	t.Log("✓ Argumentos do migrate validados antes de conectar no banco")
+}
+
+func TestMigrate_InvalidArgsExitCode(t *testing.T) {
+	var out bytes.Buffer
+
+	assert.Equal(t, 2, Migrate([]string{"sideways"}, &out))
+	assert.Empty(t, out.String())
+	t.Log("✓ Comando inválido sai com código 2")
+}
+
+func TestRunMigrateCommand(t *testing.T) {
+	ctx := context.Background()
+	migrator := newTestMigrator(t)
+	var out bytes.Buffer
+
+	require.NoError(t, runMigrateCommand(ctx, migrator, migrateCommand{name: "status", steps: 1}, &out))
+	assert.Contains(t, out.String(), "VERSION")
+	assert.Contains(t, out.String(), "000001   create_note    pending")
+
+	out.Reset()
+	require.NoError(t, runMigrateCommand(ctx, migrator, migrateCommand{name: "up", steps: 1}, &out))
+	assert.Equal(t, "2 migrations applied\n", out.String())
+
+	out.Reset()
+	require.NoError(t, runMigrateCommand(ctx, migrator, migrateCommand{name: "status", steps: 1}, &out))
+	assert.NotContains(t, out.String(), "pending")
+
+	out.Reset()
+	require.NoError(t, runMigrateCommand(ctx, migrator, migrateCommand{name: "down", steps: 1}, &out))
+	assert.Equal(t, "1 migrations reverted\n", out.String())
+
+	out.Reset()
+	require.NoError(t, runMigrateCommand(ctx, migrator, migrateCommand{name: "status", steps: 1}, &out))
+	assert.Contains(t, out.String(), "000002   add_note_body  pending")
+	t.Log("✓ up, down e status executados e reportados na saída")
+}
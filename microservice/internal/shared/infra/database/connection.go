@@ -1,24 +1,22 @@
 package database
 
 import (
-	"log"
-	"os"
+	"log/slog"
 	"sync"
 	"time"
 
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
-	"gorm.io/gorm/logger"
 
-	"tech_challenge/internal/infra/database/models"
 	"tech_challenge/internal/shared/config/env"
-	"tech_challenge/internal/shared/infra/database/seed"
+	"tech_challenge/internal/shared/pkg/logger"
 )
 
 var (
-	dbConnection *gorm.DB
-	instance     *gorm.DB
-	once         sync.Once
+	dbConnection  *gorm.DB
+	instance      *gorm.DB
+	once          sync.Once
+	queryTimeouts QueryTimeouts
 )
 
 func GetDB() *gorm.DB {
@@ -30,7 +28,7 @@ func GetDB() *gorm.DB {
 
 func Connect() {
 	if dbConnection != nil {
-		log.Println("Database connection already established")
+		slog.Info("Database connection already established")
 		return
 	}
 
@@ -42,21 +40,8 @@ func Connect() {
 		" password=" + config.Database.Password +
 		" port=" + config.Database.Port
 
-	queryLogLevel := logger.Info
-
-	if config.IsProduction() {
-		queryLogLevel = logger.Error
-	}
-
-	queryLogger := logger.New(
-		log.New(os.Stdout, "\r\n", log.LstdFlags),
-		logger.Config{
-			SlowThreshold:             time.Second,   // Limite para considerar uma query como lenta
-			LogLevel:                  queryLogLevel, // Nível de log (Info mostra todas as queries, Error mostra apenas erros)
-			IgnoreRecordNotFoundError: false,         // Mostrar erro para registros não encontrados
-			Colorful:                  true,          // Saída colorida no terminal
-		},
-	)
+	// As queries saem pelo mesmo logger da aplicação; LOG_LEVEL=debug mostra todas, o padrão mostra lentas e falhas
+	queryLogger := NewGormLogger(time.Second)
 
 	var db *gorm.DB
 	var err error
@@ -72,42 +57,33 @@ func Connect() {
 			break
 		}
 
-		log.Printf("Failed to connect to database (attempt %d/%d): %v", i+1, maxRetries, err)
+		slog.Warn("Failed to connect to database", "attempt", i+1, "max_attempts", maxRetries, logger.ErrorKey, err)
 		time.Sleep(retryInterval)
 	}
 
 	if err != nil {
-		log.Fatal("Failed to connect to database")
+		logger.Fatal("Failed to connect to database", logger.ErrorKey, err)
 	}
 
 	dbConnection = db
+	queryTimeouts = QueryTimeouts{
+		Read:  config.Database.ReadTimeout,
+		Write: config.Database.WriteTimeout,
+		Batch: config.Database.BatchTimeout,
+	}
 }
 
 func Close() {
 	if dbConnection == nil {
-		log.Println("Database connection already closed")
+		slog.Info("Database connection already closed")
 		return
 	}
 
 	sqlDriver, err := dbConnection.DB()
 
 	if err != nil {
-		log.Fatal("Failed to close database")
+		logger.Fatal("Failed to close database", logger.ErrorKey, err)
 	}
 
 	sqlDriver.Close()
 }
-
-func RunMigrations() {
-	if err := dbConnection.AutoMigrate(
-		&models.KitchenOrderModel{},
-		&models.OrderStatusModel{},
-		&models.OrderItemModel{},
-	); err != nil {
-		log.Printf("Error running migrations: %v", err)
-	}
-}
-
-func SeedDefaults() {
-	seed.SeedOrderStatus(dbConnection)
-}
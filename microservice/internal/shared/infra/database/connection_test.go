@@ -1,6 +1,7 @@
 package database
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -94,24 +95,13 @@ func TestClose_Multiple_Calls(t *testing.T) {
 func TestRunMigrations_Safe_Call(t *testing.T) {
 	// Arrange
 	originalConnection := dbConnection
+	dbConnection = nil
 
-	// Act & Assert
-	// We don't call RunMigrations when dbConnection is nil because it will panic
-	// Instead, we verify the function exists
-	assert.NotNil(t, RunMigrations, "RunMigrations function should exist")
-
-	// Cleanup
-	dbConnection = originalConnection
-}
-
-func TestSeedDefaults_Safe_Call(t *testing.T) {
-	// Arrange
-	originalConnection := dbConnection
+	// Act
+	err := RunMigrations(context.Background())
 
-	// Act & Assert
-	// We don't call SeedDefaults when dbConnection is nil because it will panic
-	// Instead, we verify the function exists
-	assert.NotNil(t, SeedDefaults, "SeedDefaults function should exist")
+	// Assert
+	assert.Error(t, err, "RunMigrations should fail without a connection")
 
 	// Cleanup
 	dbConnection = originalConnection
@@ -123,7 +113,6 @@ func TestDatabaseConnection_Functions_Exist(t *testing.T) {
 	assert.NotNil(t, Connect, "Connect function should exist")
 	assert.NotNil(t, Close, "Close function should exist")
 	assert.NotNil(t, RunMigrations, "RunMigrations function should exist")
-	assert.NotNil(t, SeedDefaults, "SeedDefaults function should exist")
 }
 
 func TestGetDB_Concurrency_Safe(t *testing.T) {
@@ -169,10 +158,9 @@ func TestDatabaseConnection_No_Panic_On_Calls(t *testing.T) {
 		Close()
 	}, "Close should not panic")
 
-	// We don't call RunMigrations and SeedDefaults because they require a valid connection
-	// Instead, we verify they exist
+	// We don't call RunMigrations because it requires a valid connection
+	// Instead, we verify it exists
 	assert.NotNil(t, RunMigrations, "RunMigrations should exist")
-	assert.NotNil(t, SeedDefaults, "SeedDefaults should exist")
 }
 
 func TestDatabaseConnection_Singleton_Pattern(t *testing.T) {
@@ -238,10 +226,9 @@ func TestDatabaseConnection_Functions_Callable(t *testing.T) {
 		})
 	}
 
-	// Verify that RunMigrations and SeedDefaults exist but don't call them
-	// because they require a valid database connection
+	// Verify that RunMigrations exists but don't call it
+	// because it requires a valid database connection
 	assert.NotNil(t, RunMigrations, "RunMigrations should exist")
-	assert.NotNil(t, SeedDefaults, "SeedDefaults should exist")
 }
 
 func TestDatabaseConnection_Once_Sync(t *testing.T) {
@@ -293,19 +280,6 @@ func TestDatabaseConnection_RunMigrations_Handles_Nil(t *testing.T) {
 	dbConnection = originalConnection
 }
 
-func TestDatabaseConnection_SeedDefaults_Handles_Nil(t *testing.T) {
-	// Arrange
-	originalConnection := dbConnection
-
-	// Act & Assert
-	// We don't call SeedDefaults when dbConnection is nil because it will panic
-	// Instead, we verify the function exists
-	assert.NotNil(t, SeedDefaults, "SeedDefaults should exist")
-
-	// Cleanup
-	dbConnection = originalConnection
-}
-
 func TestDatabaseConnection_GetDB_Idempotent(t *testing.T) {
 	// Arrange & Act
 	db1 := GetDB()
@@ -0,0 +1,89 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"gorm.io/gorm"
+	gorm_logger "gorm.io/gorm/logger"
+
+	"tech_challenge/internal/shared/pkg/logger"
+)
+
+// GormLogger envia os logs do GORM para o slog, com os campos de correlação do contexto da query.
+// O nível do slog decide o que sai: queries em debug, lentas em warn e falhas em error
+type GormLogger struct {
+	level         gorm_logger.LogLevel
+	slowThreshold time.Duration
+}
+
+func NewGormLogger(slowThreshold time.Duration) *GormLogger {
+	return &GormLogger{
+		level:         gorm_logger.Info,
+		slowThreshold: slowThreshold,
+	}
+}
+
+func (l *GormLogger) LogMode(level gorm_logger.LogLevel) gorm_logger.Interface {
+	clone := *l
+	clone.level = level
+	return &clone
+}
+
+func (l *GormLogger) Info(ctx context.Context, msg string, data ...any) {
+	if l.level >= gorm_logger.Info {
+		logger.FromContext(ctx).Info(fmt.Sprintf(msg, data...))
+	}
+}
+
+func (l *GormLogger) Warn(ctx context.Context, msg string, data ...any) {
+	if l.level >= gorm_logger.Warn {
+		logger.FromContext(ctx).Warn(fmt.Sprintf(msg, data...))
+	}
+}
+
+func (l *GormLogger) Error(ctx context.Context, msg string, data ...any) {
+	if l.level >= gorm_logger.Error {
+		logger.FromContext(ctx).Error(fmt.Sprintf(msg, data...))
+	}
+}
+
+func (l *GormLogger) Trace(ctx context.Context, begin time.Time, fc func() (sql string, rowsAffected int64), err error) {
+	if l.level <= gorm_logger.Silent {
+		return
+	}
+
+	queryLogger := logger.FromContext(ctx)
+	elapsed := time.Since(begin)
+
+	level, msg := slog.LevelDebug, "Database query"
+	switch {
+	case err != nil && !errors.Is(err, gorm.ErrRecordNotFound) && l.level >= gorm_logger.Error:
+		level, msg = slog.LevelError, "Database query failed"
+	case l.slowThreshold > 0 && elapsed > l.slowThreshold && l.level >= gorm_logger.Warn:
+		level, msg = slog.LevelWarn, "Slow database query"
+	case l.level < gorm_logger.Info:
+		return
+	}
+
+	// Montar o SQL tem custo, então só acontece quando o nível será registrado
+	if !queryLogger.Enabled(ctx, level) {
+		return
+	}
+
+	sql, rows := fc()
+	args := []any{"sql", sql, "rows", rows, "duration_ms", elapsed.Milliseconds()}
+	if err != nil {
+		args = append(args, logger.ErrorKey, err)
+	}
+
+	queryLogger.Log(ctx, level, msg, args...)
+}
+
+// ParamsFilter mantém os placeholders no SQL registrado; os valores podem conter dados de clientes
+func (l *GormLogger) ParamsFilter(_ context.Context, sql string, _ ...any) (string, []any) {
+	return sql, nil
+}
@@ -0,0 +1,94 @@
+package database
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	gorm_logger "gorm.io/gorm/logger"
+
+	"tech_challenge/internal/shared/pkg/logger"
+)
+
+type gormLoggerRecord struct {
+	ID   string `gorm:"primaryKey"`
+	Name string
+}
+
+func openLoggedDB(t *testing.T, slowThreshold time.Duration) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{Logger: NewGormLogger(slowThreshold)})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&gormLoggerRecord{}))
+
+	return db
+}
+
+func logEntries(t *testing.T, buffer *bytes.Buffer) []map[string]any {
+	var entries []map[string]any
+	for _, line := range bytes.Split(bytes.TrimSpace(buffer.Bytes()), []byte("\n")) {
+		if len(line) == 0 {
+			continue
+		}
+
+		var entry map[string]any
+		require.NoError(t, json.Unmarshal(line, &entry))
+		entries = append(entries, entry)
+	}
+
+	return entries
+}
+
+func TestGormLogger_DebugQueriesWithoutValues(t *testing.T) {
+	db := openLoggedDB(t, time.Minute)
+
+	var buffer bytes.Buffer
+	ctx := logger.WithContext(context.Background(), logger.New(&buffer, logger.Options{Level: "debug", JSON: true}).With(logger.RequestIDKey, "req-1"))
+
+	require.NoError(t, db.WithContext(ctx).Create(&gormLoggerRecord{ID: "1", Name: "customer-secret"}).Error)
+
+	entries := logEntries(t, &buffer)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "Database query", entries[0]["msg"])
+	assert.Equal(t, "req-1", entries[0][logger.RequestIDKey])
+	assert.Contains(t, entries[0]["sql"], "INSERT INTO")
+	assert.NotContains(t, buffer.String(), "customer-secret")
+	t.Log("✓ Queries registradas com o request_id e sem os valores")
+}
+
+func TestGormLogger_InfoLevelKeepsOnlyFailuresAndSlowQueries(t *testing.T) {
+	var buffer bytes.Buffer
+	ctx := logger.WithContext(context.Background(), logger.New(&buffer, logger.Options{Level: "info", JSON: true}))
+
+	db := openLoggedDB(t, time.Minute)
+	var record gormLoggerRecord
+	assert.ErrorIs(t, db.WithContext(ctx).First(&record, "id = ?", "missing").Error, gorm.ErrRecordNotFound)
+	assert.Error(t, db.WithContext(ctx).Exec("SELECT * FROM missing_table").Error)
+
+	slowDB := openLoggedDB(t, time.Nanosecond)
+	require.NoError(t, slowDB.WithContext(ctx).Find(&[]gormLoggerRecord{}).Error)
+
+	entries := logEntries(t, &buffer)
+	require.Len(t, entries, 2, "record not found is not logged at info")
+	assert.Equal(t, "Database query failed", entries[0]["msg"])
+	assert.Equal(t, "ERROR", entries[0]["level"])
+	assert.Equal(t, "Slow database query", entries[1]["msg"])
+	assert.Equal(t, "WARN", entries[1]["level"])
+	t.Log("✓ Falhas e queries lentas registradas no nível padrão")
+}
+
+func TestGormLogger_Silent(t *testing.T) {
+	var buffer bytes.Buffer
+	ctx := logger.WithContext(context.Background(), logger.New(&buffer, logger.Options{Level: "debug", JSON: true}))
+
+	db := openLoggedDB(t, time.Minute)
+	db.Logger = db.Logger.LogMode(gorm_logger.Silent)
+	assert.Error(t, db.WithContext(ctx).Exec("SELECT * FROM missing_table").Error)
+
+	assert.Empty(t, buffer.String())
+}
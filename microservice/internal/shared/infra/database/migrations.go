@@ -0,0 +1,85 @@
+package database
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"io/fs"
+
+	"gorm.io/gorm"
+)
+
+// As migrations vão embutidas no binário; novas versões entram como um par <versão>_<nome>.up.sql / .down.sql
+//
+//go:embed migrations/*.sql
+var migrationFiles embed.FS
+
+// NewDefaultMigrator monta o Migrator com as migrations embutidas sobre a conexão aberta pelo Connect
+func NewDefaultMigrator() (*Migrator, error) {
+	if dbConnection == nil {
+		return nil, fmt.Errorf("database not connected")
+	}
+
+	return newEmbeddedMigrator(dbConnection)
+}
+
+func newEmbeddedMigrator(db *gorm.DB) (*Migrator, error) {
+	source, err := fs.Sub(migrationFiles, "migrations")
+	if err != nil {
+		return nil, err
+	}
+
+	return NewMigrator(db, source)
+}
+
+// RunMigrations aplica as migrations pendentes; réplicas subindo juntas esperam o lock em vez de migrar em paralelo
+func RunMigrations(ctx context.Context) error {
+	migrator, err := NewDefaultMigrator()
+	if err != nil {
+		return err
+	}
+
+	_, err = migrator.Up(ctx)
+	return err
+}
+
+// CheckMigrations compara as migrations embutidas com as aplicadas; um banco atrás do binário deixa a readiness down
+func CheckMigrations(ctx context.Context) (map[string]any, error) {
+	migrator, err := NewDefaultMigrator()
+	if err != nil {
+		return nil, err
+	}
+
+	return checkMigrations(ctx, migrator)
+}
+
+func checkMigrations(ctx context.Context, migrator *Migrator) (map[string]any, error) {
+	statuses, err := migrator.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var current, latest uint64
+	pending := []string{}
+
+	for _, status := range statuses {
+		latest = status.Version
+		if status.AppliedAt == nil {
+			pending = append(pending, fmt.Sprintf("%d_%s", status.Version, status.Name))
+			continue
+		}
+		current = status.Version
+	}
+
+	details := map[string]any{
+		"current_version": current,
+		"latest_version":  latest,
+		"pending":         pending,
+	}
+
+	if len(pending) > 0 {
+		return details, fmt.Errorf("%d migrations pending", len(pending))
+	}
+
+	return details, nil
+}
@@ -0,0 +1,12 @@
+DROP TABLE IF EXISTS print_job;
+DROP TABLE IF EXISTS print_route;
+DROP TABLE IF EXISTS printer;
+DROP TABLE IF EXISTS rate_limit_bucket;
+DROP TABLE IF EXISTS api_key;
+DROP TABLE IF EXISTS webhook_delivery;
+DROP TABLE IF EXISTS webhook_subscription;
+DROP TABLE IF EXISTS idempotency_key;
+DROP TABLE IF EXISTS kitchen_order_event;
+DROP TABLE IF EXISTS order_item;
+DROP TABLE IF EXISTS kitchen_order;
+DROP TABLE IF EXISTS order_status;
@@ -0,0 +1,169 @@
+-- Esquema criado até então pelo AutoMigrate; o IF NOT EXISTS adota os bancos que já existem sem alterá-los
+
+CREATE TABLE IF NOT EXISTS order_status (
+    id varchar(36) NOT NULL,
+    name varchar(100) NOT NULL,
+    PRIMARY KEY (id)
+);
+
+CREATE TABLE IF NOT EXISTS kitchen_order (
+    id varchar(36) NOT NULL,
+    order_id varchar(36) NOT NULL,
+    customer_id varchar(36),
+    amount decimal(10,2) NOT NULL,
+    slug varchar(100) NOT NULL,
+    status_id varchar(36) NOT NULL,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz,
+    PRIMARY KEY (id),
+    CONSTRAINT fk_kitchen_order_status FOREIGN KEY (status_id) REFERENCES order_status (id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_kitchen_order_order_id ON kitchen_order (order_id);
+CREATE INDEX IF NOT EXISTS idx_kitchen_order_customer_id ON kitchen_order (customer_id);
+CREATE INDEX IF NOT EXISTS idx_kitchen_order_status_id ON kitchen_order (status_id);
+CREATE INDEX IF NOT EXISTS idx_kitchen_order_created_at ON kitchen_order (created_at);
+
+CREATE TABLE IF NOT EXISTS order_item (
+    id varchar(36) NOT NULL,
+    kitchen_order_id varchar(36) NOT NULL,
+    order_id varchar(36) NOT NULL,
+    product_id varchar(100) NOT NULL,
+    quantity bigint NOT NULL,
+    unit_price decimal(10,2) NOT NULL,
+    PRIMARY KEY (id),
+    CONSTRAINT fk_kitchen_order_items FOREIGN KEY (kitchen_order_id) REFERENCES kitchen_order (id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_order_item_kitchen_order_id ON order_item (kitchen_order_id);
+CREATE INDEX IF NOT EXISTS idx_order_item_order_id ON order_item (order_id);
+
+CREATE TABLE IF NOT EXISTS kitchen_order_event (
+    id bigserial NOT NULL,
+    type varchar(30) NOT NULL,
+    kitchen_order_id varchar(36) NOT NULL,
+    order_id varchar(36) NOT NULL,
+    slug varchar(100) NOT NULL,
+    status_id varchar(36) NOT NULL,
+    status_name varchar(100) NOT NULL,
+    reason varchar(255),
+    actor varchar(255),
+    created_at timestamptz NOT NULL,
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_kitchen_order_event_kitchen_order_id ON kitchen_order_event (kitchen_order_id);
+CREATE INDEX IF NOT EXISTS idx_kitchen_order_event_created_at ON kitchen_order_event (created_at);
+
+CREATE TABLE IF NOT EXISTS idempotency_key (
+    scope varchar(100) NOT NULL,
+    idempotency_key varchar(255) NOT NULL,
+    fingerprint varchar(64) NOT NULL,
+    resource_id varchar(36),
+    created_at timestamptz NOT NULL,
+    PRIMARY KEY (scope, idempotency_key)
+);
+
+CREATE INDEX IF NOT EXISTS idx_idempotency_key_created_at ON idempotency_key (created_at);
+
+CREATE TABLE IF NOT EXISTS webhook_subscription (
+    id varchar(36) NOT NULL,
+    url varchar(2048) NOT NULL,
+    secret varchar(255) NOT NULL,
+    events varchar(500) NOT NULL,
+    active boolean NOT NULL DEFAULT true,
+    consecutive_failures bigint NOT NULL DEFAULT 0,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz,
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_subscription_active ON webhook_subscription (active);
+
+CREATE TABLE IF NOT EXISTS webhook_delivery (
+    id varchar(36) NOT NULL,
+    subscription_id varchar(36) NOT NULL,
+    event_id varchar(36) NOT NULL,
+    event varchar(100) NOT NULL,
+    attempt bigint NOT NULL,
+    status_code bigint NOT NULL,
+    success boolean NOT NULL,
+    error varchar(1000),
+    duration_ms bigint NOT NULL,
+    created_at timestamptz NOT NULL,
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_delivery_subscription_id ON webhook_delivery (subscription_id);
+CREATE INDEX IF NOT EXISTS idx_webhook_delivery_event_id ON webhook_delivery (event_id);
+CREATE INDEX IF NOT EXISTS idx_webhook_delivery_created_at ON webhook_delivery (created_at);
+
+CREATE TABLE IF NOT EXISTS api_key (
+    id varchar(36) NOT NULL,
+    name varchar(100) NOT NULL,
+    prefix varchar(32) NOT NULL,
+    key_hash varchar(64) NOT NULL,
+    scopes varchar(500) NOT NULL,
+    created_by varchar(255),
+    created_at timestamptz NOT NULL,
+    last_used_at timestamptz,
+    revoked_at timestamptz,
+    PRIMARY KEY (id)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_api_key_prefix ON api_key (prefix);
+
+CREATE TABLE IF NOT EXISTS rate_limit_bucket (
+    bucket_key varchar(255) NOT NULL,
+    tokens decimal NOT NULL,
+    updated_at timestamptz NOT NULL,
+    PRIMARY KEY (bucket_key)
+);
+
+CREATE INDEX IF NOT EXISTS idx_rate_limit_bucket_updated_at ON rate_limit_bucket (updated_at);
+
+CREATE TABLE IF NOT EXISTS printer (
+    id varchar(36) NOT NULL,
+    name varchar(100) NOT NULL,
+    host varchar(255) NOT NULL,
+    port bigint NOT NULL DEFAULT 9100,
+    station varchar(50) NOT NULL,
+    width bigint NOT NULL DEFAULT 0,
+    active boolean NOT NULL DEFAULT true,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz,
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_printer_station ON printer (station);
+
+CREATE TABLE IF NOT EXISTS print_route (
+    id varchar(36) NOT NULL,
+    position bigint NOT NULL,
+    product_pattern varchar(255) NOT NULL,
+    station varchar(50) NOT NULL,
+    PRIMARY KEY (id)
+);
+
+CREATE TABLE IF NOT EXISTS print_job (
+    id varchar(36) NOT NULL,
+    kitchen_order_id varchar(36) NOT NULL,
+    printer_id varchar(36) NOT NULL,
+    station varchar(50) NOT NULL,
+    item_ids text NOT NULL,
+    status varchar(20) NOT NULL,
+    attempts bigint NOT NULL DEFAULT 0,
+    last_error varchar(1000),
+    reprint boolean NOT NULL DEFAULT false,
+    requested_by varchar(255),
+    next_attempt_at timestamptz NOT NULL,
+    printed_at timestamptz,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz,
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_print_job_kitchen_order_id ON print_job (kitchen_order_id);
+CREATE INDEX IF NOT EXISTS idx_print_job_printer_id ON print_job (printer_id);
+CREATE INDEX IF NOT EXISTS idx_print_job_queue ON print_job (status, next_attempt_at);
+CREATE INDEX IF NOT EXISTS idx_print_job_created_at ON print_job (created_at);
@@ -0,0 +1,7 @@
+-- Falha pela chave estrangeira enquanto houver pedidos usando os status
+DELETE FROM order_status WHERE id IN (
+    '56d3b3c3-1801-49cd-bae7-972c78082012',
+    '3f9a1c98-7b2f-4f3b-8a96-c0b7c761a123',
+    '5a8b2b16-9b47-4e35-ae27-28f7994ef456',
+    'bd91a1ee-1234-4cde-9c2a-efb1d2a3a789'
+);
@@ -0,0 +1,7 @@
+-- Status fixos do quadro da cozinha; os ids são os de constants.KITCHEN_ORDER_STATUS_*
+INSERT INTO order_status (id, name) VALUES
+    ('56d3b3c3-1801-49cd-bae7-972c78082012', 'Recebido'),
+    ('3f9a1c98-7b2f-4f3b-8a96-c0b7c761a123', 'Em preparação'),
+    ('5a8b2b16-9b47-4e35-ae27-28f7994ef456', 'Pronto'),
+    ('bd91a1ee-1234-4cde-9c2a-efb1d2a3a789', 'Finalizado')
+ON CONFLICT (id) DO NOTHING;
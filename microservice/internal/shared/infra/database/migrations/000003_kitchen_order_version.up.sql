@@ -0,0 +1,2 @@
+-- Versão do pedido para o If-Match e as alterações em lote; pedidos existentes começam na primeira versão
+ALTER TABLE kitchen_order ADD COLUMN IF NOT EXISTS version bigint NOT NULL DEFAULT 1;
@@ -0,0 +1,2 @@
+-- Motivo da última mudança de status, obrigatório no recall
+ALTER TABLE kitchen_order ADD COLUMN IF NOT EXISTS status_reason varchar(255);
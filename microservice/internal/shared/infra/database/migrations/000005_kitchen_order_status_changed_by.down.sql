@@ -0,0 +1 @@
+ALTER TABLE kitchen_order DROP COLUMN IF EXISTS status_changed_by;
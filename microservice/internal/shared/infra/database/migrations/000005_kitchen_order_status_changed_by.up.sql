@@ -0,0 +1,2 @@
+-- Quem fez a última mudança de status: usuário do token ou API key
+ALTER TABLE kitchen_order ADD COLUMN IF NOT EXISTS status_changed_by varchar(255);
@@ -0,0 +1,2 @@
+-- Início da preparação; com o ready_at dá o tempo de preparo dos relatórios
+ALTER TABLE kitchen_order ADD COLUMN IF NOT EXISTS preparing_at timestamptz;
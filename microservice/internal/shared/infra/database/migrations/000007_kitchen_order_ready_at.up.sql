@@ -0,0 +1,2 @@
+-- Momento em que o pedido ficou pronto; fecha o tempo de preparo dos relatórios
+ALTER TABLE kitchen_order ADD COLUMN IF NOT EXISTS ready_at timestamptz;
@@ -0,0 +1,2 @@
+-- Retirada do pedido; os relatórios agrupam os pedidos finalizados por ela
+ALTER TABLE kitchen_order ADD COLUMN IF NOT EXISTS finished_at timestamptz;
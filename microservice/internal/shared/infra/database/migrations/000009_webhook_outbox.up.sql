@@ -0,0 +1,20 @@
+-- Entregas de webhook pendentes; a fila fica no banco para sobreviver a restarts e ser drenada por qualquer réplica
+CREATE TABLE IF NOT EXISTS webhook_outbox (
+    id varchar(36) NOT NULL,
+    subscription_id varchar(36) NOT NULL,
+    event_id varchar(36) NOT NULL,
+    event varchar(100) NOT NULL,
+    payload text NOT NULL,
+    status varchar(20) NOT NULL,
+    attempts bigint NOT NULL DEFAULT 0,
+    last_error varchar(1000),
+    next_attempt_at timestamptz NOT NULL,
+    delivered_at timestamptz,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz,
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_outbox_subscription_id ON webhook_outbox (subscription_id);
+CREATE INDEX IF NOT EXISTS idx_webhook_outbox_queue ON webhook_outbox (status, next_attempt_at);
+CREATE INDEX IF NOT EXISTS idx_webhook_outbox_created_at ON webhook_outbox (created_at);
@@ -0,0 +1 @@
+ALTER TABLE rate_limit_bucket DROP COLUMN IF EXISTS allowed;
@@ -0,0 +1,2 @@
+-- Decisão da última requisição, gravada e devolvida pelo próprio upsert que consome o token
+ALTER TABLE rate_limit_bucket ADD COLUMN IF NOT EXISTS allowed boolean NOT NULL DEFAULT TRUE;
@@ -0,0 +1,181 @@
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"tech_challenge/internal/infra/database/models"
+	"tech_challenge/internal/shared/config/constants"
+)
+
+// schemaModels são os models persistidos; o baseline precisa criar todas as colunas deles
+var schemaModels = []any{
+	&models.KitchenOrderModel{},
+	&models.OrderStatusModel{},
+	&models.OrderItemModel{},
+	&models.KitchenOrderEventModel{},
+	&models.IdempotencyKeyModel{},
+	&models.WebhookSubscriptionModel{},
+	&models.WebhookDeliveryModel{},
+	&models.WebhookOutboxModel{},
+	&models.APIKeyModel{},
+	&models.RateLimitBucketModel{},
+	&models.PrinterModel{},
+	&models.PrintRouteModel{},
+	&models.PrintJobModel{},
+}
+
+// baselineKitchenOrderModel é o kitchen_order do baseline; as demais colunas entram pelas migrations seguintes
+type baselineKitchenOrderModel struct {
+	ID         string     `gorm:"primaryKey;size:36"`
+	OrderID    string     `gorm:"not null;size:36;index"`
+	CustomerID *string    `gorm:"size:36;index"`
+	Amount     float64    `gorm:"not null;type:decimal(10,2)"`
+	Slug       string     `gorm:"not null;size:100"`
+	StatusID   string     `gorm:"not null;size:36;index"`
+	CreatedAt  time.Time  `gorm:"not null;index"`
+	UpdatedAt  *time.Time `gorm:""`
+}
+
+func (baselineKitchenOrderModel) TableName() string {
+	return "kitchen_order"
+}
+
+// baselineSchemaModels reproduzem o banco criado pelo AutoMigrate antes das migrations versionadas
+var baselineSchemaModels = []any{
+	&models.OrderStatusModel{},
+	&baselineKitchenOrderModel{},
+	&models.OrderItemModel{},
+	&models.KitchenOrderEventModel{},
+	&models.IdempotencyKeyModel{},
+	&models.WebhookSubscriptionModel{},
+	&models.WebhookDeliveryModel{},
+	&models.APIKeyModel{},
+	&models.RateLimitBucketModel{},
+	&models.PrinterModel{},
+	&models.PrintRouteModel{},
+	&models.PrintJobModel{},
+}
+
+func assertSchemaMatchesModels(t *testing.T, db *gorm.DB) {
+	t.Helper()
+
+	for _, model := range schemaModels {
+		statement := &gorm.Statement{DB: db}
+		require.NoError(t, statement.Parse(model))
+
+		table := statement.Schema.Table
+		assert.True(t, db.Migrator().HasTable(table), "missing table %s", table)
+
+		for _, field := range statement.Schema.Fields {
+			if field.DBName == "" {
+				continue
+			}
+			assert.True(t, db.Migrator().HasColumn(table, field.DBName), "missing column %s.%s", table, field.DBName)
+		}
+	}
+}
+
+func TestEmbeddedMigrations_MatchModels(t *testing.T) {
+	db := openMigrationTestDB(t)
+	migrator, err := newEmbeddedMigrator(db)
+	require.NoError(t, err)
+
+	_, err = migrator.Up(context.Background())
+	require.NoError(t, err)
+
+	assertSchemaMatchesModels(t, db)
+	t.Log("✓ Migrations embutidas criam todas as tabelas e colunas dos models")
+}
+
+func TestEmbeddedMigrations_SeedOrderStatus(t *testing.T) {
+	ctx := context.Background()
+	db := openMigrationTestDB(t)
+	migrator, err := newEmbeddedMigrator(db)
+	require.NoError(t, err)
+
+	_, err = migrator.Up(ctx)
+	require.NoError(t, err)
+
+	var statuses []models.OrderStatusModel
+	require.NoError(t, db.Order("id").Find(&statuses).Error)
+	assert.Len(t, statuses, 4)
+
+	var received models.OrderStatusModel
+	require.NoError(t, db.First(&received, "id = ?", constants.KITCHEN_ORDER_STATUS_RECEIVED_ID).Error)
+	assert.Equal(t, "Recebido", received.Name)
+
+	// Reverte tudo o que veio depois do baseline, incluindo o seed
+	steps := len(migrator.migrations) - 1
+	reverted, err := migrator.Down(ctx, steps)
+	require.NoError(t, err)
+	assert.Equal(t, steps, reverted)
+
+	var count int64
+	require.NoError(t, db.Model(&models.OrderStatusModel{}).Count(&count).Error)
+	assert.Zero(t, count)
+	t.Log("✓ Status padrão criados e removidos pela migration de seed")
+}
+
+func TestEmbeddedMigrations_AdoptAutoMigratedDatabase(t *testing.T) {
+	ctx := context.Background()
+	db := openMigrationTestDB(t)
+
+	// Banco criado pelo AutoMigrate e pelo seed antigos, antes das migrations versionadas
+	require.NoError(t, db.AutoMigrate(baselineSchemaModels...))
+	require.NoError(t, db.Create(&models.OrderStatusModel{ID: constants.KITCHEN_ORDER_STATUS_READY_ID, Name: "Pronto"}).Error)
+
+	migrator, err := newEmbeddedMigrator(db)
+	require.NoError(t, err)
+
+	applied, err := migrator.Up(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, len(migrator.migrations), applied)
+
+	var count int64
+	require.NoError(t, db.Model(&models.OrderStatusModel{}).Count(&count).Error)
+	assert.Equal(t, int64(4), count)
+
+	// As colunas posteriores ao baseline chegam pelas próprias migrations
+	assertSchemaMatchesModels(t, db)
+	t.Log("✓ Banco existente adotado pelo baseline e completado pelas migrations seguintes")
+}
+
+func TestCheckMigrations(t *testing.T) {
+	ctx := context.Background()
+	db := openMigrationTestDB(t)
+	migrator, err := newEmbeddedMigrator(db)
+	require.NoError(t, err)
+	latest := migrator.migrations[len(migrator.migrations)-1].Version
+
+	details, err := checkMigrations(ctx, migrator)
+	assert.Error(t, err)
+	assert.Equal(t, uint64(0), details["current_version"])
+	assert.Equal(t, latest, details["latest_version"])
+	assert.Len(t, details["pending"], len(migrator.migrations))
+
+	_, err = migrator.Up(ctx)
+	require.NoError(t, err)
+
+	details, err = checkMigrations(ctx, migrator)
+	assert.NoError(t, err)
+	assert.Equal(t, latest, details["current_version"])
+	assert.Empty(t, details["pending"])
+	t.Log("✓ Readiness acusa migrations pendentes e fica up com o banco em dia")
+}
+
+func TestCheckMigrations_NotConnected(t *testing.T) {
+	originalConnection := dbConnection
+	dbConnection = nil
+	defer func() { dbConnection = originalConnection }()
+
+	_, err := CheckMigrations(context.Background())
+
+	assert.Error(t, err)
+	t.Log("✓ Sem conexão a checagem falha")
+}
@@ -0,0 +1,300 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"tech_challenge/internal/shared/pkg/logger"
+)
+
+// migrationLockID identifica o advisory lock do Postgres disputado pelas réplicas que sobem ao mesmo tempo
+const migrationLockID int64 = 7_301_486_127
+
+var migrationFilePattern = regexp.MustCompile(`^(\d+)_([a-z0-9_]+)\.(up|down)\.sql$`)
+
+// columnIfExistsPattern casa os ALTER TABLE de coluna com IF [NOT] EXISTS, que o SQLite não aceita
+var columnIfExistsPattern = regexp.MustCompile(`(?i)ALTER\s+TABLE\s+(\w+)\s+(ADD|DROP)\s+COLUMN\s+IF\s+(?:NOT\s+)?EXISTS\s+(\w+)([^;]*);`)
+
+type Migration struct {
+	Version uint64
+	Name    string
+	Up      string
+	Down    string
+}
+
+// MigrationStatus descreve uma migration conhecida pelo binário; AppliedAt nulo indica pendente
+type MigrationStatus struct {
+	Version   uint64
+	Name      string
+	AppliedAt *time.Time
+}
+
+type schemaMigrationModel struct {
+	Version   uint64    `gorm:"primaryKey;autoIncrement:false"`
+	Name      string    `gorm:"not null;size:255"`
+	AppliedAt time.Time `gorm:"not null"`
+}
+
+func (schemaMigrationModel) TableName() string {
+	return "schema_migrations"
+}
+
+type Migrator struct {
+	db         *gorm.DB
+	migrations []Migration
+}
+
+// NewMigrator lê os pares <versão>_<nome>.up.sql / .down.sql da raiz de source
+func NewMigrator(db *gorm.DB, source fs.FS) (*Migrator, error) {
+	migrations, err := loadMigrations(source)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Migrator{db: db, migrations: migrations}, nil
+}
+
+func loadMigrations(source fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(source, ".")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read migrations: %w", err)
+	}
+
+	byVersion := make(map[uint64]*Migration)
+
+	for _, entry := range entries {
+		if entry.IsDir() || path.Ext(entry.Name()) != ".sql" {
+			continue
+		}
+
+		match := migrationFilePattern.FindStringSubmatch(entry.Name())
+		if match == nil {
+			return nil, fmt.Errorf("invalid migration file name %q, expected <version>_<name>.up.sql or .down.sql", entry.Name())
+		}
+
+		version, err := strconv.ParseUint(match[1], 10, 64)
+		if err != nil || version == 0 {
+			return nil, fmt.Errorf("invalid migration version in %q", entry.Name())
+		}
+
+		content, err := fs.ReadFile(source, entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("failed to read migration %q: %w", entry.Name(), err)
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = migration
+		}
+
+		if migration.Name != match[2] {
+			return nil, fmt.Errorf("migration %d has files with different names: %q and %q", version, migration.Name, match[2])
+		}
+
+		if match[3] == "up" {
+			migration.Up = string(content)
+		} else {
+			migration.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		// Toda migration precisa poder ser desfeita pelo migrate down
+		if migration.Up == "" || migration.Down == "" {
+			return nil, fmt.Errorf("migration %d_%s must have both up and down files", migration.Version, migration.Name)
+		}
+		migrations = append(migrations, *migration)
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	return migrations, nil
+}
+
+// dialectSQL adapta a migration ao banco da conexão. No Postgres ela roda como foi escrita; no SQLite dos testes o
+// IF [NOT] EXISTS das colunas é resolvido consultando o schema antes de executar o ALTER TABLE
+func dialectSQL(conn *gorm.DB, sql string) string {
+	if conn.Dialector.Name() != "sqlite" {
+		return sql
+	}
+
+	return columnIfExistsPattern.ReplaceAllStringFunc(sql, func(statement string) string {
+		match := columnIfExistsPattern.FindStringSubmatch(statement)
+		table, operation, column, definition := match[1], strings.ToUpper(match[2]), match[3], match[4]
+		exists := conn.Migrator().HasColumn(table, column)
+
+		if operation == "ADD" {
+			if exists {
+				return ""
+			}
+			return fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s%s;", table, column, definition)
+		}
+
+		if !exists {
+			return ""
+		}
+		return fmt.Sprintf("ALTER TABLE %s DROP COLUMN %s;", table, column)
+	})
+}
+
+// Up aplica as migrations pendentes em ordem, cada uma na própria transação, e devolve quantas foram aplicadas
+func (m *Migrator) Up(ctx context.Context) (int, error) {
+	applied := 0
+
+	err := m.withLock(ctx, func(conn *gorm.DB) error {
+		appliedVersions, err := m.appliedVersions(conn)
+		if err != nil {
+			return err
+		}
+
+		for _, migration := range m.migrations {
+			if _, ok := appliedVersions[migration.Version]; ok {
+				continue
+			}
+
+			start := time.Now()
+			err := conn.Transaction(func(tx *gorm.DB) error {
+				if err := tx.Exec(dialectSQL(tx, migration.Up)).Error; err != nil {
+					return err
+				}
+
+				return tx.Create(&schemaMigrationModel{
+					Version:   migration.Version,
+					Name:      migration.Name,
+					AppliedAt: time.Now().UTC(),
+				}).Error
+			})
+			if err != nil {
+				return fmt.Errorf("failed to apply migration %d_%s: %w", migration.Version, migration.Name, err)
+			}
+
+			logger.FromContext(ctx).Info("Migration applied", "version", migration.Version, "name", migration.Name, "duration_ms", time.Since(start).Milliseconds())
+			applied++
+		}
+
+		return nil
+	})
+
+	return applied, err
+}
+
+// Down desfaz as últimas steps migrations aplicadas, da mais recente para a mais antiga
+func (m *Migrator) Down(ctx context.Context, steps int) (int, error) {
+	if steps < 1 {
+		return 0, fmt.Errorf("steps must be at least 1")
+	}
+
+	reverted := 0
+
+	err := m.withLock(ctx, func(conn *gorm.DB) error {
+		appliedVersions, err := m.appliedVersions(conn)
+		if err != nil {
+			return err
+		}
+
+		for i := len(m.migrations) - 1; i >= 0 && reverted < steps; i-- {
+			migration := m.migrations[i]
+			if _, ok := appliedVersions[migration.Version]; !ok {
+				continue
+			}
+
+			start := time.Now()
+			err := conn.Transaction(func(tx *gorm.DB) error {
+				if err := tx.Exec(dialectSQL(tx, migration.Down)).Error; err != nil {
+					return err
+				}
+
+				return tx.Delete(&schemaMigrationModel{}, "version = ?", migration.Version).Error
+			})
+			if err != nil {
+				return fmt.Errorf("failed to revert migration %d_%s: %w", migration.Version, migration.Name, err)
+			}
+
+			logger.FromContext(ctx).Info("Migration reverted", "version", migration.Version, "name", migration.Name, "duration_ms", time.Since(start).Milliseconds())
+			reverted++
+		}
+
+		return nil
+	})
+
+	return reverted, err
+}
+
+// Status lista as migrations do binário com a data de aplicação; não disputa o lock, para servir à readiness
+func (m *Migrator) Status(ctx context.Context) ([]MigrationStatus, error) {
+	conn := m.db.WithContext(ctx)
+
+	appliedVersions := map[uint64]time.Time{}
+	if conn.Migrator().HasTable(&schemaMigrationModel{}) {
+		var err error
+		if appliedVersions, err = m.appliedVersions(conn); err != nil {
+			return nil, err
+		}
+	}
+
+	statuses := make([]MigrationStatus, len(m.migrations))
+	for i, migration := range m.migrations {
+		statuses[i] = MigrationStatus{Version: migration.Version, Name: migration.Name}
+		if appliedAt, ok := appliedVersions[migration.Version]; ok {
+			statuses[i].AppliedAt = &appliedAt
+		}
+	}
+
+	return statuses, nil
+}
+
+func (m *Migrator) appliedVersions(conn *gorm.DB) (map[uint64]time.Time, error) {
+	var rows []schemaMigrationModel
+	if err := conn.Order("version").Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to read schema_migrations: %w", err)
+	}
+
+	applied := make(map[uint64]time.Time, len(rows))
+	for _, row := range rows {
+		applied[row.Version] = row.AppliedAt
+	}
+
+	return applied, nil
+}
+
+// withLock executa fn em uma conexão dedicada segurando o advisory lock, para que só uma réplica migre por vez;
+// as demais esperam o lock e, ao obtê-lo, já encontram as migrations aplicadas
+func (m *Migrator) withLock(ctx context.Context, fn func(conn *gorm.DB) error) error {
+	return m.db.WithContext(ctx).Connection(func(conn *gorm.DB) error {
+		conn = conn.Session(&gorm.Session{})
+
+		if conn.Dialector.Name() == "postgres" {
+			if err := conn.Exec("SELECT pg_advisory_lock(?)", migrationLockID).Error; err != nil {
+				return fmt.Errorf("failed to acquire migration lock: %w", err)
+			}
+			defer func() {
+				// Com o contexto já cancelado o unlock falharia; o lock é liberado de qualquer forma ao fechar a conexão
+				if err := conn.WithContext(context.WithoutCancel(ctx)).Exec("SELECT pg_advisory_unlock(?)", migrationLockID).Error; err != nil {
+					logger.FromContext(ctx).Warn("Failed to release migration lock", logger.ErrorKey, err)
+				}
+			}()
+		}
+
+		// Criada pelo migrator do GORM para usar o tipo de data de cada banco; o lock evita a corrida entre réplicas
+		if !conn.Migrator().HasTable(&schemaMigrationModel{}) {
+			if err := conn.Migrator().CreateTable(&schemaMigrationModel{}); err != nil {
+				return fmt.Errorf("failed to create schema_migrations: %w", err)
+			}
+		}
+
+		return fn(conn)
+	})
+}
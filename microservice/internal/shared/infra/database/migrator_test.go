@@ -0,0 +1,182 @@
+package database
+
+import (
+	"context"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
+)
+
+// openMigrationTestDB usa uma única conexão: cada conexão do sqlite em memória enxerga um banco diferente
+func openMigrationTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{Logger: gormlogger.Discard})
+	require.NoError(t, err)
+
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { sqlDB.Close() })
+
+	return db
+}
+
+func testMigrationSource() fstest.MapFS {
+	return fstest.MapFS{
+		"000001_create_note.up.sql":     {Data: []byte("CREATE TABLE note (id varchar(36) NOT NULL, PRIMARY KEY (id));")},
+		"000001_create_note.down.sql":   {Data: []byte("DROP TABLE note;")},
+		"000002_add_note_body.up.sql":   {Data: []byte("ALTER TABLE note ADD COLUMN body text;")},
+		"000002_add_note_body.down.sql": {Data: []byte("ALTER TABLE note DROP COLUMN body;")},
+		"000010_seed_note.up.sql":       {Data: []byte("INSERT INTO note (id, body) VALUES ('a', 'first'); INSERT INTO note (id, body) VALUES ('b', 'second');")},
+		"000010_seed_note.down.sql":     {Data: []byte("DELETE FROM note;")},
+		"README.md":                     {Data: []byte("ignored")},
+	}
+}
+
+func TestNewMigrator_LoadsInVersionOrder(t *testing.T) {
+	migrator, err := NewMigrator(openMigrationTestDB(t), testMigrationSource())
+	require.NoError(t, err)
+
+	require.Len(t, migrator.migrations, 3)
+	assert.Equal(t, uint64(1), migrator.migrations[0].Version)
+	assert.Equal(t, "create_note", migrator.migrations[0].Name)
+	assert.Equal(t, uint64(2), migrator.migrations[1].Version)
+	assert.Equal(t, uint64(10), migrator.migrations[2].Version)
+	assert.Contains(t, migrator.migrations[2].Down, "DELETE FROM note")
+	t.Log("✓ Migrations ordenadas pela versão numérica, com up e down")
+}
+
+func TestNewMigrator_InvalidSource(t *testing.T) {
+	testCases := []struct {
+		name   string
+		source fstest.MapFS
+	}{
+		{"invalid file name", fstest.MapFS{"create_note.up.sql": {Data: []byte("SELECT 1;")}}},
+		{"missing down", fstest.MapFS{"000001_create_note.up.sql": {Data: []byte("SELECT 1;")}}},
+		{"zero version", fstest.MapFS{
+			"000000_create_note.up.sql":   {Data: []byte("SELECT 1;")},
+			"000000_create_note.down.sql": {Data: []byte("SELECT 1;")},
+		}},
+		{"names differ", fstest.MapFS{
+			"000001_create_note.up.sql": {Data: []byte("SELECT 1;")},
+			"000001_drop_note.down.sql": {Data: []byte("SELECT 1;")},
+		}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewMigrator(openMigrationTestDB(t), tc.source)
+			assert.Error(t, err)
+		})
+	}
+	t.Log("✓ Arquivos de migration inválidos rejeitados ao carregar")
+}
+
+func TestMigrator_UpDownStatus(t *testing.T) {
+	ctx := context.Background()
+	db := openMigrationTestDB(t)
+	migrator, err := NewMigrator(db, testMigrationSource())
+	require.NoError(t, err)
+
+	// Antes do primeiro up a tabela de controle nem existe e tudo aparece pendente
+	statuses, err := migrator.Status(ctx)
+	require.NoError(t, err)
+	require.Len(t, statuses, 3)
+	for _, status := range statuses {
+		assert.Nil(t, status.AppliedAt)
+	}
+
+	applied, err := migrator.Up(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 3, applied)
+
+	var count int64
+	require.NoError(t, db.Table("note").Count(&count).Error)
+	assert.Equal(t, int64(2), count)
+
+	applied, err = migrator.Up(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, applied, "a second up must not reapply anything")
+
+	reverted, err := migrator.Down(ctx, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 2, reverted)
+	assert.False(t, db.Migrator().HasColumn("note", "body"))
+
+	statuses, err = migrator.Status(ctx)
+	require.NoError(t, err)
+	assert.NotNil(t, statuses[0].AppliedAt)
+	assert.Nil(t, statuses[1].AppliedAt)
+	assert.Nil(t, statuses[2].AppliedAt)
+
+	reverted, err = migrator.Down(ctx, 5)
+	require.NoError(t, err)
+	assert.Equal(t, 1, reverted, "down stops when nothing is left to revert")
+	assert.False(t, db.Migrator().HasTable("note"))
+
+	_, err = migrator.Down(ctx, 0)
+	assert.Error(t, err)
+	t.Log("✓ Up aplica as pendentes, down reverte as mais recentes e o status acompanha")
+}
+
+func TestMigrator_FailedMigrationIsRolledBack(t *testing.T) {
+	ctx := context.Background()
+	db := openMigrationTestDB(t)
+
+	source := testMigrationSource()
+	source["000003_broken.up.sql"] = &fstest.MapFile{Data: []byte("CREATE TABLE broken (id text); INSERT INTO missing_table VALUES (1);")}
+	source["000003_broken.down.sql"] = &fstest.MapFile{Data: []byte("DROP TABLE broken;")}
+
+	migrator, err := NewMigrator(db, source)
+	require.NoError(t, err)
+
+	applied, err := migrator.Up(ctx)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "3_broken")
+	assert.Equal(t, 2, applied, "migrations before the broken one stay applied")
+
+	// A transação desfaz o que a migration com erro chegou a executar e ela segue pendente
+	assert.False(t, db.Migrator().HasTable("broken"))
+
+	statuses, err := migrator.Status(ctx)
+	require.NoError(t, err)
+	assert.Nil(t, statuses[2].AppliedAt)
+	assert.Nil(t, statuses[3].AppliedAt)
+	t.Log("✓ Migration com erro desfeita e mantida como pendente")
+}
+
+func TestMigrator_ColumnIfNotExistsOnSQLite(t *testing.T) {
+	ctx := context.Background()
+	db := openMigrationTestDB(t)
+
+	source := fstest.MapFS{
+		"000001_create_note.up.sql":     {Data: []byte("CREATE TABLE note (id varchar(36) NOT NULL, body text, PRIMARY KEY (id));")},
+		"000001_create_note.down.sql":   {Data: []byte("DROP TABLE note;")},
+		"000002_add_note_body.up.sql":   {Data: []byte("ALTER TABLE note ADD COLUMN IF NOT EXISTS body text;")},
+		"000002_add_note_body.down.sql": {Data: []byte("ALTER TABLE note DROP COLUMN IF EXISTS body;")},
+		"000003_add_note_tag.up.sql":    {Data: []byte("-- Nova coluna\nALTER TABLE note ADD COLUMN IF NOT EXISTS tag varchar(20) NOT NULL DEFAULT 'none';")},
+		"000003_add_note_tag.down.sql":  {Data: []byte("ALTER TABLE note DROP COLUMN IF EXISTS tag;")},
+	}
+
+	migrator, err := NewMigrator(db, source)
+	require.NoError(t, err)
+
+	// A coluna body já existe, como em um banco criado pelo AutoMigrate; a tag ainda não
+	applied, err := migrator.Up(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 3, applied)
+	assert.True(t, db.Migrator().HasColumn("note", "tag"))
+
+	reverted, err := migrator.Down(ctx, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 2, reverted)
+	assert.False(t, db.Migrator().HasColumn("note", "tag"))
+	assert.False(t, db.Migrator().HasColumn("note", "body"))
+	t.Log("✓ IF [NOT] EXISTS das colunas resolvido pelo schema no SQLite")
+}
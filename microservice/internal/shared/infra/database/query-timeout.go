@@ -0,0 +1,27 @@
+package database
+
+import (
+	"context"
+	"time"
+)
+
+// QueryTimeouts limita cada operação de um data source; o cancelamento do contexto do chamador continua valendo
+type QueryTimeouts struct {
+	Read  time.Duration
+	Write time.Duration
+	Batch time.Duration
+}
+
+// GetQueryTimeouts devolve os limites lidos pelo Connect; sem conexão aberta são zero e nenhuma operação é limitada
+func GetQueryTimeouts() QueryTimeouts {
+	return queryTimeouts
+}
+
+// WithTimeout devolve ctx limitado por timeout; zero ou negativo mantém apenas o prazo do chamador
+func WithTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+
+	return context.WithTimeout(ctx, timeout)
+}
@@ -0,0 +1,41 @@
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithTimeout(t *testing.T) {
+	ctx, cancel := WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	deadline, ok := ctx.Deadline()
+	assert.True(t, ok)
+	assert.WithinDuration(t, time.Now().Add(50*time.Millisecond), deadline, 20*time.Millisecond)
+	t.Log("✓ Timeout positivo define o prazo da operação")
+}
+
+func TestWithTimeout_Disabled(t *testing.T) {
+	ctx, cancel := WithTimeout(context.Background(), 0)
+
+	_, ok := ctx.Deadline()
+	assert.False(t, ok)
+
+	cancel()
+	assert.ErrorIs(t, ctx.Err(), context.Canceled)
+	t.Log("✓ Timeout zero não define prazo e o contexto ainda pode ser cancelado")
+}
+
+func TestWithTimeout_KeepsCallerCancellation(t *testing.T) {
+	parent, cancelParent := context.WithCancel(context.Background())
+	ctx, cancel := WithTimeout(parent, time.Minute)
+	defer cancel()
+
+	cancelParent()
+
+	assert.ErrorIs(t, ctx.Err(), context.Canceled)
+	t.Log("✓ Cancelamento do chamador interrompe a operação antes do timeout")
+}
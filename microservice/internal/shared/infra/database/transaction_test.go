@@ -0,0 +1,75 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
+)
+
+type transactionTestRow struct {
+	ID string `gorm:"primaryKey"`
+}
+
+func setupTransactionTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{Logger: gormlogger.Discard})
+	require.NoError(t, err)
+
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	sqlDB.SetMaxOpenConns(1)
+
+	require.NoError(t, db.AutoMigrate(&transactionTestRow{}))
+	return db
+}
+
+func TestTransaction_RollsBackEveryWriteThroughConn(t *testing.T) {
+	db := setupTransactionTestDB(t)
+
+	err := Transaction(context.Background(), db, func(ctx context.Context) error {
+		require.NoError(t, Conn(ctx, db).Create(&transactionTestRow{ID: "first"}).Error)
+		require.NoError(t, Conn(ctx, db).Create(&transactionTestRow{ID: "second"}).Error)
+		return errors.New("enqueue failed")
+	})
+
+	assert.EqualError(t, err, "enqueue failed")
+
+	var count int64
+	require.NoError(t, db.Model(&transactionTestRow{}).Count(&count).Error)
+	assert.Zero(t, count)
+	t.Log("✓ Falha em qualquer etapa desfaz todas as gravações feitas pelo Conn")
+}
+
+func TestTransaction_NestedCallJoinsOuterTransaction(t *testing.T) {
+	db := setupTransactionTestDB(t)
+
+	err := Transaction(context.Background(), db, func(ctx context.Context) error {
+		require.NoError(t, Transaction(ctx, db, func(ctx context.Context) error {
+			return Conn(ctx, db).Create(&transactionTestRow{ID: "inner"}).Error
+		}))
+		return errors.New("outer failed")
+	})
+
+	assert.Error(t, err)
+
+	var count int64
+	require.NoError(t, db.Model(&transactionTestRow{}).Count(&count).Error)
+	assert.Zero(t, count)
+	t.Log("✓ Transação aninhada é desfeita junto com a externa")
+}
+
+func TestConn_WithoutTransaction(t *testing.T) {
+	db := setupTransactionTestDB(t)
+
+	require.NoError(t, Conn(context.Background(), db).Create(&transactionTestRow{ID: "standalone"}).Error)
+
+	var count int64
+	require.NoError(t, db.Model(&transactionTestRow{}).Count(&count).Error)
+	assert.Equal(t, int64(1), count)
+	t.Log("✓ Sem transação no contexto o Conn usa a conexão padrão")
+}
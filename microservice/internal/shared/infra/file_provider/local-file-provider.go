@@ -37,6 +37,18 @@ func (l *LocalFileProvider) UploadFile(fileName string, fileContent []byte) erro
 	return os.WriteFile(filePath, fileContent, 0644)
 }
 
+func (l *LocalFileProvider) DownloadFile(fileName string) ([]byte, error) {
+	fileExits := l.fileExists(fileName)
+
+	if !fileExits {
+		return nil, os.ErrNotExist
+	}
+
+	filePath := filepath.Join(l.basePath, fileName)
+
+	return os.ReadFile(filePath)
+}
+
 func (l *LocalFileProvider) DeleteFile(fileName string) error {
 	fileExits := l.fileExists(fileName)
 
@@ -49,6 +61,16 @@ func (l *LocalFileProvider) DeleteFile(fileName string) error {
 	return os.Remove(filePath)
 }
 
+func (l *LocalFileProvider) GetPresignedURL(fileName string) (string, error) {
+	fileExits := l.fileExists(fileName)
+
+	if !fileExits {
+		return "", os.ErrNotExist
+	}
+
+	return filepath.Join(l.basePath, fileName), nil
+}
+
 func (l *LocalFileProvider) fileExists(fileName string) bool {
 	filePath := filepath.Join(l.basePath, fileName)
 
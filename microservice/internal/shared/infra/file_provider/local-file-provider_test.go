@@ -137,6 +137,40 @@ func TestLocalFileProvider_DeleteFile_NotExists(t *testing.T) {
 	os.RemoveAll(provider.basePath)
 }
 
+func TestLocalFileProvider_DownloadFile_Success(t *testing.T) {
+	// Arrange
+	provider := NewLocalFileProvider()
+	fileName := "file-to-download.txt"
+	fileContent := []byte("content to download")
+
+	_ = provider.UploadFile(fileName, fileContent)
+
+	// Act
+	content, err := provider.DownloadFile(fileName)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, fileContent, content)
+
+	// Cleanup
+	os.RemoveAll(provider.basePath)
+}
+
+func TestLocalFileProvider_DownloadFile_NotExists(t *testing.T) {
+	// Arrange
+	provider := NewLocalFileProvider()
+
+	// Act
+	content, err := provider.DownloadFile("non-existent-file.txt")
+
+	// Assert
+	assert.Nil(t, content)
+	assert.Equal(t, os.ErrNotExist, err)
+
+	// Cleanup
+	os.RemoveAll(provider.basePath)
+}
+
 func TestLocalFileProvider_fileExists_True(t *testing.T) {
 	// Arrange
 	provider := NewLocalFileProvider()
@@ -0,0 +1,134 @@
+package file_service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+const (
+	s3RequestTimeout    = 30 * time.Second
+	defaultS3PresignTTL = 5 * time.Minute
+)
+
+type S3FileProviderConfig struct {
+	Bucket string
+	// Endpoint aponta para LocalStack ou MinIO; com ele os objetos são endereçados por path-style
+	Endpoint          string
+	PresignExpiration time.Duration
+}
+
+// S3FileProvider guarda os arquivos em um bucket compartilhado, acessível por todas as réplicas e pelos demais serviços.
+// Usa o client oficial do S3 sobre o mesmo aws.Config do SQS, herdando a cadeia de credenciais (IRSA, ECS, sessão) e os retries do SDK
+type S3FileProvider struct {
+	config  S3FileProviderConfig
+	client  *s3.Client
+	presign *s3.PresignClient
+}
+
+func NewS3FileProvider(awsConfig aws.Config, providerConfig S3FileProviderConfig) (*S3FileProvider, error) {
+	if providerConfig.Bucket == "" {
+		return nil, fmt.Errorf("s3 bucket is required")
+	}
+
+	client := s3.NewFromConfig(awsConfig, func(options *s3.Options) {
+		if providerConfig.Endpoint == "" {
+			return
+		}
+
+		// O endpoint do S3 prevalece sobre o resolver global do LocalStack configurado para o SQS
+		options.EndpointResolver = nil
+		options.BaseEndpoint = aws.String(strings.TrimSuffix(providerConfig.Endpoint, "/"))
+		options.UsePathStyle = true
+	})
+
+	expiration := providerConfig.PresignExpiration
+	if expiration <= 0 {
+		expiration = defaultS3PresignTTL
+	}
+
+	return &S3FileProvider{
+		config:  providerConfig,
+		client:  client,
+		presign: s3.NewPresignClient(client, s3.WithPresignExpires(expiration)),
+	}, nil
+}
+
+func (p *S3FileProvider) UploadFile(fileName string, fileContent []byte) error {
+	ctx, cancel := context.WithTimeout(context.Background(), s3RequestTimeout)
+	defer cancel()
+
+	_, err := p.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:        aws.String(p.config.Bucket),
+		Key:           aws.String(fileName),
+		Body:          bytes.NewReader(fileContent),
+		ContentLength: aws.Int64(int64(len(fileContent))),
+	})
+	return s3Error(err, "upload", fileName)
+}
+
+func (p *S3FileProvider) DownloadFile(fileName string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), s3RequestTimeout)
+	defer cancel()
+
+	output, err := p.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(p.config.Bucket),
+		Key:    aws.String(fileName),
+	})
+	if err != nil {
+		return nil, s3Error(err, "download", fileName)
+	}
+	defer output.Body.Close()
+
+	return io.ReadAll(output.Body)
+}
+
+func (p *S3FileProvider) DeleteFile(fileName string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), s3RequestTimeout)
+	defer cancel()
+
+	_, err := p.client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(p.config.Bucket),
+		Key:    aws.String(fileName),
+	})
+	return s3Error(err, "delete", fileName)
+}
+
+func (p *S3FileProvider) GetPresignedURL(fileName string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), s3RequestTimeout)
+	defer cancel()
+
+	request, err := p.presign.PresignGetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(p.config.Bucket),
+		Key:    aws.String(fileName),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to presign s3 url: %w", err)
+	}
+
+	return request.URL, nil
+}
+
+// s3Error mantém a semântica do LocalFileProvider: objeto ausente vira os.ErrNotExist
+func s3Error(err error, operation string, fileName string) error {
+	if err == nil {
+		return nil
+	}
+
+	var responseError *awshttp.ResponseError
+	if errors.As(err, &responseError) && responseError.HTTPStatusCode() == http.StatusNotFound {
+		return os.ErrNotExist
+	}
+
+	return fmt.Errorf("s3 %s of %s failed: %w", operation, fileName, err)
+}
@@ -0,0 +1,170 @@
+package file_service
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeS3Server struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+	headers []http.Header
+}
+
+func newFakeS3Server(t *testing.T) (*fakeS3Server, *httptest.Server) {
+	fake := &fakeS3Server{objects: make(map[string][]byte)}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fake.mu.Lock()
+		defer fake.mu.Unlock()
+
+		fake.headers = append(fake.headers, r.Header.Clone())
+
+		switch r.Method {
+		case http.MethodPut:
+			body, _ := io.ReadAll(r.Body)
+			fake.objects[r.URL.Path] = body
+		case http.MethodGet:
+			body, ok := fake.objects[r.URL.Path]
+			if !ok {
+				http.Error(w, "NoSuchKey", http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write(body)
+		case http.MethodDelete:
+			delete(fake.objects, r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return fake, server
+}
+
+func newTestS3FileProvider(t *testing.T, endpoint string) *S3FileProvider {
+	provider, err := NewS3FileProvider(testAWSConfig(), S3FileProviderConfig{
+		Bucket:   "claim-check",
+		Endpoint: endpoint,
+	})
+	require.NoError(t, err)
+	return provider
+}
+
+func testAWSConfig() aws.Config {
+	return aws.Config{
+		Region: "us-east-1",
+		Credentials: aws.CredentialsProviderFunc(func(ctx context.Context) (aws.Credentials, error) {
+			return aws.Credentials{AccessKeyID: "test", SecretAccessKey: "test"}, nil
+		}),
+		// Sem retries o teste de erro não espera o backoff do SDK
+		RetryMaxAttempts: 1,
+	}
+}
+
+func TestNewS3FileProvider_RequiresBucket(t *testing.T) {
+	// Act
+	provider, err := NewS3FileProvider(testAWSConfig(), S3FileProviderConfig{})
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, provider)
+}
+
+func TestS3FileProvider_Addressing(t *testing.T) {
+	// Sem endpoint o bucket é endereçado por virtual-hosted style
+	presignedURL, err := newTestS3FileProvider(t, "").GetPresignedURL("payload.json")
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(presignedURL, "https://claim-check.s3.us-east-1.amazonaws.com/payload.json?"))
+
+	// Com endpoint (LocalStack/MinIO) o bucket vai no path
+	presignedURL, err = newTestS3FileProvider(t, "http://minio:9000/").GetPresignedURL("payload.json")
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(presignedURL, "http://minio:9000/claim-check/payload.json?"))
+}
+
+func TestS3FileProvider_UploadDownloadDelete(t *testing.T) {
+	// Arrange
+	fake, server := newFakeS3Server(t)
+	provider := newTestS3FileProvider(t, server.URL)
+	content := []byte(`{"order_id": "large"}`)
+
+	// Act & Assert
+	require.NoError(t, provider.UploadFile("sqs-payload-1.json", content))
+	assert.Equal(t, content, fake.objects["/claim-check/sqs-payload-1.json"])
+
+	downloaded, err := provider.DownloadFile("sqs-payload-1.json")
+	require.NoError(t, err)
+	assert.Equal(t, content, downloaded)
+
+	require.NoError(t, provider.DeleteFile("sqs-payload-1.json"))
+	assert.Empty(t, fake.objects)
+
+	// O SDK assina todas as requisições com SigV4
+	for _, header := range fake.headers {
+		assert.True(t, strings.HasPrefix(header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=test/"))
+		assert.NotEmpty(t, header.Get("X-Amz-Date"))
+	}
+}
+
+func TestS3FileProvider_DownloadFile_NotFound(t *testing.T) {
+	// Arrange
+	_, server := newFakeS3Server(t)
+	provider := newTestS3FileProvider(t, server.URL)
+
+	// Act
+	content, err := provider.DownloadFile("missing.json")
+
+	// Assert
+	assert.ErrorIs(t, err, os.ErrNotExist)
+	assert.Nil(t, content)
+}
+
+func TestS3FileProvider_UploadFile_ServerError(t *testing.T) {
+	// Arrange
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/xml")
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`<Error><Code>AccessDenied</Code><Message>Access Denied</Message></Error>`))
+	}))
+	defer server.Close()
+	provider := newTestS3FileProvider(t, server.URL)
+
+	// Act
+	err := provider.UploadFile("payload.json", []byte("content"))
+
+	// Assert
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "403")
+	assert.Contains(t, err.Error(), "AccessDenied")
+}
+
+func TestS3FileProvider_GetPresignedURL(t *testing.T) {
+	// Arrange
+	provider, err := NewS3FileProvider(testAWSConfig(), S3FileProviderConfig{
+		Bucket:            "claim-check",
+		Endpoint:          "http://minio:9000",
+		PresignExpiration: 10 * time.Minute,
+	})
+	require.NoError(t, err)
+
+	// Act
+	presignedURL, err := provider.GetPresignedURL("photo.png")
+
+	// Assert
+	require.NoError(t, err)
+	parsed, err := url.Parse(presignedURL)
+	require.NoError(t, err)
+	assert.Equal(t, "/claim-check/photo.png", parsed.Path)
+	assert.Equal(t, "600", parsed.Query().Get("X-Amz-Expires"))
+	assert.NotEmpty(t, parsed.Query().Get("X-Amz-Signature"))
+}
@@ -0,0 +1,91 @@
+package health
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"time"
+
+	"tech_challenge/internal/shared/infra/metrics"
+	"tech_challenge/internal/shared/interfaces"
+)
+
+type checkFunc struct {
+	name  string
+	check func(ctx context.Context) (map[string]any, error)
+}
+
+func (c checkFunc) Name() string {
+	return c.name
+}
+
+func (c checkFunc) Check(ctx context.Context) (map[string]any, error) {
+	return c.check(ctx)
+}
+
+// NewCheck adapta uma função ao IHealthChecker, para checagens que não precisam de um tipo próprio
+func NewCheck(name string, check func(ctx context.Context) (map[string]any, error)) interfaces.IHealthChecker {
+	return checkFunc{name: name, check: check}
+}
+
+// NewDatabaseChecker faz um ping no banco e informa o uso do pool de conexões
+func NewDatabaseChecker(db *sql.DB) interfaces.IHealthChecker {
+	return NewCheck("database", func(ctx context.Context) (map[string]any, error) {
+		err := db.PingContext(ctx)
+
+		stats := db.Stats()
+		details := map[string]any{
+			"open_connections": stats.OpenConnections,
+			"in_use":           stats.InUse,
+		}
+
+		return details, err
+	})
+}
+
+// NewBrokerChecker confirma que o broker alcança a fila consumida
+func NewBrokerChecker(broker interfaces.IBrokerHealth) interfaces.IHealthChecker {
+	return NewCheck("message_broker", func(ctx context.Context) (map[string]any, error) {
+		return nil, broker.Ping(ctx)
+	})
+}
+
+// NewPollingChecker acusa as assinaturas sem um polling bem-sucedido dentro de staleAfter,
+// o sinal de um poller travado em uma mensagem ou sem acesso à fila
+func NewPollingChecker(broker interfaces.IBrokerHealth, staleAfter time.Duration) interfaces.IHealthChecker {
+	return NewCheck("message_polling", func(ctx context.Context) (map[string]any, error) {
+		now := time.Now()
+		lastPolls := broker.LastPolls()
+
+		queues := make([]string, 0, len(lastPolls))
+		for queue := range lastPolls {
+			queues = append(queues, queue)
+		}
+		sort.Strings(queues)
+
+		details := make(map[string]any, len(queues))
+		var stale []string
+
+		for _, queue := range queues {
+			age := now.Sub(lastPolls[queue])
+			label := metrics.QueueLabel(queue)
+
+			details[label] = map[string]any{
+				"last_poll_at":            lastPolls[queue].UTC(),
+				"seconds_since_last_poll": int64(age.Seconds()),
+				"stale_after_seconds":     int64(staleAfter.Seconds()),
+			}
+
+			if age > staleAfter {
+				stale = append(stale, label)
+			}
+		}
+
+		if len(stale) > 0 {
+			return details, fmt.Errorf("no successful poll within %s on %v", staleAfter, stale)
+		}
+
+		return details, nil
+	})
+}
@@ -0,0 +1,80 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type brokerHealthStub struct {
+	pingErr   error
+	lastPolls map[string]time.Time
+}
+
+func (b *brokerHealthStub) Ping(ctx context.Context) error {
+	return b.pingErr
+}
+
+func (b *brokerHealthStub) LastPolls() map[string]time.Time {
+	return b.lastPolls
+}
+
+func TestDatabaseChecker(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+
+	checker := NewDatabaseChecker(sqlDB)
+	assert.Equal(t, "database", checker.Name())
+
+	details, err := checker.Check(context.Background())
+	assert.NoError(t, err)
+	assert.Contains(t, details, "open_connections")
+	assert.Contains(t, details, "in_use")
+
+	require.NoError(t, sqlDB.Close())
+	_, err = checker.Check(context.Background())
+	assert.Error(t, err)
+	t.Log("✓ Ping no banco com o uso do pool nos detalhes")
+}
+
+func TestBrokerChecker(t *testing.T) {
+	broker := &brokerHealthStub{}
+	checker := NewBrokerChecker(broker)
+
+	_, err := checker.Check(context.Background())
+	assert.NoError(t, err)
+
+	broker.pingErr = errors.New("access denied")
+	_, err = checker.Check(context.Background())
+	assert.EqualError(t, err, "access denied")
+	t.Log("✓ Falha de conectividade do broker propagada")
+}
+
+func TestPollingChecker(t *testing.T) {
+	now := time.Now()
+	broker := &brokerHealthStub{lastPolls: map[string]time.Time{
+		"http://localhost:4566/000000000000/kitchen-orders": now.Add(-10 * time.Second),
+	}}
+	checker := NewPollingChecker(broker, time.Minute)
+
+	details, err := checker.Check(context.Background())
+	assert.NoError(t, err)
+	queue := details["kitchen-orders"].(map[string]any)
+	assert.Equal(t, int64(10), queue["seconds_since_last_poll"])
+	assert.Equal(t, int64(60), queue["stale_after_seconds"])
+
+	broker.lastPolls["http://localhost:4566/000000000000/kitchen-orders-dlq"] = now.Add(-5 * time.Minute)
+	details, err = checker.Check(context.Background())
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "kitchen-orders-dlq")
+	assert.Len(t, details, 2)
+	t.Log("✓ Fila sem polling recente deixa a checagem down")
+}
@@ -0,0 +1,92 @@
+package health
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"tech_challenge/internal/shared/interfaces"
+)
+
+const (
+	StatusUp   = "up"
+	StatusDown = "down"
+)
+
+type ComponentReport struct {
+	Status     string         `json:"status"`
+	DurationMs int64          `json:"duration_ms"`
+	Error      string         `json:"error,omitempty"`
+	Details    map[string]any `json:"details,omitempty"`
+}
+
+type Report struct {
+	Status     string                     `json:"status"`
+	Components map[string]ComponentReport `json:"components"`
+}
+
+func (r Report) Healthy() bool {
+	return r.Status == StatusUp
+}
+
+// Run executa os checkers em paralelo, cada um limitado pelo timeout; basta um componente fora para o relatório ficar down
+func Run(ctx context.Context, timeout time.Duration, checkers []interfaces.IHealthChecker) Report {
+	report := Report{
+		Status:     StatusUp,
+		Components: make(map[string]ComponentReport, len(checkers)),
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	for _, checker := range checkers {
+		wg.Add(1)
+		go func(checker interfaces.IHealthChecker) {
+			defer wg.Done()
+
+			component := runChecker(ctx, timeout, checker)
+
+			mu.Lock()
+			defer mu.Unlock()
+			report.Components[checker.Name()] = component
+			if component.Status != StatusUp {
+				report.Status = StatusDown
+			}
+		}(checker)
+	}
+
+	wg.Wait()
+	return report
+}
+
+func runChecker(ctx context.Context, timeout time.Duration, checker interfaces.IHealthChecker) ComponentReport {
+	checkCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	start := time.Now()
+	result := make(chan ComponentReport, 1)
+
+	// O checker roda à parte para que um driver que ignore o contexto não prenda o probe além do timeout
+	go func() {
+		details, err := checker.Check(checkCtx)
+
+		component := ComponentReport{Status: StatusUp, Details: details}
+		if err != nil {
+			component.Status = StatusDown
+			component.Error = err.Error()
+		}
+		result <- component
+	}()
+
+	select {
+	case component := <-result:
+		component.DurationMs = time.Since(start).Milliseconds()
+		return component
+	case <-checkCtx.Done():
+		return ComponentReport{
+			Status:     StatusDown,
+			DurationMs: time.Since(start).Milliseconds(),
+			Error:      "check timed out after " + timeout.String(),
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"tech_challenge/internal/shared/interfaces"
+)
+
+func TestRun_AllUp(t *testing.T) {
+	report := Run(context.Background(), time.Second, []interfaces.IHealthChecker{
+		NewCheck("database", func(ctx context.Context) (map[string]any, error) {
+			return map[string]any{"in_use": 0}, nil
+		}),
+		NewCheck("migrations", func(ctx context.Context) (map[string]any, error) {
+			return nil, nil
+		}),
+	})
+
+	assert.True(t, report.Healthy())
+	assert.Len(t, report.Components, 2)
+	assert.Equal(t, StatusUp, report.Components["database"].Status)
+	assert.Equal(t, map[string]any{"in_use": 0}, report.Components["database"].Details)
+	assert.Empty(t, report.Components["migrations"].Error)
+	t.Log("✓ Relatório up com todos os componentes no ar")
+}
+
+func TestRun_ComponentDown(t *testing.T) {
+	report := Run(context.Background(), time.Second, []interfaces.IHealthChecker{
+		NewCheck("database", func(ctx context.Context) (map[string]any, error) {
+			return nil, nil
+		}),
+		NewCheck("message_broker", func(ctx context.Context) (map[string]any, error) {
+			return map[string]any{"queue": "orders"}, errors.New("queue does not exist")
+		}),
+	})
+
+	assert.False(t, report.Healthy())
+	assert.Equal(t, StatusUp, report.Components["database"].Status)
+	assert.Equal(t, StatusDown, report.Components["message_broker"].Status)
+	assert.Equal(t, "queue does not exist", report.Components["message_broker"].Error)
+	assert.Equal(t, "orders", report.Components["message_broker"].Details["queue"])
+	t.Log("✓ Um componente fora deixa o relatório down e mantém os detalhes")
+}
+
+func TestRun_Timeout(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+
+	start := time.Now()
+	report := Run(context.Background(), 50*time.Millisecond, []interfaces.IHealthChecker{
+		// Simula um driver que ignora o cancelamento do contexto
+		NewCheck("database", func(ctx context.Context) (map[string]any, error) {
+			<-release
+			return nil, nil
+		}),
+	})
+
+	assert.Less(t, time.Since(start), time.Second)
+	assert.False(t, report.Healthy())
+	assert.Equal(t, StatusDown, report.Components["database"].Status)
+	assert.Contains(t, report.Components["database"].Error, "timed out after 50ms")
+	t.Log("✓ Checagem travada é encerrada pelo timeout")
+}
+
+func TestRun_NoCheckers(t *testing.T) {
+	report := Run(context.Background(), time.Second, nil)
+
+	assert.True(t, report.Healthy())
+	assert.Empty(t, report.Components)
+	t.Log("✓ Sem checkers o relatório fica up")
+}
@@ -4,43 +4,72 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"log"
+	"log/slog"
+	"strconv"
 	"sync"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/service/sqs"
 	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
 
+	"tech_challenge/internal/shared/infra/metrics"
 	"tech_challenge/internal/shared/interfaces"
+	identity_manager "tech_challenge/internal/shared/pkg/identity"
+	"tech_challenge/internal/shared/pkg/logger"
+)
+
+const (
+	// ClaimCheckHeader carrega a referência do payload armazenado no file provider
+	ClaimCheckHeader = "claim-check-reference"
+
+	// DefaultClaimCheckThreshold fica abaixo do limite de 256 KB do SQS, deixando margem para os atributos
+	DefaultClaimCheckThreshold = 200 * 1024
+
+	// DefaultDrainTimeout é o tempo máximo que o Stop aguarda as mensagens em processamento
+	DefaultDrainTimeout = 20 * time.Second
 )
 
 type SQSBroker struct {
-	client SQSClientInterface
-	config SQSConfig
-	mu     sync.Mutex
-	ctx    context.Context
-	cancel context.CancelFunc
+	client        SQSClientInterface
+	awsConfig     aws.Config
+	fileProvider  interfaces.IFileProvider
+	config        SQSConfig
+	mu            sync.Mutex
+	ctx           context.Context
+	cancel        context.CancelFunc
+	handlerCtx    context.Context
+	handlerCancel context.CancelFunc
+	pollers       sync.WaitGroup
+	lastPolls     map[string]time.Time
 }
 
 type SQSClientInterface interface {
 	ReceiveMessage(ctx context.Context, params *sqs.ReceiveMessageInput, optFns ...func(*sqs.Options)) (*sqs.ReceiveMessageOutput, error)
 	DeleteMessage(ctx context.Context, params *sqs.DeleteMessageInput, optFns ...func(*sqs.Options)) (*sqs.DeleteMessageOutput, error)
 	SendMessage(ctx context.Context, params *sqs.SendMessageInput, optFns ...func(*sqs.Options)) (*sqs.SendMessageOutput, error)
+	GetQueueAttributes(ctx context.Context, params *sqs.GetQueueAttributesInput, optFns ...func(*sqs.Options)) (*sqs.GetQueueAttributesOutput, error)
 }
 
 type SQSConfig struct {
-	Region      string
-	QueueURL    string
-	EndpointURL string
+	Region              string
+	QueueURL            string
+	EndpointURL         string
+	ClaimCheckThreshold int
+	DrainTimeout        time.Duration
 }
 
 func NewSQSBroker(config SQSConfig) *SQSBroker {
 	ctx, cancel := context.WithCancel(context.Background())
+	handlerCtx, handlerCancel := context.WithCancel(context.Background())
 	return &SQSBroker{
-		config: config,
-		ctx:    ctx,
-		cancel: cancel,
+		config:        config,
+		ctx:           ctx,
+		cancel:        cancel,
+		handlerCtx:    handlerCtx,
+		handlerCancel: handlerCancel,
+		lastPolls:     make(map[string]time.Time),
 	}
 }
 
@@ -51,6 +80,13 @@ func (s *SQSBroker) SetClient(client SQSClientInterface) {
 	s.client = client
 }
 
+// SetFileProvider habilita o claim-check: payloads acima do limite são armazenados no file provider
+func (s *SQSBroker) SetFileProvider(fileProvider interfaces.IFileProvider) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.fileProvider = fileProvider
+}
+
 func (s *SQSBroker) Connect(ctx context.Context) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -69,7 +105,7 @@ func (s *SQSBroker) Connect(ctx context.Context) error {
 			}),
 		))
 		
-		log.Println("Using LocalStack endpoint, loading credentials from environment")
+		slog.Info("Using LocalStack endpoint, loading credentials from environment")
 	}
 
 	cfg, err := config.LoadDefaultConfig(ctx, configOptions...)
@@ -77,11 +113,19 @@ func (s *SQSBroker) Connect(ctx context.Context) error {
 		return fmt.Errorf("failed to load AWS config: %w", err)
 	}
 
+	s.awsConfig = cfg
 	s.client = sqs.NewFromConfig(cfg)
-	log.Println("Connected to SQS successfully")
+	slog.Info("Connected to SQS successfully")
 	return nil
 }
 
+// AWSConfig expõe a configuração carregada no Connect para que os demais clients da AWS usem a mesma cadeia de credenciais
+func (s *SQSBroker) AWSConfig() aws.Config {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.awsConfig
+}
+
 func (s *SQSBroker) Close() error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -89,38 +133,68 @@ func (s *SQSBroker) Close() error {
 	if s.cancel != nil {
 		s.cancel()
 	}
+	if s.handlerCancel != nil {
+		s.handlerCancel()
+	}
 	return nil
 }
 
 func (s *SQSBroker) Publish(ctx context.Context, queue string, message interfaces.Message) error {
+	// O lock protege apenas a leitura das dependências; o upload e o envio acontecem fora dele
 	s.mu.Lock()
-	defer s.mu.Unlock()
+	client := s.client
+	fileProvider := s.fileProvider
+	s.mu.Unlock()
 
-	if s.client == nil {
+	if client == nil {
+		metrics.IncSQSPublishFailures(queue)
 		return fmt.Errorf("not connected to SQS")
 	}
 
-	log.Printf("Publishing message to queue: %s", queue)
-	log.Printf("Message payload: %s", string(message.Body))
+	publishLogger := logger.FromContext(ctx).With(logger.QueueKey, metrics.QueueLabel(queue))
 
-	messageAttributes := make(map[string]types.MessageAttributeValue)
+	body := message.Body
+	headers := make(map[string]string, len(message.Headers)+1)
 	for k, v := range message.Headers {
+		headers[k] = v
+	}
+
+	if s.shouldClaimCheck(fileProvider, body) {
+		reference, err := storePayload(fileProvider, body)
+		if err != nil {
+			metrics.IncSQSPublishFailures(queue)
+			return err
+		}
+
+		publishLogger.Info("Message payload stored with claim-check", "size_bytes", len(body), "claim_check_reference", reference)
+		headers[ClaimCheckHeader] = reference
+		body = []byte(fmt.Sprintf(`{"claim_check_reference":%q}`, reference))
+	}
+
+	messageAttributes := make(map[string]types.MessageAttributeValue)
+	for k, v := range headers {
 		messageAttributes[k] = types.MessageAttributeValue{
 			DataType:    aws.String("String"),
 			StringValue: aws.String(v),
 		}
 	}
 
-	_, err := s.client.SendMessage(ctx, &sqs.SendMessageInput{
+	output, err := client.SendMessage(ctx, &sqs.SendMessageInput{
 		QueueUrl:          aws.String(queue),
-		MessageBody:       aws.String(string(message.Body)),
+		MessageBody:       aws.String(string(body)),
 		MessageAttributes: messageAttributes,
 	})
 
 	if err != nil {
+		metrics.IncSQSPublishFailures(queue)
+		if reference, ok := headers[ClaimCheckHeader]; ok {
+			deletePayload(fileProvider, reference)
+		}
 		return fmt.Errorf("failed to send message to SQS: %w", err)
 	}
 
+	// O corpo não é registrado: as mensagens carregam dados do cliente
+	publishLogger.Debug("Message published", logger.MessageIDKey, aws.ToString(output.MessageId), "size_bytes", len(body))
 	return nil
 }
 
@@ -132,17 +206,29 @@ func (s *SQSBroker) Subscribe(ctx context.Context, queue string, handler interfa
 		return fmt.Errorf("not connected to SQS")
 	}
 
+	s.pollers.Add(1)
+	// A assinatura conta como um polling para que a readiness não falhe antes da primeira resposta do long polling
+	s.lastPolls[queue] = time.Now()
 	go s.pollMessages(ctx, queue, handler)
 
-	log.Printf("Subscribed to queue: %s", queue)
+	slog.Info("Subscribed to queue", logger.QueueKey, metrics.QueueLabel(queue))
 	return nil
 }
 
 func (s *SQSBroker) PollMessages(ctx context.Context, queue string, handler interfaces.MessageHandler) {
+	s.pollers.Add(1)
 	s.pollMessages(ctx, queue, handler)
 }
 
 func (s *SQSBroker) pollMessages(ctx context.Context, queue string, handler interfaces.MessageHandler) {
+	defer s.pollers.Done()
+
+	// O long polling é interrompido tanto pelo contexto do chamador quanto pelo Stop do broker
+	pollCtx, stopPolling := context.WithCancel(ctx)
+	defer stopPolling()
+	stopAfter := context.AfterFunc(s.ctx, stopPolling)
+	defer stopAfter()
+
 	for {
 		select {
 		case <-ctx.Done():
@@ -150,12 +236,54 @@ func (s *SQSBroker) pollMessages(ctx context.Context, queue string, handler inte
 		case <-s.ctx.Done():
 			return
 		default:
-			s.processBatch(ctx, handler)
+			if err := s.processBatch(pollCtx, handler); err == nil {
+				s.recordPoll(queue)
+			}
 		}
 	}
 }
 
-func (s *SQSBroker) processBatch(ctx context.Context, handler interfaces.MessageHandler) {
+func (s *SQSBroker) recordPoll(queue string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lastPolls[queue] = time.Now()
+}
+
+// LastPolls devolve o horário do último lote recebido e processado de cada fila assinada
+func (s *SQSBroker) LastPolls() map[string]time.Time {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	lastPolls := make(map[string]time.Time, len(s.lastPolls))
+	for queue, lastPoll := range s.lastPolls {
+		lastPolls[queue] = lastPoll
+	}
+	return lastPolls
+}
+
+// Ping consulta os atributos da fila consumida, o que confirma a rede, as credenciais e a existência da fila
+func (s *SQSBroker) Ping(ctx context.Context) error {
+	s.mu.Lock()
+	client := s.client
+	s.mu.Unlock()
+
+	if client == nil {
+		return fmt.Errorf("not connected to SQS")
+	}
+
+	_, err := client.GetQueueAttributes(ctx, &sqs.GetQueueAttributesInput{
+		QueueUrl:       aws.String(s.config.QueueURL),
+		AttributeNames: []types.QueueAttributeName{types.QueueAttributeNameQueueArn},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to reach SQS queue: %w", err)
+	}
+
+	return nil
+}
+
+// processBatch devolve o erro do recebimento; falhas no processamento de cada mensagem são tratadas nela
+func (s *SQSBroker) processBatch(ctx context.Context, handler interfaces.MessageHandler) error {
 	result, err := s.client.ReceiveMessage(ctx, &sqs.ReceiveMessageInput{
 		QueueUrl:            aws.String(s.config.QueueURL),
 		MaxNumberOfMessages: 10,
@@ -163,19 +291,42 @@ func (s *SQSBroker) processBatch(ctx context.Context, handler interfaces.Message
 		MessageAttributeNames: []string{
 			"All",
 		},
+		MessageSystemAttributeNames: []types.MessageSystemAttributeName{
+			types.MessageSystemAttributeNameSentTimestamp,
+		},
 	})
 
 	if err != nil {
-		log.Printf("Error receiving messages from SQS: %v", err)
-		return
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		slog.Error("Error receiving messages from SQS", logger.QueueKey, metrics.QueueLabel(s.config.QueueURL), logger.ErrorKey, err)
+		return err
 	}
 
+	metrics.AddSQSMessagesReceived(s.config.QueueURL, len(result.Messages))
+
 	for _, msg := range result.Messages {
-		s.processMessage(ctx, msg, handler)
+		// Após o Stop, as mensagens ainda não iniciadas voltam para a fila ao fim do visibility timeout
+		if s.ctx.Err() != nil {
+			return nil
+		}
+
+		// Handlers usam um contexto próprio para que o Stop não os interrompa no meio de uma transação
+		s.processMessage(s.handlerCtx, msg, handler)
 	}
+
+	return nil
 }
 
 func (s *SQSBroker) processMessage(ctx context.Context, msg types.Message, handler interfaces.MessageHandler) {
+	start := time.Now()
+	observeQueueLatency(s.config.QueueURL, msg, start)
+
+	// Os logs feitos pelo handler a partir do contexto saem com o id da mensagem
+	ctx = logger.With(ctx, logger.MessageIDKey, aws.ToString(msg.MessageId), logger.QueueKey, metrics.QueueLabel(s.config.QueueURL))
+	messageLogger := logger.FromContext(ctx)
+
 	headers := make(map[string]string)
 	for k, v := range msg.MessageAttributes {
 		if v.StringValue != nil {
@@ -185,10 +336,25 @@ func (s *SQSBroker) processMessage(ctx context.Context, msg types.Message, handl
 
 	body, err := s.unmarshalMessageBody(msg)
 	if err != nil {
-		log.Printf("Error unmarshaling message body: %v", err)
+		messageLogger.Error("Error unmarshaling message body", logger.ErrorKey, err)
+		metrics.ObserveSQSMessageFailed(s.config.QueueURL, time.Since(start))
 		return
 	}
 
+	s.mu.Lock()
+	fileProvider := s.fileProvider
+	s.mu.Unlock()
+
+	reference, hasClaimCheck := headers[ClaimCheckHeader]
+	if hasClaimCheck {
+		body, err = loadPayload(fileProvider, reference)
+		if err != nil {
+			messageLogger.Error("Error loading claim-check payload", "claim_check_reference", reference, logger.ErrorKey, err)
+			metrics.ObserveSQSMessageFailed(s.config.QueueURL, time.Since(start))
+			return
+		}
+	}
+
 	message := interfaces.Message{
 		ID:      *msg.MessageId,
 		Body:    body,
@@ -196,17 +362,80 @@ func (s *SQSBroker) processMessage(ctx context.Context, msg types.Message, handl
 	}
 
 	if err := handler(ctx, message); err != nil {
-		log.Printf("Error processing message: %v", err)
+		messageLogger.Error("Error processing message", logger.ErrorKey, err)
+		metrics.ObserveSQSMessageFailed(s.config.QueueURL, time.Since(start))
 		return
 	}
 
+	metrics.ObserveSQSMessageProcessed(s.config.QueueURL, time.Since(start))
+
 	_, err = s.client.DeleteMessage(ctx, &sqs.DeleteMessageInput{
 		QueueUrl:      aws.String(s.config.QueueURL),
 		ReceiptHandle: msg.ReceiptHandle,
 	})
 
 	if err != nil {
-		log.Printf("Error deleting message from SQS: %v", err)
+		messageLogger.Error("Error deleting message from SQS", logger.ErrorKey, err)
+		return
+	}
+
+	// O payload só é removido após a mensagem sair da fila, senão uma nova entrega não conseguiria reidratá-lo
+	if hasClaimCheck {
+		deletePayload(fileProvider, reference)
+	}
+}
+
+// observeQueueLatency mede a espera na fila a partir do SentTimestamp, em milissegundos, que o SQS envia com a mensagem
+func observeQueueLatency(queue string, msg types.Message, receivedAt time.Time) {
+	sentTimestamp, ok := msg.Attributes[string(types.MessageSystemAttributeNameSentTimestamp)]
+	if !ok {
+		return
+	}
+
+	sentAtMillis, err := strconv.ParseInt(sentTimestamp, 10, 64)
+	if err != nil {
+		return
+	}
+
+	metrics.ObserveSQSQueueLatency(queue, receivedAt.Sub(time.UnixMilli(sentAtMillis)))
+}
+
+func (s *SQSBroker) claimCheckThreshold() int {
+	if s.config.ClaimCheckThreshold > 0 {
+		return s.config.ClaimCheckThreshold
+	}
+	return DefaultClaimCheckThreshold
+}
+
+func (s *SQSBroker) shouldClaimCheck(fileProvider interfaces.IFileProvider, body []byte) bool {
+	return fileProvider != nil && len(body) > s.claimCheckThreshold()
+}
+
+func storePayload(fileProvider interfaces.IFileProvider, body []byte) (string, error) {
+	reference := fmt.Sprintf("sqs-payload-%s.json", identity_manager.NewUUIDV4())
+
+	if err := fileProvider.UploadFile(reference, body); err != nil {
+		return "", fmt.Errorf("failed to store claim-check payload: %w", err)
+	}
+
+	return reference, nil
+}
+
+func loadPayload(fileProvider interfaces.IFileProvider, reference string) ([]byte, error) {
+	if fileProvider == nil {
+		return nil, fmt.Errorf("claim-check payload received but no file provider is configured")
+	}
+
+	return fileProvider.DownloadFile(reference)
+}
+
+func deletePayload(fileProvider interfaces.IFileProvider, reference string) {
+	if fileProvider == nil {
+		return
+	}
+
+	if err := fileProvider.DeleteFile(reference); err != nil {
+		slog.Error("Error deleting claim-check payload", "claim_check_reference", reference, logger.ErrorKey, err)
 	}
 }
 
@@ -214,7 +443,29 @@ func (s *SQSBroker) Start(ctx context.Context) error {
 	return nil
 }
 
+// Stop interrompe o polling e aguarda, até o DrainTimeout, as mensagens em processamento
 func (s *SQSBroker) Stop() error {
+	s.cancel()
+
+	drained := make(chan struct{})
+	go func() {
+		s.pollers.Wait()
+		close(drained)
+	}()
+
+	drainTimeout := s.config.DrainTimeout
+	if drainTimeout <= 0 {
+		drainTimeout = DefaultDrainTimeout
+	}
+
+	select {
+	case <-drained:
+		slog.Info("SQS broker drained all in-flight messages")
+	case <-time.After(drainTimeout):
+		s.handlerCancel()
+		return fmt.Errorf("timed out after %s waiting for in-flight messages", drainTimeout)
+	}
+
 	return s.Close()
 }
 
@@ -241,7 +492,7 @@ type SNSNotification struct {
 func (s *SQSBroker) unmarshalMessageBody(message types.Message) ([]byte, error) {
 	var snsNotification SNSNotification
 	if err := json.Unmarshal([]byte(*message.Body), &snsNotification); err == nil && snsNotification.Type != "" {
-		log.Printf("Unwrapping SNS message from topic: %s", snsNotification.TopicArn)
+		slog.Debug("Unwrapping SNS message", "topic_arn", snsNotification.TopicArn)
 		return []byte(snsNotification.Message), nil
 	}
 
@@ -0,0 +1,88 @@
+package sqs
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/stretchr/testify/assert"
+
+	"tech_challenge/internal/shared/interfaces"
+)
+
+func TestSQSBroker_Ping(t *testing.T) {
+	queueURL := "http://localhost:4566/000000000000/health-queue"
+
+	disconnected := NewSQSBroker(SQSConfig{Region: "us-east-1", QueueURL: queueURL})
+	assert.Error(t, disconnected.Ping(context.Background()))
+
+	var requestedQueue string
+	broker := NewSQSBroker(SQSConfig{Region: "us-east-1", QueueURL: queueURL})
+	client := &mockSQSClient{
+		getQueueAttributesFunc: func(ctx context.Context, params *sqs.GetQueueAttributesInput, optFns ...func(*sqs.Options)) (*sqs.GetQueueAttributesOutput, error) {
+			requestedQueue = aws.ToString(params.QueueUrl)
+			return &sqs.GetQueueAttributesOutput{}, nil
+		},
+	}
+	broker.SetClient(client)
+
+	assert.NoError(t, broker.Ping(context.Background()))
+	assert.Equal(t, queueURL, requestedQueue)
+
+	client.getQueueAttributesFunc = func(ctx context.Context, params *sqs.GetQueueAttributesInput, optFns ...func(*sqs.Options)) (*sqs.GetQueueAttributesOutput, error) {
+		return nil, errors.New("access denied")
+	}
+	err := broker.Ping(context.Background())
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "access denied")
+	t.Log("✓ Ping consulta a fila consumida e propaga a falha")
+}
+
+func TestSQSBroker_LastPolls(t *testing.T) {
+	queueURL := "http://localhost:4566/000000000000/poll-queue"
+
+	receiveErr := errors.New("SQS receive error")
+	failing := true
+
+	broker := NewSQSBroker(SQSConfig{Region: "us-east-1", QueueURL: queueURL})
+	broker.SetClient(&mockSQSClient{
+		receiveMessageFunc: func(ctx context.Context, params *sqs.ReceiveMessageInput, optFns ...func(*sqs.Options)) (*sqs.ReceiveMessageOutput, error) {
+			if failing {
+				return nil, receiveErr
+			}
+			return &sqs.ReceiveMessageOutput{}, nil
+		},
+	})
+
+	handler := func(ctx context.Context, msg interfaces.Message) error { return nil }
+
+	assert.Empty(t, broker.LastPolls())
+
+	// O processBatch só devolve erro quando o recebimento falha
+	assert.ErrorIs(t, broker.processBatch(context.Background(), handler), receiveErr)
+	failing = false
+	assert.NoError(t, broker.processBatch(context.Background(), handler))
+
+	before := time.Now()
+	broker.recordPoll(queueURL)
+	lastPolls := broker.LastPolls()
+	assert.Len(t, lastPolls, 1)
+	assert.False(t, lastPolls[queueURL].Before(before))
+
+	// A cópia devolvida não altera o estado do broker
+	lastPolls[queueURL] = time.Time{}
+	assert.False(t, broker.LastPolls()[queueURL].IsZero())
+
+	// A assinatura já conta como polling, antes da primeira resposta do long polling
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	assert.NoError(t, broker.Subscribe(ctx, "http://localhost:4566/000000000000/other-queue", handler))
+	assert.Contains(t, broker.LastPolls(), "http://localhost:4566/000000000000/other-queue")
+	cancel()
+	assert.NoError(t, broker.Stop())
+
+	t.Log("✓ Último polling registrado por fila e devolvido como cópia")
+}
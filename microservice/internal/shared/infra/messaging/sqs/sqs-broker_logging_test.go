@@ -0,0 +1,61 @@
+package sqs
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"github.com/stretchr/testify/assert"
+
+	"tech_challenge/internal/shared/interfaces"
+	"tech_challenge/internal/shared/pkg/logger"
+)
+
+func captureLogs(t *testing.T) *bytes.Buffer {
+	var buffer bytes.Buffer
+	previous := slog.Default()
+	slog.SetDefault(logger.New(&buffer, logger.Options{Level: "debug", JSON: true}))
+	t.Cleanup(func() { slog.SetDefault(previous) })
+
+	return &buffer
+}
+
+func TestSQSBroker_Publish_DoesNotLogPayload(t *testing.T) {
+	logs := captureLogs(t)
+
+	broker := NewSQSBroker(SQSConfig{Region: "us-east-1"})
+	broker.SetClient(&mockSQSClient{})
+
+	err := broker.Publish(context.Background(), "http://localhost:4566/000000000000/orders", interfaces.Message{
+		Body: []byte(`{"customerId":"customer-secret-1"}`),
+	})
+
+	assert.NoError(t, err)
+	assert.Contains(t, logs.String(), `"queue":"orders"`)
+	assert.NotContains(t, logs.String(), "customer-secret-1")
+	t.Log("✓ Publicação registrada sem o corpo da mensagem")
+}
+
+func TestSQSBroker_ProcessMessage_AddsMessageIDToContext(t *testing.T) {
+	logs := captureLogs(t)
+
+	broker := NewSQSBroker(SQSConfig{Region: "us-east-1", QueueURL: "http://localhost:4566/000000000000/kitchen-orders"})
+	broker.SetClient(&mockSQSClient{})
+
+	handler := func(ctx context.Context, msg interfaces.Message) error {
+		logger.FromContext(ctx).Info("handling")
+		return nil
+	}
+
+	broker.processMessage(context.Background(), types.Message{
+		MessageId:     aws.String("message-7"),
+		Body:          aws.String(`{}`),
+		ReceiptHandle: aws.String("receipt"),
+	}, handler)
+
+	assert.Contains(t, logs.String(), `"msg":"handling","message_id":"message-7","queue":"kitchen-orders"`)
+	t.Log("✓ Logs do handler carregam o id da mensagem e a fila")
+}
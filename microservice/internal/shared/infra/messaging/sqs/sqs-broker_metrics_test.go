@@ -0,0 +1,101 @@
+package sqs
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"github.com/stretchr/testify/assert"
+
+	"tech_challenge/internal/shared/infra/metrics"
+	"tech_challenge/internal/shared/interfaces"
+)
+
+// metricValue lê uma série do endpoint de métricas; séries ausentes valem zero
+func metricValue(t *testing.T, series string) float64 {
+	w := httptest.NewRecorder()
+	metrics.Handler().ServeHTTP(w, httptest.NewRequest("GET", "/metrics", nil))
+
+	for _, line := range strings.Split(w.Body.String(), "\n") {
+		if value, ok := strings.CutPrefix(line, series+" "); ok {
+			parsed, err := strconv.ParseFloat(value, 64)
+			assert.NoError(t, err)
+			return parsed
+		}
+	}
+
+	return 0
+}
+
+func TestSQSBroker_Metrics_PublishFailures(t *testing.T) {
+	broker := NewSQSBroker(SQSConfig{Region: "us-east-1"})
+	broker.SetClient(&mockSQSClient{
+		sendMessageFunc: func(ctx context.Context, params *sqs.SendMessageInput, optFns ...func(*sqs.Options)) (*sqs.SendMessageOutput, error) {
+			return nil, errors.New("SQS send error")
+		},
+	})
+
+	queueURL := "http://localhost:4566/000000000000/metrics-publish-queue"
+	series := `kitchen_sqs_publish_failures_total{queue="metrics-publish-queue"}`
+
+	assert.Error(t, broker.Publish(context.Background(), queueURL, interfaces.Message{Body: []byte(`{}`)}))
+	assert.Equal(t, float64(1), metricValue(t, series))
+
+	disconnected := NewSQSBroker(SQSConfig{Region: "us-east-1"})
+	assert.Error(t, disconnected.Publish(context.Background(), queueURL, interfaces.Message{Body: []byte(`{}`)}))
+	assert.Equal(t, float64(2), metricValue(t, series))
+	t.Log("✓ Falhas de publicação contadas pelo nome da fila")
+}
+
+func TestSQSBroker_Metrics_ProcessBatch(t *testing.T) {
+	queueURL := "http://localhost:4566/000000000000/metrics-consume-queue"
+	sentAt := strconv.FormatInt(time.Now().Add(-2*time.Second).UnixMilli(), 10)
+
+	var requestedAttributes []types.MessageSystemAttributeName
+	broker := NewSQSBroker(SQSConfig{Region: "us-east-1", QueueURL: queueURL})
+	broker.SetClient(&mockSQSClient{
+		receiveMessageFunc: func(ctx context.Context, params *sqs.ReceiveMessageInput, optFns ...func(*sqs.Options)) (*sqs.ReceiveMessageOutput, error) {
+			requestedAttributes = params.MessageSystemAttributeNames
+			return &sqs.ReceiveMessageOutput{
+				Messages: []types.Message{
+					{
+						MessageId:     aws.String("ok"),
+						Body:          aws.String(`{"ok": true}`),
+						ReceiptHandle: aws.String("receipt-ok"),
+						Attributes:    map[string]string{"SentTimestamp": sentAt},
+					},
+					{
+						MessageId:     aws.String("fail"),
+						Body:          aws.String(`{"ok": false}`),
+						ReceiptHandle: aws.String("receipt-fail"),
+					},
+				},
+			}, nil
+		},
+	})
+
+	handler := func(ctx context.Context, msg interfaces.Message) error {
+		if strings.Contains(string(msg.Body), "false") {
+			return errors.New("handler error")
+		}
+		return nil
+	}
+
+	broker.processBatch(context.Background(), handler)
+
+	assert.Contains(t, requestedAttributes, types.MessageSystemAttributeNameSentTimestamp)
+	assert.Equal(t, float64(2), metricValue(t, `kitchen_sqs_messages_received_total{queue="metrics-consume-queue"}`))
+	assert.Equal(t, float64(1), metricValue(t, `kitchen_sqs_messages_processed_total{queue="metrics-consume-queue"}`))
+	assert.Equal(t, float64(1), metricValue(t, `kitchen_sqs_messages_failed_total{queue="metrics-consume-queue"}`))
+	assert.Equal(t, float64(2), metricValue(t, `kitchen_sqs_message_processing_seconds_count{queue="metrics-consume-queue"}`))
+	assert.Equal(t, float64(1), metricValue(t, `kitchen_sqs_message_queue_latency_seconds_count{queue="metrics-consume-queue"}`))
+	assert.GreaterOrEqual(t, metricValue(t, `kitchen_sqs_message_queue_latency_seconds_sum{queue="metrics-consume-queue"}`), float64(2))
+	t.Log("✓ Mensagens recebidas, processadas, com falha e latência da fila registradas")
+}
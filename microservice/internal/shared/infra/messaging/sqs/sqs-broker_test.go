@@ -3,7 +3,9 @@ package sqs
 import (
 	"context"
 	"errors"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/sqs"
@@ -15,6 +17,7 @@ type mockSQSClient struct {
 	sendMessageFunc    func(ctx context.Context, params *sqs.SendMessageInput, optFns ...func(*sqs.Options)) (*sqs.SendMessageOutput, error)
 	receiveMessageFunc func(ctx context.Context, params *sqs.ReceiveMessageInput, optFns ...func(*sqs.Options)) (*sqs.ReceiveMessageOutput, error)
 	deleteMessageFunc  func(ctx context.Context, params *sqs.DeleteMessageInput, optFns ...func(*sqs.Options)) (*sqs.DeleteMessageOutput, error)
+	getQueueAttributesFunc func(ctx context.Context, params *sqs.GetQueueAttributesInput, optFns ...func(*sqs.Options)) (*sqs.GetQueueAttributesOutput, error)
 }
 
 func (m *mockSQSClient) SendMessage(ctx context.Context, params *sqs.SendMessageInput, optFns ...func(*sqs.Options)) (*sqs.SendMessageOutput, error) {
@@ -38,6 +41,13 @@ func (m *mockSQSClient) DeleteMessage(ctx context.Context, params *sqs.DeleteMes
 	return &sqs.DeleteMessageOutput{}, nil
 }
 
+func (m *mockSQSClient) GetQueueAttributes(ctx context.Context, params *sqs.GetQueueAttributesInput, optFns ...func(*sqs.Options)) (*sqs.GetQueueAttributesOutput, error) {
+	if m.getQueueAttributesFunc != nil {
+		return m.getQueueAttributesFunc(ctx, params, optFns...)
+	}
+	return &sqs.GetQueueAttributesOutput{}, nil
+}
+
 func TestSQSBroker_Connect_Success(t *testing.T) {
 	config := SQSConfig{
 		Region:      "us-east-1",
@@ -502,3 +512,381 @@ func TestSQSBroker_Start_Stop(t *testing.T) {
 	
 	t.Log("✓ Start/Stop executados com sucesso")
 }
+
+type mockFileProvider struct {
+	files map[string][]byte
+}
+
+func newMockFileProvider() *mockFileProvider {
+	return &mockFileProvider{files: make(map[string][]byte)}
+}
+
+func (m *mockFileProvider) UploadFile(fileName string, fileContent []byte) error {
+	m.files[fileName] = fileContent
+	return nil
+}
+
+func (m *mockFileProvider) DownloadFile(fileName string) ([]byte, error) {
+	content, ok := m.files[fileName]
+	if !ok {
+		return nil, errors.New("file not found")
+	}
+	return content, nil
+}
+
+func (m *mockFileProvider) DeleteFile(fileName string) error {
+	delete(m.files, fileName)
+	return nil
+}
+
+func (m *mockFileProvider) GetPresignedURL(fileName string) (string, error) {
+	return fileName, nil
+}
+
+func TestSQSBroker_Publish_ClaimCheck_LargePayload(t *testing.T) {
+	broker := NewSQSBroker(SQSConfig{
+		Region:              "us-east-1",
+		QueueURL:            "http://localhost:4566/000000000000/test-queue",
+		ClaimCheckThreshold: 16,
+	})
+
+	var sentInput *sqs.SendMessageInput
+	broker.SetClient(&mockSQSClient{
+		sendMessageFunc: func(ctx context.Context, params *sqs.SendMessageInput, optFns ...func(*sqs.Options)) (*sqs.SendMessageOutput, error) {
+			sentInput = params
+			return &sqs.SendMessageOutput{}, nil
+		},
+	})
+	fileProvider := newMockFileProvider()
+	broker.SetFileProvider(fileProvider)
+
+	payload := []byte(`{"order_id": "a-very-large-payload"}`)
+	err := broker.Publish(context.Background(), "test-queue", interfaces.Message{Body: payload})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	reference := sentInput.MessageAttributes[ClaimCheckHeader].StringValue
+	if reference == nil {
+		t.Fatal("Expected claim-check header to be set")
+	}
+
+	if string(fileProvider.files[*reference]) != string(payload) {
+		t.Errorf("Expected payload to be stored under %s", *reference)
+	}
+
+	if *sentInput.MessageBody == string(payload) {
+		t.Error("Expected message body to carry only the reference")
+	} else {
+		t.Log("✓ Payload grande armazenado via claim-check")
+	}
+}
+
+func TestSQSBroker_Publish_ClaimCheck_SmallPayload(t *testing.T) {
+	broker := NewSQSBroker(SQSConfig{
+		Region:   "us-east-1",
+		QueueURL: "http://localhost:4566/000000000000/test-queue",
+	})
+
+	var sentInput *sqs.SendMessageInput
+	broker.SetClient(&mockSQSClient{
+		sendMessageFunc: func(ctx context.Context, params *sqs.SendMessageInput, optFns ...func(*sqs.Options)) (*sqs.SendMessageOutput, error) {
+			sentInput = params
+			return &sqs.SendMessageOutput{}, nil
+		},
+	})
+	fileProvider := newMockFileProvider()
+	broker.SetFileProvider(fileProvider)
+
+	payload := `{"order_id": "small"}`
+	err := broker.Publish(context.Background(), "test-queue", interfaces.Message{Body: []byte(payload)})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if _, ok := sentInput.MessageAttributes[ClaimCheckHeader]; ok {
+		t.Error("Expected no claim-check header for small payloads")
+	}
+
+	if len(fileProvider.files) != 0 || *sentInput.MessageBody != payload {
+		t.Error("Expected payload to be sent inline")
+	} else {
+		t.Log("✓ Payload pequeno enviado diretamente")
+	}
+}
+
+func TestSQSBroker_Publish_ClaimCheck_SendErrorRemovesPayload(t *testing.T) {
+	broker := NewSQSBroker(SQSConfig{
+		Region:              "us-east-1",
+		QueueURL:            "http://localhost:4566/000000000000/test-queue",
+		ClaimCheckThreshold: 4,
+	})
+
+	broker.SetClient(&mockSQSClient{
+		sendMessageFunc: func(ctx context.Context, params *sqs.SendMessageInput, optFns ...func(*sqs.Options)) (*sqs.SendMessageOutput, error) {
+			return nil, errors.New("SQS send error")
+		},
+	})
+	fileProvider := newMockFileProvider()
+	broker.SetFileProvider(fileProvider)
+
+	err := broker.Publish(context.Background(), "test-queue", interfaces.Message{Body: []byte(`{"test": "data"}`)})
+
+	if err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+
+	if len(fileProvider.files) != 0 {
+		t.Error("Expected orphan payload to be removed")
+	} else {
+		t.Log("✓ Payload órfão removido após falha no envio")
+	}
+}
+
+type blockingFileProvider struct {
+	*mockFileProvider
+	uploading chan struct{}
+	release   chan struct{}
+}
+
+func (b *blockingFileProvider) UploadFile(fileName string, fileContent []byte) error {
+	close(b.uploading)
+	<-b.release
+	return b.mockFileProvider.UploadFile(fileName, fileContent)
+}
+
+func TestSQSBroker_Publish_ClaimCheck_DoesNotHoldLockDuringUpload(t *testing.T) {
+	broker := NewSQSBroker(SQSConfig{
+		Region:              "us-east-1",
+		QueueURL:            "http://localhost:4566/000000000000/test-queue",
+		ClaimCheckThreshold: 4,
+	})
+	broker.SetClient(&mockSQSClient{})
+
+	fileProvider := &blockingFileProvider{
+		mockFileProvider: newMockFileProvider(),
+		uploading:        make(chan struct{}),
+		release:          make(chan struct{}),
+	}
+	broker.SetFileProvider(fileProvider)
+
+	published := make(chan error, 1)
+	go func() {
+		published <- broker.Publish(context.Background(), "test-queue", interfaces.Message{Body: []byte(`{"test": "data"}`)})
+	}()
+
+	<-fileProvider.uploading
+
+	// Com o upload em andamento o broker continua respondendo a quem precisa do lock
+	lockFree := make(chan struct{})
+	go func() {
+		broker.LastPolls()
+		close(lockFree)
+	}()
+
+	select {
+	case <-lockFree:
+		t.Log("✓ Lock liberado durante o upload do claim-check")
+	case <-time.After(time.Second):
+		t.Error("Expected Publish to release the lock before uploading the payload")
+	}
+
+	close(fileProvider.release)
+	if err := <-published; err != nil {
+		t.Errorf("Expected no error, got: %v", err)
+	}
+}
+
+func TestSQSBroker_ProcessMessage_ClaimCheck_Rehydrates(t *testing.T) {
+	broker := NewSQSBroker(SQSConfig{
+		Region:   "us-east-1",
+		QueueURL: "http://localhost:4566/000000000000/test-queue",
+	})
+	broker.SetClient(&mockSQSClient{})
+
+	fileProvider := newMockFileProvider()
+	fileProvider.files["sqs-payload-1.json"] = []byte(`{"order_id": "large"}`)
+	broker.SetFileProvider(fileProvider)
+
+	var receivedBody string
+	handler := func(ctx context.Context, msg interfaces.Message) error {
+		receivedBody = string(msg.Body)
+		return nil
+	}
+
+	sqsMessage := types.Message{
+		MessageId:     aws.String("test-id"),
+		Body:          aws.String(`{"claim_check_reference":"sqs-payload-1.json"}`),
+		ReceiptHandle: aws.String("receipt-handle"),
+		MessageAttributes: map[string]types.MessageAttributeValue{
+			ClaimCheckHeader: {StringValue: aws.String("sqs-payload-1.json")},
+		},
+	}
+
+	broker.processMessage(context.Background(), sqsMessage, handler)
+
+	if receivedBody != `{"order_id": "large"}` {
+		t.Errorf("Expected rehydrated body, got: %s", receivedBody)
+	}
+
+	if _, ok := fileProvider.files["sqs-payload-1.json"]; ok {
+		t.Error("Expected payload to be cleaned up after processing")
+	} else {
+		t.Log("✓ Payload reidratado e removido após processamento")
+	}
+}
+
+func TestSQSBroker_ProcessMessage_ClaimCheck_HandlerErrorKeepsPayload(t *testing.T) {
+	broker := NewSQSBroker(SQSConfig{
+		Region:   "us-east-1",
+		QueueURL: "http://localhost:4566/000000000000/test-queue",
+	})
+	broker.SetClient(&mockSQSClient{})
+
+	fileProvider := newMockFileProvider()
+	fileProvider.files["sqs-payload-2.json"] = []byte(`{"order_id": "large"}`)
+	broker.SetFileProvider(fileProvider)
+
+	handler := func(ctx context.Context, msg interfaces.Message) error {
+		return errors.New("handler error")
+	}
+
+	sqsMessage := types.Message{
+		MessageId:     aws.String("test-id"),
+		Body:          aws.String(`{"claim_check_reference":"sqs-payload-2.json"}`),
+		ReceiptHandle: aws.String("receipt-handle"),
+		MessageAttributes: map[string]types.MessageAttributeValue{
+			ClaimCheckHeader: {StringValue: aws.String("sqs-payload-2.json")},
+		},
+	}
+
+	broker.processMessage(context.Background(), sqsMessage, handler)
+
+	if _, ok := fileProvider.files["sqs-payload-2.json"]; !ok {
+		t.Error("Expected payload to be kept for redelivery")
+	} else {
+		t.Log("✓ Payload mantido para nova entrega")
+	}
+}
+
+func TestSQSBroker_ProcessMessage_ClaimCheck_WithoutFileProvider(t *testing.T) {
+	broker := NewSQSBroker(SQSConfig{
+		Region:   "us-east-1",
+		QueueURL: "http://localhost:4566/000000000000/test-queue",
+	})
+	broker.SetClient(&mockSQSClient{})
+
+	handlerCalled := false
+	handler := func(ctx context.Context, msg interfaces.Message) error {
+		handlerCalled = true
+		return nil
+	}
+
+	sqsMessage := types.Message{
+		MessageId:     aws.String("test-id"),
+		Body:          aws.String(`{"claim_check_reference":"sqs-payload-3.json"}`),
+		ReceiptHandle: aws.String("receipt-handle"),
+		MessageAttributes: map[string]types.MessageAttributeValue{
+			ClaimCheckHeader: {StringValue: aws.String("sqs-payload-3.json")},
+		},
+	}
+
+	broker.processMessage(context.Background(), sqsMessage, handler)
+
+	if handlerCalled {
+		t.Error("Expected handler not to be called without the payload")
+	} else {
+		t.Log("✓ Mensagem com claim-check ignorada sem file provider")
+	}
+}
+
+func TestSQSBroker_Stop_WaitsForInFlightMessages(t *testing.T) {
+	broker := NewSQSBroker(SQSConfig{
+		Region:       "us-east-1",
+		QueueURL:     "http://localhost:4566/000000000000/test-queue",
+		DrainTimeout: 2 * time.Second,
+	})
+
+	handlerStarted := make(chan struct{})
+	var once sync.Once
+	broker.SetClient(&mockSQSClient{
+		receiveMessageFunc: func(ctx context.Context, params *sqs.ReceiveMessageInput, optFns ...func(*sqs.Options)) (*sqs.ReceiveMessageOutput, error) {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			return &sqs.ReceiveMessageOutput{
+				Messages: []types.Message{
+					{MessageId: aws.String("test-id"), Body: aws.String(`{}`), ReceiptHandle: aws.String("receipt-handle")},
+				},
+			}, nil
+		},
+	})
+
+	handlerFinished := false
+	handler := func(ctx context.Context, msg interfaces.Message) error {
+		once.Do(func() { close(handlerStarted) })
+		time.Sleep(100 * time.Millisecond)
+		if ctx.Err() == nil {
+			handlerFinished = true
+		}
+		return nil
+	}
+
+	if err := broker.Subscribe(context.Background(), "test-queue", handler); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	<-handlerStarted
+
+	if err := broker.Stop(); err != nil {
+		t.Fatalf("Expected no error on stop, got: %v", err)
+	}
+
+	if !handlerFinished {
+		t.Error("Expected in-flight handler to finish before stop returned")
+	} else {
+		t.Log("✓ Stop aguardou a mensagem em processamento")
+	}
+}
+
+func TestSQSBroker_Stop_DrainTimeout(t *testing.T) {
+	broker := NewSQSBroker(SQSConfig{
+		Region:       "us-east-1",
+		QueueURL:     "http://localhost:4566/000000000000/test-queue",
+		DrainTimeout: 50 * time.Millisecond,
+	})
+
+	handlerStarted := make(chan struct{})
+	var once sync.Once
+	broker.SetClient(&mockSQSClient{
+		receiveMessageFunc: func(ctx context.Context, params *sqs.ReceiveMessageInput, optFns ...func(*sqs.Options)) (*sqs.ReceiveMessageOutput, error) {
+			return &sqs.ReceiveMessageOutput{
+				Messages: []types.Message{
+					{MessageId: aws.String("test-id"), Body: aws.String(`{}`), ReceiptHandle: aws.String("receipt-handle")},
+				},
+			}, nil
+		},
+	})
+
+	handler := func(ctx context.Context, msg interfaces.Message) error {
+		once.Do(func() { close(handlerStarted) })
+		<-ctx.Done()
+		return ctx.Err()
+	}
+
+	if err := broker.Subscribe(context.Background(), "test-queue", handler); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	<-handlerStarted
+
+	err := broker.Stop()
+
+	if err == nil {
+		t.Error("Expected drain timeout error")
+	} else {
+		t.Logf("✓ Prazo de drenagem respeitado: %v", err)
+	}
+}
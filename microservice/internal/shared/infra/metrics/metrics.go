@@ -0,0 +1,137 @@
+package metrics
+
+import (
+	"database/sql"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "kitchen"
+
+// Registry concentra as métricas da réplica; um registry próprio evita métricas registradas por bibliotecas no registry global
+var Registry = prometheus.NewRegistry()
+
+var (
+	httpRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "Duration of HTTP requests by method, route template and status code.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route", "status"})
+
+	sqsMessagesReceived = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "sqs_messages_received_total",
+		Help:      "Messages received from SQS by queue.",
+	}, []string{"queue"})
+
+	sqsMessagesProcessed = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "sqs_messages_processed_total",
+		Help:      "Messages handled successfully by queue.",
+	}, []string{"queue"})
+
+	sqsMessagesFailed = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "sqs_messages_failed_total",
+		Help:      "Messages that failed to be decoded or handled by queue; SQS delivers them again after the visibility timeout.",
+	}, []string{"queue"})
+
+	sqsMessageProcessingDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "sqs_message_processing_seconds",
+		Help:      "Time spent handling a message by queue.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"queue"})
+
+	sqsMessageQueueLatency = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "sqs_message_queue_latency_seconds",
+		Help:      "Time between a message being sent and received by queue.",
+		Buckets:   []float64{0.1, 0.5, 1, 5, 15, 30, 60, 120, 300, 600, 1800},
+	}, []string{"queue"})
+
+	sqsPublishFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "sqs_publish_failures_total",
+		Help:      "Messages that could not be published by queue; status updates to the orders service go to the orders queue.",
+	}, []string{"queue"})
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		httpRequestDuration,
+		sqsMessagesReceived,
+		sqsMessagesProcessed,
+		sqsMessagesFailed,
+		sqsMessageProcessingDuration,
+		sqsMessageQueueLatency,
+		sqsPublishFailures,
+	)
+}
+
+// Handler expõe o registry no formato de texto do Prometheus
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{Registry: Registry})
+}
+
+// Register adiciona coletores definidos fora deste pacote, como os indicadores do quadro da cozinha
+func Register(collector prometheus.Collector) error {
+	return Registry.Register(collector)
+}
+
+// RegisterDBStats publica as estatísticas do pool de conexões do banco
+func RegisterDBStats(db *sql.DB) error {
+	return Registry.Register(collectors.NewDBStatsCollector(db, namespace))
+}
+
+func ObserveHTTPRequest(method, route string, status int, duration time.Duration) {
+	httpRequestDuration.WithLabelValues(method, route, strconv.Itoa(status)).Observe(duration.Seconds())
+}
+
+func AddSQSMessagesReceived(queue string, count int) {
+	sqsMessagesReceived.WithLabelValues(QueueLabel(queue)).Add(float64(count))
+}
+
+func ObserveSQSMessageProcessed(queue string, duration time.Duration) {
+	label := QueueLabel(queue)
+	sqsMessagesProcessed.WithLabelValues(label).Inc()
+	sqsMessageProcessingDuration.WithLabelValues(label).Observe(duration.Seconds())
+}
+
+func ObserveSQSMessageFailed(queue string, duration time.Duration) {
+	label := QueueLabel(queue)
+	sqsMessagesFailed.WithLabelValues(label).Inc()
+	sqsMessageProcessingDuration.WithLabelValues(label).Observe(duration.Seconds())
+}
+
+func ObserveSQSQueueLatency(queue string, latency time.Duration) {
+	sqsMessageQueueLatency.WithLabelValues(QueueLabel(queue)).Observe(latency.Seconds())
+}
+
+func IncSQSPublishFailures(queue string) {
+	sqsPublishFailures.WithLabelValues(QueueLabel(queue)).Inc()
+}
+
+// QueueLabel usa apenas o nome da fila, sem a conta e a região que fazem parte da URL do SQS
+func QueueLabel(queue string) string {
+	queue = strings.TrimRight(queue, "/")
+
+	if i := strings.LastIndex(queue, "/"); i >= 0 {
+		queue = queue[i+1:]
+	}
+
+	if queue == "" {
+		return "unknown"
+	}
+
+	return queue
+}
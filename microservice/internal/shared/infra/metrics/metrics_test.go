@@ -0,0 +1,60 @@
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func scrape(t *testing.T) string {
+	w := httptest.NewRecorder()
+	Handler().ServeHTTP(w, httptest.NewRequest("GET", "/metrics", nil))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	return w.Body.String()
+}
+
+func TestQueueLabel(t *testing.T) {
+	testCases := map[string]string{
+		"https://sqs.us-east-1.amazonaws.com/123456789012/kitchen-orders": "kitchen-orders",
+		"http://localhost:4566/000000000000/orders/":                      "orders",
+		"orders": "orders",
+		"":       "unknown",
+	}
+
+	for queue, expected := range testCases {
+		assert.Equal(t, expected, QueueLabel(queue), queue)
+	}
+}
+
+func TestHandler_ExposesRegisteredMetrics(t *testing.T) {
+	ObserveHTTPRequest("GET", "/v1/metrics-test", http.StatusOK, 10*time.Millisecond)
+	IncSQSPublishFailures("https://sqs.us-east-1.amazonaws.com/123456789012/handler-test")
+
+	body := scrape(t)
+
+	assert.Contains(t, body, `kitchen_http_request_duration_seconds_count{method="GET",route="/v1/metrics-test",status="200"} 1`)
+	assert.Contains(t, body, `kitchen_sqs_publish_failures_total{queue="handler-test"} 1`)
+	assert.Contains(t, body, "go_goroutines")
+	t.Log("✓ Registry expõe métricas HTTP, SQS e do runtime")
+}
+
+func TestRegisterDBStats(t *testing.T) {
+	gormDB, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+
+	db, err := gormDB.DB()
+	require.NoError(t, err)
+	defer db.Close()
+
+	require.NoError(t, RegisterDBStats(db))
+
+	assert.Contains(t, scrape(t), `go_sql_open_connections{db_name="kitchen"}`)
+	t.Log("✓ Estatísticas do pool de conexões expostas")
+}
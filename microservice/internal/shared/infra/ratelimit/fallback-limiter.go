@@ -0,0 +1,103 @@
+package ratelimit
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"tech_challenge/internal/shared/pkg/logger"
+)
+
+const (
+	fallbackFailureThreshold = 5
+	fallbackOpenDuration     = 30 * time.Second
+)
+
+// FallbackLimiter usa os buckets locais quando o armazenamento compartilhado falha, em vez de bloquear ou liberar tudo.
+// Depois de falhas seguidas o circuito abre: o primário deixa de ser consultado e, passado o intervalo, uma única
+// requisição testa se ele voltou
+type FallbackLimiter struct {
+	primary          Limiter
+	fallback         Limiter
+	failureThreshold int
+	openDuration     time.Duration
+	now              func() time.Time
+
+	mu        sync.Mutex
+	failures  int
+	openUntil time.Time
+}
+
+func NewFallbackLimiter(primary, fallback Limiter) *FallbackLimiter {
+	return &FallbackLimiter{
+		primary:          primary,
+		fallback:         fallback,
+		failureThreshold: fallbackFailureThreshold,
+		openDuration:     fallbackOpenDuration,
+		now:              time.Now,
+	}
+}
+
+func (l *FallbackLimiter) Allow(ctx context.Context, key string, policy Policy) (Result, error) {
+	if !l.tryPrimary() {
+		return l.fallback.Allow(ctx, key, policy)
+	}
+
+	result, err := l.primary.Allow(ctx, key, policy)
+	if err == nil {
+		l.recordSuccess()
+		return result, nil
+	}
+
+	// A requisição cancelada pelo cliente não diz nada sobre a saúde do banco
+	if ctx.Err() == nil {
+		l.recordFailure(err)
+	}
+
+	slog.Warn("Shared rate limiter unavailable, using in-process buckets", logger.ErrorKey, err)
+
+	return l.fallback.Allow(ctx, key, policy)
+}
+
+// tryPrimary indica se a requisição deve consultar o primário. Com o circuito aberto e o intervalo vencido, a primeira
+// requisição reserva um novo intervalo para si e testa o primário enquanto as demais seguem no fallback
+func (l *FallbackLimiter) tryPrimary() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.failures < l.failureThreshold {
+		return true
+	}
+
+	now := l.now()
+	if now.Before(l.openUntil) {
+		return false
+	}
+
+	l.openUntil = now.Add(l.openDuration)
+	return true
+}
+
+func (l *FallbackLimiter) recordSuccess() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.failures >= l.failureThreshold {
+		slog.Info("Shared rate limiter recovered")
+	}
+
+	l.failures = 0
+	l.openUntil = time.Time{}
+}
+
+func (l *FallbackLimiter) recordFailure(err error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.failures++
+	if l.failures == l.failureThreshold {
+		l.openUntil = l.now().Add(l.openDuration)
+		slog.Error("Shared rate limiter failing repeatedly, using in-process buckets", "retry_in", l.openDuration.String(), logger.ErrorKey, err)
+	}
+}
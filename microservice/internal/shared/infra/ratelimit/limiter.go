@@ -0,0 +1,41 @@
+package ratelimit
+
+import (
+	"context"
+	"time"
+)
+
+// Policy define a taxa média do grupo de rotas e o burst que absorve picos curtos
+type Policy struct {
+	RequestsPerMinute int
+	Burst             int
+}
+
+// Disabled indica um grupo sem limite configurado
+func (p Policy) Disabled() bool {
+	return p.RequestsPerMinute <= 0
+}
+
+func (p Policy) ratePerSecond() float64 {
+	return float64(p.RequestsPerMinute) / 60
+}
+
+func (p Policy) capacity() float64 {
+	if p.Burst < 1 {
+		return 1
+	}
+
+	return float64(p.Burst)
+}
+
+type Result struct {
+	Allowed    bool
+	Limit      int
+	Remaining  int
+	RetryAfter time.Duration
+}
+
+// Limiter consome um token do bucket identificado pela chave
+type Limiter interface {
+	Allow(ctx context.Context, key string, policy Policy) (Result, error)
+}
@@ -0,0 +1,63 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const memorySweepInterval = time.Minute
+
+type memoryBucket struct {
+	state     bucketState
+	expiresAt time.Time
+}
+
+// MemoryLimiter guarda os buckets no processo; cada réplica aplica o limite de forma independente
+type MemoryLimiter struct {
+	mu        sync.Mutex
+	buckets   map[string]*memoryBucket
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+func NewMemoryLimiter() *MemoryLimiter {
+	return &MemoryLimiter{
+		buckets: make(map[string]*memoryBucket),
+		now:     time.Now,
+	}
+}
+
+func (l *MemoryLimiter) Allow(_ context.Context, key string, policy Policy) (Result, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.sweep(now)
+
+	bucket, ok := l.buckets[key]
+	if !ok {
+		bucket = &memoryBucket{}
+		l.buckets[key] = bucket
+	}
+
+	state, result := take(bucket.state, policy, now)
+	bucket.state = state
+	bucket.expiresAt = now.Add(idleAfter(policy))
+
+	return result, nil
+}
+
+// sweep descarta buckets que já estariam cheios, evitando crescer a memória com clientes que não voltaram
+func (l *MemoryLimiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < memorySweepInterval {
+		return
+	}
+	l.lastSweep = now
+
+	for key, bucket := range l.buckets {
+		if now.After(bucket.expiresAt) {
+			delete(l.buckets, key)
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoryLimiter_SeparateBucketsPerKey(t *testing.T) {
+	limiter := NewMemoryLimiter()
+	policy := Policy{RequestsPerMinute: 60, Burst: 1}
+	ctx := context.Background()
+
+	first, _ := limiter.Allow(ctx, "kitchen-orders:ip:10.0.0.1", policy)
+	second, _ := limiter.Allow(ctx, "kitchen-orders:ip:10.0.0.1", policy)
+	other, _ := limiter.Allow(ctx, "kitchen-orders:ip:10.0.0.2", policy)
+
+	assert.True(t, first.Allowed)
+	assert.False(t, second.Allowed)
+	assert.True(t, other.Allowed)
+	t.Log("✓ Cada cliente possui o próprio bucket")
+}
+
+func TestMemoryLimiter_SweepsIdleBuckets(t *testing.T) {
+	limiter := NewMemoryLimiter()
+	now := time.Now()
+	limiter.now = func() time.Time { return now }
+	policy := Policy{RequestsPerMinute: 60, Burst: 5}
+
+	limiter.Allow(context.Background(), "idle", policy)
+
+	now = now.Add(2 * time.Minute)
+	limiter.Allow(context.Background(), "active", policy)
+
+	assert.NotContains(t, limiter.buckets, "idle")
+	assert.Contains(t, limiter.buckets, "active")
+}
@@ -0,0 +1,123 @@
+package ratelimit
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"math"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+
+	"tech_challenge/internal/infra/database/models"
+	"tech_challenge/internal/shared/pkg/logger"
+)
+
+const (
+	postgresSweepInterval = 10 * time.Minute
+	postgresBucketTTL     = time.Hour
+	// postgresAllowTimeout impede que um banco lento segure as requisições; estourado o prazo o FallbackLimiter decide localmente
+	postgresAllowTimeout = 200 * time.Millisecond
+	maxBucketKeyLength   = 255
+)
+
+// PostgresLimiter compartilha os buckets entre réplicas; cada consumo é um único upsert, sem transação aberta
+type PostgresLimiter struct {
+	db        *gorm.DB
+	now       func() time.Time
+	timeout   time.Duration
+	mu        sync.Mutex
+	lastSweep time.Time
+}
+
+func NewPostgresLimiter(db *gorm.DB) *PostgresLimiter {
+	return &PostgresLimiter{
+		db:        db,
+		now:       time.Now,
+		timeout:   postgresAllowTimeout,
+		lastSweep: time.Now(),
+	}
+}
+
+// takeTokenQuery aplica a mesma conta do take no banco. A decisão é calculada no mesmo upsert, sobre o saldo da
+// linha travada, e devolvida em allowed; uma requisição negada não altera o saldo nem o updated_at
+const takeTokenQuery = `
+INSERT INTO rate_limit_bucket (bucket_key, tokens, updated_at, allowed)
+VALUES (@key, CAST(@capacity AS numeric) - 1, @now, TRUE)
+ON CONFLICT (bucket_key) DO UPDATE SET
+	tokens = CASE WHEN %[1]s >= 1 THEN %[1]s - 1 ELSE rate_limit_bucket.tokens END,
+	updated_at = CASE WHEN %[1]s >= 1 THEN @now ELSE rate_limit_bucket.updated_at END,
+	allowed = %[1]s >= 1
+RETURNING tokens, updated_at, allowed`
+
+func (l *PostgresLimiter) Allow(ctx context.Context, key string, policy Policy) (Result, error) {
+	ctx, cancel := context.WithTimeout(ctx, l.timeout)
+	defer cancel()
+
+	key = bucketKey(key)
+	now := l.now().UTC()
+
+	var bucket models.RateLimitBucketModel
+	err := l.db.WithContext(ctx).Raw(fmt.Sprintf(takeTokenQuery, l.refilledTokens()), map[string]any{
+		"key":      key,
+		"capacity": policy.capacity(),
+		"rate":     policy.ratePerSecond(),
+		"now":      now,
+	}).Scan(&bucket).Error
+
+	if err != nil {
+		return Result{}, err
+	}
+
+	l.sweep(now)
+
+	if bucket.Allowed {
+		return Result{Allowed: true, Limit: int(policy.capacity()), Remaining: int(math.Floor(bucket.Tokens))}, nil
+	}
+
+	// O banco negou; o take calcula a espera a partir do saldo devolvido
+	_, result := take(bucketState{Tokens: bucket.Tokens, UpdatedAt: bucket.UpdatedAt}, policy, now)
+	result.Allowed = false
+
+	return result, nil
+}
+
+// refilledTokens é o saldo reabastecido pelo tempo decorrido, limitado à capacidade. As funções de data e de
+// mínimo e máximo são as únicas que diferem entre o Postgres e o SQLite dos testes
+func (l *PostgresLimiter) refilledTokens() string {
+	if l.db.Dialector.Name() == "postgres" {
+		return "LEAST(CAST(@capacity AS numeric), rate_limit_bucket.tokens + GREATEST(0, EXTRACT(EPOCH FROM (CAST(@now AS timestamptz) - rate_limit_bucket.updated_at))) * CAST(@rate AS numeric))"
+	}
+
+	return "MIN(@capacity, rate_limit_bucket.tokens + MAX(0, (julianday(@now) - julianday(rate_limit_bucket.updated_at)) * 86400.0) * @rate)"
+}
+
+// sweep remove em segundo plano os buckets sem uso, no máximo uma vez por intervalo em cada réplica
+func (l *PostgresLimiter) sweep(now time.Time) {
+	l.mu.Lock()
+	if now.Sub(l.lastSweep) < postgresSweepInterval {
+		l.mu.Unlock()
+		return
+	}
+	l.lastSweep = now
+	l.mu.Unlock()
+
+	go func() {
+		if err := l.db.Delete(&models.RateLimitBucketModel{}, "updated_at < ?", now.Add(-postgresBucketTTL)).Error; err != nil {
+			slog.Error("Error deleting idle rate limit buckets", logger.ErrorKey, err)
+		}
+	}()
+}
+
+// bucketKey respeita o tamanho da coluna quando o subject do token é muito longo
+func bucketKey(key string) string {
+	if len(key) <= maxBucketKeyLength {
+		return key
+	}
+
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,190 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"tech_challenge/internal/infra/database/models"
+)
+
+func setupRateLimitTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("Failed to connect to test database: %v", err)
+	}
+
+	if err := db.AutoMigrate(&models.RateLimitBucketModel{}); err != nil {
+		t.Fatalf("Failed to migrate test database: %v", err)
+	}
+
+	return db
+}
+
+func TestPostgresLimiter_SharedBetweenReplicas(t *testing.T) {
+	db := setupRateLimitTestDB(t)
+	replicaA := NewPostgresLimiter(db)
+	replicaB := NewPostgresLimiter(db)
+	policy := Policy{RequestsPerMinute: 60, Burst: 2}
+	ctx := context.Background()
+
+	first, err := replicaA.Allow(ctx, "kitchen-orders:subject:cook-1", policy)
+	assert.NoError(t, err)
+	second, _ := replicaB.Allow(ctx, "kitchen-orders:subject:cook-1", policy)
+	third, _ := replicaA.Allow(ctx, "kitchen-orders:subject:cook-1", policy)
+
+	assert.True(t, first.Allowed)
+	assert.True(t, second.Allowed)
+	assert.False(t, third.Allowed)
+	assert.Greater(t, third.RetryAfter.Seconds(), 0.0)
+	t.Log("✓ Réplicas consomem o mesmo bucket")
+}
+
+func TestPostgresLimiter_RefillsWithoutConsumingOnDenial(t *testing.T) {
+	limiter := NewPostgresLimiter(setupRateLimitTestDB(t))
+	clock := time.Now()
+	limiter.now = func() time.Time { return clock }
+	policy := Policy{RequestsPerMinute: 60, Burst: 1}
+	ctx := context.Background()
+
+	first, err := limiter.Allow(ctx, "reports:ip:10.0.0.1", policy)
+	assert.NoError(t, err)
+	assert.True(t, first.Allowed)
+
+	clock = clock.Add(500 * time.Millisecond)
+	denied, err := limiter.Allow(ctx, "reports:ip:10.0.0.1", policy)
+	assert.NoError(t, err)
+	assert.False(t, denied.Allowed)
+	assert.InDelta(t, 500*time.Millisecond, denied.RetryAfter, float64(5*time.Millisecond))
+
+	// A negação não consome saldo; pouco depois do segundo o token já está disponível
+	clock = clock.Add(600 * time.Millisecond)
+	allowed, err := limiter.Allow(ctx, "reports:ip:10.0.0.1", policy)
+	assert.NoError(t, err)
+	assert.True(t, allowed.Allowed)
+	assert.Equal(t, 0, allowed.Remaining)
+	t.Log("✓ Bucket reabastecido no próprio upsert")
+}
+
+func TestPostgresLimiter_DeniesWithinTheSameInstant(t *testing.T) {
+	limiter := NewPostgresLimiter(setupRateLimitTestDB(t))
+	clock := time.Now()
+	limiter.now = func() time.Time { return clock }
+	policy := Policy{RequestsPerMinute: 60, Burst: 1}
+	ctx := context.Background()
+
+	first, err := limiter.Allow(ctx, "kitchen-orders:ip:10.0.0.1", policy)
+	assert.NoError(t, err)
+	assert.True(t, first.Allowed)
+
+	// Sem tempo decorrido não há reabastecimento; a decisão vem do upsert, não do updated_at devolvido
+	second, err := limiter.Allow(ctx, "kitchen-orders:ip:10.0.0.1", policy)
+	assert.NoError(t, err)
+	assert.False(t, second.Allowed)
+	t.Log("✓ Requisição no mesmo instante negada pela decisão devolvida no upsert")
+}
+
+func TestPostgresLimiter_Timeout(t *testing.T) {
+	limiter := NewPostgresLimiter(setupRateLimitTestDB(t))
+	limiter.timeout = time.Nanosecond
+
+	_, err := limiter.Allow(context.Background(), "kitchen-orders:ip:10.0.0.1", Policy{RequestsPerMinute: 60, Burst: 1})
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	t.Log("✓ Consulta ao bucket limitada pelo timeout próprio")
+}
+
+func TestPostgresLimiter_LongKeys(t *testing.T) {
+	limiter := NewPostgresLimiter(setupRateLimitTestDB(t))
+
+	result, err := limiter.Allow(context.Background(), strings.Repeat("a", 400), Policy{RequestsPerMinute: 60, Burst: 1})
+
+	assert.NoError(t, err)
+	assert.True(t, result.Allowed)
+	assert.Len(t, bucketKey(strings.Repeat("a", 400)), 64)
+}
+
+// failingLimiter simula o banco indisponível
+type failingLimiter struct{}
+
+func (failingLimiter) Allow(context.Context, string, Policy) (Result, error) {
+	return Result{}, errors.New("connection refused")
+}
+
+func TestFallbackLimiter_UsesInProcessBuckets(t *testing.T) {
+	limiter := NewFallbackLimiter(failingLimiter{}, NewMemoryLimiter())
+	policy := Policy{RequestsPerMinute: 60, Burst: 1}
+
+	first, err := limiter.Allow(context.Background(), "key", policy)
+	assert.NoError(t, err)
+	second, _ := limiter.Allow(context.Background(), "key", policy)
+
+	assert.True(t, first.Allowed)
+	assert.False(t, second.Allowed)
+	t.Log("✓ Limite mantido localmente quando o banco falha")
+}
+
+// countingFailingLimiter simula o banco indisponível e conta as consultas recebidas
+type countingFailingLimiter struct {
+	calls  int
+	failed bool
+}
+
+func (l *countingFailingLimiter) Allow(context.Context, string, Policy) (Result, error) {
+	l.calls++
+	if l.failed {
+		return Result{}, errors.New("connection refused")
+	}
+	return Result{Allowed: true, Limit: 1}, nil
+}
+
+func TestFallbackLimiter_OpensAfterConsecutiveFailures(t *testing.T) {
+	primary := &countingFailingLimiter{failed: true}
+	limiter := NewFallbackLimiter(primary, NewMemoryLimiter())
+	clock := time.Now()
+	limiter.now = func() time.Time { return clock }
+	policy := Policy{RequestsPerMinute: 600, Burst: 100}
+	ctx := context.Background()
+
+	for i := 0; i < fallbackFailureThreshold+3; i++ {
+		result, err := limiter.Allow(ctx, "key", policy)
+		assert.NoError(t, err)
+		assert.True(t, result.Allowed)
+	}
+	assert.Equal(t, fallbackFailureThreshold, primary.calls, "open circuit should skip the primary")
+
+	// Vencido o intervalo uma requisição testa o primário; a falha mantém o circuito aberto
+	clock = clock.Add(fallbackOpenDuration)
+	_, _ = limiter.Allow(ctx, "key", policy)
+	_, _ = limiter.Allow(ctx, "key", policy)
+	assert.Equal(t, fallbackFailureThreshold+1, primary.calls)
+
+	// Com o primário de volta o teste fecha o circuito
+	primary.failed = false
+	clock = clock.Add(fallbackOpenDuration)
+	_, _ = limiter.Allow(ctx, "key", policy)
+	_, _ = limiter.Allow(ctx, "key", policy)
+	assert.Equal(t, fallbackFailureThreshold+3, primary.calls)
+	t.Log("✓ Circuito abre após falhas seguidas e fecha quando o banco volta")
+}
+
+func TestFallbackLimiter_CanceledRequestsDoNotOpenTheCircuit(t *testing.T) {
+	primary := &countingFailingLimiter{failed: true}
+	limiter := NewFallbackLimiter(primary, NewMemoryLimiter())
+	policy := Policy{RequestsPerMinute: 600, Burst: 100}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	for i := 0; i < fallbackFailureThreshold+1; i++ {
+		_, _ = limiter.Allow(ctx, "key", policy)
+	}
+
+	assert.Equal(t, fallbackFailureThreshold+1, primary.calls)
+}
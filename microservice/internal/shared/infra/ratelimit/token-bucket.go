@@ -0,0 +1,41 @@
+package ratelimit
+
+import (
+	"math"
+	"time"
+)
+
+type bucketState struct {
+	Tokens    float64
+	UpdatedAt time.Time
+}
+
+// take reabastece o bucket pelo tempo decorrido desde o último acesso e consome um token quando houver saldo
+func take(state bucketState, policy Policy, now time.Time) (bucketState, Result) {
+	capacity := policy.capacity()
+	tokens := capacity
+
+	if !state.UpdatedAt.IsZero() {
+		// Relógios de réplicas diferentes podem divergir; tempo negativo não reabastece nem retira tokens
+		elapsed := math.Max(0, now.Sub(state.UpdatedAt).Seconds())
+		tokens = math.Min(capacity, state.Tokens+elapsed*policy.ratePerSecond())
+	}
+
+	result := Result{Limit: int(capacity)}
+
+	if tokens >= 1 {
+		tokens--
+		result.Allowed = true
+	} else {
+		result.RetryAfter = time.Duration((1 - tokens) / policy.ratePerSecond() * float64(time.Second))
+	}
+
+	result.Remaining = int(math.Floor(tokens))
+
+	return bucketState{Tokens: tokens, UpdatedAt: now}, result
+}
+
+// idleAfter é o tempo para um bucket voltar a ficar cheio; depois disso o estado pode ser descartado
+func idleAfter(policy Policy) time.Duration {
+	return time.Duration(policy.capacity() / policy.ratePerSecond() * float64(time.Second))
+}
@@ -0,0 +1,52 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTake_ConsumesBurstThenRefills(t *testing.T) {
+	policy := Policy{RequestsPerMinute: 60, Burst: 3}
+	now := time.Now()
+	state := bucketState{}
+
+	for i := 0; i < 3; i++ {
+		var result Result
+		state, result = take(state, policy, now)
+		assert.True(t, result.Allowed, "request %d", i)
+		assert.Equal(t, 2-i, result.Remaining)
+	}
+
+	state, result := take(state, policy, now)
+	assert.False(t, result.Allowed)
+	assert.Equal(t, time.Second, result.RetryAfter)
+	assert.Equal(t, 3, result.Limit)
+
+	_, result = take(state, policy, now.Add(time.Second))
+	assert.True(t, result.Allowed)
+	t.Log("✓ Bucket consome o burst e reabastece pela taxa configurada")
+}
+
+func TestTake_NeverExceedsCapacity(t *testing.T) {
+	policy := Policy{RequestsPerMinute: 60, Burst: 2}
+	state := bucketState{Tokens: 0, UpdatedAt: time.Now().Add(-time.Hour)}
+
+	state, result := take(state, policy, time.Now())
+
+	assert.True(t, result.Allowed)
+	assert.Equal(t, 1.0, state.Tokens)
+}
+
+func TestTake_IgnoresClockSkew(t *testing.T) {
+	policy := Policy{RequestsPerMinute: 60, Burst: 2}
+	now := time.Now()
+	state := bucketState{Tokens: 0.5, UpdatedAt: now.Add(time.Minute)}
+
+	state, result := take(state, policy, now)
+
+	assert.False(t, result.Allowed)
+	assert.Equal(t, 0.5, state.Tokens)
+	assert.Equal(t, 500*time.Millisecond, result.RetryAfter)
+}
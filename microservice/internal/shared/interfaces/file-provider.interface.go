@@ -2,6 +2,7 @@ package interfaces
 
 type IFileProvider interface {
 	UploadFile(fileName string, fileContent []byte) error
+	DownloadFile(fileName string) ([]byte, error)
 	DeleteFile(fileName string) error
 	GetPresignedURL(fileName string) (string, error)
 }
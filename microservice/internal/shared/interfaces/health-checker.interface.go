@@ -0,0 +1,9 @@
+package interfaces
+
+import "context"
+
+// IHealthChecker verifica uma dependência do serviço; os detalhes devolvidos aparecem na resposta dos probes
+type IHealthChecker interface {
+	Name() string
+	Check(ctx context.Context) (map[string]any, error)
+}
@@ -2,6 +2,7 @@ package interfaces
 
 import (
 	"context"
+	"time"
 )
 
 // Message representa uma mensagem genérica do broker
@@ -34,3 +35,9 @@ type MessageBroker interface {
 	// Stop para o consumo de mensagens
 	Stop() error
 }
+
+// IBrokerHealth é implementado pelos brokers que informam a conexão e o último polling bem-sucedido de cada assinatura
+type IBrokerHealth interface {
+	Ping(ctx context.Context) error
+	LastPolls() map[string]time.Time
+}
@@ -0,0 +1,62 @@
+package apikey
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"strings"
+)
+
+const (
+	keyPrefix    = "ko"
+	separator    = "_"
+	prefixBytes  = 6
+	secretBytes  = 32
+	prefixLength = prefixBytes * 2
+)
+
+// Generate cria uma chave no formato "ko_<prefixo>_<segredo>"; o prefixo identifica a chave sem revelar o segredo
+func Generate() (key string, prefix string, err error) {
+	prefix, err = randomHex(prefixBytes)
+	if err != nil {
+		return "", "", err
+	}
+
+	secret, err := randomHex(secretBytes)
+	if err != nil {
+		return "", "", err
+	}
+
+	return keyPrefix + separator + prefix + separator + secret, prefix, nil
+}
+
+// Prefix extrai o prefixo usado para localizar a chave no banco
+func Prefix(key string) (string, bool) {
+	parts := strings.Split(key, separator)
+	if len(parts) != 3 || parts[0] != keyPrefix || len(parts[1]) != prefixLength || parts[2] == "" {
+		return "", false
+	}
+
+	return parts[1], true
+}
+
+// Hash é o único valor persistido; a chave em texto puro só é exibida na criação
+func Hash(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+func Matches(key, hash string) bool {
+	return subtle.ConstantTimeCompare([]byte(Hash(key)), []byte(hash)) == 1
+}
+
+func randomHex(size int) (string, error) {
+	buffer := make([]byte, size)
+
+	if _, err := rand.Read(buffer); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(buffer), nil
+}
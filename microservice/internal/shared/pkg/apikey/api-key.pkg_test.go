@@ -0,0 +1,43 @@
+package apikey
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerate_Format(t *testing.T) {
+	key, prefix, err := Generate()
+
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(key, "ko_"+prefix+"_"))
+	assert.Len(t, prefix, 12)
+	assert.Len(t, key, len("ko_")+12+len("_")+64)
+
+	other, _, _ := Generate()
+	assert.NotEqual(t, key, other)
+}
+
+func TestPrefix(t *testing.T) {
+	key, prefix, _ := Generate()
+
+	parsed, ok := Prefix(key)
+	assert.True(t, ok)
+	assert.Equal(t, prefix, parsed)
+
+	for _, invalid := range []string{"", "ko_abc", "xx_0123456789ab_secret", "ko_0123456789ab_", "ko_short_secret"} {
+		_, ok := Prefix(invalid)
+		assert.False(t, ok, "key %q", invalid)
+	}
+}
+
+func TestMatches(t *testing.T) {
+	key, _, _ := Generate()
+	hash := Hash(key)
+
+	assert.Len(t, hash, 64)
+	assert.True(t, Matches(key, hash))
+	assert.False(t, Matches(key+"x", hash))
+	t.Log("✓ Apenas o hash da chave é comparado")
+}
@@ -0,0 +1,33 @@
+package cursor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testPosition struct {
+	CreatedAt time.Time `json:"c"`
+	ID        string    `json:"i"`
+}
+
+func TestEncodeDecode_RoundTrip(t *testing.T) {
+	position := testPosition{CreatedAt: time.Date(2024, 5, 1, 12, 30, 0, 123, time.UTC), ID: "order-1"}
+
+	encoded, err := Encode(position)
+	assert.NoError(t, err)
+	assert.NotContains(t, encoded, "=")
+
+	var decoded testPosition
+	assert.NoError(t, Decode(encoded, &decoded))
+	assert.True(t, position.CreatedAt.Equal(decoded.CreatedAt))
+	assert.Equal(t, position.ID, decoded.ID)
+}
+
+func TestDecode_Invalid(t *testing.T) {
+	var decoded testPosition
+
+	assert.ErrorIs(t, Decode("%%%", &decoded), ErrInvalidCursor)
+	assert.ErrorIs(t, Decode("bm90LWpzb24", &decoded), ErrInvalidCursor)
+}
@@ -0,0 +1,138 @@
+package logger
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+)
+
+// Campos de correlação usados em todos os logs
+const (
+	RequestIDKey      = "request_id"
+	MessageIDKey      = "message_id"
+	OrderIDKey        = "order_id"
+	KitchenOrderIDKey = "kitchen_order_id"
+	QueueKey          = "queue"
+	ErrorKey          = "error"
+)
+
+// ContextKey guarda o logger entre as chaves do gin.Context; por ser string, o gin.Context também o encontra em Value
+const ContextKey = "logger"
+
+const redactedValue = "[REDACTED]"
+
+type contextKey struct{}
+
+// sensitiveKeys nunca chegam à saída, independentemente do nível; a comparação ignora maiúsculas, hífens e sublinhados
+var sensitiveKeys = map[string]bool{
+	"customerid":    true,
+	"cpf":           true,
+	"email":         true,
+	"phone":         true,
+	"authorization": true,
+	"password":      true,
+	"token":         true,
+	"secret":        true,
+	"apikey":        true,
+	"payload":       true,
+	"body":          true,
+}
+
+var sensitiveSuffixes = []string{"token", "secret", "password"}
+
+type Options struct {
+	Level string
+	JSON  bool
+}
+
+// New cria o logger com redação dos campos sensíveis; JSON é o formato esperado em produção
+func New(w io.Writer, options Options) *slog.Logger {
+	handlerOptions := &slog.HandlerOptions{
+		Level:       ParseLevel(options.Level),
+		ReplaceAttr: redact,
+	}
+
+	if options.JSON {
+		return slog.New(slog.NewJSONHandler(w, handlerOptions))
+	}
+
+	return slog.New(slog.NewTextHandler(w, handlerOptions))
+}
+
+// Configure define o logger padrão do processo; o pacote log passa a escrever pelo mesmo handler
+func Configure(options Options) *slog.Logger {
+	logger := New(os.Stdout, options)
+	slog.SetDefault(logger)
+
+	return logger
+}
+
+func ParseLevel(level string) slog.Level {
+	switch strings.ToLower(strings.TrimSpace(level)) {
+	case "debug":
+		return slog.LevelDebug
+	case "warn", "warning":
+		return slog.LevelWarn
+	case "error":
+		return slog.LevelError
+	default:
+		return slog.LevelInfo
+	}
+}
+
+func WithContext(ctx context.Context, logger *slog.Logger) context.Context {
+	return context.WithValue(ctx, contextKey{}, logger)
+}
+
+// FromContext devolve o logger da requisição ou da mensagem; sem um logger no contexto usa o padrão do processo
+func FromContext(ctx context.Context) *slog.Logger {
+	if ctx == nil {
+		return slog.Default()
+	}
+
+	if logger, ok := ctx.Value(contextKey{}).(*slog.Logger); ok {
+		return logger
+	}
+
+	if logger, ok := ctx.Value(ContextKey).(*slog.Logger); ok {
+		return logger
+	}
+
+	return slog.Default()
+}
+
+// With acrescenta campos ao logger do contexto, que seguem para todos os logs feitos a partir dele
+func With(ctx context.Context, args ...any) context.Context {
+	return WithContext(ctx, FromContext(ctx).With(args...))
+}
+
+// Fatal registra o erro e encerra o processo, como log.Fatal
+func Fatal(msg string, args ...any) {
+	slog.Error(msg, args...)
+	os.Exit(1)
+}
+
+func redact(_ []string, attr slog.Attr) slog.Attr {
+	if attr.Value.Kind() != slog.KindGroup && isSensitive(attr.Key) {
+		return slog.String(attr.Key, redactedValue)
+	}
+
+	return attr
+}
+
+func isSensitive(key string) bool {
+	normalized := strings.NewReplacer("_", "", "-", "", " ", "").Replace(strings.ToLower(key))
+	if sensitiveKeys[normalized] {
+		return true
+	}
+
+	for _, suffix := range sensitiveSuffixes {
+		if strings.HasSuffix(normalized, suffix) {
+			return true
+		}
+	}
+
+	return false
+}
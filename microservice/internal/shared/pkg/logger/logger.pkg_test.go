@@ -0,0 +1,97 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func decodeLine(t *testing.T, buffer *bytes.Buffer) map[string]any {
+	var entry map[string]any
+	require.NoError(t, json.Unmarshal(buffer.Bytes(), &entry))
+	return entry
+}
+
+func TestNew_JSONWithRedaction(t *testing.T) {
+	var buffer bytes.Buffer
+	logger := New(&buffer, Options{Level: "info", JSON: true})
+
+	logger.Info("order received",
+		OrderIDKey, "order-1",
+		"customer_id", "customer-1",
+		"Authorization", "Bearer abc",
+		"signing_secret", "s3cr3t",
+		"payload", `{"cpf":"123"}`,
+	)
+
+	entry := decodeLine(t, &buffer)
+	assert.Equal(t, "order received", entry["msg"])
+	assert.Equal(t, "order-1", entry[OrderIDKey])
+	assert.Equal(t, redactedValue, entry["customer_id"])
+	assert.Equal(t, redactedValue, entry["Authorization"])
+	assert.Equal(t, redactedValue, entry["signing_secret"])
+	assert.Equal(t, redactedValue, entry["payload"])
+	t.Log("✓ Campos sensíveis omitidos da saída")
+}
+
+func TestNew_RedactsInsideGroups(t *testing.T) {
+	var buffer bytes.Buffer
+	logger := New(&buffer, Options{JSON: true})
+
+	logger.Info("webhook", slog.Group("request", "url", "https://example.com", "api_key", "kitchen_live_abc"))
+
+	request := decodeLine(t, &buffer)["request"].(map[string]any)
+	assert.Equal(t, "https://example.com", request["url"])
+	assert.Equal(t, redactedValue, request["api_key"])
+}
+
+func TestNew_Level(t *testing.T) {
+	var buffer bytes.Buffer
+	logger := New(&buffer, Options{Level: "warn"})
+
+	logger.Info("ignored")
+	assert.Empty(t, buffer.String())
+
+	logger.Warn("kept")
+	assert.Contains(t, buffer.String(), "level=WARN msg=kept")
+}
+
+func TestParseLevel(t *testing.T) {
+	testCases := map[string]slog.Level{
+		"debug":   slog.LevelDebug,
+		"INFO":    slog.LevelInfo,
+		"warning": slog.LevelWarn,
+		"error":   slog.LevelError,
+		"":        slog.LevelInfo,
+		"verbose": slog.LevelInfo,
+	}
+
+	for level, expected := range testCases {
+		assert.Equal(t, expected, ParseLevel(level), level)
+	}
+}
+
+func TestFromContext(t *testing.T) {
+	var buffer bytes.Buffer
+	base := New(&buffer, Options{JSON: true})
+
+	assert.Equal(t, slog.Default(), FromContext(context.Background()))
+
+	ctx := With(WithContext(context.Background(), base), RequestIDKey, "req-1")
+	ctx = With(ctx, MessageIDKey, "msg-1")
+	FromContext(ctx).Info("processed")
+
+	entry := decodeLine(t, &buffer)
+	assert.Equal(t, "req-1", entry[RequestIDKey])
+	assert.Equal(t, "msg-1", entry[MessageIDKey])
+
+	// Contextos que guardam valores por chave string, como o gin.Context, também são reconhecidos
+	stringKeyed := context.WithValue(context.Background(), any(ContextKey), base)
+	assert.Equal(t, base, FromContext(stringKeyed))
+	t.Log("✓ Logger com campos de correlação recuperado do contexto")
+}
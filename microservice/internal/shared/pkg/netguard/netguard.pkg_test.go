@@ -0,0 +1,56 @@
+package netguard
+
+import (
+	"context"
+	"errors"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeResolver map[string][]netip.Addr
+
+func (r fakeResolver) LookupNetIP(ctx context.Context, network, host string) ([]netip.Addr, error) {
+	addrs, ok := r[host]
+	if !ok {
+		return nil, errors.New("no such host")
+	}
+	return addrs, nil
+}
+
+func TestIsPublicAddr(t *testing.T) {
+	blocked := []string{
+		"127.0.0.1", "10.0.0.5", "172.16.3.4", "192.168.1.1", "169.254.169.254",
+		"0.0.0.0", "100.64.0.1", "::1", "fe80::1", "fd00::1", "::ffff:127.0.0.1", "224.0.0.1",
+	}
+	for _, raw := range blocked {
+		assert.False(t, IsPublicAddr(netip.MustParseAddr(raw)), raw)
+	}
+
+	for _, raw := range []string{"8.8.8.8", "203.0.114.10", "2606:4700:4700::1111"} {
+		assert.True(t, IsPublicAddr(netip.MustParseAddr(raw)), raw)
+	}
+	t.Log("✓ Endereços internos, de loopback e link-local reprovados")
+}
+
+func TestCheckHost(t *testing.T) {
+	resolver := fakeResolver{
+		"partner.example.com":  {netip.MustParseAddr("93.184.216.34")},
+		"internal.example.com": {netip.MustParseAddr("93.184.216.34"), netip.MustParseAddr("10.0.0.8")},
+	}
+	ctx := context.Background()
+
+	assert.NoError(t, CheckHost(ctx, resolver, "partner.example.com"))
+	assert.ErrorIs(t, CheckHost(ctx, resolver, "internal.example.com"), ErrNonPublicAddress)
+	assert.ErrorIs(t, CheckHost(ctx, resolver, "169.254.169.254"), ErrNonPublicAddress)
+	assert.Error(t, CheckHost(ctx, resolver, "unknown.example.com"))
+	t.Log("✓ Host reprovado quando qualquer endereço resolvido é interno")
+}
+
+func TestDialControl(t *testing.T) {
+	assert.NoError(t, DialControl("tcp", "93.184.216.34:443", nil))
+	assert.ErrorIs(t, DialControl("tcp", "127.0.0.1:8080", nil), ErrNonPublicAddress)
+	assert.ErrorIs(t, DialControl("tcp6", "[::1]:443", nil), ErrNonPublicAddress)
+	t.Log("✓ Conexão bloqueada no momento do dial")
+}
@@ -0,0 +1,43 @@
+package signature
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSign_Format(t *testing.T) {
+	signature := Sign("super-secret-value", "1700000000", []byte(`{"event":"kitchen_order.ready"}`))
+
+	assert.True(t, strings.HasPrefix(signature, "sha256="))
+	assert.Len(t, signature, len("sha256=")+64)
+}
+
+func TestSign_Deterministic(t *testing.T) {
+	body := []byte(`{"event":"kitchen_order.ready"}`)
+
+	assert.Equal(t, Sign("secret", "1700000000", body), Sign("secret", "1700000000", body))
+	assert.NotEqual(t, Sign("secret", "1700000000", body), Sign("other", "1700000000", body))
+	assert.NotEqual(t, Sign("secret", "1700000000", body), Sign("secret", "1700000001", body))
+}
+
+func TestVerify(t *testing.T) {
+	body := []byte(`{"event":"kitchen_order.ready"}`)
+	signature := Sign("secret", "1700000000", body)
+
+	assert.True(t, Verify("secret", "1700000000", body, signature))
+	assert.False(t, Verify("secret", "1700000000", []byte(`{}`), signature))
+	assert.False(t, Verify("wrong", "1700000000", body, signature))
+}
+
+func TestGenerateSecret(t *testing.T) {
+	first, err := GenerateSecret()
+	assert.NoError(t, err)
+
+	second, err := GenerateSecret()
+	assert.NoError(t, err)
+
+	assert.Len(t, first, 64)
+	assert.NotEqual(t, first, second)
+}
@@ -55,7 +55,7 @@ func TestFindAllKitchenOrdersUseCase_Success(t *testing.T) {
 	kitchenOrderGateway := NewMockKitchenOrderGateway(dataStore)
 	useCase := NewFindAllKitchenOrderUseCase(kitchenOrderGateway)
 
-	result, err := useCase.Execute(dtos.KitchenOrderFilter{})
+	result, _, err := useCase.Execute(context.Background(), dtos.KitchenOrderFilter{})
 
 	if err != nil {
 		t.Errorf("Expected no error, got %v", err)
@@ -76,7 +76,7 @@ func TestFindKitchenOrderByIDUseCase_Success(t *testing.T) {
 	kitchenOrderGateway := NewMockKitchenOrderGateway(dataStore)
 	useCase := NewFindKitchenOrderByIDUseCase(kitchenOrderGateway)
 
-	result, err := useCase.Execute(orderID)
+	result, err := useCase.Execute(context.Background(), orderID)
 
 	if err != nil {
 		t.Errorf("Expected no error, got %v", err)
@@ -101,9 +101,9 @@ func TestUpdateKitchenOrderUseCase_Success(t *testing.T) {
 
 	kitchenOrderGateway := NewMockKitchenOrderGateway(dataStore)
 	orderStatusGateway := NewMockOrderStatusGateway(dataStore)
-	useCase := NewUpdateKitchenOrderUseCase(kitchenOrderGateway, orderStatusGateway, &MockMessageBroker{})
+	useCase := NewUpdateKitchenOrderUseCase(kitchenOrderGateway, orderStatusGateway, &MockMessageBroker{}, nil)
 
-	result, err := useCase.Execute(dtos.UpdateKitchenOrderDTO{
+	result, err := useCase.Execute(context.Background(), dtos.UpdateKitchenOrderDTO{
 		ID:       orderID,
 		StatusID: newStatusID,
 	})
@@ -121,7 +121,7 @@ func TestFindAllOrderStatusUseCase_Success(t *testing.T) {
 	orderStatusGateway := NewMockOrderStatusGateway(dataStore)
 	useCase := NewFindAllOrdersStatusUseCase(orderStatusGateway)
 
-	result, err := useCase.Execute()
+	result, err := useCase.Execute(context.Background())
 
 	if err != nil {
 		t.Errorf("Expected no error, got %v", err)
@@ -0,0 +1,156 @@
+package use_cases
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"tech_challenge/internal/application/dtos"
+	"tech_challenge/internal/domain/entities"
+	"tech_challenge/internal/domain/exceptions"
+	"tech_challenge/internal/shared/config/constants"
+	"tech_challenge/internal/shared/pkg/apikey"
+)
+
+func createTestAPIKey(t *testing.T, dataSource *MockAPIKeyDataSource) (entities.APIKey, string) {
+	createdBy := "manager-1"
+
+	apiKey, key, err := NewCreateAPIKeyUseCase(NewMockAPIKeyGateway(dataSource)).Execute(dtos.CreateAPIKeyDTO{
+		Name:      "orders-service",
+		Scopes:    []string{constants.API_KEY_SCOPE_KITCHEN_ORDERS_CREATE},
+		CreatedBy: &createdBy,
+	})
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+
+	return apiKey, key
+}
+
+func TestCreateAPIKeyUseCase_StoresOnlyTheHash(t *testing.T) {
+	dataSource := NewMockAPIKeyDataSource()
+
+	apiKey, key := createTestAPIKey(t, dataSource)
+
+	stored := dataSource.apiKeys[apiKey.ID]
+	prefix, _ := apikey.Prefix(key)
+	assert.Equal(t, prefix, stored.Prefix)
+	assert.Equal(t, apikey.Hash(key), stored.KeyHash)
+	assert.NotContains(t, stored.KeyHash, key)
+	assert.Equal(t, "manager-1", *stored.CreatedBy)
+}
+
+func TestCreateAPIKeyUseCase_InvalidScope(t *testing.T) {
+	useCase := NewCreateAPIKeyUseCase(NewMockAPIKeyGateway(NewMockAPIKeyDataSource()))
+
+	_, _, err := useCase.Execute(dtos.CreateAPIKeyDTO{Name: "orders-service", Scopes: []string{"orders:delete"}})
+
+	assert.IsType(t, &exceptions.InvalidAPIKeyDataException{}, err)
+}
+
+func TestAuthenticateAPIKeyUseCase_ValidKey(t *testing.T) {
+	dataSource := NewMockAPIKeyDataSource()
+	created, key := createTestAPIKey(t, dataSource)
+	useCase := NewAuthenticateAPIKeyUseCase(NewMockAPIKeyGateway(dataSource), time.Minute)
+
+	apiKey, err := useCase.Execute(key)
+
+	assert.NoError(t, err)
+	assert.Equal(t, created.ID, apiKey.ID)
+	assert.NotNil(t, apiKey.LastUsedAt)
+	assert.NotNil(t, dataSource.apiKeys[created.ID].LastUsedAt)
+}
+
+func TestAuthenticateAPIKeyUseCase_ThrottlesLastUsed(t *testing.T) {
+	dataSource := NewMockAPIKeyDataSource()
+	_, key := createTestAPIKey(t, dataSource)
+	useCase := NewAuthenticateAPIKeyUseCase(NewMockAPIKeyGateway(dataSource), time.Minute)
+
+	for i := 0; i < 3; i++ {
+		_, err := useCase.Execute(key)
+		assert.NoError(t, err)
+	}
+
+	assert.Equal(t, 1, dataSource.touches)
+	t.Log("✓ Último uso gravado uma vez dentro do intervalo")
+}
+
+func TestAuthenticateAPIKeyUseCase_IgnoresLastUsedFailure(t *testing.T) {
+	dataSource := NewMockAPIKeyDataSource()
+	_, key := createTestAPIKey(t, dataSource)
+	dataSource.touchFailure = errors.New("database unavailable")
+
+	_, err := NewAuthenticateAPIKeyUseCase(NewMockAPIKeyGateway(dataSource), time.Minute).Execute(key)
+
+	assert.NoError(t, err)
+}
+
+func TestAuthenticateAPIKeyUseCase_RejectsInvalidKeys(t *testing.T) {
+	dataSource := NewMockAPIKeyDataSource()
+	created, key := createTestAPIKey(t, dataSource)
+	unknown, _, _ := apikey.Generate()
+	prefix, _ := apikey.Prefix(key)
+	useCase := NewAuthenticateAPIKeyUseCase(NewMockAPIKeyGateway(dataSource), time.Minute)
+
+	for _, invalid := range []string{"", "not-a-key", unknown, "ko_" + prefix + "_wrong-secret"} {
+		_, err := useCase.Execute(invalid)
+		assert.IsType(t, &exceptions.UnauthorizedException{}, err, "key %q", invalid)
+	}
+
+	_, err := NewRevokeAPIKeyUseCase(NewMockAPIKeyGateway(dataSource)).Execute(created.ID)
+	assert.NoError(t, err)
+
+	_, err = useCase.Execute(key)
+	assert.EqualError(t, err, "API key has been revoked")
+}
+
+func TestAuthenticateAPIKeyUseCase_LookupFailureIsNotUnauthorized(t *testing.T) {
+	dataSource := NewMockAPIKeyDataSource()
+	_, key := createTestAPIKey(t, dataSource)
+	dataSource.findFailure = errors.New("database unavailable")
+
+	_, err := NewAuthenticateAPIKeyUseCase(NewMockAPIKeyGateway(dataSource), time.Minute).Execute(key)
+
+	assert.ErrorIs(t, err, dataSource.findFailure)
+	assert.NotErrorAs(t, err, new(*exceptions.UnauthorizedException))
+	t.Log("✓ Falha na consulta da API key não é tratada como credencial inválida")
+}
+
+func TestRevokeAPIKeyUseCase(t *testing.T) {
+	dataSource := NewMockAPIKeyDataSource()
+	created, _ := createTestAPIKey(t, dataSource)
+	useCase := NewRevokeAPIKeyUseCase(NewMockAPIKeyGateway(dataSource))
+
+	revoked, err := useCase.Execute(created.ID)
+	assert.NoError(t, err)
+	assert.True(t, revoked.IsRevoked())
+
+	again, err := useCase.Execute(created.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, *revoked.RevokedAt, *again.RevokedAt)
+
+	_, err = useCase.Execute("invalid")
+	assert.IsType(t, &exceptions.InvalidAPIKeyDataException{}, err)
+
+	_, err = useCase.Execute("550e8400-e29b-41d4-a716-446655440000")
+	assert.IsType(t, &exceptions.APIKeyNotFoundException{}, err)
+}
+
+func TestFindAPIKeyUseCases(t *testing.T) {
+	dataSource := NewMockAPIKeyDataSource()
+	created, _ := createTestAPIKey(t, dataSource)
+	gateway := NewMockAPIKeyGateway(dataSource)
+
+	apiKeys, err := NewFindAllAPIKeysUseCase(gateway).Execute()
+	assert.NoError(t, err)
+	assert.Len(t, apiKeys, 1)
+
+	found, err := NewFindAPIKeyByIDUseCase(gateway).Execute(created.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "orders-service", found.Name)
+
+	_, err = NewFindAPIKeyByIDUseCase(gateway).Execute("550e8400-e29b-41d4-a716-446655440000")
+	assert.IsType(t, &exceptions.APIKeyNotFoundException{}, err)
+}
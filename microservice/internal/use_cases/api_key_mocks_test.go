@@ -0,0 +1,98 @@
+package use_cases
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"tech_challenge/internal/application/gateways"
+	"tech_challenge/internal/daos"
+	"tech_challenge/internal/interfaces"
+)
+
+// MockAPIKeyDataSource simula a tabela de API keys em memória para testes
+type MockAPIKeyDataSource struct {
+	mu           sync.Mutex
+	apiKeys      map[string]daos.APIKeyDAO
+	touches      int
+	touchFailure error
+	findFailure  error
+}
+
+func NewMockAPIKeyDataSource() *MockAPIKeyDataSource {
+	return &MockAPIKeyDataSource{
+		apiKeys: make(map[string]daos.APIKeyDAO),
+	}
+}
+
+func (ds *MockAPIKeyDataSource) Insert(apiKey daos.APIKeyDAO) error {
+	ds.mu.Lock()
+	defer ds.mu.Unlock()
+	ds.apiKeys[apiKey.ID] = apiKey
+	return nil
+}
+
+func (ds *MockAPIKeyDataSource) FindByID(id string) (daos.APIKeyDAO, error) {
+	ds.mu.Lock()
+	defer ds.mu.Unlock()
+	apiKey, ok := ds.apiKeys[id]
+	if !ok {
+		return daos.APIKeyDAO{}, errors.New("record not found")
+	}
+	return apiKey, nil
+}
+
+func (ds *MockAPIKeyDataSource) FindByPrefix(prefix string) (daos.APIKeyDAO, error) {
+	ds.mu.Lock()
+	defer ds.mu.Unlock()
+	if ds.findFailure != nil {
+		return daos.APIKeyDAO{}, ds.findFailure
+	}
+	for _, apiKey := range ds.apiKeys {
+		if apiKey.Prefix == prefix {
+			return apiKey, nil
+		}
+	}
+	return daos.APIKeyDAO{}, interfaces.ErrAPIKeyNotFound
+}
+
+func (ds *MockAPIKeyDataSource) FindAll() ([]daos.APIKeyDAO, error) {
+	ds.mu.Lock()
+	defer ds.mu.Unlock()
+	result := make([]daos.APIKeyDAO, 0, len(ds.apiKeys))
+	for _, apiKey := range ds.apiKeys {
+		result = append(result, apiKey)
+	}
+	return result, nil
+}
+
+func (ds *MockAPIKeyDataSource) Revoke(id string, revokedAt time.Time) error {
+	ds.mu.Lock()
+	defer ds.mu.Unlock()
+	apiKey, ok := ds.apiKeys[id]
+	if !ok {
+		return errors.New("record not found")
+	}
+	if apiKey.RevokedAt == nil {
+		apiKey.RevokedAt = &revokedAt
+		ds.apiKeys[id] = apiKey
+	}
+	return nil
+}
+
+func (ds *MockAPIKeyDataSource) TouchLastUsed(id string, usedAt time.Time) error {
+	ds.mu.Lock()
+	defer ds.mu.Unlock()
+	if ds.touchFailure != nil {
+		return ds.touchFailure
+	}
+	apiKey := ds.apiKeys[id]
+	apiKey.LastUsedAt = &usedAt
+	ds.apiKeys[id] = apiKey
+	ds.touches++
+	return nil
+}
+
+func NewMockAPIKeyGateway(dataSource *MockAPIKeyDataSource) gateways.APIKeyGateway {
+	return *gateways.NewAPIKeyGateway(dataSource)
+}
@@ -0,0 +1,64 @@
+package use_cases
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"tech_challenge/internal/application/gateways"
+	"tech_challenge/internal/domain/entities"
+	"tech_challenge/internal/domain/exceptions"
+	"tech_challenge/internal/interfaces"
+	"tech_challenge/internal/shared/pkg/apikey"
+	"tech_challenge/internal/shared/pkg/logger"
+)
+
+type AuthenticateAPIKeyUseCase struct {
+	gateway          gateways.APIKeyGateway
+	lastUsedInterval time.Duration
+}
+
+func NewAuthenticateAPIKeyUseCase(gateway gateways.APIKeyGateway, lastUsedInterval time.Duration) *AuthenticateAPIKeyUseCase {
+	return &AuthenticateAPIKeyUseCase{
+		gateway:          gateway,
+		lastUsedInterval: lastUsedInterval,
+	}
+}
+
+func (uc *AuthenticateAPIKeyUseCase) Execute(key string) (entities.APIKey, error) {
+	prefix, ok := apikey.Prefix(key)
+	if !ok {
+		return entities.APIKey{}, &exceptions.UnauthorizedException{Message: "Invalid API key"}
+	}
+
+	apiKey, err := uc.gateway.FindByPrefix(prefix)
+	if errors.Is(err, interfaces.ErrAPIKeyNotFound) {
+		return entities.APIKey{}, &exceptions.UnauthorizedException{Message: "Invalid API key"}
+	}
+
+	// Banco indisponível não é credencial inválida: o erro segue para virar 5xx em vez de 401
+	if err != nil {
+		return entities.APIKey{}, fmt.Errorf("failed to find API key: %w", err)
+	}
+
+	if !apikey.Matches(key, apiKey.KeyHash) {
+		return entities.APIKey{}, &exceptions.UnauthorizedException{Message: "Invalid API key"}
+	}
+
+	if apiKey.IsRevoked() {
+		return entities.APIKey{}, &exceptions.UnauthorizedException{Message: "API key has been revoked"}
+	}
+
+	now := time.Now()
+	if apiKey.ShouldTouchLastUsed(now, uc.lastUsedInterval) {
+		// Falhar ao registrar o último uso não deve impedir a requisição do serviço
+		if err := uc.gateway.TouchLastUsed(apiKey.ID, now); err != nil {
+			slog.Error("Error updating last use of API key", "api_key_id", apiKey.ID, logger.ErrorKey, err)
+		} else {
+			apiKey.LastUsedAt = &now
+		}
+	}
+
+	return apiKey, nil
+}
@@ -0,0 +1,152 @@
+package use_cases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"tech_challenge/internal/application/dtos"
+	"tech_challenge/internal/application/gateways"
+	"tech_challenge/internal/domain/entities"
+	"tech_challenge/internal/domain/exceptions"
+	"tech_challenge/internal/shared/config/constants"
+)
+
+// BulkUpdateKitchenOrderStatusResult é o resultado de um pedido; Err preenchido indica que aquele pedido não foi alterado
+// e Skipped que ele já estava no status pedido
+type BulkUpdateKitchenOrderStatusResult struct {
+	ID           string
+	KitchenOrder entities.KitchenOrder
+	Skipped      bool
+	Err          error
+}
+
+type BulkUpdateKitchenOrderStatusUseCase struct {
+	gateway       gateways.KitchenOrderGateway
+	statusGateway gateways.OrderStatusGateway
+	updateUseCase *UpdateKitchenOrderUseCase
+	batchSize     int
+}
+
+func NewBulkUpdateKitchenOrderStatusUseCase(gateway gateways.KitchenOrderGateway, statusGateway gateways.OrderStatusGateway, updateUseCase *UpdateKitchenOrderUseCase) *BulkUpdateKitchenOrderStatusUseCase {
+	return &BulkUpdateKitchenOrderStatusUseCase{
+		gateway:       gateway,
+		statusGateway: statusGateway,
+		updateUseCase: updateUseCase,
+		batchSize:     constants.KITCHEN_ORDER_BULK_BATCH_SIZE,
+	}
+}
+
+// Execute aplica o status a cada pedido pelo UpdateKitchenOrderUseCase, com as mesmas notificações e regras de transição do PUT;
+// sem motivo, o lote não devolve à preparação pedidos prontos ou finalizados. Cada pedido é gravado na sua própria transação; os lotes limitam quantas alterações rodam ao mesmo tempo.
+func (uc *BulkUpdateKitchenOrderStatusUseCase) Execute(ctx context.Context, bulkDTO dtos.BulkUpdateKitchenOrderStatusDTO) ([]BulkUpdateKitchenOrderStatusResult, error) {
+	ids := uniqueKitchenOrderIDs(bulkDTO.IDs)
+
+	if len(ids) == 0 {
+		return nil, &exceptions.InvalidKitchenOrderDataException{
+			Message: "At least one kitchen order ID is required",
+		}
+	}
+
+	if len(ids) > constants.KITCHEN_ORDER_BULK_MAX_ITEMS {
+		return nil, &exceptions.InvalidKitchenOrderDataException{
+			Message: fmt.Sprintf("At most %d kitchen orders can be updated at once", constants.KITCHEN_ORDER_BULK_MAX_ITEMS),
+		}
+	}
+
+	// Um status inexistente falharia em todos os pedidos, então é rejeitado antes de começar
+	if _, err := uc.statusGateway.FindByID(ctx, bulkDTO.StatusID); err != nil {
+		if isQueryCanceled(err) {
+			return nil, err
+		}
+
+		return nil, &exceptions.InvalidKitchenOrderDataException{
+			Message: "Order Status not found",
+		}
+	}
+
+	results := make([]BulkUpdateKitchenOrderStatusResult, len(ids))
+
+	for start := 0; start < len(ids); start += uc.batchSize {
+		end := min(start+uc.batchSize, len(ids))
+
+		var wg sync.WaitGroup
+		for i := start; i < end; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+
+				results[i] = uc.updateKitchenOrder(ctx, ids[i], bulkDTO)
+			}(i)
+		}
+		wg.Wait()
+	}
+
+	return results, nil
+}
+
+func (uc *BulkUpdateKitchenOrderStatusUseCase) updateKitchenOrder(ctx context.Context, id string, bulkDTO dtos.BulkUpdateKitchenOrderStatusDTO) BulkUpdateKitchenOrderStatusResult {
+	result := BulkUpdateKitchenOrderStatusResult{ID: id}
+
+	if result.Err = entities.ValidateID(id); result.Err != nil {
+		return result
+	}
+
+	kitchenOrder, err := uc.gateway.FindByID(ctx, id)
+
+	if isQueryCanceled(err) {
+		result.Err = err
+		return result
+	}
+
+	if err != nil || kitchenOrder.IsEmpty() {
+		result.Err = &exceptions.KitchenOrderNotFoundException{}
+		return result
+	}
+
+	// Pedido já no status pedido não gera nova mensagem para Orders nem webhook
+	if kitchenOrder.Status.ID == bulkDTO.StatusID {
+		result.KitchenOrder = kitchenOrder
+		result.Skipped = true
+		return result
+	}
+
+	// A alteração só é gravada se a versão lida aqui ainda for a atual
+	result.KitchenOrder, result.Err = uc.updateUseCase.Execute(ctx, dtos.UpdateKitchenOrderDTO{
+		ID:              id,
+		StatusID:        bulkDTO.StatusID,
+		ChangedBy:       bulkDTO.ChangedBy,
+		ExpectedVersion: &kitchenOrder.Version,
+	})
+
+	var versionMismatch *exceptions.KitchenOrderVersionMismatchException
+	if errors.As(result.Err, &versionMismatch) {
+		result.Err = &exceptions.KitchenOrderUpdateConflictException{}
+	}
+
+	return result
+}
+
+// uniqueKitchenOrderIDs remove IDs vazios e repetidos mantendo a ordem enviada
+func uniqueKitchenOrderIDs(ids []string) []string {
+	seen := make(map[string]struct{}, len(ids))
+	unique := make([]string, 0, len(ids))
+
+	for _, id := range ids {
+		id = strings.TrimSpace(id)
+		if id == "" {
+			continue
+		}
+
+		if _, ok := seen[id]; ok {
+			continue
+		}
+
+		seen[id] = struct{}{}
+		unique = append(unique, id)
+	}
+
+	return unique
+}
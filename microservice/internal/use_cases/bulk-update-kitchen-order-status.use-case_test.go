@@ -0,0 +1,273 @@
+package use_cases
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"tech_challenge/internal"
+	"tech_challenge/internal/application/dtos"
+	"tech_challenge/internal/domain/entities"
+	"tech_challenge/internal/domain/exceptions"
+	"tech_challenge/internal/shared/config/constants"
+)
+
+func newBulkUpdateKitchenOrderStatusUseCase(dataStore *MockDataStore) *BulkUpdateKitchenOrderStatusUseCase {
+	return newBulkUpdateKitchenOrderStatusUseCaseWithDispatcher(dataStore, nil)
+}
+
+func newBulkUpdateKitchenOrderStatusUseCaseWithDispatcher(dataStore *MockDataStore, dispatcher *recordingWebhookDispatcher) *BulkUpdateKitchenOrderStatusUseCase {
+	kitchenOrderGateway := NewMockKitchenOrderGateway(dataStore)
+	orderStatusGateway := NewMockOrderStatusGateway(dataStore)
+
+	var updateUseCase *UpdateKitchenOrderUseCase
+	if dispatcher != nil {
+		updateUseCase = NewUpdateKitchenOrderUseCase(kitchenOrderGateway, orderStatusGateway, &MockMessageBroker{}, dispatcher)
+	} else {
+		updateUseCase = NewUpdateKitchenOrderUseCase(kitchenOrderGateway, orderStatusGateway, &MockMessageBroker{}, nil)
+	}
+
+	return NewBulkUpdateKitchenOrderStatusUseCase(kitchenOrderGateway, orderStatusGateway, updateUseCase)
+}
+
+func seedKitchenOrders(dataStore *MockDataStore, count int) []string {
+	ids := make([]string, count)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("550e8400-e29b-41d4-a716-%012d", i)
+		order, _ := entities.NewKitchenOrder(ids[i], fmt.Sprintf("order-%d", i), fmt.Sprintf("%03d", i+1), dataStore.orderStatuses[0], time.Now(), nil)
+		dataStore.kitchenOrders = append(dataStore.kitchenOrders, *order)
+	}
+	return ids
+}
+
+func TestBulkUpdateKitchenOrderStatusUseCase_UpdatesAllInBatches(t *testing.T) {
+	internal.SetupTestEnv()
+	defer internal.CleanupTestEnv()
+
+	dataStore := NewMockDataStore()
+	// Mais pedidos que o tamanho do lote para passar por mais de um lote
+	ids := seedKitchenOrders(dataStore, constants.KITCHEN_ORDER_BULK_BATCH_SIZE*2+3)
+	useCase := newBulkUpdateKitchenOrderStatusUseCase(dataStore)
+
+	results, err := useCase.Execute(context.Background(), dtos.BulkUpdateKitchenOrderStatusDTO{
+		IDs:      ids,
+		StatusID: constants.KITCHEN_ORDER_STATUS_READY_ID,
+	})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(results) != len(ids) {
+		t.Fatalf("Expected %d results, got %d", len(ids), len(results))
+	}
+
+	for i, result := range results {
+		if result.ID != ids[i] {
+			t.Errorf("Expected result %d to be for %s, got %s", i, ids[i], result.ID)
+		}
+
+		if result.Err != nil {
+			t.Errorf("Expected no error for %s, got %v", result.ID, result.Err)
+		}
+	}
+
+	for _, order := range dataStore.kitchenOrders {
+		if order.Status.ID != constants.KITCHEN_ORDER_STATUS_READY_ID {
+			t.Errorf("Expected order %s to be ready, got %s", order.ID, order.Status.ID)
+		}
+	}
+
+	t.Log("✓ Todos os pedidos marcados como prontos em lotes")
+}
+
+func TestBulkUpdateKitchenOrderStatusUseCase_ReportsPerItemErrors(t *testing.T) {
+	internal.SetupTestEnv()
+	defer internal.CleanupTestEnv()
+
+	dataStore := NewMockDataStore()
+	ids := seedKitchenOrders(dataStore, 1)
+	useCase := newBulkUpdateKitchenOrderStatusUseCase(dataStore)
+
+	missingID := "550e8400-e29b-41d4-a716-999999999999"
+	results, err := useCase.Execute(context.Background(), dtos.BulkUpdateKitchenOrderStatusDTO{
+		IDs:      []string{ids[0], "invalid-id", missingID, ids[0]},
+		StatusID: constants.KITCHEN_ORDER_STATUS_READY_ID,
+	})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	// IDs repetidos são aplicados uma única vez
+	if len(results) != 3 {
+		t.Fatalf("Expected 3 results, got %d", len(results))
+	}
+
+	if results[0].Err != nil {
+		t.Errorf("Expected first order to be updated, got %v", results[0].Err)
+	}
+
+	if _, ok := results[1].Err.(*exceptions.InvalidKitchenOrderDataException); !ok {
+		t.Errorf("Expected InvalidKitchenOrderDataException, got %T", results[1].Err)
+	}
+
+	if _, ok := results[2].Err.(*exceptions.KitchenOrderNotFoundException); !ok {
+		t.Errorf("Expected KitchenOrderNotFoundException, got %T", results[2].Err)
+	}
+
+	t.Log("✓ Falhas reportadas por pedido sem interromper os demais")
+}
+
+func TestBulkUpdateKitchenOrderStatusUseCase_InvalidRequest(t *testing.T) {
+	internal.SetupTestEnv()
+	defer internal.CleanupTestEnv()
+
+	dataStore := NewMockDataStore()
+	ids := seedKitchenOrders(dataStore, 1)
+	useCase := newBulkUpdateKitchenOrderStatusUseCase(dataStore)
+
+	tooMany := make([]string, constants.KITCHEN_ORDER_BULK_MAX_ITEMS+1)
+	for i := range tooMany {
+		tooMany[i] = fmt.Sprintf("id-%d", i)
+	}
+
+	testCases := []struct {
+		name string
+		dto  dtos.BulkUpdateKitchenOrderStatusDTO
+	}{
+		{"no IDs", dtos.BulkUpdateKitchenOrderStatusDTO{IDs: []string{" "}, StatusID: constants.KITCHEN_ORDER_STATUS_FINISHED_ID}},
+		{"too many IDs", dtos.BulkUpdateKitchenOrderStatusDTO{IDs: tooMany, StatusID: constants.KITCHEN_ORDER_STATUS_FINISHED_ID}},
+		{"unknown status", dtos.BulkUpdateKitchenOrderStatusDTO{IDs: ids, StatusID: "unknown-status"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := useCase.Execute(context.Background(), tc.dto)
+
+			if _, ok := err.(*exceptions.InvalidKitchenOrderDataException); !ok {
+				t.Errorf("Expected InvalidKitchenOrderDataException, got %T", err)
+			}
+		})
+	}
+
+	if dataStore.kitchenOrders[0].Status.ID != constants.KITCHEN_ORDER_STATUS_RECEIVED_ID {
+		t.Error("Expected kitchen order to be left unchanged")
+	}
+}
+
+func TestBulkUpdateKitchenOrderStatusUseCase_FinishesFromAnyOpenStatus(t *testing.T) {
+	internal.SetupTestEnv()
+	defer internal.CleanupTestEnv()
+
+	dataStore := NewMockDataStore()
+	ids := seedKitchenOrders(dataStore, 3)
+	dataStore.kitchenOrders[1].Status = dataStore.orderStatuses[1]
+	dataStore.kitchenOrders[2].Status = dataStore.orderStatuses[2]
+	useCase := newBulkUpdateKitchenOrderStatusUseCase(dataStore)
+
+	results, err := useCase.Execute(context.Background(), dtos.BulkUpdateKitchenOrderStatusDTO{
+		IDs:      ids,
+		StatusID: constants.KITCHEN_ORDER_STATUS_FINISHED_ID,
+	})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	// Como no PUT, o fechamento do turno finaliza pedidos recebidos, em preparação e prontos
+	for i, result := range results {
+		if result.Err != nil {
+			t.Errorf("Expected order %d to be finished, got %v", i, result.Err)
+		}
+
+		if dataStore.kitchenOrders[i].Status.ID != constants.KITCHEN_ORDER_STATUS_FINISHED_ID {
+			t.Errorf("Expected order %d to be finished, got status %s", i, dataStore.kitchenOrders[i].Status.ID)
+		}
+	}
+
+	t.Log("✓ Lote finaliza pedidos recebidos e em preparação como o PUT")
+}
+
+func TestBulkUpdateKitchenOrderStatusUseCase_RejectsRecallWithoutReason(t *testing.T) {
+	internal.SetupTestEnv()
+	defer internal.CleanupTestEnv()
+
+	dataStore := NewMockDataStore()
+	ids := seedKitchenOrders(dataStore, 3)
+	dataStore.kitchenOrders[1].Status = dataStore.orderStatuses[2]
+	dataStore.kitchenOrders[2].Status = dataStore.orderStatuses[3]
+	dispatcher := &recordingWebhookDispatcher{}
+	useCase := newBulkUpdateKitchenOrderStatusUseCaseWithDispatcher(dataStore, dispatcher)
+
+	results, err := useCase.Execute(context.Background(), dtos.BulkUpdateKitchenOrderStatusDTO{
+		IDs:      ids,
+		StatusID: constants.KITCHEN_ORDER_STATUS_PREPARING_ID,
+	})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if results[0].Err != nil {
+		t.Errorf("Expected received order to start preparation, got %v", results[0].Err)
+	}
+
+	// Pronto e finalizado só voltam à preparação pelo recall, que exige motivo
+	for i, want := range []string{constants.KITCHEN_ORDER_STATUS_READY_ID, constants.KITCHEN_ORDER_STATUS_FINISHED_ID} {
+		result := results[i+1]
+		if _, ok := result.Err.(*exceptions.InvalidKitchenOrderTransitionException); !ok {
+			t.Errorf("Expected InvalidKitchenOrderTransitionException for %s, got %T", want, result.Err)
+		}
+
+		if dataStore.kitchenOrders[i+1].Status.ID != want {
+			t.Errorf("Expected order in %s to be left unchanged, got %s", want, dataStore.kitchenOrders[i+1].Status.ID)
+		}
+	}
+
+	if len(dispatcher.events) != 1 || dispatcher.events[0].Data.ID != ids[0] {
+		t.Errorf("Expected a single webhook for %s, got %v", ids[0], dispatcher.events)
+	}
+
+	t.Log("✓ Lote não devolve pedidos prontos ou finalizados à preparação sem motivo")
+}
+
+func TestBulkUpdateKitchenOrderStatusUseCase_SkipsOrdersAlreadyInStatus(t *testing.T) {
+	internal.SetupTestEnv()
+	defer internal.CleanupTestEnv()
+
+	dataStore := NewMockDataStore()
+	ids := seedKitchenOrders(dataStore, 2)
+	dataStore.kitchenOrders[1].Status = dataStore.orderStatuses[1]
+	version := dataStore.kitchenOrders[1].Version
+	dispatcher := &recordingWebhookDispatcher{}
+	useCase := newBulkUpdateKitchenOrderStatusUseCaseWithDispatcher(dataStore, dispatcher)
+
+	results, err := useCase.Execute(context.Background(), dtos.BulkUpdateKitchenOrderStatusDTO{
+		IDs:      ids,
+		StatusID: constants.KITCHEN_ORDER_STATUS_PREPARING_ID,
+	})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if results[0].Skipped || results[0].Err != nil {
+		t.Errorf("Expected first order to be updated, got skipped=%v err=%v", results[0].Skipped, results[0].Err)
+	}
+
+	if !results[1].Skipped || results[1].Err != nil {
+		t.Errorf("Expected second order to be skipped, got skipped=%v err=%v", results[1].Skipped, results[1].Err)
+	}
+
+	if dataStore.kitchenOrders[1].Version != version {
+		t.Errorf("Expected skipped order not to be written, got version %d", dataStore.kitchenOrders[1].Version)
+	}
+
+	if len(dispatcher.events) != 1 || dispatcher.events[0].Data.ID != ids[0] {
+		t.Errorf("Expected a single webhook for %s, got %v", ids[0], dispatcher.events)
+	}
+
+	t.Log("✓ Pedidos já no status pedido ignorados sem notificação")
+}
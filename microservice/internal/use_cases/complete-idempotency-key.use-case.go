@@ -0,0 +1,19 @@
+package use_cases
+
+import (
+	"tech_challenge/internal/application/gateways"
+)
+
+type CompleteIdempotencyKeyUseCase struct {
+	gateway gateways.IdempotencyKeyGateway
+}
+
+func NewCompleteIdempotencyKeyUseCase(gateway gateways.IdempotencyKeyGateway) *CompleteIdempotencyKeyUseCase {
+	return &CompleteIdempotencyKeyUseCase{
+		gateway: gateway,
+	}
+}
+
+func (uc *CompleteIdempotencyKeyUseCase) Execute(scope, key, resourceID string) error {
+	return uc.gateway.Complete(scope, key, resourceID)
+}
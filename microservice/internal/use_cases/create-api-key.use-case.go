@@ -0,0 +1,51 @@
+package use_cases
+
+import (
+	"time"
+
+	"tech_challenge/internal/application/dtos"
+	"tech_challenge/internal/application/gateways"
+	"tech_challenge/internal/domain/entities"
+	"tech_challenge/internal/shared/pkg/apikey"
+	identity_manager "tech_challenge/internal/shared/pkg/identity"
+)
+
+type CreateAPIKeyUseCase struct {
+	gateway gateways.APIKeyGateway
+}
+
+func NewCreateAPIKeyUseCase(gateway gateways.APIKeyGateway) *CreateAPIKeyUseCase {
+	return &CreateAPIKeyUseCase{
+		gateway: gateway,
+	}
+}
+
+// Execute devolve também a chave em texto puro, que não é persistida e só pode ser exibida agora
+func (uc *CreateAPIKeyUseCase) Execute(apiKeyDTO dtos.CreateAPIKeyDTO) (entities.APIKey, string, error) {
+	key, prefix, err := apikey.Generate()
+	if err != nil {
+		return entities.APIKey{}, "", err
+	}
+
+	apiKey, err := entities.NewAPIKey(
+		identity_manager.NewUUIDV4(),
+		apiKeyDTO.Name,
+		prefix,
+		apikey.Hash(key),
+		apiKeyDTO.Scopes,
+		apiKeyDTO.CreatedBy,
+		time.Now(),
+		nil,
+		nil,
+	)
+
+	if err != nil {
+		return entities.APIKey{}, "", err
+	}
+
+	if err := uc.gateway.Insert(*apiKey); err != nil {
+		return entities.APIKey{}, "", err
+	}
+
+	return *apiKey, key, nil
+}
@@ -1,13 +1,16 @@
 package use_cases
 
 import (
+	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"tech_challenge/internal/application/dtos"
 	"tech_challenge/internal/application/gateways"
 	"tech_challenge/internal/domain/entities"
 	"tech_challenge/internal/domain/exceptions"
+	"tech_challenge/internal/interfaces"
 	"tech_challenge/internal/shared/config/constants"
 	identity_manager "tech_challenge/internal/shared/pkg/identity"
 )
@@ -15,27 +18,38 @@ import (
 type CreateKitchenOrderUseCase struct {
 	kitchenOrderGateway gateways.KitchenOrderGateway
 	orderStatusGateway  gateways.OrderStatusGateway
+	printDispatcher     interfaces.IPrintDispatcher
 }
 
-func NewCreateKitchenOrderUseCase(kitchenOrderGateway gateways.KitchenOrderGateway, orderStatusGateway gateways.OrderStatusGateway) *CreateKitchenOrderUseCase {
+func NewCreateKitchenOrderUseCase(kitchenOrderGateway gateways.KitchenOrderGateway, orderStatusGateway gateways.OrderStatusGateway, printDispatcher interfaces.IPrintDispatcher) *CreateKitchenOrderUseCase {
 	return &CreateKitchenOrderUseCase{
 		kitchenOrderGateway: kitchenOrderGateway,
 		orderStatusGateway:  orderStatusGateway,
+		printDispatcher:     printDispatcher,
 	}
 }
 
-func (ko *CreateKitchenOrderUseCase) Execute(orderID string) (entities.KitchenOrder, error) {
+func (ko *CreateKitchenOrderUseCase) Execute(ctx context.Context, kitchenOrderDTO dtos.CreateKitchenOrderDTO) (entities.KitchenOrder, error) {
+	orderID := kitchenOrderDTO.OrderID
+
+	if strings.TrimSpace(orderID) == "" {
+		return entities.KitchenOrder{}, &exceptions.InvalidKitchenOrderDataException{
+			Message: "Order ID is required",
+		}
+	}
 
 	now := time.Now()
 	from := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
 	to := now
 
+	// Finalizados contam: um order_id repetido não gera um segundo pedido e a senha do dia não é reaproveitada
 	filterDailyKitchenOrder := dtos.KitchenOrderFilter{
-		CreatedAtFrom: &from,
-		CreatedAtTo:   &to,
+		CreatedAtFrom:   &from,
+		CreatedAtTo:     &to,
+		IncludeFinished: true,
 	}
 
-	orders, err := ko.kitchenOrderGateway.FindAll(filterDailyKitchenOrder)
+	orders, _, err := ko.kitchenOrderGateway.FindAll(ctx, filterDailyKitchenOrder)
 	if err != nil {
 		return entities.KitchenOrder{}, err
 	}
@@ -46,7 +60,7 @@ func (ko *CreateKitchenOrderUseCase) Execute(orderID string) (entities.KitchenOr
 		}
 	}
 
-	status, err := ko.orderStatusGateway.FindByID(constants.KITCHEN_ORDER_STATUS_RECEIVED_ID)
+	status, err := ko.orderStatusGateway.FindByID(ctx, constants.KITCHEN_ORDER_STATUS_RECEIVED_ID)
 
 	if err != nil {
 		return entities.KitchenOrder{}, &exceptions.OrderStatusNotFoundException{}
@@ -54,10 +68,30 @@ func (ko *CreateKitchenOrderUseCase) Execute(orderID string) (entities.KitchenOr
 
 	slug := fmt.Sprintf("%03d", len(orders)+1)
 
-	kitchenOrder, err := entities.NewKitchenOrder(
+	items := make([]entities.OrderItem, len(kitchenOrderDTO.Items))
+	for i, itemDTO := range kitchenOrderDTO.Items {
+		item, err := entities.NewOrderItem(
+			identity_manager.NewUUIDV4(),
+			orderID,
+			itemDTO.ProductID,
+			itemDTO.Quantity,
+			itemDTO.UnitPrice,
+		)
+
+		if err != nil {
+			return entities.KitchenOrder{}, err
+		}
+
+		items[i] = *item
+	}
+
+	kitchenOrder, err := entities.NewKitchenOrderWithOrderData(
 		identity_manager.NewUUIDV4(),
 		orderID,
 		slug,
+		kitchenOrderDTO.CustomerID,
+		0,
+		items,
 		status,
 		time.Now(),
 		nil,
@@ -67,11 +101,37 @@ func (ko *CreateKitchenOrderUseCase) Execute(orderID string) (entities.KitchenOr
 		return entities.KitchenOrder{}, err
 	}
 
-	err = ko.kitchenOrderGateway.Insert(*kitchenOrder)
+	kitchenOrder.CalcTotalAmount()
+
+	// As comandas são gravadas na mesma transação do pedido: todo pedido criado tem a sua impressão na fila
+	err = ko.kitchenOrderGateway.Transaction(ctx, func(ctx context.Context) error {
+		if err := ko.kitchenOrderGateway.Insert(ctx, *kitchenOrder); err != nil {
+			return err
+		}
+
+		// Pedidos repetidos retornam antes daqui, então cada pedido é impresso uma única vez
+		return ko.dispatchTickets(ctx, kitchenOrder.ID)
+	})
 
 	if err != nil {
 		return entities.KitchenOrder{}, err
 	}
 
+	if ko.printDispatcher != nil {
+		ko.printDispatcher.Notify()
+	}
+
 	return *kitchenOrder, nil
 }
+
+func (ko *CreateKitchenOrderUseCase) dispatchTickets(ctx context.Context, kitchenOrderID string) error {
+	if ko.printDispatcher == nil {
+		return nil
+	}
+
+	if err := ko.printDispatcher.Dispatch(ctx, kitchenOrderID); err != nil {
+		return fmt.Errorf("enqueuing kitchen order tickets: %w", err)
+	}
+
+	return nil
+}
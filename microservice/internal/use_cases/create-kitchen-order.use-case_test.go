@@ -1,9 +1,12 @@
 package use_cases
 
 import (
+	"context"
+	"errors"
 	"testing"
 	"time"
 
+	"tech_challenge/internal/application/dtos"
 	"tech_challenge/internal/domain/entities"
 	"tech_challenge/internal/domain/exceptions"
 	"tech_challenge/internal/shared/config/constants"
@@ -15,10 +18,10 @@ func TestCreateKitchenOrderUseCase_Success(t *testing.T) {
 	kitchenOrderGateway := NewMockKitchenOrderGateway(dataStore)
 	orderStatusGateway := NewMockOrderStatusGateway(dataStore)
 
-	useCase := NewCreateKitchenOrderUseCase(kitchenOrderGateway, orderStatusGateway)
+	useCase := NewCreateKitchenOrderUseCase(kitchenOrderGateway, orderStatusGateway, nil)
 	orderID := "550e8400-e29b-41d4-a716-446655440000"
 
-	result, err := useCase.Execute(orderID)
+	result, err := useCase.Execute(context.Background(), dtos.CreateKitchenOrderDTO{OrderID: orderID})
 
 	if err != nil {
 		t.Errorf("Expected no error, got %v", err)
@@ -46,10 +49,10 @@ func TestCreateKitchenOrderUseCase_StatusNotFound(t *testing.T) {
 	kitchenOrderGateway := NewMockKitchenOrderGateway(dataStore)
 	orderStatusGateway := NewMockOrderStatusGateway(dataStore)
 
-	useCase := NewCreateKitchenOrderUseCase(kitchenOrderGateway, orderStatusGateway)
+	useCase := NewCreateKitchenOrderUseCase(kitchenOrderGateway, orderStatusGateway, nil)
 	orderID := "550e8400-e29b-41d4-a716-446655440000"
 
-	_, err := useCase.Execute(orderID)
+	_, err := useCase.Execute(context.Background(), dtos.CreateKitchenOrderDTO{OrderID: orderID})
 
 	if err == nil {
 		t.Error("Expected error for status not found, got nil")
@@ -81,10 +84,10 @@ func TestCreateKitchenOrderUseCase_SlugGeneration(t *testing.T) {
 	kitchenOrderGateway := NewMockKitchenOrderGateway(dataStore)
 	orderStatusGateway := NewMockOrderStatusGateway(dataStore)
 
-	useCase := NewCreateKitchenOrderUseCase(kitchenOrderGateway, orderStatusGateway)
+	useCase := NewCreateKitchenOrderUseCase(kitchenOrderGateway, orderStatusGateway, nil)
 	orderID := "550e8400-e29b-41d4-a716-446655440000"
 
-	result, err := useCase.Execute(orderID)
+	result, err := useCase.Execute(context.Background(), dtos.CreateKitchenOrderDTO{OrderID: orderID})
 
 	if err != nil {
 		t.Errorf("Expected no error, got %v", err)
@@ -94,3 +97,170 @@ func TestCreateKitchenOrderUseCase_SlugGeneration(t *testing.T) {
 		t.Errorf("Expected slug '003', got %s", result.Slug.Value())
 	}
 }
+
+func TestCreateKitchenOrderUseCase_FinishedOrdersOfTheDay(t *testing.T) {
+	// Arrange - o pedido do dia já foi finalizado e saiu do quadro
+	dataStore := NewMockDataStore()
+	finishedStatus := dataStore.orderStatuses[3] // Status "Finalizado"
+
+	finishedOrder, _ := entities.NewKitchenOrder(
+		"id1", "order1", "001",
+		finishedStatus,
+		time.Now(), nil,
+	)
+	dataStore.kitchenOrders = []entities.KitchenOrder{*finishedOrder}
+
+	useCase := NewCreateKitchenOrderUseCase(NewMockKitchenOrderGateway(dataStore), NewMockOrderStatusGateway(dataStore), nil)
+
+	// Act - a nova tentativa do mesmo order_id devolve o pedido finalizado
+	retried, err := useCase.Execute(context.Background(), dtos.CreateKitchenOrderDTO{OrderID: "order1"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if retried.ID != finishedOrder.ID || len(dataStore.kitchenOrders) != 1 {
+		t.Errorf("Expected the finished order to be returned without a new insert, got %s with %d orders", retried.ID, len(dataStore.kitchenOrders))
+	}
+
+	// Act - um novo pedido não reaproveita a senha do finalizado
+	created, err := useCase.Execute(context.Background(), dtos.CreateKitchenOrderDTO{OrderID: "order2"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if created.Slug.Value() != "002" {
+		t.Errorf("Expected slug '002', got %s", created.Slug.Value())
+	}
+
+	t.Log("✓ Pedidos finalizados do dia contam na deduplicação e na senha")
+}
+
+func TestCreateKitchenOrderUseCase_WithItems(t *testing.T) {
+	dataStore := NewMockDataStore()
+	kitchenOrderGateway := NewMockKitchenOrderGateway(dataStore)
+	orderStatusGateway := NewMockOrderStatusGateway(dataStore)
+
+	useCase := NewCreateKitchenOrderUseCase(kitchenOrderGateway, orderStatusGateway, nil)
+	customerID := "customer-001"
+
+	result, err := useCase.Execute(context.Background(), dtos.CreateKitchenOrderDTO{
+		OrderID:    "550e8400-e29b-41d4-a716-446655440000",
+		CustomerID: &customerID,
+		Items: []dtos.CreateOrderItemDTO{
+			{ProductID: "burger", Quantity: 2, UnitPrice: 25.5},
+			{ProductID: "soda", Quantity: 1, UnitPrice: 7},
+		},
+	})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(result.Items) != 2 {
+		t.Fatalf("Expected 2 items, got %d", len(result.Items))
+	}
+
+	if result.Amount != 58 {
+		t.Errorf("Expected amount 58, got %v", result.Amount)
+	}
+
+	if result.CustomerID == nil || *result.CustomerID != customerID {
+		t.Errorf("Expected customer ID %s, got %v", customerID, result.CustomerID)
+	}
+
+	t.Log("✓ Pedido criado com itens, cliente e valor total")
+}
+
+func TestCreateKitchenOrderUseCase_InvalidData(t *testing.T) {
+	dataStore := NewMockDataStore()
+	useCase := NewCreateKitchenOrderUseCase(NewMockKitchenOrderGateway(dataStore), NewMockOrderStatusGateway(dataStore), nil)
+
+	testCases := []struct {
+		name string
+		dto  dtos.CreateKitchenOrderDTO
+	}{
+		{"missing order ID", dtos.CreateKitchenOrderDTO{OrderID: " "}},
+		{"invalid quantity", dtos.CreateKitchenOrderDTO{
+			OrderID: "550e8400-e29b-41d4-a716-446655440000",
+			Items:   []dtos.CreateOrderItemDTO{{ProductID: "burger", Quantity: 0, UnitPrice: 10}},
+		}},
+		{"missing product ID", dtos.CreateKitchenOrderDTO{
+			OrderID: "550e8400-e29b-41d4-a716-446655440000",
+			Items:   []dtos.CreateOrderItemDTO{{Quantity: 1, UnitPrice: 10}},
+		}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := useCase.Execute(context.Background(), tc.dto)
+
+			if _, ok := err.(*exceptions.InvalidKitchenOrderDataException); !ok {
+				t.Errorf("Expected InvalidKitchenOrderDataException, got %T", err)
+			}
+		})
+	}
+
+	if len(dataStore.kitchenOrders) != 0 {
+		t.Errorf("Expected no kitchen order to be inserted, got %d", len(dataStore.kitchenOrders))
+	}
+}
+
+type recordingPrintDispatcher struct {
+	kitchenOrderIDs []string
+	notifications   int
+	err             error
+}
+
+func (d *recordingPrintDispatcher) Dispatch(ctx context.Context, kitchenOrderID string) error {
+	if d.err != nil {
+		return d.err
+	}
+	d.kitchenOrderIDs = append(d.kitchenOrderIDs, kitchenOrderID)
+	return nil
+}
+
+func (d *recordingPrintDispatcher) Notify() {
+	d.notifications++
+}
+
+func TestCreateKitchenOrderUseCase_DispatchesPrintOnce(t *testing.T) {
+	dataStore := NewMockDataStore()
+	dispatcher := &recordingPrintDispatcher{}
+	useCase := NewCreateKitchenOrderUseCase(NewMockKitchenOrderGateway(dataStore), NewMockOrderStatusGateway(dataStore), dispatcher)
+
+	first, err := useCase.Execute(context.Background(), dtos.CreateKitchenOrderDTO{OrderID: "order-123"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	// A mesma mensagem reentregue devolve o pedido existente sem imprimir de novo
+	if _, err := useCase.Execute(context.Background(), dtos.CreateKitchenOrderDTO{OrderID: "order-123"}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(dispatcher.kitchenOrderIDs) != 1 || dispatcher.kitchenOrderIDs[0] != first.ID || dispatcher.notifications != 1 {
+		t.Errorf("Expected a single print dispatch for %s, got %v (%d notifications)", first.ID, dispatcher.kitchenOrderIDs, dispatcher.notifications)
+	} else {
+		t.Log("✓ Comanda enviada para impressão apenas na criação do pedido")
+	}
+}
+
+func TestCreateKitchenOrderUseCase_PrintEnqueueFailure(t *testing.T) {
+	dataStore := NewMockDataStore()
+	enqueueErr := errors.New("print queue unavailable")
+	dispatcher := &recordingPrintDispatcher{err: enqueueErr}
+	useCase := NewCreateKitchenOrderUseCase(NewMockKitchenOrderGateway(dataStore), NewMockOrderStatusGateway(dataStore), dispatcher)
+
+	_, err := useCase.Execute(context.Background(), dtos.CreateKitchenOrderDTO{OrderID: "order-123"})
+
+	// O erro desfaz a transação do pedido para que a mensagem seja reprocessada com as comandas
+	if !errors.Is(err, enqueueErr) {
+		t.Fatalf("Expected enqueue error, got %v", err)
+	}
+
+	if dispatcher.notifications != 0 {
+		t.Errorf("Expected no notification for a rolled back order, got %d", dispatcher.notifications)
+	} else {
+		t.Log("✓ Falha ao enfileirar as comandas falha a criação do pedido")
+	}
+}
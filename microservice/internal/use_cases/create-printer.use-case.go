@@ -0,0 +1,50 @@
+package use_cases
+
+import (
+	"time"
+
+	"tech_challenge/internal/application/dtos"
+	"tech_challenge/internal/application/gateways"
+	"tech_challenge/internal/domain/entities"
+	"tech_challenge/internal/shared/config/constants"
+	identity_manager "tech_challenge/internal/shared/pkg/identity"
+)
+
+type CreatePrinterUseCase struct {
+	gateway gateways.PrinterGateway
+}
+
+func NewCreatePrinterUseCase(gateway gateways.PrinterGateway) *CreatePrinterUseCase {
+	return &CreatePrinterUseCase{
+		gateway: gateway,
+	}
+}
+
+func (uc *CreatePrinterUseCase) Execute(printerDTO dtos.CreatePrinterDTO) (entities.Printer, error) {
+	port := printerDTO.Port
+	if port == 0 {
+		port = constants.PRINTER_DEFAULT_PORT
+	}
+
+	printer, err := entities.NewPrinter(
+		identity_manager.NewUUIDV4(),
+		printerDTO.Name,
+		printerDTO.Host,
+		port,
+		printerDTO.Station,
+		printerDTO.Width,
+		true,
+		time.Now(),
+		nil,
+	)
+
+	if err != nil {
+		return entities.Printer{}, err
+	}
+
+	if err := uc.gateway.Insert(*printer); err != nil {
+		return entities.Printer{}, err
+	}
+
+	return *printer, nil
+}
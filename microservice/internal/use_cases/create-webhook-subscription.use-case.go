@@ -0,0 +1,66 @@
+package use_cases
+
+import (
+	"time"
+
+	"tech_challenge/internal/application/dtos"
+	"tech_challenge/internal/application/gateways"
+	"tech_challenge/internal/domain/entities"
+	"tech_challenge/internal/interfaces"
+	identity_manager "tech_challenge/internal/shared/pkg/identity"
+	"tech_challenge/internal/shared/pkg/signature"
+)
+
+type CreateWebhookSubscriptionUseCase struct {
+	gateway      gateways.WebhookSubscriptionGateway
+	urlValidator interfaces.IWebhookURLValidator
+}
+
+func NewCreateWebhookSubscriptionUseCase(gateway gateways.WebhookSubscriptionGateway, urlValidator interfaces.IWebhookURLValidator) *CreateWebhookSubscriptionUseCase {
+	return &CreateWebhookSubscriptionUseCase{
+		gateway:      gateway,
+		urlValidator: urlValidator,
+	}
+}
+
+func (uc *CreateWebhookSubscriptionUseCase) Execute(subscriptionDTO dtos.CreateWebhookSubscriptionDTO) (entities.WebhookSubscription, error) {
+	secret := subscriptionDTO.Secret
+
+	if secret == "" {
+		generatedSecret, err := signature.GenerateSecret()
+		if err != nil {
+			return entities.WebhookSubscription{}, err
+		}
+		secret = generatedSecret
+	}
+
+	events := subscriptionDTO.Events
+	if events == nil {
+		events = []string{}
+	}
+
+	subscription, err := entities.NewWebhookSubscription(
+		identity_manager.NewUUIDV4(),
+		subscriptionDTO.URL,
+		secret,
+		events,
+		true,
+		0,
+		time.Now(),
+		nil,
+	)
+
+	if err != nil {
+		return entities.WebhookSubscription{}, err
+	}
+
+	if err := uc.urlValidator.Validate(subscription.URL); err != nil {
+		return entities.WebhookSubscription{}, err
+	}
+
+	if err := uc.gateway.Insert(*subscription); err != nil {
+		return entities.WebhookSubscription{}, err
+	}
+
+	return *subscription, nil
+}
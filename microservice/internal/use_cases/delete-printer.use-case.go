@@ -0,0 +1,29 @@
+package use_cases
+
+import (
+	"tech_challenge/internal/application/gateways"
+	"tech_challenge/internal/domain/entities"
+	"tech_challenge/internal/domain/exceptions"
+)
+
+type DeletePrinterUseCase struct {
+	gateway gateways.PrinterGateway
+}
+
+func NewDeletePrinterUseCase(gateway gateways.PrinterGateway) *DeletePrinterUseCase {
+	return &DeletePrinterUseCase{
+		gateway: gateway,
+	}
+}
+
+func (uc *DeletePrinterUseCase) Execute(id string) error {
+	if err := entities.ValidatePrinterID(id); err != nil {
+		return err
+	}
+
+	if err := uc.gateway.Delete(id); err != nil {
+		return &exceptions.PrinterNotFoundException{}
+	}
+
+	return nil
+}
@@ -0,0 +1,29 @@
+package use_cases
+
+import (
+	"tech_challenge/internal/application/gateways"
+	"tech_challenge/internal/domain/entities"
+	"tech_challenge/internal/domain/exceptions"
+)
+
+type DeleteWebhookSubscriptionUseCase struct {
+	gateway gateways.WebhookSubscriptionGateway
+}
+
+func NewDeleteWebhookSubscriptionUseCase(gateway gateways.WebhookSubscriptionGateway) *DeleteWebhookSubscriptionUseCase {
+	return &DeleteWebhookSubscriptionUseCase{
+		gateway: gateway,
+	}
+}
+
+func (uc *DeleteWebhookSubscriptionUseCase) Execute(id string) error {
+	if err := entities.ValidateWebhookID(id); err != nil {
+		return err
+	}
+
+	if err := uc.gateway.Delete(id); err != nil {
+		return &exceptions.WebhookSubscriptionNotFoundException{}
+	}
+
+	return nil
+}
@@ -0,0 +1,111 @@
+package use_cases
+
+import (
+	"context"
+	"time"
+
+	"tech_challenge/internal/application/dtos"
+	"tech_challenge/internal/application/gateways"
+	"tech_challenge/internal/domain/entities"
+	"tech_challenge/internal/domain/exceptions"
+	"tech_challenge/internal/shared/config/constants"
+	identity_manager "tech_challenge/internal/shared/pkg/identity"
+)
+
+type EnqueueKitchenOrderTicketsUseCase struct {
+	kitchenOrderGateway gateways.KitchenOrderGateway
+	printerGateway      gateways.PrinterGateway
+	printRouteGateway   gateways.PrintRouteGateway
+	printJobGateway     gateways.PrintJobGateway
+}
+
+func NewEnqueueKitchenOrderTicketsUseCase(
+	kitchenOrderGateway gateways.KitchenOrderGateway,
+	printerGateway gateways.PrinterGateway,
+	printRouteGateway gateways.PrintRouteGateway,
+	printJobGateway gateways.PrintJobGateway,
+) *EnqueueKitchenOrderTicketsUseCase {
+	return &EnqueueKitchenOrderTicketsUseCase{
+		kitchenOrderGateway: kitchenOrderGateway,
+		printerGateway:      printerGateway,
+		printRouteGateway:   printRouteGateway,
+		printJobGateway:     printJobGateway,
+	}
+}
+
+// Execute cria um trabalho por impressora com os itens da sua praça; a expedição recebe sempre o pedido inteiro
+func (uc *EnqueueKitchenOrderTicketsUseCase) Execute(ctx context.Context, printDTO dtos.PrintKitchenOrderDTO) ([]entities.PrintJob, error) {
+	kitchenOrder, err := NewFindKitchenOrderByIDUseCase(uc.kitchenOrderGateway).Execute(ctx, printDTO.KitchenOrderID)
+	if err != nil {
+		return nil, err
+	}
+
+	printers, err := uc.targetPrinters(ctx, printDTO.PrinterID)
+	if err != nil {
+		return nil, err
+	}
+
+	routes, err := uc.printRouteGateway.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	itemsByStation := entities.RouteOrderItems(kitchenOrder.Items, routes, constants.PRINT_STATION_DEFAULT)
+	singlePrinter := printDTO.PrinterID != ""
+
+	now := time.Now()
+	jobs := make([]entities.PrintJob, 0, len(printers))
+
+	for _, printer := range printers {
+		itemIDs := []string{}
+
+		if !printer.ReceivesFullTicket() {
+			stationItems := itemsByStation[printer.Station]
+
+			// Na reimpressão para uma impressora específica, uma praça sem itens recebe o pedido inteiro
+			if len(stationItems) == 0 && !singlePrinter {
+				continue
+			}
+
+			for _, item := range stationItems {
+				itemIDs = append(itemIDs, item.ID)
+			}
+		}
+
+		jobs = append(jobs, *entities.NewPrintJob(
+			identity_manager.NewUUIDV4(),
+			kitchenOrder.ID,
+			printer.ID,
+			printer.Station,
+			itemIDs,
+			printDTO.Reprint,
+			printDTO.RequestedBy,
+			now,
+		))
+	}
+
+	if err := uc.printJobGateway.InsertAll(ctx, jobs); err != nil {
+		return nil, err
+	}
+
+	return jobs, nil
+}
+
+func (uc *EnqueueKitchenOrderTicketsUseCase) targetPrinters(ctx context.Context, printerID string) ([]entities.Printer, error) {
+	if printerID == "" {
+		return uc.printerGateway.FindAllActive(ctx)
+	}
+
+	printer, err := NewFindPrinterByIDUseCase(uc.printerGateway).Execute(printerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !printer.Active {
+		return nil, &exceptions.InvalidPrinterDataException{
+			Message: "Printer is inactive",
+		}
+	}
+
+	return []entities.Printer{printer}, nil
+}
@@ -0,0 +1,95 @@
+package use_cases
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"tech_challenge/internal/application/dtos"
+	"tech_challenge/internal/application/gateways"
+	"tech_challenge/internal/domain/entities"
+	identity_manager "tech_challenge/internal/shared/pkg/identity"
+)
+
+type EnqueueWebhookEventUseCase struct {
+	subscriptionGateway gateways.WebhookSubscriptionGateway
+	outboxGateway       gateways.WebhookOutboxGateway
+	now                 func() time.Time
+}
+
+func NewEnqueueWebhookEventUseCase(
+	subscriptionGateway gateways.WebhookSubscriptionGateway,
+	outboxGateway gateways.WebhookOutboxGateway,
+) *EnqueueWebhookEventUseCase {
+	return &EnqueueWebhookEventUseCase{
+		subscriptionGateway: subscriptionGateway,
+		outboxGateway:       outboxGateway,
+		now:                 time.Now,
+	}
+}
+
+type WebhookPayload struct {
+	ID         string                  `json:"id"`
+	Event      string                  `json:"event"`
+	OccurredAt time.Time               `json:"occurred_at"`
+	Data       WebhookKitchenOrderData `json:"data"`
+}
+
+type WebhookKitchenOrderData struct {
+	ID       string `json:"id"`
+	OrderID  string `json:"order_id"`
+	Slug     string `json:"slug"`
+	StatusID string `json:"status_id"`
+	Status   string `json:"status"`
+}
+
+// Execute grava uma entrega pendente por inscrição ativa interessada no evento; o envio fica com o worker da fila
+func (uc *EnqueueWebhookEventUseCase) Execute(ctx context.Context, event dtos.WebhookEventDTO) ([]entities.WebhookOutboxEntry, error) {
+	subscriptions, err := uc.subscriptionGateway.FindAllActive()
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := json.Marshal(WebhookPayload{
+		ID:         event.ID,
+		Event:      event.Event,
+		OccurredAt: event.OccurredAt,
+		Data: WebhookKitchenOrderData{
+			ID:       event.Data.ID,
+			OrderID:  event.Data.OrderID,
+			Slug:     event.Data.Slug,
+			StatusID: event.Data.StatusID,
+			Status:   event.Data.Status,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	now := uc.now()
+	entries := make([]entities.WebhookOutboxEntry, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		if !subscription.IsSubscribedTo(event.Event) {
+			continue
+		}
+
+		entries = append(entries, *entities.NewWebhookOutboxEntry(
+			identity_manager.NewUUIDV4(),
+			subscription.ID,
+			event.ID,
+			event.Event,
+			body,
+			now,
+		))
+	}
+
+	if len(entries) == 0 {
+		return entries, nil
+	}
+
+	if err := uc.outboxGateway.InsertAll(ctx, entries); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
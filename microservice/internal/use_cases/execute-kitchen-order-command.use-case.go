@@ -0,0 +1,81 @@
+package use_cases
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"tech_challenge/internal/application/dtos"
+	"tech_challenge/internal/application/gateways"
+	"tech_challenge/internal/domain/entities"
+	"tech_challenge/internal/domain/exceptions"
+	"tech_challenge/internal/shared/config/constants"
+)
+
+type ExecuteKitchenOrderCommandUseCase struct {
+	gateway       gateways.KitchenOrderGateway
+	updateUseCase *UpdateKitchenOrderUseCase
+}
+
+func NewExecuteKitchenOrderCommandUseCase(gateway gateways.KitchenOrderGateway, updateUseCase *UpdateKitchenOrderUseCase) *ExecuteKitchenOrderCommandUseCase {
+	return &ExecuteKitchenOrderCommandUseCase{
+		gateway:       gateway,
+		updateUseCase: updateUseCase,
+	}
+}
+
+// Execute resolve o status de destino do comando e delega a alteração para o UpdateKitchenOrderUseCase,
+// mantendo as notificações e webhooks no mesmo fluxo do PUT
+func (uc *ExecuteKitchenOrderCommandUseCase) Execute(ctx context.Context, commandDTO dtos.KitchenOrderCommandDTO) (entities.KitchenOrder, error) {
+	err := entities.ValidateID(commandDTO.ID)
+
+	if err != nil {
+		return entities.KitchenOrder{}, err
+	}
+
+	kitchenOrder, err := uc.gateway.FindByID(ctx, commandDTO.ID)
+
+	if isQueryCanceled(err) {
+		return entities.KitchenOrder{}, err
+	}
+
+	if err != nil || kitchenOrder.IsEmpty() {
+		return entities.KitchenOrder{}, &exceptions.KitchenOrderNotFoundException{}
+	}
+
+	if commandDTO.ExpectedVersion != nil && *commandDTO.ExpectedVersion != kitchenOrder.Version {
+		return entities.KitchenOrder{}, &exceptions.KitchenOrderVersionMismatchException{}
+	}
+
+	statusID, err := entities.ResolveKitchenOrderCommand(commandDTO.Command, kitchenOrder.Status.ID)
+
+	if err != nil {
+		return entities.KitchenOrder{}, err
+	}
+
+	var reason *string
+	if commandDTO.Command == constants.KITCHEN_ORDER_COMMAND_RECALL {
+		if err := entities.ValidateKitchenOrderStatusReason(commandDTO.Reason); err != nil {
+			return entities.KitchenOrder{}, err
+		}
+
+		trimmedReason := strings.TrimSpace(commandDTO.Reason)
+		reason = &trimmedReason
+	}
+
+	// O status de destino foi resolvido sobre esta versão; a alteração só é gravada se ela ainda for a atual
+	updatedKitchenOrder, err := uc.updateUseCase.Execute(ctx, dtos.UpdateKitchenOrderDTO{
+		ID:              commandDTO.ID,
+		StatusID:        statusID,
+		Reason:          reason,
+		ChangedBy:       commandDTO.ChangedBy,
+		ExpectedVersion: &kitchenOrder.Version,
+	})
+
+	var versionMismatch *exceptions.KitchenOrderVersionMismatchException
+	if errors.As(err, &versionMismatch) && commandDTO.ExpectedVersion == nil {
+		return entities.KitchenOrder{}, &exceptions.KitchenOrderUpdateConflictException{}
+	}
+
+	return updatedKitchenOrder, err
+}
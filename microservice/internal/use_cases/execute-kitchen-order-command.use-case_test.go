@@ -0,0 +1,235 @@
+package use_cases
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"tech_challenge/internal"
+	"tech_challenge/internal/application/dtos"
+	"tech_challenge/internal/domain/entities"
+	"tech_challenge/internal/domain/exceptions"
+	"tech_challenge/internal/shared/config/constants"
+)
+
+func newExecuteKitchenOrderCommandUseCase(dataStore *MockDataStore) *ExecuteKitchenOrderCommandUseCase {
+	kitchenOrderGateway := NewMockKitchenOrderGateway(dataStore)
+	orderStatusGateway := NewMockOrderStatusGateway(dataStore)
+	updateUseCase := NewUpdateKitchenOrderUseCase(kitchenOrderGateway, orderStatusGateway, &MockMessageBroker{}, nil)
+
+	return NewExecuteKitchenOrderCommandUseCase(kitchenOrderGateway, updateUseCase)
+}
+
+func seedKitchenOrderWithStatus(t *testing.T, dataStore *MockDataStore, orderID, statusID string) {
+	for _, status := range dataStore.orderStatuses {
+		if status.ID == statusID {
+			order, err := entities.NewKitchenOrder(orderID, "order123", "001", status, time.Now(), nil)
+			if err != nil {
+				t.Fatalf("Failed to build kitchen order: %v", err)
+			}
+			dataStore.kitchenOrders = []entities.KitchenOrder{*order}
+			return
+		}
+	}
+
+	t.Fatalf("Status %s not found in mock data store", statusID)
+}
+
+func TestExecuteKitchenOrderCommandUseCase_Commands(t *testing.T) {
+	internal.SetupTestEnv()
+	defer internal.CleanupTestEnv()
+
+	orderID := "550e8400-e29b-41d4-a716-446655440000"
+
+	cases := []struct {
+		command  string
+		from     string
+		expected string
+	}{
+		{constants.KITCHEN_ORDER_COMMAND_START, constants.KITCHEN_ORDER_STATUS_RECEIVED_ID, constants.KITCHEN_ORDER_STATUS_PREPARING_ID},
+		{constants.KITCHEN_ORDER_COMMAND_READY, constants.KITCHEN_ORDER_STATUS_PREPARING_ID, constants.KITCHEN_ORDER_STATUS_READY_ID},
+		{constants.KITCHEN_ORDER_COMMAND_FINISH, constants.KITCHEN_ORDER_STATUS_READY_ID, constants.KITCHEN_ORDER_STATUS_FINISHED_ID},
+		{constants.KITCHEN_ORDER_COMMAND_RECALL, constants.KITCHEN_ORDER_STATUS_READY_ID, constants.KITCHEN_ORDER_STATUS_PREPARING_ID},
+	}
+
+	for _, tc := range cases {
+		dataStore := NewMockDataStore()
+		seedKitchenOrderWithStatus(t, dataStore, orderID, tc.from)
+		useCase := newExecuteKitchenOrderCommandUseCase(dataStore)
+
+		result, err := useCase.Execute(context.Background(), dtos.KitchenOrderCommandDTO{ID: orderID, Command: tc.command, Reason: "Cliente devolveu o lanche frio"})
+
+		if err != nil {
+			t.Errorf("Expected no error for command %s, got %v", tc.command, err)
+			continue
+		}
+
+		if result.Status.ID != tc.expected {
+			t.Errorf("Command %s: expected status %s, got %s", tc.command, tc.expected, result.Status.ID)
+		}
+	}
+
+	t.Log("✓ Comandos do KDS resolvidos para os status corretos")
+}
+
+func TestExecuteKitchenOrderCommandUseCase_OrderNotFound(t *testing.T) {
+	useCase := newExecuteKitchenOrderCommandUseCase(NewMockDataStore())
+
+	_, err := useCase.Execute(context.Background(), dtos.KitchenOrderCommandDTO{
+		ID:      "550e8400-e29b-41d4-a716-446655440000",
+		Command: constants.KITCHEN_ORDER_COMMAND_START,
+	})
+
+	if _, ok := err.(*exceptions.KitchenOrderNotFoundException); !ok {
+		t.Errorf("Expected KitchenOrderNotFoundException, got %T", err)
+	}
+}
+
+func TestExecuteKitchenOrderCommandUseCase_InvalidCommand(t *testing.T) {
+	orderID := "550e8400-e29b-41d4-a716-446655440000"
+	dataStore := NewMockDataStore()
+	seedKitchenOrderWithStatus(t, dataStore, orderID, constants.KITCHEN_ORDER_STATUS_RECEIVED_ID)
+	useCase := newExecuteKitchenOrderCommandUseCase(dataStore)
+
+	_, err := useCase.Execute(context.Background(), dtos.KitchenOrderCommandDTO{ID: orderID, Command: "explode"})
+
+	if _, ok := err.(*exceptions.InvalidKitchenOrderCommandException); !ok {
+		t.Errorf("Expected InvalidKitchenOrderCommandException, got %T", err)
+	}
+
+	if dataStore.kitchenOrders[0].Status.ID != constants.KITCHEN_ORDER_STATUS_RECEIVED_ID {
+		t.Error("Expected status to remain unchanged after an invalid command")
+	}
+}
+
+func TestExecuteKitchenOrderCommandUseCase_InvalidTransition(t *testing.T) {
+	orderID := "550e8400-e29b-41d4-a716-446655440000"
+
+	cases := []struct {
+		command string
+		from    string
+	}{
+		{constants.KITCHEN_ORDER_COMMAND_START, constants.KITCHEN_ORDER_STATUS_READY_ID},
+		{constants.KITCHEN_ORDER_COMMAND_FINISH, constants.KITCHEN_ORDER_STATUS_RECEIVED_ID},
+		{constants.KITCHEN_ORDER_COMMAND_RECALL, constants.KITCHEN_ORDER_STATUS_RECEIVED_ID},
+	}
+
+	for _, tc := range cases {
+		dataStore := NewMockDataStore()
+		seedKitchenOrderWithStatus(t, dataStore, orderID, tc.from)
+		useCase := newExecuteKitchenOrderCommandUseCase(dataStore)
+
+		_, err := useCase.Execute(context.Background(), dtos.KitchenOrderCommandDTO{ID: orderID, Command: tc.command, Reason: "Pedido errado"})
+
+		if _, ok := err.(*exceptions.InvalidKitchenOrderTransitionException); !ok {
+			t.Errorf("Command %q from %s: expected InvalidKitchenOrderTransitionException, got %T", tc.command, tc.from, err)
+		}
+
+		if dataStore.kitchenOrders[0].Status.ID != tc.from {
+			t.Errorf("Command %q: expected status to remain unchanged", tc.command)
+		}
+	}
+
+	t.Log("✓ Transições inválidas não alteram o pedido")
+}
+
+func TestExecuteKitchenOrderCommandUseCase_RecallReason(t *testing.T) {
+	internal.SetupTestEnv()
+	defer internal.CleanupTestEnv()
+
+	orderID := "550e8400-e29b-41d4-a716-446655440000"
+
+	t.Run("reason is required", func(t *testing.T) {
+		dataStore := NewMockDataStore()
+		seedKitchenOrderWithStatus(t, dataStore, orderID, constants.KITCHEN_ORDER_STATUS_FINISHED_ID)
+		useCase := newExecuteKitchenOrderCommandUseCase(dataStore)
+
+		_, err := useCase.Execute(context.Background(), dtos.KitchenOrderCommandDTO{ID: orderID, Command: constants.KITCHEN_ORDER_COMMAND_RECALL, Reason: "  "})
+
+		if _, ok := err.(*exceptions.InvalidKitchenOrderCommandException); !ok {
+			t.Errorf("Expected InvalidKitchenOrderCommandException, got %T", err)
+		}
+
+		if dataStore.kitchenOrders[0].Status.ID != constants.KITCHEN_ORDER_STATUS_FINISHED_ID {
+			t.Error("Expected status to remain unchanged without a reason")
+		}
+	})
+
+	t.Run("reason is stored and cleared by the next change", func(t *testing.T) {
+		dataStore := NewMockDataStore()
+		seedKitchenOrderWithStatus(t, dataStore, orderID, constants.KITCHEN_ORDER_STATUS_FINISHED_ID)
+		useCase := newExecuteKitchenOrderCommandUseCase(dataStore)
+
+		recalled, err := useCase.Execute(context.Background(), dtos.KitchenOrderCommandDTO{ID: orderID, Command: constants.KITCHEN_ORDER_COMMAND_RECALL, Reason: " Cliente devolveu o lanche frio "})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if recalled.StatusReason == nil || *recalled.StatusReason != "Cliente devolveu o lanche frio" {
+			t.Errorf("Expected trimmed recall reason, got %v", recalled.StatusReason)
+		}
+
+		stored := dataStore.kitchenOrders[0].StatusReason
+		if stored == nil || *stored != "Cliente devolveu o lanche frio" {
+			t.Errorf("Expected recall reason to be persisted, got %v", stored)
+		}
+
+		ready, err := useCase.Execute(context.Background(), dtos.KitchenOrderCommandDTO{ID: orderID, Command: constants.KITCHEN_ORDER_COMMAND_READY})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if ready.StatusReason != nil {
+			t.Errorf("Expected reason to be cleared, got %v", *ready.StatusReason)
+		}
+	})
+
+	t.Log("✓ Recall exige motivo e o registra no pedido")
+}
+
+func TestExecuteKitchenOrderCommandUseCase_InvalidID(t *testing.T) {
+	useCase := newExecuteKitchenOrderCommandUseCase(NewMockDataStore())
+
+	_, err := useCase.Execute(context.Background(), dtos.KitchenOrderCommandDTO{ID: "invalid", Command: constants.KITCHEN_ORDER_COMMAND_START})
+
+	if _, ok := err.(*exceptions.InvalidKitchenOrderDataException); !ok {
+		t.Errorf("Expected InvalidKitchenOrderDataException, got %T", err)
+	}
+}
+
+func TestExecuteKitchenOrderCommandUseCase_Versioning(t *testing.T) {
+	internal.SetupTestEnv()
+	defer internal.CleanupTestEnv()
+
+	orderID := "550e8400-e29b-41d4-a716-446655440000"
+
+	t.Run("stale expected version", func(t *testing.T) {
+		dataStore := NewMockDataStore()
+		seedKitchenOrderWithStatus(t, dataStore, orderID, constants.KITCHEN_ORDER_STATUS_RECEIVED_ID)
+		useCase := newExecuteKitchenOrderCommandUseCase(dataStore)
+
+		staleVersion := uint64(0)
+		_, err := useCase.Execute(context.Background(), dtos.KitchenOrderCommandDTO{
+			ID:              orderID,
+			Command:         constants.KITCHEN_ORDER_COMMAND_START,
+			ExpectedVersion: &staleVersion,
+		})
+
+		if _, ok := err.(*exceptions.KitchenOrderVersionMismatchException); !ok {
+			t.Errorf("Expected KitchenOrderVersionMismatchException, got %T", err)
+		}
+	})
+
+	t.Run("order changed while the command was resolved", func(t *testing.T) {
+		dataStore := NewMockDataStore()
+		seedKitchenOrderWithStatus(t, dataStore, orderID, constants.KITCHEN_ORDER_STATUS_RECEIVED_ID)
+		dataStore.concurrentUpdate = true
+		useCase := newExecuteKitchenOrderCommandUseCase(dataStore)
+
+		_, err := useCase.Execute(context.Background(), dtos.KitchenOrderCommandDTO{ID: orderID, Command: constants.KITCHEN_ORDER_COMMAND_START})
+
+		if _, ok := err.(*exceptions.KitchenOrderUpdateConflictException); !ok {
+			t.Errorf("Expected KitchenOrderUpdateConflictException, got %T", err)
+		}
+	})
+}
@@ -0,0 +1,48 @@
+package use_cases
+
+import (
+	"context"
+
+	"tech_challenge/internal/application/dtos"
+	"tech_challenge/internal/application/gateways"
+	"tech_challenge/internal/domain/entities"
+	"tech_challenge/internal/domain/exceptions"
+	"tech_challenge/internal/shared/config/constants"
+)
+
+type ExportKitchenOrdersUseCase struct {
+	gateway gateways.KitchenOrderGateway
+}
+
+func NewExportKitchenOrdersUseCase(gateway gateways.KitchenOrderGateway) *ExportKitchenOrdersUseCase {
+	return &ExportKitchenOrdersUseCase{
+		gateway: gateway,
+	}
+}
+
+// Execute entrega cada pedido do período ao handle, lendo o banco em lotes para não carregar a exportação inteira em memória
+func (uc *ExportKitchenOrdersUseCase) Execute(ctx context.Context, filter dtos.KitchenOrderFilter, handle func(entities.KitchenOrder) error) error {
+	if filter.CreatedAtFrom == nil || filter.CreatedAtTo == nil {
+		return &exceptions.InvalidKitchenOrderFilterException{
+			Message: "from and to are required to export kitchen orders",
+		}
+	}
+
+	// A exportação percorre todo o período; paginação não se aplica
+	filter.Limit = 0
+	filter.Cursor = ""
+
+	if err := validateKitchenOrderFilter(filter); err != nil {
+		return err
+	}
+
+	return uc.gateway.FindAllInBatches(ctx, filter, constants.KITCHEN_ORDER_EXPORT_BATCH_SIZE, func(kitchenOrders []entities.KitchenOrder) error {
+		for _, kitchenOrder := range kitchenOrders {
+			if err := handle(kitchenOrder); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,90 @@
+package use_cases
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"tech_challenge/internal/application/dtos"
+	"tech_challenge/internal/domain/entities"
+	"tech_challenge/internal/domain/exceptions"
+)
+
+func TestExportKitchenOrdersUseCase_StreamsOrdersOfThePeriod(t *testing.T) {
+	dataStore := NewMockDataStore()
+	status := dataStore.orderStatuses[0]
+
+	now := time.Now()
+	inside, _ := entities.NewKitchenOrder("id1", "order1", "001", status, now, nil)
+	outside, _ := entities.NewKitchenOrder("id2", "order2", "002", status, now.Add(-48*time.Hour), nil)
+	dataStore.kitchenOrders = []entities.KitchenOrder{*inside, *outside}
+
+	useCase := NewExportKitchenOrdersUseCase(NewMockKitchenOrderGateway(dataStore))
+
+	from := now.Add(-time.Hour)
+	to := now.Add(time.Hour)
+	var exported []string
+	err := useCase.Execute(context.Background(), dtos.KitchenOrderFilter{CreatedAtFrom: &from, CreatedAtTo: &to, Limit: 1, Cursor: "ignored"}, func(kitchenOrder entities.KitchenOrder) error {
+		exported = append(exported, kitchenOrder.ID)
+		return nil
+	})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(exported) != 1 || exported[0] != "id1" {
+		t.Errorf("Expected only id1 to be exported, got %v", exported)
+	} else {
+		t.Log("✓ Apenas os pedidos do período são exportados, sem paginação")
+	}
+}
+
+func TestExportKitchenOrdersUseCase_InvalidFilter(t *testing.T) {
+	useCase := NewExportKitchenOrdersUseCase(NewMockKitchenOrderGateway(NewMockDataStore()))
+
+	now := time.Now()
+	earlier := now.Add(-time.Hour)
+
+	filters := map[string]dtos.KitchenOrderFilter{
+		"missing from":   {CreatedAtTo: &now},
+		"missing to":     {CreatedAtFrom: &now},
+		"inverted range": {CreatedAtFrom: &now, CreatedAtTo: &earlier},
+		"invalid sort":   {CreatedAtFrom: &earlier, CreatedAtTo: &now, Sort: "amount"},
+	}
+
+	for name, filter := range filters {
+		err := useCase.Execute(context.Background(), filter, func(entities.KitchenOrder) error { return nil })
+
+		var filterErr *exceptions.InvalidKitchenOrderFilterException
+		if !errors.As(err, &filterErr) {
+			t.Errorf("%s: expected InvalidKitchenOrderFilterException, got %v", name, err)
+		}
+	}
+}
+
+func TestExportKitchenOrdersUseCase_StopsOnHandleError(t *testing.T) {
+	dataStore := NewMockDataStore()
+	status := dataStore.orderStatuses[0]
+
+	now := time.Now()
+	first, _ := entities.NewKitchenOrder("id1", "order1", "001", status, now, nil)
+	second, _ := entities.NewKitchenOrder("id2", "order2", "002", status, now, nil)
+	dataStore.kitchenOrders = []entities.KitchenOrder{*first, *second}
+
+	useCase := NewExportKitchenOrdersUseCase(NewMockKitchenOrderGateway(dataStore))
+
+	from := now.Add(-time.Hour)
+	to := now.Add(time.Hour)
+	writeErr := errors.New("client disconnected")
+	calls := 0
+	err := useCase.Execute(context.Background(), dtos.KitchenOrderFilter{CreatedAtFrom: &from, CreatedAtTo: &to}, func(entities.KitchenOrder) error {
+		calls++
+		return writeErr
+	})
+
+	if !errors.Is(err, writeErr) || calls != 1 {
+		t.Errorf("Expected the export to stop on the first write error, got %v after %d calls", err, calls)
+	}
+}
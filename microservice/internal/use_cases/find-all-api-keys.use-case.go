@@ -0,0 +1,26 @@
+package use_cases
+
+import (
+	"tech_challenge/internal/application/gateways"
+	"tech_challenge/internal/domain/entities"
+)
+
+type FindAllAPIKeysUseCase struct {
+	gateway gateways.APIKeyGateway
+}
+
+func NewFindAllAPIKeysUseCase(gateway gateways.APIKeyGateway) *FindAllAPIKeysUseCase {
+	return &FindAllAPIKeysUseCase{
+		gateway: gateway,
+	}
+}
+
+func (uc *FindAllAPIKeysUseCase) Execute() ([]entities.APIKey, error) {
+	apiKeys, err := uc.gateway.FindAll()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return apiKeys, nil
+}
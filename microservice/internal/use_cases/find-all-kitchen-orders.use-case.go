@@ -1,11 +1,26 @@
 package use_cases
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
 	"tech_challenge/internal/application/dtos"
 	"tech_challenge/internal/application/gateways"
 	"tech_challenge/internal/domain/entities"
+	"tech_challenge/internal/domain/exceptions"
+	"tech_challenge/internal/shared/config/constants"
+	"tech_challenge/internal/shared/pkg/cursor"
+	identity_manager "tech_challenge/internal/shared/pkg/identity"
 )
 
+var kitchenOrderSortOptions = []string{
+	constants.KITCHEN_ORDER_SORT_BOARD,
+	constants.KITCHEN_ORDER_SORT_CREATED_AT,
+	constants.KITCHEN_ORDER_SORT_CREATED_AT_DESC,
+}
+
 type FindAllKitchenOrdersUseCase struct {
 	gateway gateways.KitchenOrderGateway
 }
@@ -16,12 +31,50 @@ func NewFindAllKitchenOrderUseCase(gateway gateways.KitchenOrderGateway) *FindAl
 	}
 }
 
-func (uc *FindAllKitchenOrdersUseCase) Execute(filter dtos.KitchenOrderFilter) ([]entities.KitchenOrder, error) {
-	kitchenOrders, err := uc.gateway.FindAll(filter)
+func (uc *FindAllKitchenOrdersUseCase) Execute(ctx context.Context, filter dtos.KitchenOrderFilter) ([]entities.KitchenOrder, dtos.PageInfo, error) {
+	if err := validateKitchenOrderFilter(filter); err != nil {
+		return nil, dtos.PageInfo{}, err
+	}
+
+	kitchenOrders, pageInfo, err := uc.gateway.FindAll(ctx, filter)
 
 	if err != nil {
-		return nil, err
+		if errors.Is(err, cursor.ErrInvalidCursor) {
+			return nil, dtos.PageInfo{}, &exceptions.InvalidKitchenOrderFilterException{Message: "Invalid cursor"}
+		}
+		return nil, dtos.PageInfo{}, err
+	}
+
+	return kitchenOrders, pageInfo, nil
+}
+
+// validateKitchenOrderFilter é compartilhada pela listagem e pela exportação, que usam o mesmo modelo de filtro
+func validateKitchenOrderFilter(filter dtos.KitchenOrderFilter) error {
+	if filter.Limit < 0 || filter.Limit > constants.KITCHEN_ORDER_MAX_PAGE_LIMIT {
+		return &exceptions.InvalidKitchenOrderFilterException{
+			Message: fmt.Sprintf("Limit must be between 1 and %d", constants.KITCHEN_ORDER_MAX_PAGE_LIMIT),
+		}
+	}
+
+	if filter.Sort != "" && !slices.Contains(kitchenOrderSortOptions, filter.Sort) {
+		return &exceptions.InvalidKitchenOrderFilterException{
+			Message: fmt.Sprintf("Invalid sort '%s'", filter.Sort),
+		}
+	}
+
+	for _, statusID := range filter.StatusIDs {
+		if identity_manager.IsNotValidUUID(statusID) {
+			return &exceptions.InvalidKitchenOrderFilterException{
+				Message: fmt.Sprintf("Invalid status_id '%s'", statusID),
+			}
+		}
+	}
+
+	if filter.CreatedAtFrom != nil && filter.CreatedAtTo != nil && filter.CreatedAtFrom.After(*filter.CreatedAtTo) {
+		return &exceptions.InvalidKitchenOrderFilterException{
+			Message: "created_at_from must be before created_at_to",
+		}
 	}
 
-	return kitchenOrders, nil
+	return nil
 }
@@ -1,12 +1,17 @@
 package use_cases
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
 	"tech_challenge/internal/application/dtos"
 	"tech_challenge/internal/domain/entities"
+	"tech_challenge/internal/domain/exceptions"
+	"tech_challenge/internal/shared/config/constants"
+	"tech_challenge/internal/shared/pkg/cursor"
 )
 
 func TestFindAllKitchenOrdersUseCase_EmptyResult(t *testing.T) {
@@ -19,7 +24,7 @@ func TestFindAllKitchenOrdersUseCase_EmptyResult(t *testing.T) {
 	filter := dtos.KitchenOrderFilter{}
 
 	// Act
-	result, err := useCase.Execute(filter)
+	result, _, err := useCase.Execute(context.Background(), filter)
 
 	// Assert
 	if err != nil {
@@ -52,16 +57,14 @@ func TestFindAllKitchenOrdersUseCase_WithFilters(t *testing.T) {
 	// Filtro por data
 	fromTime := now.Add(-30 * time.Minute)
 	toTime := now.Add(30 * time.Minute)
-	statusID := uint(1)
-
 	filter := dtos.KitchenOrderFilter{
 		CreatedAtFrom: &fromTime,
 		CreatedAtTo:   &toTime,
-		StatusID:      &statusID,
+		StatusIDs:     []string{constants.KITCHEN_ORDER_STATUS_RECEIVED_ID},
 	}
 
 	// Act
-	result, err := useCase.Execute(filter)
+	result, _, err := useCase.Execute(context.Background(), filter)
 
 	// Assert
 	if err != nil {
@@ -89,7 +92,7 @@ func TestFindAllKitchenOrdersUseCase_GatewayError(t *testing.T) {
 	filter := dtos.KitchenOrderFilter{}
 
 	// Act
-	result, err := useCase.Execute(filter)
+	result, _, err := useCase.Execute(context.Background(), filter)
 
 	// Assert
 	if err == nil {
@@ -119,7 +122,7 @@ func TestFindAllKitchenOrdersUseCase_NilFilter(t *testing.T) {
 	useCase := NewFindAllKitchenOrderUseCase(kitchenOrderGateway)
 
 	// Act - passando filtro vazio
-	result, err := useCase.Execute(dtos.KitchenOrderFilter{})
+	result, _, err := useCase.Execute(context.Background(), dtos.KitchenOrderFilter{})
 
 	// Assert
 	if err != nil {
@@ -151,7 +154,7 @@ func TestFindAllKitchenOrdersUseCase_MultipleStatuses(t *testing.T) {
 	filter := dtos.KitchenOrderFilter{}
 
 	// Act
-	result, err := useCase.Execute(filter)
+	result, _, err := useCase.Execute(context.Background(), filter)
 
 	// Assert
 	if err != nil {
@@ -195,7 +198,7 @@ func TestFindAllKitchenOrdersUseCase_LargeDataset(t *testing.T) {
 	filter := dtos.KitchenOrderFilter{}
 
 	// Act
-	result, err := useCase.Execute(filter)
+	result, _, err := useCase.Execute(context.Background(), filter)
 
 	// Assert
 	if err != nil {
@@ -225,13 +228,12 @@ func TestFindAllKitchenOrdersUseCase_FilterByStatusOnly(t *testing.T) {
 	kitchenOrderGateway := NewMockKitchenOrderGateway(dataStore)
 	useCase := NewFindAllKitchenOrderUseCase(kitchenOrderGateway)
 
-	statusID := uint(1)
 	filter := dtos.KitchenOrderFilter{
-		StatusID: &statusID,
+		StatusIDs: []string{receivedStatus.ID},
 	}
 
 	// Act
-	result, err := useCase.Execute(filter)
+	result, _, err := useCase.Execute(context.Background(), filter)
 
 	// Assert
 	if err != nil {
@@ -268,7 +270,7 @@ func TestFindAllKitchenOrdersUseCase_FilterByDateOnly(t *testing.T) {
 	}
 
 	// Act
-	result, err := useCase.Execute(filter)
+	result, _, err := useCase.Execute(context.Background(), filter)
 
 	// Assert
 	if err != nil {
@@ -283,4 +285,122 @@ func TestFindAllKitchenOrdersUseCase_FilterByDateOnly(t *testing.T) {
 	if len(result) > 0 && result[0].ID != "id1" {
 		t.Errorf("Expected order ID 'id1', got %s", result[0].ID)
 	}
-}
\ No newline at end of file
+}
+func TestFindAllKitchenOrdersUseCase_Pagination(t *testing.T) {
+	// Arrange
+	dataStore := NewMockDataStore()
+	status := dataStore.orderStatuses[0]
+	now := time.Now()
+
+	for i := 1; i <= 3; i++ {
+		order, _ := entities.NewKitchenOrder(
+			fmt.Sprintf("id%d", i), fmt.Sprintf("order%d", i), fmt.Sprintf("%03d", i), status, now, nil,
+		)
+		dataStore.kitchenOrders = append(dataStore.kitchenOrders, *order)
+	}
+
+	useCase := NewFindAllKitchenOrderUseCase(NewMockKitchenOrderGateway(dataStore))
+
+	// Act
+	result, pageInfo, err := useCase.Execute(context.Background(), dtos.KitchenOrderFilter{Limit: 2})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(result) != 2 {
+		t.Errorf("Expected 2 orders, got %d", len(result))
+	}
+
+	if pageInfo.Total != 3 || pageInfo.NextCursor == nil {
+		t.Errorf("Expected total 3 with next cursor, got %+v", pageInfo)
+	}
+}
+
+func TestFindAllKitchenOrdersUseCase_InvalidFilter(t *testing.T) {
+	// Arrange
+	useCase := NewFindAllKitchenOrderUseCase(NewMockKitchenOrderGateway(NewMockDataStore()))
+
+	invalidFilters := []dtos.KitchenOrderFilter{
+		{Limit: -1},
+		{Limit: constants.KITCHEN_ORDER_MAX_PAGE_LIMIT + 1},
+		{Sort: "name"},
+	}
+
+	for _, filter := range invalidFilters {
+		// Act
+		_, _, err := useCase.Execute(context.Background(), filter)
+
+		// Assert
+		if _, ok := err.(*exceptions.InvalidKitchenOrderFilterException); !ok {
+			t.Errorf("Expected InvalidKitchenOrderFilterException for %+v, got %T", filter, err)
+		}
+	}
+}
+
+func TestFindAllKitchenOrdersUseCase_InvalidCursor(t *testing.T) {
+	// Arrange
+	dataStore := NewMockDataStore()
+	dataStore.shouldReturnError = true
+	dataStore.errorToReturn = cursor.ErrInvalidCursor
+
+	useCase := NewFindAllKitchenOrderUseCase(NewMockKitchenOrderGateway(dataStore))
+
+	// Act
+	_, _, err := useCase.Execute(context.Background(), dtos.KitchenOrderFilter{Cursor: "garbage"})
+
+	// Assert
+	if _, ok := err.(*exceptions.InvalidKitchenOrderFilterException); !ok {
+		t.Errorf("Expected InvalidKitchenOrderFilterException, got %T", err)
+	}
+}
+
+func TestFindAllKitchenOrdersUseCase_FilterByOrderAndSlug(t *testing.T) {
+	// Arrange
+	dataStore := NewMockDataStore()
+	status := dataStore.orderStatuses[0]
+
+	order1, _ := entities.NewKitchenOrder("id1", "order1", "001", status, time.Now(), nil)
+	order2, _ := entities.NewKitchenOrder("id2", "order2", "002", status, time.Now(), nil)
+	dataStore.kitchenOrders = []entities.KitchenOrder{*order1, *order2}
+
+	useCase := NewFindAllKitchenOrderUseCase(NewMockKitchenOrderGateway(dataStore))
+
+	orderID := "order2"
+	slug := "002"
+
+	// Act
+	result, _, err := useCase.Execute(context.Background(), dtos.KitchenOrderFilter{OrderID: &orderID, Slug: &slug})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(result) != 1 || result[0].ID != "id2" {
+		t.Errorf("Expected only id2, got %d orders", len(result))
+	}
+}
+
+func TestFindAllKitchenOrdersUseCase_InvalidStatusAndDateRange(t *testing.T) {
+	// Arrange
+	useCase := NewFindAllKitchenOrderUseCase(NewMockKitchenOrderGateway(NewMockDataStore()))
+	now := time.Now()
+	earlier := now.Add(-time.Hour)
+
+	invalidFilters := []dtos.KitchenOrderFilter{
+		{StatusIDs: []string{constants.KITCHEN_ORDER_STATUS_READY_ID, "1"}},
+		{CreatedAtFrom: &now, CreatedAtTo: &earlier},
+	}
+
+	for _, filter := range invalidFilters {
+		// Act
+		_, _, err := useCase.Execute(context.Background(), filter)
+
+		// Assert
+		if _, ok := err.(*exceptions.InvalidKitchenOrderFilterException); !ok {
+			t.Errorf("Expected InvalidKitchenOrderFilterException for %+v, got %T", filter, err)
+		}
+	}
+}
@@ -1,6 +1,8 @@
 package use_cases
 
 import (
+	"context"
+
 	"tech_challenge/internal/application/gateways"
 	"tech_challenge/internal/domain/entities"
 )
@@ -15,8 +17,8 @@ func NewFindAllOrdersStatusUseCase(gateway gateways.OrderStatusGateway) *FindAll
 	}
 }
 
-func (uc *FindAllOrderStatusUseCase) Execute() ([]entities.OrderStatus, error) {
-	statusList, err := uc.gateway.FindAll()
+func (uc *FindAllOrderStatusUseCase) Execute(ctx context.Context) ([]entities.OrderStatus, error) {
+	statusList, err := uc.gateway.FindAll(ctx)
 
 	if err != nil {
 		return nil, err
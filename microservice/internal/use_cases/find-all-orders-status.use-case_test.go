@@ -1,6 +1,7 @@
 package use_cases
 
 import (
+	"context"
 	"testing"
 
 	"tech_challenge/internal/domain/entities"
@@ -13,7 +14,7 @@ func TestFindAllOrdersStatusUseCase_Success(t *testing.T) {
 
 	useCase := NewFindAllOrdersStatusUseCase(orderStatusGateway)
 
-	result, err := useCase.Execute()
+	result, err := useCase.Execute(context.Background())
 
 	if err != nil {
 		t.Errorf("Expected no error, got %v", err)
@@ -37,7 +38,7 @@ func TestFindAllOrdersStatusUseCase_Empty(t *testing.T) {
 
 	useCase := NewFindAllOrdersStatusUseCase(orderStatusGateway)
 
-	result, err := useCase.Execute()
+	result, err := useCase.Execute(context.Background())
 
 	if err != nil {
 		t.Errorf("Expected no error, got %v", err)
@@ -0,0 +1,26 @@
+package use_cases
+
+import (
+	"tech_challenge/internal/application/gateways"
+	"tech_challenge/internal/domain/entities"
+)
+
+type FindAllPrintersUseCase struct {
+	gateway gateways.PrinterGateway
+}
+
+func NewFindAllPrintersUseCase(gateway gateways.PrinterGateway) *FindAllPrintersUseCase {
+	return &FindAllPrintersUseCase{
+		gateway: gateway,
+	}
+}
+
+func (uc *FindAllPrintersUseCase) Execute() ([]entities.Printer, error) {
+	printers, err := uc.gateway.FindAll()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return printers, nil
+}
@@ -0,0 +1,26 @@
+package use_cases
+
+import (
+	"tech_challenge/internal/application/gateways"
+	"tech_challenge/internal/domain/entities"
+)
+
+type FindAllWebhookSubscriptionsUseCase struct {
+	gateway gateways.WebhookSubscriptionGateway
+}
+
+func NewFindAllWebhookSubscriptionsUseCase(gateway gateways.WebhookSubscriptionGateway) *FindAllWebhookSubscriptionsUseCase {
+	return &FindAllWebhookSubscriptionsUseCase{
+		gateway: gateway,
+	}
+}
+
+func (uc *FindAllWebhookSubscriptionsUseCase) Execute() ([]entities.WebhookSubscription, error) {
+	subscriptions, err := uc.gateway.FindAll()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return subscriptions, nil
+}
@@ -0,0 +1,31 @@
+package use_cases
+
+import (
+	"tech_challenge/internal/application/gateways"
+	"tech_challenge/internal/domain/entities"
+	"tech_challenge/internal/domain/exceptions"
+)
+
+type FindAPIKeyByIDUseCase struct {
+	gateway gateways.APIKeyGateway
+}
+
+func NewFindAPIKeyByIDUseCase(gateway gateways.APIKeyGateway) *FindAPIKeyByIDUseCase {
+	return &FindAPIKeyByIDUseCase{
+		gateway: gateway,
+	}
+}
+
+func (uc *FindAPIKeyByIDUseCase) Execute(id string) (entities.APIKey, error) {
+	if err := entities.ValidateAPIKeyID(id); err != nil {
+		return entities.APIKey{}, err
+	}
+
+	apiKey, err := uc.gateway.FindByID(id)
+
+	if err != nil {
+		return entities.APIKey{}, &exceptions.APIKeyNotFoundException{}
+	}
+
+	return apiKey, nil
+}
@@ -1,6 +1,8 @@
 package use_cases
 
 import (
+	"context"
+
 	"tech_challenge/internal/application/gateways"
 	"tech_challenge/internal/domain/entities"
 	"tech_challenge/internal/domain/exceptions"
@@ -16,14 +18,18 @@ func NewFindKitchenOrderByIDUseCase(gateway gateways.KitchenOrderGateway) *FindK
 	}
 }
 
-func (uc *FindKitchenOrderByIDUseCase) Execute(id string) (entities.KitchenOrder, error) {
+func (uc *FindKitchenOrderByIDUseCase) Execute(ctx context.Context, id string) (entities.KitchenOrder, error) {
 	err := entities.ValidateID(id)
 
 	if err != nil {
 		return entities.KitchenOrder{}, err
 	}
 
-	kitchenOrder, err := uc.gateway.FindByID(id)
+	kitchenOrder, err := uc.gateway.FindByID(ctx, id)
+
+	if isQueryCanceled(err) {
+		return entities.KitchenOrder{}, err
+	}
 
 	if err != nil || kitchenOrder.IsEmpty() {
 		return entities.KitchenOrder{}, &exceptions.KitchenOrderNotFoundException{}
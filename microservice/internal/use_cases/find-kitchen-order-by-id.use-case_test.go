@@ -1,6 +1,7 @@
 package use_cases
 
 import (
+	"context"
 	"errors"
 	"testing"
 	"time"
@@ -24,7 +25,7 @@ func TestFindKitchenOrderByIDUseCase_InvalidID(t *testing.T) {
 
 	for _, invalidID := range invalidIDs {
 		// Act
-		result, err := useCase.Execute(invalidID)
+		result, err := useCase.Execute(context.Background(), invalidID)
 
 		// Assert
 		if err == nil {
@@ -48,7 +49,7 @@ func TestFindKitchenOrderByIDUseCase_OrderNotFound(t *testing.T) {
 	validID := "550e8400-e29b-41d4-a716-446655440000"
 
 	// Act
-	result, err := useCase.Execute(validID)
+	result, err := useCase.Execute(context.Background(), validID)
 
 	// Assert
 	if err == nil {
@@ -76,7 +77,7 @@ func TestFindKitchenOrderByIDUseCase_GatewayError(t *testing.T) {
 	validID := "550e8400-e29b-41d4-a716-446655440000"
 
 	// Act
-	result, err := useCase.Execute(validID)
+	result, err := useCase.Execute(context.Background(), validID)
 
 	// Assert
 	if err == nil {
@@ -92,6 +93,26 @@ func TestFindKitchenOrderByIDUseCase_GatewayError(t *testing.T) {
 	}
 }
 
+func TestFindKitchenOrderByIDUseCase_QueryTimeout(t *testing.T) {
+	// Arrange
+	dataStore := NewMockDataStore()
+	dataStore.shouldReturnError = true
+	dataStore.errorToReturn = context.DeadlineExceeded
+
+	kitchenOrderGateway := NewMockKitchenOrderGateway(dataStore)
+	useCase := NewFindKitchenOrderByIDUseCase(kitchenOrderGateway)
+
+	// Act
+	_, err := useCase.Execute(context.Background(), "550e8400-e29b-41d4-a716-446655440000")
+
+	// Assert
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected context.DeadlineExceeded, got %v", err)
+	} else {
+		t.Log("✓ Timeout da consulta não é tratado como pedido inexistente")
+	}
+}
+
 func TestFindKitchenOrderByIDUseCase_EmptyOrder(t *testing.T) {
 	// Arrange
 	dataStore := NewMockDataStore()
@@ -106,7 +127,7 @@ func TestFindKitchenOrderByIDUseCase_EmptyOrder(t *testing.T) {
 	validID := "550e8400-e29b-41d4-a716-446655440000"
 
 	// Act
-	result, err := useCase.Execute(validID)
+	result, err := useCase.Execute(context.Background(), validID)
 
 	// Assert
 	if err == nil {
@@ -146,7 +167,7 @@ func TestFindKitchenOrderByIDUseCase_SuccessWithItems(t *testing.T) {
 	useCase := NewFindKitchenOrderByIDUseCase(kitchenOrderGateway)
 
 	// Act
-	result, err := useCase.Execute(orderID)
+	result, err := useCase.Execute(context.Background(), orderID)
 
 	// Assert
 	if err != nil {
@@ -190,7 +211,7 @@ func TestFindKitchenOrderByIDUseCase_ValidUUIDs(t *testing.T) {
 
 	for _, uuid := range validUUIDs {
 		// Act
-		result, err := useCase.Execute(uuid)
+		result, err := useCase.Execute(context.Background(), uuid)
 
 		// Assert
 		if err != nil {
@@ -229,7 +250,7 @@ func TestFindKitchenOrderByIDUseCase_DifferentStatuses(t *testing.T) {
 
 	for _, tc := range testCases {
 		// Act
-		result, err := useCase.Execute(tc.orderID)
+		result, err := useCase.Execute(context.Background(), tc.orderID)
 
 		// Assert
 		if err != nil {
@@ -265,7 +286,7 @@ func TestFindKitchenOrderByIDUseCase_WithUpdatedAt(t *testing.T) {
 	useCase := NewFindKitchenOrderByIDUseCase(kitchenOrderGateway)
 
 	// Act
-	result, err := useCase.Execute(orderID)
+	result, err := useCase.Execute(context.Background(), orderID)
 
 	// Assert
 	if err != nil {
@@ -309,7 +330,7 @@ func TestFindKitchenOrderByIDUseCase_EdgeCaseUUIDs(t *testing.T) {
 
 	for _, uuid := range edgeCaseUUIDs {
 		// Act
-		result, err := useCase.Execute(uuid)
+		result, err := useCase.Execute(context.Background(), uuid)
 
 		// Assert
 		if err != nil {
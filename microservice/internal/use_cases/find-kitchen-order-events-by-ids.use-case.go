@@ -0,0 +1,29 @@
+package use_cases
+
+import (
+	"tech_challenge/internal/application/gateways"
+	"tech_challenge/internal/domain/entities"
+)
+
+type FindKitchenOrderEventsByIDsUseCase struct {
+	gateway gateways.KitchenOrderEventGateway
+}
+
+func NewFindKitchenOrderEventsByIDsUseCase(gateway gateways.KitchenOrderEventGateway) *FindKitchenOrderEventsByIDsUseCase {
+	return &FindKitchenOrderEventsByIDsUseCase{
+		gateway: gateway,
+	}
+}
+
+// Execute busca eventos específicos do feed; usado para recuperar IDs que ainda não estavam visíveis na leitura anterior
+func (uc *FindKitchenOrderEventsByIDsUseCase) Execute(ids []uint64) ([]entities.KitchenOrderEvent, error) {
+	if len(ids) == 0 {
+		return []entities.KitchenOrderEvent{}, nil
+	}
+
+	if len(ids) > maxKitchenOrderEventsPerRead {
+		ids = ids[:maxKitchenOrderEventsPerRead]
+	}
+
+	return uc.gateway.FindByIDs(ids)
+}
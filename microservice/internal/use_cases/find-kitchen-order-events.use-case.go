@@ -0,0 +1,26 @@
+package use_cases
+
+import (
+	"tech_challenge/internal/application/gateways"
+	"tech_challenge/internal/domain/entities"
+)
+
+const maxKitchenOrderEventsPerRead = 500
+
+type FindKitchenOrderEventsUseCase struct {
+	gateway gateways.KitchenOrderEventGateway
+}
+
+func NewFindKitchenOrderEventsUseCase(gateway gateways.KitchenOrderEventGateway) *FindKitchenOrderEventsUseCase {
+	return &FindKitchenOrderEventsUseCase{
+		gateway: gateway,
+	}
+}
+
+func (uc *FindKitchenOrderEventsUseCase) Execute(afterID uint64, limit int) ([]entities.KitchenOrderEvent, error) {
+	if limit <= 0 || limit > maxKitchenOrderEventsPerRead {
+		limit = maxKitchenOrderEventsPerRead
+	}
+
+	return uc.gateway.FindAfter(afterID, limit)
+}
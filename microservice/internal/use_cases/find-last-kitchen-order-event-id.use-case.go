@@ -0,0 +1,19 @@
+package use_cases
+
+import (
+	"tech_challenge/internal/application/gateways"
+)
+
+type FindLastKitchenOrderEventIDUseCase struct {
+	gateway gateways.KitchenOrderEventGateway
+}
+
+func NewFindLastKitchenOrderEventIDUseCase(gateway gateways.KitchenOrderEventGateway) *FindLastKitchenOrderEventIDUseCase {
+	return &FindLastKitchenOrderEventIDUseCase{
+		gateway: gateway,
+	}
+}
+
+func (uc *FindLastKitchenOrderEventIDUseCase) Execute() (uint64, error) {
+	return uc.gateway.FindLastID()
+}
@@ -0,0 +1,44 @@
+package use_cases
+
+import (
+	"tech_challenge/internal/application/dtos"
+	"tech_challenge/internal/application/gateways"
+	"tech_challenge/internal/domain/entities"
+	"tech_challenge/internal/domain/exceptions"
+	"tech_challenge/internal/shared/config/constants"
+	identity_manager "tech_challenge/internal/shared/pkg/identity"
+)
+
+type FindPrintJobsUseCase struct {
+	gateway gateways.PrintJobGateway
+}
+
+func NewFindPrintJobsUseCase(gateway gateways.PrintJobGateway) *FindPrintJobsUseCase {
+	return &FindPrintJobsUseCase{
+		gateway: gateway,
+	}
+}
+
+func (uc *FindPrintJobsUseCase) Execute(filter dtos.PrintJobFilter) ([]entities.PrintJob, error) {
+	if filter.KitchenOrderID != "" && !identity_manager.IsValidUUID(filter.KitchenOrderID) {
+		return nil, &exceptions.InvalidPrintJobFilterException{Message: "Invalid Kitchen Order ID"}
+	}
+
+	if filter.PrinterID != "" && !identity_manager.IsValidUUID(filter.PrinterID) {
+		return nil, &exceptions.InvalidPrintJobFilterException{Message: "Invalid Printer ID"}
+	}
+
+	if filter.Status != "" && !entities.IsValidPrintJobStatus(filter.Status) {
+		return nil, &exceptions.InvalidPrintJobFilterException{Message: "Unknown print job status: " + filter.Status}
+	}
+
+	if filter.Limit == 0 {
+		filter.Limit = constants.PRINT_JOB_DEFAULT_LIMIT
+	}
+
+	if filter.Limit < 0 || filter.Limit > constants.PRINT_JOB_MAX_LIMIT {
+		return nil, &exceptions.InvalidPrintJobFilterException{Message: "Limit must be between 1 and 200"}
+	}
+
+	return uc.gateway.FindAll(filter)
+}
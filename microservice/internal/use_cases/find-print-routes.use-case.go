@@ -0,0 +1,28 @@
+package use_cases
+
+import (
+	"context"
+
+	"tech_challenge/internal/application/gateways"
+	"tech_challenge/internal/domain/entities"
+)
+
+type FindPrintRoutesUseCase struct {
+	gateway gateways.PrintRouteGateway
+}
+
+func NewFindPrintRoutesUseCase(gateway gateways.PrintRouteGateway) *FindPrintRoutesUseCase {
+	return &FindPrintRoutesUseCase{
+		gateway: gateway,
+	}
+}
+
+func (uc *FindPrintRoutesUseCase) Execute(ctx context.Context) ([]entities.PrintRoute, error) {
+	routes, err := uc.gateway.FindAll(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return routes, nil
+}
@@ -0,0 +1,31 @@
+package use_cases
+
+import (
+	"tech_challenge/internal/application/gateways"
+	"tech_challenge/internal/domain/entities"
+	"tech_challenge/internal/domain/exceptions"
+)
+
+type FindPrinterByIDUseCase struct {
+	gateway gateways.PrinterGateway
+}
+
+func NewFindPrinterByIDUseCase(gateway gateways.PrinterGateway) *FindPrinterByIDUseCase {
+	return &FindPrinterByIDUseCase{
+		gateway: gateway,
+	}
+}
+
+func (uc *FindPrinterByIDUseCase) Execute(id string) (entities.Printer, error) {
+	if err := entities.ValidatePrinterID(id); err != nil {
+		return entities.Printer{}, err
+	}
+
+	printer, err := uc.gateway.FindByID(id)
+
+	if err != nil || printer.IsEmpty() {
+		return entities.Printer{}, &exceptions.PrinterNotFoundException{}
+	}
+
+	return printer, nil
+}
@@ -0,0 +1,33 @@
+package use_cases
+
+import (
+	"tech_challenge/internal/application/gateways"
+	"tech_challenge/internal/domain/entities"
+	"tech_challenge/internal/domain/exceptions"
+)
+
+const webhookDeliveriesLimit = 100
+
+type FindWebhookDeliveriesUseCase struct {
+	subscriptionGateway gateways.WebhookSubscriptionGateway
+	deliveryGateway     gateways.WebhookDeliveryGateway
+}
+
+func NewFindWebhookDeliveriesUseCase(subscriptionGateway gateways.WebhookSubscriptionGateway, deliveryGateway gateways.WebhookDeliveryGateway) *FindWebhookDeliveriesUseCase {
+	return &FindWebhookDeliveriesUseCase{
+		subscriptionGateway: subscriptionGateway,
+		deliveryGateway:     deliveryGateway,
+	}
+}
+
+func (uc *FindWebhookDeliveriesUseCase) Execute(subscriptionID string) ([]entities.WebhookDelivery, error) {
+	if err := entities.ValidateWebhookID(subscriptionID); err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.subscriptionGateway.FindByID(subscriptionID); err != nil {
+		return nil, &exceptions.WebhookSubscriptionNotFoundException{}
+	}
+
+	return uc.deliveryGateway.FindBySubscriptionID(subscriptionID, webhookDeliveriesLimit)
+}
@@ -0,0 +1,31 @@
+package use_cases
+
+import (
+	"tech_challenge/internal/application/gateways"
+	"tech_challenge/internal/domain/entities"
+	"tech_challenge/internal/domain/exceptions"
+)
+
+type FindWebhookSubscriptionByIDUseCase struct {
+	gateway gateways.WebhookSubscriptionGateway
+}
+
+func NewFindWebhookSubscriptionByIDUseCase(gateway gateways.WebhookSubscriptionGateway) *FindWebhookSubscriptionByIDUseCase {
+	return &FindWebhookSubscriptionByIDUseCase{
+		gateway: gateway,
+	}
+}
+
+func (uc *FindWebhookSubscriptionByIDUseCase) Execute(id string) (entities.WebhookSubscription, error) {
+	if err := entities.ValidateWebhookID(id); err != nil {
+		return entities.WebhookSubscription{}, err
+	}
+
+	subscription, err := uc.gateway.FindByID(id)
+
+	if err != nil || subscription.IsEmpty() {
+		return entities.WebhookSubscription{}, &exceptions.WebhookSubscriptionNotFoundException{}
+	}
+
+	return subscription, nil
+}
@@ -0,0 +1,55 @@
+package use_cases
+
+import (
+	"context"
+	"time"
+
+	"tech_challenge/internal/application/dtos"
+	"tech_challenge/internal/application/gateways"
+	"tech_challenge/internal/domain/entities"
+	"tech_challenge/internal/shared/config/constants"
+)
+
+var displayBoardStatusIDs = []string{
+	constants.KITCHEN_ORDER_STATUS_RECEIVED_ID,
+	constants.KITCHEN_ORDER_STATUS_PREPARING_ID,
+	constants.KITCHEN_ORDER_STATUS_READY_ID,
+}
+
+type GetDisplayBoardUseCase struct {
+	gateway  gateways.KitchenOrderGateway
+	readyTTL time.Duration
+}
+
+func NewGetDisplayBoardUseCase(gateway gateways.KitchenOrderGateway, readyTTL time.Duration) *GetDisplayBoardUseCase {
+	return &GetDisplayBoardUseCase{
+		gateway:  gateway,
+		readyTTL: readyTTL,
+	}
+}
+
+func (uc *GetDisplayBoardUseCase) Execute(ctx context.Context) (entities.DisplayBoard, error) {
+	filter := dtos.KitchenOrderFilter{
+		Sort:      constants.KITCHEN_ORDER_SORT_BOARD,
+		StatusIDs: displayBoardStatusIDs,
+		Limit:     constants.KITCHEN_ORDER_MAX_PAGE_LIMIT,
+	}
+
+	// O quadro precisa de todos os pedidos ativos, então percorre todas as páginas
+	var kitchenOrders []entities.KitchenOrder
+	for {
+		page, pageInfo, err := uc.gateway.FindAll(ctx, filter)
+		if err != nil {
+			return entities.DisplayBoard{}, err
+		}
+
+		kitchenOrders = append(kitchenOrders, page...)
+
+		if pageInfo.NextCursor == nil {
+			break
+		}
+		filter.Cursor = *pageInfo.NextCursor
+	}
+
+	return entities.NewDisplayBoard(kitchenOrders, time.Now(), uc.readyTTL), nil
+}
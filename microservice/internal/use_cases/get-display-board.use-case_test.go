@@ -0,0 +1,89 @@
+package use_cases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"tech_challenge/internal/domain/entities"
+	"tech_challenge/internal/shared/config/constants"
+)
+
+func findMockStatus(dataStore *MockDataStore, statusID string) entities.OrderStatus {
+	for _, status := range dataStore.orderStatuses {
+		if status.ID == statusID {
+			return status
+		}
+	}
+	return entities.OrderStatus{}
+}
+
+func TestGetDisplayBoardUseCase_GroupsActiveOrders(t *testing.T) {
+	dataStore := NewMockDataStore()
+	now := time.Now()
+	recentReady := now.Add(-time.Minute)
+	oldReady := now.Add(-time.Hour)
+
+	preparing, _ := entities.NewKitchenOrder("id1", "order1", "001", findMockStatus(dataStore, constants.KITCHEN_ORDER_STATUS_PREPARING_ID), now, nil)
+	ready, _ := entities.NewKitchenOrder("id2", "order2", "002", findMockStatus(dataStore, constants.KITCHEN_ORDER_STATUS_READY_ID), now, &recentReady)
+	expired, _ := entities.NewKitchenOrder("id3", "order3", "003", findMockStatus(dataStore, constants.KITCHEN_ORDER_STATUS_READY_ID), now, &oldReady)
+	finished, _ := entities.NewKitchenOrder("id4", "order4", "004", findMockStatus(dataStore, constants.KITCHEN_ORDER_STATUS_FINISHED_ID), now, nil)
+	dataStore.kitchenOrders = []entities.KitchenOrder{*preparing, *ready, *expired, *finished}
+
+	useCase := NewGetDisplayBoardUseCase(NewMockKitchenOrderGateway(dataStore), 10*time.Minute)
+
+	board, err := useCase.Execute(context.Background())
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(board.Preparing) != 1 || board.Preparing[0].Slug != "001" {
+		t.Errorf("Expected slug 001 in preparing, got %v", board.Preparing)
+	}
+
+	if len(board.Ready) != 1 || board.Ready[0].Slug != "002" {
+		t.Errorf("Expected only slug 002 in ready, got %v", board.Ready)
+	}
+}
+
+func TestGetDisplayBoardUseCase_ReadsAllPages(t *testing.T) {
+	dataStore := NewMockDataStore()
+	status := findMockStatus(dataStore, constants.KITCHEN_ORDER_STATUS_RECEIVED_ID)
+
+	total := constants.KITCHEN_ORDER_MAX_PAGE_LIMIT + 5
+	for i := 0; i < total; i++ {
+		order, _ := entities.NewKitchenOrder(fmt.Sprintf("id%d", i), fmt.Sprintf("order%d", i), fmt.Sprintf("%03d", i), status, time.Now(), nil)
+		dataStore.kitchenOrders = append(dataStore.kitchenOrders, *order)
+	}
+
+	useCase := NewGetDisplayBoardUseCase(NewMockKitchenOrderGateway(dataStore), time.Minute)
+
+	board, err := useCase.Execute(context.Background())
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(board.Preparing) != total {
+		t.Errorf("Expected %d orders in preparing, got %d", total, len(board.Preparing))
+	} else {
+		t.Log("✓ Todas as páginas foram lidas")
+	}
+}
+
+func TestGetDisplayBoardUseCase_GatewayError(t *testing.T) {
+	dataStore := NewMockDataStore()
+	dataStore.shouldReturnError = true
+	dataStore.errorToReturn = errors.New("database error")
+
+	useCase := NewGetDisplayBoardUseCase(NewMockKitchenOrderGateway(dataStore), time.Minute)
+
+	_, err := useCase.Execute(context.Background())
+
+	if err == nil {
+		t.Error("Expected error, got nil")
+	}
+}
@@ -0,0 +1,35 @@
+package use_cases
+
+import (
+	"context"
+	"time"
+
+	"tech_challenge/internal/application/gateways"
+	"tech_challenge/internal/domain/entities"
+	"tech_challenge/internal/shared/config/constants"
+)
+
+type GetKitchenBoardStatsUseCase struct {
+	gateway gateways.KitchenReportGateway
+}
+
+func NewGetKitchenBoardStatsUseCase(gateway gateways.KitchenReportGateway) *GetKitchenBoardStatsUseCase {
+	return &GetKitchenBoardStatsUseCase{
+		gateway: gateway,
+	}
+}
+
+// Execute conta os pedidos em cada status do quadro e mede há quanto tempo o pedido recebido mais antigo espera
+func (uc *GetKitchenBoardStatsUseCase) Execute(ctx context.Context) (entities.KitchenBoardStats, error) {
+	counts, err := uc.gateway.CountByStatus(ctx, entities.ReportStageStatusIDs)
+	if err != nil {
+		return entities.KitchenBoardStats{}, err
+	}
+
+	oldestReceivedAt, err := uc.gateway.FindOldestCreatedAt(ctx, constants.KITCHEN_ORDER_STATUS_RECEIVED_ID)
+	if err != nil {
+		return entities.KitchenBoardStats{}, err
+	}
+
+	return entities.NewKitchenBoardStats(counts, oldestReceivedAt, time.Now()), nil
+}
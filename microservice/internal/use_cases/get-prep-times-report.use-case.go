@@ -0,0 +1,45 @@
+package use_cases
+
+import (
+	"context"
+
+	"tech_challenge/internal/application/dtos"
+	"tech_challenge/internal/application/gateways"
+	"tech_challenge/internal/domain/entities"
+)
+
+type GetPrepTimesReportUseCase struct {
+	gateway gateways.KitchenReportGateway
+}
+
+func NewGetPrepTimesReportUseCase(gateway gateways.KitchenReportGateway) *GetPrepTimesReportUseCase {
+	return &GetPrepTimesReportUseCase{
+		gateway: gateway,
+	}
+}
+
+// Execute mede o tempo em cada status dos pedidos criados no período; o p90 do período inteiro
+// é calculado à parte porque não pode ser obtido a partir do p90 de cada bucket
+func (uc *GetPrepTimesReportUseCase) Execute(ctx context.Context, filter dtos.KitchenReportFilter) (entities.PrepTimeReport, error) {
+	filter, err := normalizeKitchenReportFilter(filter)
+	if err != nil {
+		return entities.PrepTimeReport{}, err
+	}
+
+	stages, err := uc.gateway.FindStageDurations(ctx, filter)
+	if err != nil {
+		return entities.PrepTimeReport{}, err
+	}
+
+	buckets, err := uc.gateway.FindStageDurationsByPeriod(ctx, filter)
+	if err != nil {
+		return entities.PrepTimeReport{}, err
+	}
+
+	slowestProducts, err := uc.gateway.FindSlowestProducts(ctx, filter)
+	if err != nil {
+		return entities.PrepTimeReport{}, err
+	}
+
+	return entities.NewPrepTimeReport(filter.From, filter.To, filter.GroupBy, stages, buckets, slowestProducts), nil
+}
@@ -0,0 +1,86 @@
+package use_cases
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"tech_challenge/internal/application/dtos"
+	"tech_challenge/internal/application/gateways"
+	"tech_challenge/internal/domain/entities"
+	"tech_challenge/internal/domain/exceptions"
+	"tech_challenge/internal/shared/config/constants"
+)
+
+type GetThroughputReportUseCase struct {
+	gateway gateways.KitchenReportGateway
+}
+
+func NewGetThroughputReportUseCase(gateway gateways.KitchenReportGateway) *GetThroughputReportUseCase {
+	return &GetThroughputReportUseCase{
+		gateway: gateway,
+	}
+}
+
+func (uc *GetThroughputReportUseCase) Execute(ctx context.Context, filter dtos.KitchenReportFilter) (entities.ThroughputReport, error) {
+	filter, err := normalizeKitchenReportFilter(filter)
+	if err != nil {
+		return entities.ThroughputReport{}, err
+	}
+
+	counts, err := uc.gateway.CountThroughput(ctx, filter)
+	if err != nil {
+		return entities.ThroughputReport{}, err
+	}
+
+	return entities.NewThroughputReport(filter.From, filter.To, filter.GroupBy, counts), nil
+}
+
+// normalizeKitchenReportFilter aplica os padrões e limita o intervalo para que o relatório tenha um número razoável de períodos
+func normalizeKitchenReportFilter(filter dtos.KitchenReportFilter) (dtos.KitchenReportFilter, error) {
+	if filter.From.IsZero() || filter.To.IsZero() {
+		return filter, &exceptions.InvalidReportFilterException{
+			Message: "from and to are required",
+		}
+	}
+
+	if !filter.From.Before(filter.To) {
+		return filter, &exceptions.InvalidReportFilterException{
+			Message: "from must be before to",
+		}
+	}
+
+	if filter.GroupBy == "" {
+		filter.GroupBy = constants.REPORT_GROUP_BY_DAY
+	}
+
+	maxDays := 0
+	switch filter.GroupBy {
+	case constants.REPORT_GROUP_BY_HOUR:
+		maxDays = constants.REPORT_MAX_HOURLY_RANGE_DAYS
+	case constants.REPORT_GROUP_BY_DAY:
+		maxDays = constants.REPORT_MAX_DAILY_RANGE_DAYS
+	default:
+		return filter, &exceptions.InvalidReportFilterException{
+			Message: "group_by must be hour or day",
+		}
+	}
+
+	if filter.To.Sub(filter.From) > time.Duration(maxDays)*24*time.Hour {
+		return filter, &exceptions.InvalidReportFilterException{
+			Message: fmt.Sprintf("The period must be at most %d days when grouped by %s", maxDays, filter.GroupBy),
+		}
+	}
+
+	if filter.ProductsLimit == 0 {
+		filter.ProductsLimit = constants.REPORT_SLOWEST_PRODUCTS_DEFAULT_LIMIT
+	}
+
+	if filter.ProductsLimit < 0 || filter.ProductsLimit > constants.REPORT_SLOWEST_PRODUCTS_MAX_LIMIT {
+		return filter, &exceptions.InvalidReportFilterException{
+			Message: fmt.Sprintf("products_limit must be between 1 and %d", constants.REPORT_SLOWEST_PRODUCTS_MAX_LIMIT),
+		}
+	}
+
+	return filter, nil
+}
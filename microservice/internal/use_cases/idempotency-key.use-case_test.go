@@ -0,0 +1,245 @@
+package use_cases
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tech_challenge/internal/application/dtos"
+	"tech_challenge/internal/application/gateways"
+	"tech_challenge/internal/daos"
+	"tech_challenge/internal/domain/exceptions"
+	"tech_challenge/internal/shared/config/constants"
+)
+
+// MockIdempotencyKeyDataSource simula a tabela de chaves em memória
+type MockIdempotencyKeyDataSource struct {
+	mu   sync.Mutex
+	keys map[string]daos.IdempotencyKeyDAO
+}
+
+func NewMockIdempotencyKeyDataSource() *MockIdempotencyKeyDataSource {
+	return &MockIdempotencyKeyDataSource{keys: make(map[string]daos.IdempotencyKeyDAO)}
+}
+
+func (ds *MockIdempotencyKeyDataSource) Reserve(idempotencyKey daos.IdempotencyKeyDAO) (bool, error) {
+	ds.mu.Lock()
+	defer ds.mu.Unlock()
+
+	id := idempotencyKey.Scope + "/" + idempotencyKey.Key
+	if _, ok := ds.keys[id]; ok {
+		return false, nil
+	}
+	ds.keys[id] = idempotencyKey
+	return true, nil
+}
+
+func (ds *MockIdempotencyKeyDataSource) Reclaim(idempotencyKey daos.IdempotencyKeyDAO, staleBefore time.Time) (bool, error) {
+	ds.mu.Lock()
+	defer ds.mu.Unlock()
+
+	id := idempotencyKey.Scope + "/" + idempotencyKey.Key
+	existing, ok := ds.keys[id]
+	if !ok || existing.ResourceID != nil || !existing.CreatedAt.Before(staleBefore) {
+		return false, nil
+	}
+	ds.keys[id] = idempotencyKey
+	return true, nil
+}
+
+func (ds *MockIdempotencyKeyDataSource) ReclaimExpired(idempotencyKey daos.IdempotencyKeyDAO, expiredBefore time.Time) (bool, error) {
+	ds.mu.Lock()
+	defer ds.mu.Unlock()
+
+	id := idempotencyKey.Scope + "/" + idempotencyKey.Key
+	existing, ok := ds.keys[id]
+	if !ok || !existing.CreatedAt.Before(expiredBefore) {
+		return false, nil
+	}
+	ds.keys[id] = idempotencyKey
+	return true, nil
+}
+
+func (ds *MockIdempotencyKeyDataSource) FindByKey(scope, key string) (daos.IdempotencyKeyDAO, error) {
+	ds.mu.Lock()
+	defer ds.mu.Unlock()
+
+	existing, ok := ds.keys[scope+"/"+key]
+	if !ok {
+		return daos.IdempotencyKeyDAO{}, errors.New("record not found")
+	}
+	return existing, nil
+}
+
+func (ds *MockIdempotencyKeyDataSource) Complete(scope, key, resourceID string) error {
+	ds.mu.Lock()
+	defer ds.mu.Unlock()
+
+	existing := ds.keys[scope+"/"+key]
+	existing.ResourceID = &resourceID
+	ds.keys[scope+"/"+key] = existing
+	return nil
+}
+
+func (ds *MockIdempotencyKeyDataSource) Release(scope, key string) error {
+	ds.mu.Lock()
+	defer ds.mu.Unlock()
+
+	if existing, ok := ds.keys[scope+"/"+key]; ok && existing.ResourceID == nil {
+		delete(ds.keys, scope+"/"+key)
+	}
+	return nil
+}
+
+func (ds *MockIdempotencyKeyDataSource) DeleteOlderThan(before time.Time) (int64, error) {
+	ds.mu.Lock()
+	defer ds.mu.Unlock()
+
+	var deleted int64
+	for id, existing := range ds.keys {
+		if existing.CreatedAt.Before(before) {
+			delete(ds.keys, id)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+func newIdempotencyKeyDTO(fingerprint string) dtos.IdempotencyKeyDTO {
+	return dtos.IdempotencyKeyDTO{
+		Scope:       constants.IDEMPOTENCY_SCOPE_CREATE_KITCHEN_ORDER,
+		Key:         "key-1",
+		Fingerprint: fingerprint,
+	}
+}
+
+func TestReserveIdempotencyKeyUseCase_FirstRequestReserves(t *testing.T) {
+	gateway := *gateways.NewIdempotencyKeyGateway(NewMockIdempotencyKeyDataSource())
+	useCase := NewReserveIdempotencyKeyUseCase(gateway, time.Hour)
+
+	key, err := useCase.Execute(newIdempotencyKeyDTO("abc"))
+
+	require.NoError(t, err)
+	assert.False(t, key.IsCompleted())
+}
+
+func TestReserveIdempotencyKeyUseCase_CompletedKeyIsReplayed(t *testing.T) {
+	gateway := *gateways.NewIdempotencyKeyGateway(NewMockIdempotencyKeyDataSource())
+	useCase := NewReserveIdempotencyKeyUseCase(gateway, time.Hour)
+
+	_, err := useCase.Execute(newIdempotencyKeyDTO("abc"))
+	require.NoError(t, err)
+	require.NoError(t, NewCompleteIdempotencyKeyUseCase(gateway).Execute(constants.IDEMPOTENCY_SCOPE_CREATE_KITCHEN_ORDER, "key-1", "resource-1"))
+
+	key, err := useCase.Execute(newIdempotencyKeyDTO("abc"))
+
+	require.NoError(t, err)
+	require.True(t, key.IsCompleted())
+	assert.Equal(t, "resource-1", *key.ResourceID)
+	t.Log("✓ Repetição da requisição devolve o recurso original")
+}
+
+func TestReserveIdempotencyKeyUseCase_DifferentPayloadConflicts(t *testing.T) {
+	gateway := *gateways.NewIdempotencyKeyGateway(NewMockIdempotencyKeyDataSource())
+	useCase := NewReserveIdempotencyKeyUseCase(gateway, time.Hour)
+
+	_, _ = useCase.Execute(newIdempotencyKeyDTO("abc"))
+
+	_, err := useCase.Execute(newIdempotencyKeyDTO("def"))
+
+	assert.IsType(t, &exceptions.IdempotencyKeyConflictException{}, err)
+}
+
+func TestReserveIdempotencyKeyUseCase_PendingKeyIsInProgress(t *testing.T) {
+	gateway := *gateways.NewIdempotencyKeyGateway(NewMockIdempotencyKeyDataSource())
+	useCase := NewReserveIdempotencyKeyUseCase(gateway, time.Hour)
+
+	_, _ = useCase.Execute(newIdempotencyKeyDTO("abc"))
+
+	_, err := useCase.Execute(newIdempotencyKeyDTO("abc"))
+
+	assert.IsType(t, &exceptions.IdempotencyKeyInProgressException{}, err)
+}
+
+func TestReserveIdempotencyKeyUseCase_ReclaimsAbandonedReservation(t *testing.T) {
+	dataSource := NewMockIdempotencyKeyDataSource()
+	_, _ = dataSource.Reserve(daos.IdempotencyKeyDAO{
+		Scope:       constants.IDEMPOTENCY_SCOPE_CREATE_KITCHEN_ORDER,
+		Key:         "key-1",
+		Fingerprint: "abc",
+		CreatedAt:   time.Now().Add(-2 * idempotencyPendingTimeout),
+	})
+	useCase := NewReserveIdempotencyKeyUseCase(*gateways.NewIdempotencyKeyGateway(dataSource), time.Hour)
+
+	key, err := useCase.Execute(newIdempotencyKeyDTO("abc"))
+
+	require.NoError(t, err)
+	assert.False(t, key.IsCompleted())
+}
+
+func TestReserveIdempotencyKeyUseCase_ExpiredKeyCanBeReused(t *testing.T) {
+	dataSource := NewMockIdempotencyKeyDataSource()
+	resourceID := "resource-1"
+	_, _ = dataSource.Reserve(daos.IdempotencyKeyDAO{
+		Scope:       constants.IDEMPOTENCY_SCOPE_CREATE_KITCHEN_ORDER,
+		Key:         "key-1",
+		Fingerprint: "abc",
+		ResourceID:  &resourceID,
+		CreatedAt:   time.Now().Add(-2 * time.Hour),
+	})
+	useCase := NewReserveIdempotencyKeyUseCase(*gateways.NewIdempotencyKeyGateway(dataSource), time.Hour)
+
+	key, err := useCase.Execute(newIdempotencyKeyDTO("def"))
+
+	require.NoError(t, err)
+	assert.False(t, key.IsCompleted())
+}
+
+func TestSweepIdempotencyKeysUseCase_RunsOncePerInterval(t *testing.T) {
+	dataSource := NewMockIdempotencyKeyDataSource()
+	now := time.Now()
+	for _, key := range []string{"expired-1", "expired-2"} {
+		_, _ = dataSource.Reserve(daos.IdempotencyKeyDAO{Scope: constants.IDEMPOTENCY_SCOPE_CREATE_KITCHEN_ORDER, Key: key, CreatedAt: now.Add(-2 * time.Hour)})
+	}
+	useCase := NewSweepIdempotencyKeysUseCase(*gateways.NewIdempotencyKeyGateway(dataSource), time.Hour)
+	useCase.now = func() time.Time { return now }
+
+	// Logo após a criação ainda está dentro do intervalo; nada é removido
+	useCase.Execute()
+	time.Sleep(10 * time.Millisecond)
+	_, err := dataSource.FindByKey(constants.IDEMPOTENCY_SCOPE_CREATE_KITCHEN_ORDER, "expired-1")
+	require.NoError(t, err)
+
+	now = now.Add(idempotencySweepInterval + time.Second)
+	useCase.Execute()
+
+	assert.Eventually(t, func() bool {
+		_, err := dataSource.FindByKey(constants.IDEMPOTENCY_SCOPE_CREATE_KITCHEN_ORDER, "expired-1")
+		return err != nil
+	}, time.Second, 5*time.Millisecond)
+	t.Log("✓ Chaves expiradas removidas em segundo plano")
+}
+
+func TestReleaseIdempotencyKeyUseCase_AllowsRetry(t *testing.T) {
+	gateway := *gateways.NewIdempotencyKeyGateway(NewMockIdempotencyKeyDataSource())
+	useCase := NewReserveIdempotencyKeyUseCase(gateway, time.Hour)
+
+	_, _ = useCase.Execute(newIdempotencyKeyDTO("abc"))
+	require.NoError(t, NewReleaseIdempotencyKeyUseCase(gateway).Execute(constants.IDEMPOTENCY_SCOPE_CREATE_KITCHEN_ORDER, "key-1"))
+
+	_, err := useCase.Execute(newIdempotencyKeyDTO("abc"))
+
+	assert.NoError(t, err)
+}
+
+func TestReserveIdempotencyKeyUseCase_InvalidKey(t *testing.T) {
+	useCase := NewReserveIdempotencyKeyUseCase(*gateways.NewIdempotencyKeyGateway(NewMockIdempotencyKeyDataSource()), time.Hour)
+
+	_, err := useCase.Execute(dtos.IdempotencyKeyDTO{Scope: constants.IDEMPOTENCY_SCOPE_CREATE_KITCHEN_ORDER, Key: " "})
+
+	assert.IsType(t, &exceptions.InvalidIdempotencyKeyException{}, err)
+}
@@ -0,0 +1,105 @@
+package use_cases
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"tech_challenge/internal/application/gateways"
+	"tech_challenge/internal/daos"
+	"tech_challenge/internal/shared/config/constants"
+)
+
+type MockKitchenOrderEventDataSource struct {
+	events        []daos.KitchenOrderEventDAO
+	requestedSize int
+	deletedBefore time.Time
+	err           error
+}
+
+func (ds *MockKitchenOrderEventDataSource) FindAfter(afterID uint64, limit int) ([]daos.KitchenOrderEventDAO, error) {
+	ds.requestedSize = limit
+	if ds.err != nil {
+		return nil, ds.err
+	}
+
+	var result []daos.KitchenOrderEventDAO
+	for _, event := range ds.events {
+		if event.ID > afterID {
+			result = append(result, event)
+		}
+	}
+	return result, nil
+}
+
+func (ds *MockKitchenOrderEventDataSource) FindByIDs(ids []uint64) ([]daos.KitchenOrderEventDAO, error) {
+	ds.requestedSize = len(ids)
+	if ds.err != nil {
+		return nil, ds.err
+	}
+
+	var result []daos.KitchenOrderEventDAO
+	for _, event := range ds.events {
+		if slices.Contains(ids, event.ID) {
+			result = append(result, event)
+		}
+	}
+	return result, nil
+}
+
+func (ds *MockKitchenOrderEventDataSource) FindLastID() (uint64, error) {
+	return uint64(len(ds.events)), ds.err
+}
+
+func (ds *MockKitchenOrderEventDataSource) DeleteOlderThan(before time.Time) (int64, error) {
+	ds.deletedBefore = before
+	return 0, ds.err
+}
+
+func TestFindKitchenOrderEventsUseCase_Execute(t *testing.T) {
+	// Arrange
+	dataSource := &MockKitchenOrderEventDataSource{events: []daos.KitchenOrderEventDAO{
+		{ID: 1, Type: constants.KITCHEN_ORDER_EVENT_CREATED, StatusID: constants.KITCHEN_ORDER_STATUS_RECEIVED_ID, StatusName: "Recebido"},
+		{ID: 2, Type: constants.KITCHEN_ORDER_EVENT_STATUS_CHANGED, StatusID: constants.KITCHEN_ORDER_STATUS_READY_ID, StatusName: "Pronto"},
+	}}
+	useCase := NewFindKitchenOrderEventsUseCase(*gateways.NewKitchenOrderEventGateway(dataSource))
+
+	// Act
+	events, err := useCase.Execute(1, 0)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(events) != 1 || events[0].Status.Name.Value() != "Pronto" {
+		t.Errorf("Expected only the status change event, got %+v", events)
+	}
+
+	if dataSource.requestedSize != maxKitchenOrderEventsPerRead {
+		t.Errorf("Expected default limit %d, got %d", maxKitchenOrderEventsPerRead, dataSource.requestedSize)
+	}
+}
+
+func TestFindKitchenOrderEventsUseCase_Error(t *testing.T) {
+	dataSource := &MockKitchenOrderEventDataSource{err: errors.New("db error")}
+	useCase := NewFindKitchenOrderEventsUseCase(*gateways.NewKitchenOrderEventGateway(dataSource))
+
+	if _, err := useCase.Execute(0, 10); err == nil {
+		t.Error("Expected error, got nil")
+	}
+}
+
+func TestPruneKitchenOrderEventsUseCase_Execute(t *testing.T) {
+	dataSource := &MockKitchenOrderEventDataSource{}
+	useCase := NewPruneKitchenOrderEventsUseCase(*gateways.NewKitchenOrderEventGateway(dataSource))
+
+	if _, err := useCase.Execute(24 * time.Hour); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if time.Since(dataSource.deletedBefore) < 24*time.Hour-time.Minute {
+		t.Errorf("Expected cutoff 24h ago, got %v", dataSource.deletedBefore)
+	}
+}
@@ -0,0 +1,183 @@
+package use_cases
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tech_challenge/internal/application/dtos"
+	"tech_challenge/internal/application/gateways"
+	"tech_challenge/internal/daos"
+	"tech_challenge/internal/domain/exceptions"
+	"tech_challenge/internal/shared/config/constants"
+)
+
+// MockKitchenReportDataSource devolve agregados prontos e guarda o último filtro recebido
+type MockKitchenReportDataSource struct {
+	throughput      []daos.ThroughputBucketDAO
+	stageDurations  []daos.StageDurationDAO
+	slowestProducts []daos.ProductPrepTimeDAO
+	statusCounts    []daos.BoardStatusCountDAO
+	oldestCreatedAt *time.Time
+	err             error
+	lastFilter      dtos.KitchenReportFilter
+}
+
+func (ds *MockKitchenReportDataSource) CountThroughput(ctx context.Context, filter dtos.KitchenReportFilter) ([]daos.ThroughputBucketDAO, error) {
+	ds.lastFilter = filter
+	return ds.throughput, ds.err
+}
+
+func (ds *MockKitchenReportDataSource) FindStageDurations(ctx context.Context, filter dtos.KitchenReportFilter, grouped bool) ([]daos.StageDurationDAO, error) {
+	ds.lastFilter = filter
+
+	var result []daos.StageDurationDAO
+	for _, duration := range ds.stageDurations {
+		if (duration.PeriodStart != nil) == grouped {
+			result = append(result, duration)
+		}
+	}
+	return result, ds.err
+}
+
+func (ds *MockKitchenReportDataSource) FindSlowestProducts(ctx context.Context, filter dtos.KitchenReportFilter) ([]daos.ProductPrepTimeDAO, error) {
+	ds.lastFilter = filter
+	return ds.slowestProducts, ds.err
+}
+
+func (ds *MockKitchenReportDataSource) CountByStatus(ctx context.Context, statusIDs []string) ([]daos.BoardStatusCountDAO, error) {
+	return ds.statusCounts, ds.err
+}
+
+func (ds *MockKitchenReportDataSource) FindOldestCreatedAt(ctx context.Context, statusID string) (*time.Time, error) {
+	return ds.oldestCreatedAt, ds.err
+}
+
+func newReportGateway(dataSource *MockKitchenReportDataSource) gateways.KitchenReportGateway {
+	return *gateways.NewKitchenReportGateway(dataSource)
+}
+
+func reportDay(day int) time.Time {
+	return time.Date(2024, 1, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestGetThroughputReportUseCase_DefaultsToDailyBuckets(t *testing.T) {
+	dataSource := &MockKitchenReportDataSource{
+		throughput: []daos.ThroughputBucketDAO{{PeriodStart: reportDay(16), Received: 48, Finished: 24}},
+	}
+
+	report, err := NewGetThroughputReportUseCase(newReportGateway(dataSource)).Execute(context.Background(), dtos.KitchenReportFilter{
+		From: reportDay(15),
+		To:   reportDay(18).Add(-time.Nanosecond),
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, constants.REPORT_GROUP_BY_DAY, dataSource.lastFilter.GroupBy)
+	assert.Equal(t, constants.REPORT_GROUP_BY_DAY, report.GroupBy)
+	require.Len(t, report.Buckets, 3)
+	assert.Equal(t, 0, report.Buckets[0].Received)
+	assert.Equal(t, 2.0, report.Buckets[1].ReceivedPerHour)
+	assert.Equal(t, 1.0, report.Buckets[1].FinishedPerHour)
+	assert.Equal(t, 48, report.Received)
+	t.Log("✓ Relatório de vazão agrupado por dia por padrão")
+}
+
+func TestGetThroughputReportUseCase_InvalidFilter(t *testing.T) {
+	useCase := NewGetThroughputReportUseCase(newReportGateway(&MockKitchenReportDataSource{}))
+
+	for name, filter := range map[string]dtos.KitchenReportFilter{
+		"missing range":         {},
+		"reversed range":        {From: reportDay(16), To: reportDay(15)},
+		"unknown group":         {From: reportDay(15), To: reportDay(16), GroupBy: "week"},
+		"hourly range too long": {From: reportDay(1), To: reportDay(1).AddDate(0, 0, constants.REPORT_MAX_HOURLY_RANGE_DAYS+1), GroupBy: constants.REPORT_GROUP_BY_HOUR},
+		"daily range too long":  {From: reportDay(1), To: reportDay(1).AddDate(0, 0, constants.REPORT_MAX_DAILY_RANGE_DAYS+1)},
+		"products limit":        {From: reportDay(15), To: reportDay(16), ProductsLimit: constants.REPORT_SLOWEST_PRODUCTS_MAX_LIMIT + 1},
+	} {
+		_, err := useCase.Execute(context.Background(), filter)
+		var invalidFilter *exceptions.InvalidReportFilterException
+		assert.ErrorAs(t, err, &invalidFilter, name)
+	}
+	t.Log("✓ Filtros de relatório inválidos rejeitados")
+}
+
+func TestGetPrepTimesReportUseCase_CombinesAggregates(t *testing.T) {
+	day15, day16 := reportDay(15), reportDay(16)
+	dataSource := &MockKitchenReportDataSource{
+		stageDurations: []daos.StageDurationDAO{
+			{StatusID: constants.KITCHEN_ORDER_STATUS_PREPARING_ID, StatusName: "Em preparação", Orders: 3, AverageSeconds: 660, P90Seconds: 1200},
+			{StatusID: constants.KITCHEN_ORDER_STATUS_RECEIVED_ID, StatusName: "Recebido", Orders: 4, AverageSeconds: 135, P90Seconds: 300},
+			{PeriodStart: &day16, StatusID: constants.KITCHEN_ORDER_STATUS_RECEIVED_ID, Orders: 1},
+			{PeriodStart: &day15, StatusID: constants.KITCHEN_ORDER_STATUS_PREPARING_ID, Orders: 2},
+			{PeriodStart: &day15, StatusID: constants.KITCHEN_ORDER_STATUS_RECEIVED_ID, Orders: 3},
+		},
+		slowestProducts: []daos.ProductPrepTimeDAO{{ProductID: "burger", Orders: 2, AverageSeconds: 900, P90Seconds: 1200}},
+	}
+
+	report, err := NewGetPrepTimesReportUseCase(newReportGateway(dataSource)).Execute(context.Background(), dtos.KitchenReportFilter{
+		From:    day15,
+		To:      reportDay(17),
+		GroupBy: constants.REPORT_GROUP_BY_DAY,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, constants.REPORT_SLOWEST_PRODUCTS_DEFAULT_LIMIT, dataSource.lastFilter.ProductsLimit)
+
+	require.Len(t, report.Stages, 2)
+	assert.Equal(t, "Recebido", report.Stages[0].StatusName)
+	assert.Equal(t, 1200.0, report.Stages[1].P90Seconds)
+
+	require.Len(t, report.Buckets, 2)
+	assert.Equal(t, day15, report.Buckets[0].PeriodStart)
+	require.Len(t, report.Buckets[0].Stages, 2)
+	assert.Equal(t, constants.KITCHEN_ORDER_STATUS_RECEIVED_ID, report.Buckets[0].Stages[0].StatusID)
+	assert.Equal(t, day16, report.Buckets[1].PeriodStart)
+
+	require.Len(t, report.SlowestProducts, 1)
+	assert.Equal(t, "burger", report.SlowestProducts[0].ProductID)
+	t.Log("✓ Tempos por status e produtos mais lentos combinados no relatório")
+}
+
+func TestGetPrepTimesReportUseCase_DataSourceError(t *testing.T) {
+	dataSource := &MockKitchenReportDataSource{err: errors.New("database unavailable")}
+
+	_, err := NewGetPrepTimesReportUseCase(newReportGateway(dataSource)).Execute(context.Background(), dtos.KitchenReportFilter{
+		From: reportDay(15),
+		To:   reportDay(16),
+	})
+
+	assert.EqualError(t, err, "database unavailable")
+}
+
+func TestGetKitchenBoardStatsUseCase_Execute(t *testing.T) {
+	oldestReceivedAt := time.Now().Add(-5 * time.Minute)
+	dataSource := &MockKitchenReportDataSource{
+		statusCounts: []daos.BoardStatusCountDAO{
+			{StatusID: constants.KITCHEN_ORDER_STATUS_READY_ID, StatusName: "Pronto", Orders: 1},
+			{StatusID: constants.KITCHEN_ORDER_STATUS_RECEIVED_ID, StatusName: "Recebido", Orders: 4},
+		},
+		oldestCreatedAt: &oldestReceivedAt,
+	}
+
+	stats, err := NewGetKitchenBoardStatsUseCase(newReportGateway(dataSource)).Execute(context.Background())
+	require.NoError(t, err)
+
+	require.Len(t, stats.Statuses, 3)
+	assert.Equal(t, 4, stats.Statuses[0].Orders)
+	assert.Equal(t, constants.KITCHEN_ORDER_STATUS_PREPARING_ID, stats.Statuses[1].StatusID)
+	assert.Equal(t, 0, stats.Statuses[1].Orders)
+	assert.Equal(t, 1, stats.Statuses[2].Orders)
+	assert.GreaterOrEqual(t, stats.OldestReceivedAge, 5*time.Minute)
+	t.Log("✓ Estatísticas do quadro calculadas")
+}
+
+func TestGetKitchenBoardStatsUseCase_DataSourceError(t *testing.T) {
+	dataSource := &MockKitchenReportDataSource{err: errors.New("database unavailable")}
+
+	_, err := NewGetKitchenBoardStatsUseCase(newReportGateway(dataSource)).Execute(context.Background())
+
+	assert.EqualError(t, err, "database unavailable")
+}
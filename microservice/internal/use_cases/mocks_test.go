@@ -1,22 +1,31 @@
 package use_cases
 
 import (
+	"context"
+	"slices"
+	"sync"
+
 	"tech_challenge/internal/application/dtos"
 	"tech_challenge/internal/application/gateways"
 	"tech_challenge/internal/daos"
 	"tech_challenge/internal/domain/entities"
 	"tech_challenge/internal/domain/exceptions"
+	"tech_challenge/internal/interfaces"
 	"tech_challenge/internal/shared/config/constants"
 )
 
 // MockDataStore simula um banco de dados em memória para testes
 type MockDataStore struct {
+	// mu protege os pedidos nos testes que alteram pedidos em paralelo
+	mu                         sync.Mutex
 	kitchenOrders              []entities.KitchenOrder
 	orderStatuses              []entities.OrderStatus
 	shouldReturnError          bool
 	errorToReturn              error
 	shouldReturnErrorOnUpdate  bool
 	updateErrorToReturn        error
+	// concurrentUpdate simula outra alteração gravada entre a leitura e a escrita do use case
+	concurrentUpdate bool
 }
 
 func NewMockDataStore() *MockDataStore {
@@ -48,7 +57,7 @@ type MockKitchenOrderDataSource struct {
 	dataStore *MockDataStore
 }
 
-func (ds *MockKitchenOrderDataSource) Insert(kitchenOrder daos.KitchenOrderDAO) error {
+func (ds *MockKitchenOrderDataSource) Insert(ctx context.Context, kitchenOrder daos.KitchenOrderDAO) error {
 	if ds.dataStore.shouldReturnError {
 		return ds.dataStore.errorToReturn
 	}
@@ -62,7 +71,10 @@ func (ds *MockKitchenOrderDataSource) Insert(kitchenOrder daos.KitchenOrderDAO)
 	return nil
 }
 
-func (ds *MockKitchenOrderDataSource) FindByID(id string) (daos.KitchenOrderDAO, error) {
+func (ds *MockKitchenOrderDataSource) FindByID(ctx context.Context, id string) (daos.KitchenOrderDAO, error) {
+	ds.dataStore.mu.Lock()
+	defer ds.dataStore.mu.Unlock()
+
 	if ds.dataStore.shouldReturnError {
 		return daos.KitchenOrderDAO{}, ds.dataStore.errorToReturn
 	}
@@ -75,9 +87,9 @@ func (ds *MockKitchenOrderDataSource) FindByID(id string) (daos.KitchenOrderDAO,
 	return daos.KitchenOrderDAO{}, &exceptions.KitchenOrderNotFoundException{}
 }
 
-func (ds *MockKitchenOrderDataSource) FindAll(filter dtos.KitchenOrderFilter) ([]daos.KitchenOrderDAO, error) {
+func (ds *MockKitchenOrderDataSource) FindAll(ctx context.Context, filter dtos.KitchenOrderFilter) ([]daos.KitchenOrderDAO, dtos.PageInfo, error) {
 	if ds.dataStore.shouldReturnError {
-		return nil, ds.dataStore.errorToReturn
+		return nil, dtos.PageInfo{}, ds.dataStore.errorToReturn
 	}
 
 	var result []daos.KitchenOrderDAO
@@ -86,16 +98,65 @@ func (ds *MockKitchenOrderDataSource) FindAll(filter dtos.KitchenOrderFilter) ([
 			result = append(result, ds.entityToDAO(order))
 		}
 	}
-	return result, nil
+
+	pageInfo := dtos.PageInfo{Total: int64(len(result))}
+
+	// O cursor do mock é o ID do último pedido da página anterior
+	if filter.Cursor != "" {
+		for i, order := range result {
+			if order.ID == filter.Cursor {
+				result = result[i+1:]
+				break
+			}
+		}
+	}
+
+	if filter.Limit > 0 && len(result) > filter.Limit {
+		nextCursor := result[filter.Limit-1].ID
+		pageInfo.NextCursor = &nextCursor
+		result = result[:filter.Limit]
+	}
+
+	return result, pageInfo, nil
 }
 
-func (ds *MockKitchenOrderDataSource) Update(kitchenOrder daos.KitchenOrderDAO) error {
+func (ds *MockKitchenOrderDataSource) FindAllInBatches(ctx context.Context, filter dtos.KitchenOrderFilter, batchSize int, handle func([]daos.KitchenOrderDAO) error) error {
+	filter.Limit = 0
+	filter.Cursor = ""
+
+	result, _, err := ds.FindAll(ctx, filter)
+	if err != nil {
+		return err
+	}
+
+	for start := 0; start < len(result); start += batchSize {
+		end := min(start+batchSize, len(result))
+		if err := handle(result[start:end]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (ds *MockKitchenOrderDataSource) Update(ctx context.Context, kitchenOrder daos.KitchenOrderDAO) error {
+	ds.dataStore.mu.Lock()
+	defer ds.dataStore.mu.Unlock()
+
 	if ds.dataStore.shouldReturnErrorOnUpdate {
 		return ds.dataStore.updateErrorToReturn
 	}
 
 	for i, order := range ds.dataStore.kitchenOrders {
 		if order.ID == kitchenOrder.ID {
+			if ds.dataStore.concurrentUpdate {
+				order.Version++
+				ds.dataStore.kitchenOrders[i] = order
+			}
+
+			if order.Version != kitchenOrder.Version {
+				return interfaces.ErrKitchenOrderVersionConflict
+			}
+
 			status, _ := entities.NewOrderStatus(kitchenOrder.Status.ID, kitchenOrder.Status.Name)
 			updatedOrder, _ := entities.NewKitchenOrder(
 				kitchenOrder.ID, kitchenOrder.OrderID, kitchenOrder.Slug,
@@ -113,6 +174,8 @@ func (ds *MockKitchenOrderDataSource) Update(kitchenOrder daos.KitchenOrderDAO)
 				updatedOrder.AddItem(*item)
 			}
 			
+			updatedOrder.Version = kitchenOrder.Version + 1
+			updatedOrder.StatusReason = kitchenOrder.StatusReason
 			ds.dataStore.kitchenOrders[i] = *updatedOrder
 			return nil
 		}
@@ -120,6 +183,10 @@ func (ds *MockKitchenOrderDataSource) Update(kitchenOrder daos.KitchenOrderDAO)
 	return &exceptions.KitchenOrderNotFoundException{}
 }
 
+func (ds *MockKitchenOrderDataSource) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
 func (ds *MockKitchenOrderDataSource) entityToDAO(order entities.KitchenOrder) daos.KitchenOrderDAO {
 	items := make([]daos.OrderItemDAO, len(order.Items))
 	for i, item := range order.Items {
@@ -142,21 +209,38 @@ func (ds *MockKitchenOrderDataSource) entityToDAO(order entities.KitchenOrder) d
 			ID:   order.Status.ID,
 			Name: order.Status.Name.Value(),
 		},
-		Items:     items,
-		CreatedAt: order.CreatedAt,
-		UpdatedAt: order.UpdatedAt,
+		Items:        items,
+		Version:      order.Version,
+		StatusReason: order.StatusReason,
+		PreparingAt:  order.PreparingAt,
+		ReadyAt:      order.ReadyAt,
+		FinishedAt:   order.FinishedAt,
+		CreatedAt:    order.CreatedAt,
+		UpdatedAt:    order.UpdatedAt,
 	}
 }
 
 func (ds *MockKitchenOrderDataSource) matchesFilter(order entities.KitchenOrder, filter dtos.KitchenOrderFilter) bool {
+	// Como no banco, finalizados ficam fora da listagem padrão
+	if !filter.IncludeFinished && len(filter.StatusIDs) == 0 && order.Status.ID == constants.KITCHEN_ORDER_STATUS_FINISHED_ID {
+		return false
+	}
 	if filter.CreatedAtFrom != nil && order.CreatedAt.Before(*filter.CreatedAtFrom) {
 		return false
 	}
 	if filter.CreatedAtTo != nil && order.CreatedAt.After(*filter.CreatedAtTo) {
 		return false
 	}
-	if filter.StatusID != nil && order.Status.ID != constants.KITCHEN_ORDER_STATUS_RECEIVED_ID {
-		// Simula filtro por status - aqui simplificamos para o teste
+	if len(filter.StatusIDs) > 0 && !slices.Contains(filter.StatusIDs, order.Status.ID) {
+		return false
+	}
+	if filter.OrderID != nil && order.OrderID != *filter.OrderID {
+		return false
+	}
+	if filter.Slug != nil && order.Slug.Value() != *filter.Slug {
+		return false
+	}
+	if filter.CustomerID != nil && (order.CustomerID == nil || *order.CustomerID != *filter.CustomerID) {
 		return false
 	}
 	return true
@@ -167,7 +251,7 @@ type MockOrderStatusDataSource struct {
 	dataStore *MockDataStore
 }
 
-func (ds *MockOrderStatusDataSource) FindByID(id string) (daos.OrderStatusDAO, error) {
+func (ds *MockOrderStatusDataSource) FindByID(ctx context.Context, id string) (daos.OrderStatusDAO, error) {
 	if ds.dataStore.shouldReturnError {
 		return daos.OrderStatusDAO{}, ds.dataStore.errorToReturn
 	}
@@ -183,7 +267,7 @@ func (ds *MockOrderStatusDataSource) FindByID(id string) (daos.OrderStatusDAO, e
 	return daos.OrderStatusDAO{}, &exceptions.OrderStatusNotFoundException{}
 }
 
-func (ds *MockOrderStatusDataSource) FindAll() ([]daos.OrderStatusDAO, error) {
+func (ds *MockOrderStatusDataSource) FindAll(ctx context.Context) ([]daos.OrderStatusDAO, error) {
 	if ds.dataStore.shouldReturnError {
 		return nil, ds.dataStore.errorToReturn
 	}
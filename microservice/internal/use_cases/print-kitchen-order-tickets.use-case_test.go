@@ -0,0 +1,291 @@
+package use_cases
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tech_challenge/internal/application/dtos"
+	"tech_challenge/internal/daos"
+	"tech_challenge/internal/domain/entities"
+	"tech_challenge/internal/domain/exceptions"
+	"tech_challenge/internal/shared/config/constants"
+)
+
+const (
+	printTestOrderID   = "550e8400-e29b-41d4-a716-446655440000"
+	printTestGrillID   = "6ba7b810-9dad-11d1-80b4-00c04fd430c8"
+	printTestDrinksID  = "6ba7b811-9dad-11d1-80b4-00c04fd430c8"
+	printTestExpoID    = "6ba7b812-9dad-11d1-80b4-00c04fd430c8"
+	printTestDessertID = "6ba7b813-9dad-11d1-80b4-00c04fd430c8"
+)
+
+type printFixture struct {
+	dataStore  *MockDataStore
+	printStore *MockPrintStore
+}
+
+// newPrintFixture monta um pedido com lanche e bebida e impressoras da chapa, do bar, da sobremesa e da expedição
+func newPrintFixture(t *testing.T) printFixture {
+	t.Helper()
+
+	dataStore := NewMockDataStore()
+	burger, _ := entities.NewOrderItem("item-burger", "ext-order", "burger-classic", 2, 25.50)
+	soda, _ := entities.NewOrderItem("item-soda", "ext-order", "drink-soda", 1, 8.00)
+	order, err := entities.NewKitchenOrderWithOrderData(
+		printTestOrderID, "ext-order", "001", nil, 59.00,
+		[]entities.OrderItem{*burger, *soda}, dataStore.orderStatuses[0], time.Now(), nil,
+	)
+	require.NoError(t, err)
+	dataStore.kitchenOrders = append(dataStore.kitchenOrders, *order)
+
+	printStore := NewMockPrintStore()
+	for _, printer := range []daos.PrinterDAO{
+		{ID: printTestGrillID, Name: "Chapa", Host: "10.0.0.10", Port: 9100, Station: "grill", Active: true},
+		{ID: printTestDrinksID, Name: "Bar", Host: "10.0.0.11", Port: 9100, Station: "drinks", Active: true},
+		{ID: printTestDessertID, Name: "Sobremesa", Host: "10.0.0.12", Port: 9100, Station: "dessert", Active: true},
+		{ID: printTestExpoID, Name: "Expedição", Host: "10.0.0.13", Port: 9100, Station: constants.PRINT_STATION_EXPO, Active: true},
+	} {
+		printStore.printers[printer.ID] = printer
+	}
+	printStore.routes = []daos.PrintRouteDAO{
+		{ID: "route-1", Position: 0, ProductPattern: "drink-*", Station: "drinks"},
+		{ID: "route-2", Position: 1, ProductPattern: "*", Station: "grill"},
+	}
+
+	return printFixture{dataStore: dataStore, printStore: printStore}
+}
+
+func (f printFixture) enqueue(printDTO dtos.PrintKitchenOrderDTO) ([]entities.PrintJob, error) {
+	return NewEnqueueKitchenOrderTicketsUseCase(
+		NewMockKitchenOrderGateway(f.dataStore),
+		NewMockPrinterGateway(f.printStore),
+		NewMockPrintRouteGateway(f.printStore),
+		NewMockPrintJobGateway(f.printStore),
+	).Execute(context.Background(), printDTO)
+}
+
+func (f printFixture) processor(maxAttempts int) *ProcessPrintJobsUseCase {
+	return NewProcessPrintJobsUseCase(
+		NewMockPrintJobGateway(f.printStore),
+		NewMockPrinterGateway(f.printStore),
+		NewMockKitchenOrderGateway(f.dataStore),
+		dtos.PrintRetryPolicyDTO{MaxAttempts: maxAttempts, BaseDelay: 2 * time.Second, Lease: 30 * time.Second},
+	)
+}
+
+func jobsByPrinter(jobs []entities.PrintJob) map[string]entities.PrintJob {
+	result := make(map[string]entities.PrintJob, len(jobs))
+	for _, job := range jobs {
+		result[job.PrinterID] = job
+	}
+	return result
+}
+
+func TestEnqueueKitchenOrderTicketsUseCase_RoutesItemsByStation(t *testing.T) {
+	fixture := newPrintFixture(t)
+
+	jobs, err := fixture.enqueue(dtos.PrintKitchenOrderDTO{KitchenOrderID: printTestOrderID})
+	require.NoError(t, err)
+
+	byPrinter := jobsByPrinter(jobs)
+	assert.Len(t, jobs, 3)
+	assert.Equal(t, []string{"item-burger"}, byPrinter[printTestGrillID].ItemIDs)
+	assert.Equal(t, []string{"item-soda"}, byPrinter[printTestDrinksID].ItemIDs)
+	assert.Empty(t, byPrinter[printTestExpoID].ItemIDs, "expo receives the full ticket")
+	assert.NotContains(t, byPrinter, printTestDessertID, "stations without items are skipped")
+
+	for _, job := range jobs {
+		assert.Equal(t, constants.PRINT_JOB_STATUS_PENDING, job.Status)
+		assert.False(t, job.Reprint)
+	}
+	assert.Len(t, fixture.printStore.jobs, 3)
+	t.Log("✓ Cada praça recebe apenas os seus itens e a expedição o pedido inteiro")
+}
+
+func TestEnqueueKitchenOrderTicketsUseCase_ReprintOnSinglePrinter(t *testing.T) {
+	fixture := newPrintFixture(t)
+	actor := "expo-7"
+
+	jobs, err := fixture.enqueue(dtos.PrintKitchenOrderDTO{
+		KitchenOrderID: printTestOrderID,
+		PrinterID:      printTestDessertID,
+		Reprint:        true,
+		RequestedBy:    &actor,
+	})
+	require.NoError(t, err)
+
+	require.Len(t, jobs, 1)
+	assert.Equal(t, printTestDessertID, jobs[0].PrinterID)
+	assert.Empty(t, jobs[0].ItemIDs, "a station without items gets the full ticket on reprint")
+	assert.True(t, jobs[0].Reprint)
+	assert.Equal(t, &actor, jobs[0].RequestedBy)
+	t.Log("✓ Reimpressão direcionada a uma impressora")
+}
+
+func TestEnqueueKitchenOrderTicketsUseCase_Errors(t *testing.T) {
+	fixture := newPrintFixture(t)
+	inactive := fixture.printStore.printers[printTestGrillID]
+	inactive.Active = false
+	fixture.printStore.printers[printTestGrillID] = inactive
+
+	_, err := fixture.enqueue(dtos.PrintKitchenOrderDTO{KitchenOrderID: printTestOrderID, PrinterID: printTestGrillID})
+	var invalidPrinter *exceptions.InvalidPrinterDataException
+	assert.ErrorAs(t, err, &invalidPrinter)
+
+	_, err = fixture.enqueue(dtos.PrintKitchenOrderDTO{KitchenOrderID: printTestOrderID, PrinterID: "7c9e6679-7425-40de-944b-e07fc1f90ae7"})
+	var printerNotFound *exceptions.PrinterNotFoundException
+	assert.ErrorAs(t, err, &printerNotFound)
+
+	_, err = fixture.enqueue(dtos.PrintKitchenOrderDTO{KitchenOrderID: "7c9e6679-7425-40de-944b-e07fc1f90ae7"})
+	var orderNotFound *exceptions.KitchenOrderNotFoundException
+	assert.ErrorAs(t, err, &orderNotFound)
+
+	assert.Empty(t, fixture.printStore.jobs)
+	t.Log("✓ Nenhum trabalho criado quando o pedido ou a impressora são inválidos")
+}
+
+func TestProcessPrintJobsUseCase_PrintsStationItems(t *testing.T) {
+	fixture := newPrintFixture(t)
+	_, err := fixture.enqueue(dtos.PrintKitchenOrderDTO{KitchenOrderID: printTestOrderID})
+	require.NoError(t, err)
+
+	var mu sync.Mutex
+	printed := make(map[string][]string)
+
+	processed, err := fixture.processor(3).Execute(context.Background(), func(printer entities.Printer, kitchenOrder entities.KitchenOrder) error {
+		mu.Lock()
+		defer mu.Unlock()
+		for _, item := range kitchenOrder.Items {
+			printed[printer.ID] = append(printed[printer.ID], item.ID)
+		}
+		return nil
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, 3, processed)
+	assert.Equal(t, []string{"item-burger"}, printed[printTestGrillID])
+	assert.Equal(t, []string{"item-soda"}, printed[printTestDrinksID])
+	assert.Len(t, printed[printTestExpoID], 2)
+
+	for _, job := range fixture.printStore.jobs {
+		assert.Equal(t, constants.PRINT_JOB_STATUS_PRINTED, job.Status)
+		assert.NotNil(t, job.PrintedAt)
+		assert.Equal(t, 1, job.Attempts)
+	}
+	t.Log("✓ Trabalhos impressos com os itens de cada praça")
+}
+
+func TestProcessPrintJobsUseCase_RetriesWithBackoffThenFails(t *testing.T) {
+	fixture := newPrintFixture(t)
+	_, err := fixture.enqueue(dtos.PrintKitchenOrderDTO{KitchenOrderID: printTestOrderID, PrinterID: printTestGrillID})
+	require.NoError(t, err)
+
+	now := time.Now()
+	processor := fixture.processor(2)
+	processor.now = func() time.Time { return now }
+	offline := func(entities.Printer, entities.KitchenOrder) error { return errors.New("connection refused") }
+
+	processed, err := processor.Execute(context.Background(), offline)
+	require.NoError(t, err)
+	assert.Equal(t, 1, processed)
+
+	job := fixture.printStore.jobs[0]
+	assert.Equal(t, constants.PRINT_JOB_STATUS_PENDING, job.Status)
+	assert.Equal(t, "connection refused", job.LastError)
+	assert.Equal(t, now.Add(2*time.Second), job.NextAttemptAt)
+
+	// Antes do backoff vencer nada é reprocessado
+	processed, _ = processor.Execute(context.Background(), offline)
+	assert.Equal(t, 0, processed)
+
+	now = now.Add(2 * time.Second)
+	processed, _ = processor.Execute(context.Background(), offline)
+	assert.Equal(t, 1, processed)
+
+	job = fixture.printStore.jobs[0]
+	assert.Equal(t, constants.PRINT_JOB_STATUS_FAILED, job.Status)
+	assert.Equal(t, 2, job.Attempts)
+	t.Log("✓ Falhas reagendadas com backoff até o limite de tentativas")
+}
+
+func TestProcessPrintJobsUseCase_FailsWhenPrinterWasRemoved(t *testing.T) {
+	fixture := newPrintFixture(t)
+	_, err := fixture.enqueue(dtos.PrintKitchenOrderDTO{KitchenOrderID: printTestOrderID, PrinterID: printTestGrillID})
+	require.NoError(t, err)
+	delete(fixture.printStore.printers, printTestGrillID)
+
+	called := false
+	_, err = fixture.processor(5).Execute(context.Background(), func(entities.Printer, entities.KitchenOrder) error {
+		called = true
+		return nil
+	})
+	require.NoError(t, err)
+
+	assert.False(t, called)
+	assert.Equal(t, constants.PRINT_JOB_STATUS_FAILED, fixture.printStore.jobs[0].Status)
+	assert.Equal(t, "printer not found", fixture.printStore.jobs[0].LastError)
+	t.Log("✓ Trabalho de impressora removida falha sem novas tentativas")
+}
+
+func TestProcessPrintJobsUseCase_RetriesWhenKitchenOrderLookupTimesOut(t *testing.T) {
+	fixture := newPrintFixture(t)
+	_, err := fixture.enqueue(dtos.PrintKitchenOrderDTO{KitchenOrderID: printTestOrderID, PrinterID: printTestGrillID})
+	require.NoError(t, err)
+
+	fixture.dataStore.shouldReturnError = true
+	fixture.dataStore.errorToReturn = context.DeadlineExceeded
+
+	called := false
+	_, err = fixture.processor(3).Execute(context.Background(), func(entities.Printer, entities.KitchenOrder) error {
+		called = true
+		return nil
+	})
+	require.NoError(t, err)
+
+	assert.False(t, called)
+	assert.Equal(t, constants.PRINT_JOB_STATUS_PENDING, fixture.printStore.jobs[0].Status)
+	assert.Equal(t, 1, fixture.printStore.jobs[0].Attempts)
+	t.Log("✓ Timeout ao ler o pedido conta como tentativa e o trabalho volta para a fila")
+}
+
+func TestCreatePrinterUseCase_DefaultPort(t *testing.T) {
+	printStore := NewMockPrintStore()
+
+	printer, err := NewCreatePrinterUseCase(NewMockPrinterGateway(printStore)).Execute(dtos.CreatePrinterDTO{
+		Name:    "Chapa",
+		Host:    "10.0.0.10",
+		Station: "Grill",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, constants.PRINTER_DEFAULT_PORT, printer.Port)
+	assert.Equal(t, "grill", printer.Station)
+	assert.True(t, printer.Active)
+	assert.Contains(t, printStore.printers, printer.ID)
+}
+
+func TestFindPrintJobsUseCase_ValidatesFilter(t *testing.T) {
+	useCase := NewFindPrintJobsUseCase(NewMockPrintJobGateway(NewMockPrintStore()))
+
+	for _, filter := range []dtos.PrintJobFilter{
+		{KitchenOrderID: "not-a-uuid"},
+		{PrinterID: "not-a-uuid"},
+		{Status: "lost"},
+		{Limit: -1},
+		{Limit: constants.PRINT_JOB_MAX_LIMIT + 1},
+	} {
+		_, err := useCase.Execute(filter)
+		var invalidFilter *exceptions.InvalidPrintJobFilterException
+		assert.ErrorAs(t, err, &invalidFilter, "filter %+v", filter)
+	}
+
+	_, err := useCase.Execute(dtos.PrintJobFilter{Status: constants.PRINT_JOB_STATUS_FAILED})
+	assert.NoError(t, err)
+	t.Log("✓ Filtros de trabalhos de impressão validados")
+}
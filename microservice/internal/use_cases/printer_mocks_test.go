@@ -0,0 +1,176 @@
+package use_cases
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"time"
+
+	"tech_challenge/internal/application/dtos"
+	"tech_challenge/internal/application/gateways"
+	"tech_challenge/internal/daos"
+	"tech_challenge/internal/shared/config/constants"
+)
+
+// MockPrintStore simula as tabelas de impressão em memória para testes
+type MockPrintStore struct {
+	mu       sync.Mutex
+	printers map[string]daos.PrinterDAO
+	routes   []daos.PrintRouteDAO
+	jobs     []daos.PrintJobDAO
+}
+
+func NewMockPrintStore() *MockPrintStore {
+	return &MockPrintStore{
+		printers: make(map[string]daos.PrinterDAO),
+	}
+}
+
+type MockPrinterDataSource struct {
+	store *MockPrintStore
+}
+
+func (ds *MockPrinterDataSource) Insert(printer daos.PrinterDAO) error {
+	ds.store.mu.Lock()
+	defer ds.store.mu.Unlock()
+	ds.store.printers[printer.ID] = printer
+	return nil
+}
+
+func (ds *MockPrinterDataSource) FindByID(id string) (daos.PrinterDAO, error) {
+	ds.store.mu.Lock()
+	defer ds.store.mu.Unlock()
+	printer, ok := ds.store.printers[id]
+	if !ok {
+		return daos.PrinterDAO{}, errors.New("record not found")
+	}
+	return printer, nil
+}
+
+func (ds *MockPrinterDataSource) FindAll() ([]daos.PrinterDAO, error) {
+	ds.store.mu.Lock()
+	defer ds.store.mu.Unlock()
+	result := make([]daos.PrinterDAO, 0, len(ds.store.printers))
+	for _, printer := range ds.store.printers {
+		result = append(result, printer)
+	}
+	return result, nil
+}
+
+func (ds *MockPrinterDataSource) FindAllActive(ctx context.Context) ([]daos.PrinterDAO, error) {
+	all, _ := ds.FindAll()
+	result := make([]daos.PrinterDAO, 0, len(all))
+	for _, printer := range all {
+		if printer.Active {
+			result = append(result, printer)
+		}
+	}
+	return result, nil
+}
+
+func (ds *MockPrinterDataSource) Update(printer daos.PrinterDAO) error {
+	ds.store.mu.Lock()
+	defer ds.store.mu.Unlock()
+	if _, ok := ds.store.printers[printer.ID]; !ok {
+		return errors.New("record not found")
+	}
+	ds.store.printers[printer.ID] = printer
+	return nil
+}
+
+func (ds *MockPrinterDataSource) Delete(id string) error {
+	ds.store.mu.Lock()
+	defer ds.store.mu.Unlock()
+	if _, ok := ds.store.printers[id]; !ok {
+		return errors.New("record not found")
+	}
+	delete(ds.store.printers, id)
+	return nil
+}
+
+type MockPrintRouteDataSource struct {
+	store *MockPrintStore
+}
+
+func (ds *MockPrintRouteDataSource) FindAll(ctx context.Context) ([]daos.PrintRouteDAO, error) {
+	ds.store.mu.Lock()
+	defer ds.store.mu.Unlock()
+	return slices.Clone(ds.store.routes), nil
+}
+
+func (ds *MockPrintRouteDataSource) ReplaceAll(routes []daos.PrintRouteDAO) error {
+	ds.store.mu.Lock()
+	defer ds.store.mu.Unlock()
+	ds.store.routes = slices.Clone(routes)
+	return nil
+}
+
+type MockPrintJobDataSource struct {
+	store *MockPrintStore
+}
+
+func (ds *MockPrintJobDataSource) InsertAll(ctx context.Context, jobs []daos.PrintJobDAO) error {
+	ds.store.mu.Lock()
+	defer ds.store.mu.Unlock()
+	ds.store.jobs = append(ds.store.jobs, jobs...)
+	return nil
+}
+
+func (ds *MockPrintJobDataSource) FindAll(filter dtos.PrintJobFilter) ([]daos.PrintJobDAO, error) {
+	ds.store.mu.Lock()
+	defer ds.store.mu.Unlock()
+	var result []daos.PrintJobDAO
+	for _, job := range ds.store.jobs {
+		if filter.KitchenOrderID != "" && job.KitchenOrderID != filter.KitchenOrderID {
+			continue
+		}
+		if filter.Status != "" && job.Status != filter.Status {
+			continue
+		}
+		result = append(result, job)
+	}
+	return result, nil
+}
+
+func (ds *MockPrintJobDataSource) ClaimDue(now, leaseUntil time.Time, limit int) ([]daos.PrintJobDAO, error) {
+	ds.store.mu.Lock()
+	defer ds.store.mu.Unlock()
+	var claimed []daos.PrintJobDAO
+	for i, job := range ds.store.jobs {
+		due := job.Status == constants.PRINT_JOB_STATUS_PENDING || job.Status == constants.PRINT_JOB_STATUS_PRINTING
+		if !due || job.NextAttemptAt.After(now) || len(claimed) >= limit {
+			continue
+		}
+		job.Status = constants.PRINT_JOB_STATUS_PRINTING
+		job.Attempts++
+		job.NextAttemptAt = leaseUntil
+		ds.store.jobs[i] = job
+		claimed = append(claimed, job)
+	}
+	return claimed, nil
+}
+
+func (ds *MockPrintJobDataSource) Update(job daos.PrintJobDAO) error {
+	ds.store.mu.Lock()
+	defer ds.store.mu.Unlock()
+	for i, stored := range ds.store.jobs {
+		if stored.ID == job.ID && stored.Attempts == job.Attempts {
+			ds.store.jobs[i] = job
+			return nil
+		}
+	}
+	return errors.New("record not found")
+}
+
+func NewMockPrinterGateway(store *MockPrintStore) gateways.PrinterGateway {
+	return *gateways.NewPrinterGateway(&MockPrinterDataSource{store: store})
+}
+
+func NewMockPrintRouteGateway(store *MockPrintStore) gateways.PrintRouteGateway {
+	return *gateways.NewPrintRouteGateway(&MockPrintRouteDataSource{store: store})
+}
+
+func NewMockPrintJobGateway(store *MockPrintStore) gateways.PrintJobGateway {
+	return *gateways.NewPrintJobGateway(&MockPrintJobDataSource{store: store})
+}
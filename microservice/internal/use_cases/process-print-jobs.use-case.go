@@ -0,0 +1,152 @@
+package use_cases
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"tech_challenge/internal/application/dtos"
+	"tech_challenge/internal/application/gateways"
+	"tech_challenge/internal/domain/entities"
+	"tech_challenge/internal/shared/config/constants"
+	"tech_challenge/internal/shared/pkg/logger"
+)
+
+const printMaxBackoff = time.Minute
+
+// PrintTicketFunc envia à impressora a comanda do pedido, já limitada aos itens do trabalho
+type PrintTicketFunc func(printer entities.Printer, kitchenOrder entities.KitchenOrder) error
+
+type ProcessPrintJobsUseCase struct {
+	printJobGateway     gateways.PrintJobGateway
+	printerGateway      gateways.PrinterGateway
+	kitchenOrderGateway gateways.KitchenOrderGateway
+	policy              dtos.PrintRetryPolicyDTO
+	now                 func() time.Time
+}
+
+func NewProcessPrintJobsUseCase(
+	printJobGateway gateways.PrintJobGateway,
+	printerGateway gateways.PrinterGateway,
+	kitchenOrderGateway gateways.KitchenOrderGateway,
+	policy dtos.PrintRetryPolicyDTO,
+) *ProcessPrintJobsUseCase {
+	return &ProcessPrintJobsUseCase{
+		printJobGateway:     printJobGateway,
+		printerGateway:      printerGateway,
+		kitchenOrderGateway: kitchenOrderGateway,
+		policy:              policy,
+		now:                 time.Now,
+	}
+}
+
+// Execute imprime os trabalhos vencidos e devolve quantos foram processados.
+// Impressoras diferentes imprimem em paralelo; os trabalhos de uma mesma impressora seguem em ordem
+func (uc *ProcessPrintJobsUseCase) Execute(ctx context.Context, print PrintTicketFunc) (int, error) {
+	processed := 0
+
+	for {
+		now := uc.now()
+
+		jobs, err := uc.printJobGateway.ClaimDue(now, now.Add(uc.policy.Lease), constants.PRINT_JOB_CLAIM_BATCH)
+		if err != nil {
+			return processed, err
+		}
+
+		jobsByPrinter := make(map[string][]entities.PrintJob)
+		for _, job := range jobs {
+			jobsByPrinter[job.PrinterID] = append(jobsByPrinter[job.PrinterID], job)
+		}
+
+		var wg sync.WaitGroup
+		for _, printerJobs := range jobsByPrinter {
+			wg.Add(1)
+			go func(printerJobs []entities.PrintJob) {
+				defer wg.Done()
+				for _, job := range printerJobs {
+					uc.process(ctx, job, print)
+				}
+			}(printerJobs)
+		}
+		wg.Wait()
+
+		processed += len(jobs)
+
+		if len(jobs) < constants.PRINT_JOB_CLAIM_BATCH {
+			return processed, nil
+		}
+	}
+}
+
+func (uc *ProcessPrintJobsUseCase) process(ctx context.Context, job entities.PrintJob, print PrintTicketFunc) {
+	printer, err := uc.printerGateway.FindByID(job.PrinterID)
+	if err != nil || printer.IsEmpty() {
+		job.MarkFailed("printer not found", uc.now())
+		uc.save(job)
+		return
+	}
+
+	if !printer.Active {
+		job.MarkFailed("printer is inactive", uc.now())
+		uc.save(job)
+		return
+	}
+
+	kitchenOrder, err := uc.kitchenOrderGateway.FindByID(ctx, job.KitchenOrderID)
+	if isQueryCanceled(err) {
+		// A leitura expirou, mas o pedido pode existir; o trabalho volta para a fila como uma tentativa falha
+		uc.retry(job, err)
+		return
+	}
+
+	if err != nil || kitchenOrder.IsEmpty() {
+		job.MarkFailed("kitchen order not found", uc.now())
+		uc.save(job)
+		return
+	}
+
+	kitchenOrder.Items = job.FilterItems(kitchenOrder.Items)
+
+	if err := print(printer, kitchenOrder); err != nil {
+		slog.Warn("Print job attempt failed", "print_job_id", job.ID, "printer_id", printer.ID, logger.KitchenOrderIDKey, job.KitchenOrderID, "attempt", job.Attempts, logger.ErrorKey, err)
+
+		uc.retry(job, err)
+		return
+	}
+
+	job.MarkPrinted(uc.now())
+	uc.save(job)
+}
+
+// retry registra a tentativa falha e agenda a próxima com backoff, até esgotar MaxAttempts
+func (uc *ProcessPrintJobsUseCase) retry(job entities.PrintJob, err error) {
+	now := uc.now()
+	job.MarkAttemptFailed(err.Error(), max(uc.policy.MaxAttempts, 1), now.Add(uc.backoff(job.Attempts)), now)
+	uc.save(job)
+}
+
+func (uc *ProcessPrintJobsUseCase) save(job entities.PrintJob) {
+	if job.Status == constants.PRINT_JOB_STATUS_FAILED {
+		slog.Error("Print job failed", "print_job_id", job.ID, logger.KitchenOrderIDKey, job.KitchenOrderID, logger.ErrorKey, job.LastError)
+	}
+
+	if err := uc.printJobGateway.Update(job); err != nil {
+		slog.Error("Error saving print job", "print_job_id", job.ID, logger.ErrorKey, err)
+	}
+}
+
+// backoff dobra a espera a cada tentativa, limitada a printMaxBackoff
+func (uc *ProcessPrintJobsUseCase) backoff(attempt int) time.Duration {
+	if uc.policy.BaseDelay <= 0 {
+		return 0
+	}
+
+	delay := uc.policy.BaseDelay << (attempt - 1)
+
+	if delay <= 0 || delay > printMaxBackoff {
+		return printMaxBackoff
+	}
+
+	return delay
+}
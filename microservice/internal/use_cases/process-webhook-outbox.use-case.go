@@ -0,0 +1,189 @@
+package use_cases
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"tech_challenge/internal/application/dtos"
+	"tech_challenge/internal/application/gateways"
+	"tech_challenge/internal/domain/entities"
+	"tech_challenge/internal/interfaces"
+	"tech_challenge/internal/shared/config/constants"
+	identity_manager "tech_challenge/internal/shared/pkg/identity"
+	"tech_challenge/internal/shared/pkg/logger"
+	"tech_challenge/internal/shared/pkg/signature"
+)
+
+const webhookMaxBackoff = time.Minute
+
+type ProcessWebhookOutboxUseCase struct {
+	subscriptionGateway gateways.WebhookSubscriptionGateway
+	deliveryGateway     gateways.WebhookDeliveryGateway
+	outboxGateway       gateways.WebhookOutboxGateway
+	sender              interfaces.IWebhookSender
+	policy              dtos.WebhookRetryPolicyDTO
+	now                 func() time.Time
+}
+
+func NewProcessWebhookOutboxUseCase(
+	subscriptionGateway gateways.WebhookSubscriptionGateway,
+	deliveryGateway gateways.WebhookDeliveryGateway,
+	outboxGateway gateways.WebhookOutboxGateway,
+	sender interfaces.IWebhookSender,
+	policy dtos.WebhookRetryPolicyDTO,
+) *ProcessWebhookOutboxUseCase {
+	return &ProcessWebhookOutboxUseCase{
+		subscriptionGateway: subscriptionGateway,
+		deliveryGateway:     deliveryGateway,
+		outboxGateway:       outboxGateway,
+		sender:              sender,
+		policy:              policy,
+		now:                 time.Now,
+	}
+}
+
+// Execute envia as entregas vencidas e devolve quantas foram processadas.
+// Inscrições diferentes recebem em paralelo; as entregas de uma mesma inscrição seguem em ordem
+func (uc *ProcessWebhookOutboxUseCase) Execute(ctx context.Context) (int, error) {
+	processed := 0
+
+	for {
+		now := uc.now()
+
+		entries, err := uc.outboxGateway.ClaimDue(now, now.Add(uc.policy.Lease), constants.WEBHOOK_OUTBOX_CLAIM_BATCH)
+		if err != nil {
+			return processed, err
+		}
+
+		entriesBySubscription := make(map[string][]entities.WebhookOutboxEntry)
+		for _, entry := range entries {
+			entriesBySubscription[entry.SubscriptionID] = append(entriesBySubscription[entry.SubscriptionID], entry)
+		}
+
+		var wg sync.WaitGroup
+		for _, subscriptionEntries := range entriesBySubscription {
+			wg.Add(1)
+			go func(subscriptionEntries []entities.WebhookOutboxEntry) {
+				defer wg.Done()
+				for _, entry := range subscriptionEntries {
+					uc.process(ctx, entry)
+				}
+			}(subscriptionEntries)
+		}
+		wg.Wait()
+
+		processed += len(entries)
+
+		if len(entries) < constants.WEBHOOK_OUTBOX_CLAIM_BATCH {
+			return processed, nil
+		}
+	}
+}
+
+func (uc *ProcessWebhookOutboxUseCase) process(ctx context.Context, entry entities.WebhookOutboxEntry) {
+	subscription, err := uc.subscriptionGateway.FindByID(entry.SubscriptionID)
+	if err != nil || subscription.IsEmpty() {
+		entry.MarkFailed("webhook subscription not found", uc.now())
+		uc.save(ctx, entry)
+		return
+	}
+
+	if !subscription.Active {
+		entry.MarkFailed("webhook subscription is inactive", uc.now())
+		uc.save(ctx, entry)
+		return
+	}
+
+	success, errorMessage := uc.attempt(ctx, subscription, entry)
+	if success {
+		entry.MarkDelivered(uc.now())
+		uc.save(ctx, entry)
+
+		if err := uc.subscriptionGateway.ResetFailures(subscription.ID); err != nil {
+			logger.FromContext(ctx).Error("Error resetting webhook failures", "subscription_id", subscription.ID, logger.ErrorKey, err)
+		}
+		return
+	}
+
+	now := uc.now()
+	entry.MarkAttemptFailed(errorMessage, max(uc.policy.MaxAttempts, 1), now.Add(uc.backoff(entry.Attempts)), now)
+	uc.save(ctx, entry)
+
+	if !entry.IsFailed() {
+		return
+	}
+
+	logger.FromContext(ctx).Warn("Webhook delivery failed after all attempts", "event_id", entry.EventID, "subscription_id", subscription.ID, "attempts", entry.Attempts)
+
+	if err := uc.subscriptionGateway.IncrementFailures(subscription.ID, uc.policy.DisableAfterFailures); err != nil {
+		logger.FromContext(ctx).Error("Error registering webhook failure", "subscription_id", subscription.ID, logger.ErrorKey, err)
+	}
+}
+
+// attempt envia a entrega uma vez e registra o resultado no histórico de entregas
+func (uc *ProcessWebhookOutboxUseCase) attempt(ctx context.Context, subscription entities.WebhookSubscription, entry entities.WebhookOutboxEntry) (bool, string) {
+	timestamp := strconv.FormatInt(uc.now().Unix(), 10)
+
+	headers := map[string]string{
+		"Content-Type":        "application/json",
+		"X-Webhook-ID":        entry.EventID,
+		"X-Webhook-Event":     entry.Event,
+		"X-Webhook-Timestamp": timestamp,
+		"X-Webhook-Signature": signature.Sign(subscription.Secret, timestamp, entry.Payload),
+	}
+
+	startedAt := time.Now()
+	statusCode, err := uc.sender.Send(subscription.URL, headers, entry.Payload)
+	duration := time.Since(startedAt)
+
+	success := err == nil && statusCode >= 200 && statusCode < 300
+
+	errorMessage := ""
+	if err != nil {
+		errorMessage = err.Error()
+	} else if !success {
+		errorMessage = "unexpected status code " + strconv.Itoa(statusCode)
+	}
+
+	delivery := entities.NewWebhookDelivery(
+		identity_manager.NewUUIDV4(),
+		subscription.ID,
+		entry.EventID,
+		entry.Event,
+		entry.Attempts,
+		statusCode,
+		success,
+		errorMessage,
+		duration.Milliseconds(),
+		startedAt,
+	)
+
+	if err := uc.deliveryGateway.Insert(*delivery); err != nil {
+		logger.FromContext(ctx).Error("Error saving webhook delivery log", "subscription_id", subscription.ID, logger.ErrorKey, err)
+	}
+
+	return success, errorMessage
+}
+
+func (uc *ProcessWebhookOutboxUseCase) save(ctx context.Context, entry entities.WebhookOutboxEntry) {
+	if err := uc.outboxGateway.Update(entry); err != nil {
+		logger.FromContext(ctx).Error("Error saving webhook outbox entry", "webhook_outbox_id", entry.ID, logger.ErrorKey, err)
+	}
+}
+
+// backoff dobra a espera a cada tentativa, limitada a webhookMaxBackoff
+func (uc *ProcessWebhookOutboxUseCase) backoff(attempt int) time.Duration {
+	if uc.policy.BaseDelay <= 0 {
+		return 0
+	}
+
+	delay := uc.policy.BaseDelay << (attempt - 1)
+
+	if delay <= 0 || delay > webhookMaxBackoff {
+		return webhookMaxBackoff
+	}
+
+	return delay
+}
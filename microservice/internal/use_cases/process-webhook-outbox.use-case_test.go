@@ -0,0 +1,208 @@
+package use_cases
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"tech_challenge/internal/application/dtos"
+	"tech_challenge/internal/daos"
+	"tech_challenge/internal/shared/config/constants"
+	"tech_challenge/internal/shared/pkg/signature"
+)
+
+func seedWebhookSubscription(store *MockWebhookStore, id string, events []string, active bool) {
+	store.subscriptions[id] = daos.WebhookSubscriptionDAO{
+		ID:        id,
+		URL:       "https://partner.example.com/" + id,
+		Secret:    "0123456789abcdef",
+		Events:    events,
+		Active:    active,
+		CreatedAt: time.Now(),
+	}
+}
+
+func newTestWebhookEvent(event string) dtos.WebhookEventDTO {
+	return dtos.WebhookEventDTO{
+		ID:         "evt-1",
+		Event:      event,
+		OccurredAt: time.Now(),
+		Data: dtos.WebhookKitchenOrderDataDTO{
+			ID:       "550e8400-e29b-41d4-a716-446655440000",
+			OrderID:  "order-1",
+			Slug:     "001",
+			StatusID: constants.KITCHEN_ORDER_STATUS_READY_ID,
+			Status:   "Pronto",
+		},
+	}
+}
+
+// webhookOutboxHarness enfileira eventos e drena a fila com um relógio controlado pelo teste
+type webhookOutboxHarness struct {
+	enqueue *EnqueueWebhookEventUseCase
+	process *ProcessWebhookOutboxUseCase
+	clock   time.Time
+}
+
+func newWebhookOutboxHarness(store *MockWebhookStore, sender *MockWebhookSender, policy dtos.WebhookRetryPolicyDTO) *webhookOutboxHarness {
+	harness := &webhookOutboxHarness{clock: time.Now()}
+	now := func() time.Time { return harness.clock }
+
+	harness.enqueue = NewEnqueueWebhookEventUseCase(NewMockWebhookSubscriptionGateway(store), NewMockWebhookOutboxGateway(store))
+	harness.enqueue.now = now
+
+	harness.process = NewProcessWebhookOutboxUseCase(
+		NewMockWebhookSubscriptionGateway(store),
+		NewMockWebhookDeliveryGateway(store),
+		NewMockWebhookOutboxGateway(store),
+		sender,
+		policy,
+	)
+	harness.process.now = now
+
+	return harness
+}
+
+func (h *webhookOutboxHarness) drain(t *testing.T) int {
+	processed, err := h.process.Execute(context.Background())
+	assert.NoError(t, err)
+	return processed
+}
+
+func TestEnqueueWebhookEventUseCase_SkipsUnsubscribedAndInactive(t *testing.T) {
+	store := NewMockWebhookStore()
+	seedWebhookSubscription(store, "sub-ready", []string{constants.WEBHOOK_EVENT_KITCHEN_ORDER_READY}, true)
+	seedWebhookSubscription(store, "sub-finished", []string{constants.WEBHOOK_EVENT_KITCHEN_ORDER_FINISHED}, true)
+	seedWebhookSubscription(store, "sub-inactive", []string{}, false)
+	harness := newWebhookOutboxHarness(store, &MockWebhookSender{statusCodes: []int{200}}, dtos.WebhookRetryPolicyDTO{MaxAttempts: 1})
+
+	entries, err := harness.enqueue.Execute(context.Background(), newTestWebhookEvent(constants.WEBHOOK_EVENT_KITCHEN_ORDER_READY))
+
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+	assert.Len(t, store.outbox, 1)
+	assert.Equal(t, "sub-ready", entries[0].SubscriptionID)
+	assert.Equal(t, constants.WEBHOOK_OUTBOX_STATUS_PENDING, entries[0].Status)
+}
+
+func TestProcessWebhookOutboxUseCase_DeliversSignedPayload(t *testing.T) {
+	store := NewMockWebhookStore()
+	seedWebhookSubscription(store, "sub-1", []string{constants.WEBHOOK_EVENT_KITCHEN_ORDER_READY}, true)
+	sender := &MockWebhookSender{statusCodes: []int{200}}
+	harness := newWebhookOutboxHarness(store, sender, dtos.WebhookRetryPolicyDTO{MaxAttempts: 3, DisableAfterFailures: 5})
+
+	entries, err := harness.enqueue.Execute(context.Background(), newTestWebhookEvent(constants.WEBHOOK_EVENT_KITCHEN_ORDER_READY))
+	assert.NoError(t, err)
+	assert.Empty(t, sender.calls)
+
+	assert.Equal(t, 1, harness.drain(t))
+	assert.Len(t, sender.calls, 1)
+
+	call := sender.calls[0]
+	assert.Equal(t, "https://partner.example.com/sub-1", call.URL)
+	assert.Equal(t, constants.WEBHOOK_EVENT_KITCHEN_ORDER_READY, call.Headers["X-Webhook-Event"])
+	assert.True(t, signature.Verify("0123456789abcdef", call.Headers["X-Webhook-Timestamp"], call.Body, call.Headers["X-Webhook-Signature"]))
+
+	var payload WebhookPayload
+	assert.NoError(t, json.Unmarshal(call.Body, &payload))
+	assert.Equal(t, "order-1", payload.Data.OrderID)
+
+	assert.Len(t, store.deliveries, 1)
+	assert.True(t, store.deliveries[0].Success)
+
+	delivered := store.outbox[entries[0].ID]
+	assert.Equal(t, constants.WEBHOOK_OUTBOX_STATUS_DELIVERED, delivered.Status)
+	assert.NotNil(t, delivered.DeliveredAt)
+	assert.Zero(t, harness.drain(t))
+}
+
+func TestProcessWebhookOutboxUseCase_RetriesWithExponentialBackoff(t *testing.T) {
+	store := NewMockWebhookStore()
+	seedWebhookSubscription(store, "sub-1", []string{}, true)
+	store.subscriptions["sub-1"] = func() daos.WebhookSubscriptionDAO {
+		s := store.subscriptions["sub-1"]
+		s.ConsecutiveFailures = 2
+		return s
+	}()
+	sender := &MockWebhookSender{statusCodes: []int{500, 503, 204}}
+	harness := newWebhookOutboxHarness(store, sender, dtos.WebhookRetryPolicyDTO{MaxAttempts: 5, BaseDelay: time.Second, DisableAfterFailures: 5})
+
+	entries, _ := harness.enqueue.Execute(context.Background(), newTestWebhookEvent(constants.WEBHOOK_EVENT_KITCHEN_ORDER_READY))
+	entryID := entries[0].ID
+
+	// A retentativa fica agendada na fila em vez de bloquear o worker
+	harness.drain(t)
+	assert.Equal(t, constants.WEBHOOK_OUTBOX_STATUS_PENDING, store.outbox[entryID].Status)
+	assert.Equal(t, harness.clock.Add(time.Second), store.outbox[entryID].NextAttemptAt)
+	assert.Zero(t, harness.drain(t))
+
+	harness.clock = harness.clock.Add(time.Second)
+	harness.drain(t)
+	assert.Equal(t, harness.clock.Add(2*time.Second), store.outbox[entryID].NextAttemptAt)
+
+	harness.clock = harness.clock.Add(2 * time.Second)
+	harness.drain(t)
+
+	assert.Len(t, sender.calls, 3)
+	assert.Len(t, store.deliveries, 3)
+	assert.Equal(t, 3, store.deliveries[2].Attempt)
+	assert.Equal(t, "unexpected status code 500", store.deliveries[0].Error)
+	assert.Equal(t, constants.WEBHOOK_OUTBOX_STATUS_DELIVERED, store.outbox[entryID].Status)
+	assert.Equal(t, 0, store.subscriptions["sub-1"].ConsecutiveFailures)
+}
+
+func TestProcessWebhookOutboxUseCase_DisablesAfterRepeatedFailures(t *testing.T) {
+	store := NewMockWebhookStore()
+	seedWebhookSubscription(store, "sub-1", []string{}, true)
+	sender := &MockWebhookSender{err: errors.New("connection refused")}
+	harness := newWebhookOutboxHarness(store, sender, dtos.WebhookRetryPolicyDTO{MaxAttempts: 2, DisableAfterFailures: 2})
+
+	event := newTestWebhookEvent(constants.WEBHOOK_EVENT_KITCHEN_ORDER_READY)
+
+	first, _ := harness.enqueue.Execute(context.Background(), event)
+	harness.drain(t)
+	harness.drain(t)
+	assert.Equal(t, constants.WEBHOOK_OUTBOX_STATUS_FAILED, store.outbox[first[0].ID].Status)
+	assert.True(t, store.subscriptions["sub-1"].Active)
+	assert.Equal(t, 1, store.subscriptions["sub-1"].ConsecutiveFailures)
+
+	harness.enqueue.Execute(context.Background(), event)
+	harness.drain(t)
+	harness.drain(t)
+	assert.False(t, store.subscriptions["sub-1"].Active)
+	assert.Len(t, store.deliveries, 4)
+	assert.Contains(t, store.deliveries[0].Error, "connection refused")
+}
+
+func TestProcessWebhookOutboxUseCase_InactiveSubscriptionIsNotCalled(t *testing.T) {
+	store := NewMockWebhookStore()
+	seedWebhookSubscription(store, "sub-1", []string{}, true)
+	sender := &MockWebhookSender{statusCodes: []int{200}}
+	harness := newWebhookOutboxHarness(store, sender, dtos.WebhookRetryPolicyDTO{MaxAttempts: 3})
+
+	entries, _ := harness.enqueue.Execute(context.Background(), newTestWebhookEvent(constants.WEBHOOK_EVENT_KITCHEN_ORDER_READY))
+
+	// A inscrição foi desativada entre o enfileiramento e o envio
+	disabled := store.subscriptions["sub-1"]
+	disabled.Active = false
+	store.subscriptions["sub-1"] = disabled
+
+	harness.drain(t)
+
+	assert.Empty(t, sender.calls)
+	assert.Equal(t, constants.WEBHOOK_OUTBOX_STATUS_FAILED, store.outbox[entries[0].ID].Status)
+	assert.Equal(t, "webhook subscription is inactive", store.outbox[entries[0].ID].LastError)
+}
+
+func TestProcessWebhookOutboxUseCase_BackoffIsCapped(t *testing.T) {
+	harness := newWebhookOutboxHarness(NewMockWebhookStore(), &MockWebhookSender{}, dtos.WebhookRetryPolicyDTO{BaseDelay: 10 * time.Second})
+
+	assert.Equal(t, 10*time.Second, harness.process.backoff(1))
+	assert.Equal(t, 40*time.Second, harness.process.backoff(3))
+	assert.Equal(t, webhookMaxBackoff, harness.process.backoff(10))
+	assert.Equal(t, webhookMaxBackoff, harness.process.backoff(80))
+}
@@ -0,0 +1,22 @@
+package use_cases
+
+import (
+	"time"
+
+	"tech_challenge/internal/application/gateways"
+)
+
+type PruneKitchenOrderEventsUseCase struct {
+	gateway gateways.KitchenOrderEventGateway
+}
+
+func NewPruneKitchenOrderEventsUseCase(gateway gateways.KitchenOrderEventGateway) *PruneKitchenOrderEventsUseCase {
+	return &PruneKitchenOrderEventsUseCase{
+		gateway: gateway,
+	}
+}
+
+// Execute remove eventos mais antigos que a retenção; clientes que voltarem depois disso recebem apenas eventos novos
+func (uc *PruneKitchenOrderEventsUseCase) Execute(retention time.Duration) (int64, error) {
+	return uc.gateway.DeleteOlderThan(time.Now().Add(-retention))
+}
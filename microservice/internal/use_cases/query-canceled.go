@@ -0,0 +1,12 @@
+package use_cases
+
+import (
+	"context"
+	"errors"
+)
+
+// isQueryCanceled indica que a consulta foi interrompida por timeout ou cancelamento, e não que o registro não existe.
+// Os use cases devolvem esse erro sem traduzir, para que a API responda com timeout em vez de um 404 ou 400
+func isQueryCanceled(err error) bool {
+	return errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled)
+}
@@ -0,0 +1,19 @@
+package use_cases
+
+import (
+	"tech_challenge/internal/application/gateways"
+)
+
+type ReleaseIdempotencyKeyUseCase struct {
+	gateway gateways.IdempotencyKeyGateway
+}
+
+func NewReleaseIdempotencyKeyUseCase(gateway gateways.IdempotencyKeyGateway) *ReleaseIdempotencyKeyUseCase {
+	return &ReleaseIdempotencyKeyUseCase{
+		gateway: gateway,
+	}
+}
+
+func (uc *ReleaseIdempotencyKeyUseCase) Execute(scope, key string) error {
+	return uc.gateway.Release(scope, key)
+}
@@ -0,0 +1,38 @@
+package use_cases
+
+import (
+	"tech_challenge/internal/application/dtos"
+	"tech_challenge/internal/application/gateways"
+	"tech_challenge/internal/domain/entities"
+	identity_manager "tech_challenge/internal/shared/pkg/identity"
+)
+
+type ReplacePrintRoutesUseCase struct {
+	gateway gateways.PrintRouteGateway
+}
+
+func NewReplacePrintRoutesUseCase(gateway gateways.PrintRouteGateway) *ReplacePrintRoutesUseCase {
+	return &ReplacePrintRoutesUseCase{
+		gateway: gateway,
+	}
+}
+
+// Execute grava as regras na ordem recebida, que é a ordem de avaliação no roteamento
+func (uc *ReplacePrintRoutesUseCase) Execute(routeDTOs []dtos.PrintRouteDTO) ([]entities.PrintRoute, error) {
+	routes := make([]entities.PrintRoute, len(routeDTOs))
+
+	for i, routeDTO := range routeDTOs {
+		route, err := entities.NewPrintRoute(identity_manager.NewUUIDV4(), i, routeDTO.ProductPattern, routeDTO.Station)
+		if err != nil {
+			return nil, err
+		}
+
+		routes[i] = *route
+	}
+
+	if err := uc.gateway.ReplaceAll(routes); err != nil {
+		return nil, err
+	}
+
+	return routes, nil
+}
@@ -0,0 +1,83 @@
+package use_cases
+
+import (
+	"time"
+
+	"tech_challenge/internal/application/dtos"
+	"tech_challenge/internal/application/gateways"
+	"tech_challenge/internal/domain/entities"
+	"tech_challenge/internal/domain/exceptions"
+)
+
+// Reserva sem recurso após esse tempo é considerada abandonada (ex.: réplica caiu no meio da criação)
+const idempotencyPendingTimeout = time.Minute
+
+type ReserveIdempotencyKeyUseCase struct {
+	gateway gateways.IdempotencyKeyGateway
+	ttl     time.Duration
+}
+
+func NewReserveIdempotencyKeyUseCase(gateway gateways.IdempotencyKeyGateway, ttl time.Duration) *ReserveIdempotencyKeyUseCase {
+	return &ReserveIdempotencyKeyUseCase{
+		gateway: gateway,
+		ttl:     ttl,
+	}
+}
+
+// Execute reserva a chave para a requisição atual ou retorna a chave já concluída para que o resultado seja repetido
+func (uc *ReserveIdempotencyKeyUseCase) Execute(idempotencyKeyDTO dtos.IdempotencyKeyDTO) (entities.IdempotencyKey, error) {
+	now := time.Now()
+
+	idempotencyKey, err := entities.NewIdempotencyKey(idempotencyKeyDTO.Scope, idempotencyKeyDTO.Key, idempotencyKeyDTO.Fingerprint, nil, now)
+	if err != nil {
+		return entities.IdempotencyKey{}, err
+	}
+
+	reserved, err := uc.gateway.Reserve(*idempotencyKey)
+	if err != nil {
+		return entities.IdempotencyKey{}, err
+	}
+
+	if reserved {
+		return *idempotencyKey, nil
+	}
+
+	existing, err := uc.gateway.FindByKey(idempotencyKey.Scope, idempotencyKey.Key)
+	if err != nil {
+		// A reserva concorrente foi liberada entre as duas consultas; o cliente pode tentar novamente
+		return entities.IdempotencyKey{}, &exceptions.IdempotencyKeyInProgressException{}
+	}
+
+	// Chaves expiradas ficam na tabela até a limpeza periódica, mas já podem ser reutilizadas
+	if existing.CreatedAt.Before(now.Add(-uc.ttl)) {
+		reclaimed, err := uc.gateway.ReclaimExpired(*idempotencyKey, now.Add(-uc.ttl))
+		if err != nil {
+			return entities.IdempotencyKey{}, err
+		}
+
+		if !reclaimed {
+			return entities.IdempotencyKey{}, &exceptions.IdempotencyKeyInProgressException{}
+		}
+
+		return *idempotencyKey, nil
+	}
+
+	if !existing.Matches(idempotencyKey.Fingerprint) {
+		return entities.IdempotencyKey{}, &exceptions.IdempotencyKeyConflictException{}
+	}
+
+	if existing.IsCompleted() {
+		return existing, nil
+	}
+
+	reclaimed, err := uc.gateway.Reclaim(*idempotencyKey, now.Add(-idempotencyPendingTimeout))
+	if err != nil {
+		return entities.IdempotencyKey{}, err
+	}
+
+	if !reclaimed {
+		return entities.IdempotencyKey{}, &exceptions.IdempotencyKeyInProgressException{}
+	}
+
+	return *idempotencyKey, nil
+}
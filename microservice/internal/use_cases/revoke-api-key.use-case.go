@@ -0,0 +1,44 @@
+package use_cases
+
+import (
+	"time"
+
+	"tech_challenge/internal/application/gateways"
+	"tech_challenge/internal/domain/entities"
+	"tech_challenge/internal/domain/exceptions"
+)
+
+type RevokeAPIKeyUseCase struct {
+	gateway gateways.APIKeyGateway
+}
+
+func NewRevokeAPIKeyUseCase(gateway gateways.APIKeyGateway) *RevokeAPIKeyUseCase {
+	return &RevokeAPIKeyUseCase{
+		gateway: gateway,
+	}
+}
+
+// Execute mantém o registro revogado para auditoria; revogar novamente não altera a data original
+func (uc *RevokeAPIKeyUseCase) Execute(id string) (entities.APIKey, error) {
+	if err := entities.ValidateAPIKeyID(id); err != nil {
+		return entities.APIKey{}, err
+	}
+
+	apiKey, err := uc.gateway.FindByID(id)
+	if err != nil {
+		return entities.APIKey{}, &exceptions.APIKeyNotFoundException{}
+	}
+
+	if apiKey.IsRevoked() {
+		return apiKey, nil
+	}
+
+	revokedAt := time.Now()
+	if err := uc.gateway.Revoke(id, revokedAt); err != nil {
+		return entities.APIKey{}, err
+	}
+
+	apiKey.Revoke(revokedAt)
+
+	return apiKey, nil
+}
@@ -0,0 +1,49 @@
+package use_cases
+
+import (
+	"log/slog"
+	"sync"
+	"time"
+
+	"tech_challenge/internal/application/gateways"
+	"tech_challenge/internal/shared/pkg/logger"
+)
+
+const idempotencySweepInterval = 10 * time.Minute
+
+// SweepIdempotencyKeysUseCase vive enquanto o controller existir, para limitar a limpeza a uma vez por intervalo em cada réplica
+type SweepIdempotencyKeysUseCase struct {
+	gateway   gateways.IdempotencyKeyGateway
+	ttl       time.Duration
+	now       func() time.Time
+	mu        sync.Mutex
+	lastSweep time.Time
+}
+
+func NewSweepIdempotencyKeysUseCase(gateway gateways.IdempotencyKeyGateway, ttl time.Duration) *SweepIdempotencyKeysUseCase {
+	return &SweepIdempotencyKeysUseCase{
+		gateway:   gateway,
+		ttl:       ttl,
+		now:       time.Now,
+		lastSweep: time.Now(),
+	}
+}
+
+// Execute remove em segundo plano as chaves expiradas, fora do caminho da requisição
+func (uc *SweepIdempotencyKeysUseCase) Execute() {
+	now := uc.now()
+
+	uc.mu.Lock()
+	if now.Sub(uc.lastSweep) < idempotencySweepInterval {
+		uc.mu.Unlock()
+		return
+	}
+	uc.lastSweep = now
+	uc.mu.Unlock()
+
+	go func() {
+		if _, err := uc.gateway.DeleteOlderThan(now.Add(-uc.ttl)); err != nil {
+			slog.Error("Error deleting expired idempotency keys", logger.ErrorKey, err)
+		}
+	}()
+}
@@ -3,32 +3,47 @@ package use_cases
 import (
 	"context"
 	"encoding/json"
-	"log"
+	"errors"
+	"fmt"
 	"time"
 
 	"tech_challenge/internal/application/dtos"
 	"tech_challenge/internal/application/gateways"
 	"tech_challenge/internal/domain/entities"
 	"tech_challenge/internal/domain/exceptions"
+	"tech_challenge/internal/interfaces"
+	"tech_challenge/internal/shared/config/constants"
 	"tech_challenge/internal/shared/config/env"
-	"tech_challenge/internal/shared/interfaces"
+	shared_interfaces "tech_challenge/internal/shared/interfaces"
+	identity_manager "tech_challenge/internal/shared/pkg/identity"
+	"tech_challenge/internal/shared/pkg/logger"
 )
 
+var webhookEventByStatusID = map[string]string{
+	constants.KITCHEN_ORDER_STATUS_RECEIVED_ID:  constants.WEBHOOK_EVENT_KITCHEN_ORDER_RECEIVED,
+	constants.KITCHEN_ORDER_STATUS_PREPARING_ID: constants.WEBHOOK_EVENT_KITCHEN_ORDER_PREPARING,
+	constants.KITCHEN_ORDER_STATUS_READY_ID:     constants.WEBHOOK_EVENT_KITCHEN_ORDER_READY,
+	constants.KITCHEN_ORDER_STATUS_FINISHED_ID:  constants.WEBHOOK_EVENT_KITCHEN_ORDER_FINISHED,
+}
+
 type UpdateKitchenOrderUseCase struct {
-	gateway       gateways.KitchenOrderGateway
-	statusGateway gateways.OrderStatusGateway
-	messageBroker interfaces.MessageBroker
+	gateway           gateways.KitchenOrderGateway
+	statusGateway     gateways.OrderStatusGateway
+	messageBroker     shared_interfaces.MessageBroker
+	webhookDispatcher interfaces.IWebhookDispatcher
 }
 
 func NewUpdateKitchenOrderUseCase(
 	gateway gateways.KitchenOrderGateway, 
 	statusGateway gateways.OrderStatusGateway,
-	messageBroker interfaces.MessageBroker,
+	messageBroker shared_interfaces.MessageBroker,
+	webhookDispatcher interfaces.IWebhookDispatcher,
 ) *UpdateKitchenOrderUseCase {
 	return &UpdateKitchenOrderUseCase{
-		gateway:       gateway,
-		statusGateway: statusGateway,
-		messageBroker: messageBroker,
+		gateway:           gateway,
+		statusGateway:     statusGateway,
+		messageBroker:     messageBroker,
+		webhookDispatcher: webhookDispatcher,
 	}
 }
 
@@ -37,40 +52,98 @@ type KitchenOrderStatusUpdateMessage struct {
 	Status  string `json:"status"`
 }
 
-func (ko *UpdateKitchenOrderUseCase) Execute(kitchenOrderDTO dtos.UpdateKitchenOrderDTO) (entities.KitchenOrder, error) {
+func (ko *UpdateKitchenOrderUseCase) Execute(ctx context.Context, kitchenOrderDTO dtos.UpdateKitchenOrderDTO) (entities.KitchenOrder, error) {
 	err := entities.ValidateID(kitchenOrderDTO.ID)
 
 	if err != nil {
 		return entities.KitchenOrder{}, err
 	}
 
-	kitchenOrder, err := ko.gateway.FindByID(kitchenOrderDTO.ID)
+	kitchenOrder, err := ko.gateway.FindByID(ctx, kitchenOrderDTO.ID)
+
+	if isQueryCanceled(err) {
+		return entities.KitchenOrder{}, err
+	}
 
 	if err != nil {
 		return entities.KitchenOrder{}, &exceptions.KitchenOrderNotFoundException{}
 	}
 
-	kitchenOrderStatus, err := ko.statusGateway.FindByID(kitchenOrderDTO.StatusID)
+	if kitchenOrderDTO.ExpectedVersion != nil && *kitchenOrderDTO.ExpectedVersion != kitchenOrder.Version {
+		return entities.KitchenOrder{}, &exceptions.KitchenOrderVersionMismatchException{}
+	}
+
+	kitchenOrderStatus, err := ko.statusGateway.FindByID(ctx, kitchenOrderDTO.StatusID)
+
+	if isQueryCanceled(err) {
+		return entities.KitchenOrder{}, err
+	}
 
 	if err != nil {
 		return entities.KitchenOrder{}, &exceptions.OrderStatusNotFoundException{}
 	}
 
+	statusChanged := kitchenOrder.Status.ID != kitchenOrderDTO.StatusID
+
+	if statusChanged {
+		if err := entities.ValidateKitchenOrderStatusChange(kitchenOrder.Status.ID, kitchenOrderDTO.StatusID, kitchenOrderDTO.Reason); err != nil {
+			return entities.KitchenOrder{}, err
+		}
+	}
+
 	kitchenOrder.Status.ID = kitchenOrderDTO.StatusID
 	kitchenOrder.Status.Name = kitchenOrderStatus.Name
+	// O motivo vale apenas para esta mudança de status
+	kitchenOrder.StatusReason = kitchenOrderDTO.Reason
+	kitchenOrder.StatusChangedBy = kitchenOrderDTO.ChangedBy
 
 	now := time.Now()
 	kitchenOrder.UpdatedAt = &now
 
-	err = ko.gateway.Update(kitchenOrder)
+	// As entregas de webhook são gravadas na mesma transação da mudança de status e do seu kitchen_order_event
+	var webhookErr error
+	err = ko.gateway.Transaction(ctx, func(ctx context.Context) error {
+		if err := ko.gateway.Update(ctx, kitchenOrder); err != nil {
+			return err
+		}
+
+		// Regravar o mesmo status não é um evento para os parceiros
+		if statusChanged {
+			webhookErr = ko.dispatchWebhooks(ctx, kitchenOrder, now)
+		}
+
+		return webhookErr
+	})
+
+	if webhookErr != nil {
+		return entities.KitchenOrder{}, webhookErr
+	}
+
+	if isQueryCanceled(err) {
+		return entities.KitchenOrder{}, err
+	}
+
+	if errors.Is(err, interfaces.ErrKitchenOrderVersionConflict) {
+		// Outra alteração foi gravada entre a leitura e a escrita
+		if kitchenOrderDTO.ExpectedVersion != nil {
+			return entities.KitchenOrder{}, &exceptions.KitchenOrderVersionMismatchException{}
+		}
+		return entities.KitchenOrder{}, &exceptions.KitchenOrderUpdateConflictException{}
+	}
 
 	if err != nil {
 		return entities.KitchenOrder{}, &exceptions.InvalidKitchenOrderDataException{}
 	}
 
+	kitchenOrder.Version++
+
 	// Notificar Orders apenas para status específicos
 	if ko.shouldNotifyOrders(kitchenOrderStatus.Name.Value()) {
-		ko.notifyOrdersService(kitchenOrder, kitchenOrderStatus.Name.Value())
+		ko.notifyOrdersService(ctx, kitchenOrder, kitchenOrderStatus.Name.Value())
+	}
+
+	if statusChanged && ko.webhookDispatcher != nil {
+		ko.webhookDispatcher.Notify()
 	}
 
 	return kitchenOrder, nil
@@ -86,7 +159,7 @@ func (ko *UpdateKitchenOrderUseCase) shouldNotifyOrders(status string) bool {
 	return false
 }
 
-func (ko *UpdateKitchenOrderUseCase) notifyOrdersService(kitchenOrder entities.KitchenOrder, status string) {
+func (ko *UpdateKitchenOrderUseCase) notifyOrdersService(ctx context.Context, kitchenOrder entities.KitchenOrder, status string) {
 	message := KitchenOrderStatusUpdateMessage{
 		OrderID: kitchenOrder.OrderID,
 		Status:  status,
@@ -94,21 +167,51 @@ func (ko *UpdateKitchenOrderUseCase) notifyOrdersService(kitchenOrder entities.K
 
 	messageBody, err := json.Marshal(message)
 	if err != nil {
-		log.Printf("Error marshaling kitchen order status update message: %v", err)
+		logger.FromContext(ctx).Error("Error marshaling kitchen order status update message", logger.OrderIDKey, kitchenOrder.OrderID, logger.ErrorKey, err)
 		return
 	}
 
 	config := env.GetConfig()
 	queueName := config.MessageBroker.SQS.OrdersQueueURL
 
-	msg := interfaces.Message{
+	msg := shared_interfaces.Message{
 		Body:    messageBody,
 		Headers: map[string]string{"message-type": "kitchen-order-status-update"},
 	}
 
-	if err := ko.messageBroker.Publish(context.Background(), queueName, msg); err != nil {
-		log.Printf("Failed to send kitchen order status update to orders service: %v", err)
+	// O pedido já foi gravado; o cancelamento da requisição não deve impedir a notificação
+	if err := ko.messageBroker.Publish(context.WithoutCancel(ctx), queueName, msg); err != nil {
+		logger.FromContext(ctx).Error("Failed to send kitchen order status update to orders service", logger.OrderIDKey, kitchenOrder.OrderID, logger.KitchenOrderIDKey, kitchenOrder.ID, "status", status, logger.ErrorKey, err)
 	} else {
-		log.Printf("Kitchen order status update sent to orders service: OrderID=%s, Status=%s", kitchenOrder.OrderID, status)
+		logger.FromContext(ctx).Info("Kitchen order status update sent to orders service", logger.OrderIDKey, kitchenOrder.OrderID, logger.KitchenOrderIDKey, kitchenOrder.ID, "status", status)
 	}
 }
+
+func (ko *UpdateKitchenOrderUseCase) dispatchWebhooks(ctx context.Context, kitchenOrder entities.KitchenOrder, occurredAt time.Time) error {
+	if ko.webhookDispatcher == nil {
+		return nil
+	}
+
+	event, ok := webhookEventByStatusID[kitchenOrder.Status.ID]
+	if !ok {
+		return nil
+	}
+
+	err := ko.webhookDispatcher.Dispatch(ctx, dtos.WebhookEventDTO{
+		ID:         identity_manager.NewUUIDV4(),
+		Event:      event,
+		OccurredAt: occurredAt,
+		Data: dtos.WebhookKitchenOrderDataDTO{
+			ID:       kitchenOrder.ID,
+			OrderID:  kitchenOrder.OrderID,
+			Slug:     kitchenOrder.Slug.Value(),
+			StatusID: kitchenOrder.Status.ID,
+			Status:   kitchenOrder.Status.Name.Value(),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("enqueuing webhook event %s: %w", event, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package use_cases
+
+import (
+	"time"
+
+	"tech_challenge/internal/application/dtos"
+	"tech_challenge/internal/application/gateways"
+	"tech_challenge/internal/domain/entities"
+	"tech_challenge/internal/domain/exceptions"
+	"tech_challenge/internal/shared/config/constants"
+)
+
+type UpdatePrinterUseCase struct {
+	gateway gateways.PrinterGateway
+}
+
+func NewUpdatePrinterUseCase(gateway gateways.PrinterGateway) *UpdatePrinterUseCase {
+	return &UpdatePrinterUseCase{
+		gateway: gateway,
+	}
+}
+
+func (uc *UpdatePrinterUseCase) Execute(printerDTO dtos.UpdatePrinterDTO) (entities.Printer, error) {
+	if err := entities.ValidatePrinterID(printerDTO.ID); err != nil {
+		return entities.Printer{}, err
+	}
+
+	existing, err := uc.gateway.FindByID(printerDTO.ID)
+
+	if err != nil || existing.IsEmpty() {
+		return entities.Printer{}, &exceptions.PrinterNotFoundException{}
+	}
+
+	port := printerDTO.Port
+	if port == 0 {
+		port = constants.PRINTER_DEFAULT_PORT
+	}
+
+	now := time.Now()
+	printer, err := entities.NewPrinter(
+		existing.ID,
+		printerDTO.Name,
+		printerDTO.Host,
+		port,
+		printerDTO.Station,
+		printerDTO.Width,
+		printerDTO.Active,
+		existing.CreatedAt,
+		&now,
+	)
+
+	if err != nil {
+		return entities.Printer{}, err
+	}
+
+	if err := uc.gateway.Update(*printer); err != nil {
+		return entities.Printer{}, err
+	}
+
+	return *printer, nil
+}
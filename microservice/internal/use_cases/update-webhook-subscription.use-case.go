@@ -0,0 +1,75 @@
+package use_cases
+
+import (
+	"time"
+
+	"tech_challenge/internal/application/dtos"
+	"tech_challenge/internal/application/gateways"
+	"tech_challenge/internal/domain/entities"
+	"tech_challenge/internal/domain/exceptions"
+	"tech_challenge/internal/interfaces"
+)
+
+type UpdateWebhookSubscriptionUseCase struct {
+	gateway      gateways.WebhookSubscriptionGateway
+	urlValidator interfaces.IWebhookURLValidator
+}
+
+func NewUpdateWebhookSubscriptionUseCase(gateway gateways.WebhookSubscriptionGateway, urlValidator interfaces.IWebhookURLValidator) *UpdateWebhookSubscriptionUseCase {
+	return &UpdateWebhookSubscriptionUseCase{
+		gateway:      gateway,
+		urlValidator: urlValidator,
+	}
+}
+
+func (uc *UpdateWebhookSubscriptionUseCase) Execute(subscriptionDTO dtos.UpdateWebhookSubscriptionDTO) (entities.WebhookSubscription, error) {
+	if err := entities.ValidateWebhookID(subscriptionDTO.ID); err != nil {
+		return entities.WebhookSubscription{}, err
+	}
+
+	existing, err := uc.gateway.FindByID(subscriptionDTO.ID)
+
+	if err != nil || existing.IsEmpty() {
+		return entities.WebhookSubscription{}, &exceptions.WebhookSubscriptionNotFoundException{}
+	}
+
+	events := subscriptionDTO.Events
+	if events == nil {
+		events = []string{}
+	}
+
+	// Reativar uma inscrição desabilitada por falhas zera o contador
+	consecutiveFailures := existing.ConsecutiveFailures
+	if subscriptionDTO.Active && !existing.Active {
+		consecutiveFailures = 0
+	}
+
+	now := time.Now()
+	subscription, err := entities.NewWebhookSubscription(
+		existing.ID,
+		subscriptionDTO.URL,
+		existing.Secret,
+		events,
+		subscriptionDTO.Active,
+		consecutiveFailures,
+		existing.CreatedAt,
+		&now,
+	)
+
+	if err != nil {
+		return entities.WebhookSubscription{}, err
+	}
+
+	// A URL já gravada foi validada na inscrição; o envio ainda barra endereços internos na conexão
+	if subscription.URL != existing.URL {
+		if err := uc.urlValidator.Validate(subscription.URL); err != nil {
+			return entities.WebhookSubscription{}, err
+		}
+	}
+
+	if err := uc.gateway.Update(*subscription); err != nil {
+		return entities.WebhookSubscription{}, err
+	}
+
+	return *subscription, nil
+}
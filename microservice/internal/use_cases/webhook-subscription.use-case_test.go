@@ -0,0 +1,127 @@
+package use_cases
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"tech_challenge/internal/application/dtos"
+	"tech_challenge/internal/domain/exceptions"
+	"tech_challenge/internal/shared/config/constants"
+)
+
+func TestCreateWebhookSubscriptionUseCase_GeneratesSecret(t *testing.T) {
+	store := NewMockWebhookStore()
+	useCase := NewCreateWebhookSubscriptionUseCase(NewMockWebhookSubscriptionGateway(store), &MockWebhookURLValidator{})
+
+	subscription, err := useCase.Execute(dtos.CreateWebhookSubscriptionDTO{
+		URL:    "https://partner.example.com/hooks",
+		Events: []string{constants.WEBHOOK_EVENT_KITCHEN_ORDER_READY},
+	})
+
+	assert.NoError(t, err)
+	assert.Len(t, subscription.Secret, 64)
+	assert.True(t, subscription.Active)
+	assert.Contains(t, store.subscriptions, subscription.ID)
+}
+
+func TestCreateWebhookSubscriptionUseCase_InvalidData(t *testing.T) {
+	useCase := NewCreateWebhookSubscriptionUseCase(NewMockWebhookSubscriptionGateway(NewMockWebhookStore()), &MockWebhookURLValidator{})
+
+	_, err := useCase.Execute(dtos.CreateWebhookSubscriptionDTO{URL: "not-a-url"})
+
+	assert.IsType(t, &exceptions.InvalidWebhookSubscriptionDataException{}, err)
+}
+
+func TestUpdateWebhookSubscriptionUseCase_ReactivationResetsFailures(t *testing.T) {
+	store := NewMockWebhookStore()
+	gateway := NewMockWebhookSubscriptionGateway(store)
+	created, _ := NewCreateWebhookSubscriptionUseCase(gateway, &MockWebhookURLValidator{}).Execute(dtos.CreateWebhookSubscriptionDTO{
+		URL: "https://partner.example.com/hooks",
+	})
+
+	disabled := store.subscriptions[created.ID]
+	disabled.Active = false
+	disabled.ConsecutiveFailures = 10
+	store.subscriptions[created.ID] = disabled
+
+	updated, err := NewUpdateWebhookSubscriptionUseCase(gateway, &MockWebhookURLValidator{}).Execute(dtos.UpdateWebhookSubscriptionDTO{
+		ID:     created.ID,
+		URL:    "https://partner.example.com/v2/hooks",
+		Events: []string{constants.WEBHOOK_EVENT_KITCHEN_ORDER_FINISHED},
+		Active: true,
+	})
+
+	assert.NoError(t, err)
+	assert.True(t, updated.Active)
+	assert.Equal(t, 0, updated.ConsecutiveFailures)
+	assert.Equal(t, created.Secret, updated.Secret)
+	assert.Equal(t, "https://partner.example.com/v2/hooks", store.subscriptions[created.ID].URL)
+}
+
+func TestCreateWebhookSubscriptionUseCase_RejectsInternalURL(t *testing.T) {
+	store := NewMockWebhookStore()
+	validator := &MockWebhookURLValidator{rejected: map[string]bool{"https://169.254.169.254/latest/meta-data": true}}
+	useCase := NewCreateWebhookSubscriptionUseCase(NewMockWebhookSubscriptionGateway(store), validator)
+
+	_, err := useCase.Execute(dtos.CreateWebhookSubscriptionDTO{URL: "https://169.254.169.254/latest/meta-data"})
+
+	assert.IsType(t, &exceptions.InvalidWebhookSubscriptionDataException{}, err)
+	assert.Empty(t, store.subscriptions)
+}
+
+func TestUpdateWebhookSubscriptionUseCase_ValidatesOnlyChangedURL(t *testing.T) {
+	store := NewMockWebhookStore()
+	gateway := NewMockWebhookSubscriptionGateway(store)
+	validator := &MockWebhookURLValidator{}
+	created, _ := NewCreateWebhookSubscriptionUseCase(gateway, validator).Execute(dtos.CreateWebhookSubscriptionDTO{
+		URL: "https://partner.example.com/hooks",
+	})
+	useCase := NewUpdateWebhookSubscriptionUseCase(gateway, validator)
+
+	_, err := useCase.Execute(dtos.UpdateWebhookSubscriptionDTO{ID: created.ID, URL: created.URL, Active: false})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{created.URL}, validator.validated)
+
+	validator.rejected = map[string]bool{"https://10.0.0.4/hooks": true}
+	_, err = useCase.Execute(dtos.UpdateWebhookSubscriptionDTO{ID: created.ID, URL: "https://10.0.0.4/hooks", Active: true})
+
+	assert.IsType(t, &exceptions.InvalidWebhookSubscriptionDataException{}, err)
+	assert.Equal(t, created.URL, store.subscriptions[created.ID].URL)
+}
+
+func TestUpdateWebhookSubscriptionUseCase_NotFound(t *testing.T) {
+	useCase := NewUpdateWebhookSubscriptionUseCase(NewMockWebhookSubscriptionGateway(NewMockWebhookStore()), &MockWebhookURLValidator{})
+
+	_, err := useCase.Execute(dtos.UpdateWebhookSubscriptionDTO{
+		ID:     "550e8400-e29b-41d4-a716-446655440000",
+		URL:    "https://partner.example.com/hooks",
+		Active: true,
+	})
+
+	assert.IsType(t, &exceptions.WebhookSubscriptionNotFoundException{}, err)
+}
+
+func TestDeleteWebhookSubscriptionUseCase(t *testing.T) {
+	store := NewMockWebhookStore()
+	gateway := NewMockWebhookSubscriptionGateway(store)
+	created, _ := NewCreateWebhookSubscriptionUseCase(gateway, &MockWebhookURLValidator{}).Execute(dtos.CreateWebhookSubscriptionDTO{
+		URL: "https://partner.example.com/hooks",
+	})
+
+	useCase := NewDeleteWebhookSubscriptionUseCase(gateway)
+
+	assert.NoError(t, useCase.Execute(created.ID))
+	assert.Empty(t, store.subscriptions)
+	assert.IsType(t, &exceptions.WebhookSubscriptionNotFoundException{}, useCase.Execute(created.ID))
+	assert.IsType(t, &exceptions.InvalidWebhookSubscriptionDataException{}, useCase.Execute("invalid"))
+}
+
+func TestFindWebhookDeliveriesUseCase_UnknownSubscription(t *testing.T) {
+	store := NewMockWebhookStore()
+	useCase := NewFindWebhookDeliveriesUseCase(NewMockWebhookSubscriptionGateway(store), NewMockWebhookDeliveryGateway(store))
+
+	_, err := useCase.Execute("550e8400-e29b-41d4-a716-446655440000")
+
+	assert.IsType(t, &exceptions.WebhookSubscriptionNotFoundException{}, err)
+}
@@ -0,0 +1,234 @@
+package use_cases
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"tech_challenge/internal/application/gateways"
+	"tech_challenge/internal/daos"
+	"tech_challenge/internal/domain/exceptions"
+	"tech_challenge/internal/shared/config/constants"
+)
+
+// MockWebhookStore simula as tabelas de webhooks em memória para testes
+type MockWebhookStore struct {
+	mu            sync.Mutex
+	subscriptions map[string]daos.WebhookSubscriptionDAO
+	deliveries    []daos.WebhookDeliveryDAO
+	outbox        map[string]daos.WebhookOutboxDAO
+}
+
+func NewMockWebhookStore() *MockWebhookStore {
+	return &MockWebhookStore{
+		subscriptions: make(map[string]daos.WebhookSubscriptionDAO),
+		outbox:        make(map[string]daos.WebhookOutboxDAO),
+	}
+}
+
+type MockWebhookSubscriptionDataSource struct {
+	store *MockWebhookStore
+}
+
+func (ds *MockWebhookSubscriptionDataSource) Insert(subscription daos.WebhookSubscriptionDAO) error {
+	ds.store.mu.Lock()
+	defer ds.store.mu.Unlock()
+	ds.store.subscriptions[subscription.ID] = subscription
+	return nil
+}
+
+func (ds *MockWebhookSubscriptionDataSource) FindByID(id string) (daos.WebhookSubscriptionDAO, error) {
+	ds.store.mu.Lock()
+	defer ds.store.mu.Unlock()
+	subscription, ok := ds.store.subscriptions[id]
+	if !ok {
+		return daos.WebhookSubscriptionDAO{}, errors.New("record not found")
+	}
+	return subscription, nil
+}
+
+func (ds *MockWebhookSubscriptionDataSource) FindAll() ([]daos.WebhookSubscriptionDAO, error) {
+	ds.store.mu.Lock()
+	defer ds.store.mu.Unlock()
+	result := make([]daos.WebhookSubscriptionDAO, 0, len(ds.store.subscriptions))
+	for _, subscription := range ds.store.subscriptions {
+		result = append(result, subscription)
+	}
+	return result, nil
+}
+
+func (ds *MockWebhookSubscriptionDataSource) FindAllActive() ([]daos.WebhookSubscriptionDAO, error) {
+	all, _ := ds.FindAll()
+	result := make([]daos.WebhookSubscriptionDAO, 0, len(all))
+	for _, subscription := range all {
+		if subscription.Active {
+			result = append(result, subscription)
+		}
+	}
+	return result, nil
+}
+
+func (ds *MockWebhookSubscriptionDataSource) Update(subscription daos.WebhookSubscriptionDAO) error {
+	ds.store.mu.Lock()
+	defer ds.store.mu.Unlock()
+	if _, ok := ds.store.subscriptions[subscription.ID]; !ok {
+		return errors.New("record not found")
+	}
+	ds.store.subscriptions[subscription.ID] = subscription
+	return nil
+}
+
+func (ds *MockWebhookSubscriptionDataSource) Delete(id string) error {
+	ds.store.mu.Lock()
+	defer ds.store.mu.Unlock()
+	if _, ok := ds.store.subscriptions[id]; !ok {
+		return errors.New("record not found")
+	}
+	delete(ds.store.subscriptions, id)
+	return nil
+}
+
+func (ds *MockWebhookSubscriptionDataSource) IncrementFailures(id string, disableAfter int) error {
+	ds.store.mu.Lock()
+	defer ds.store.mu.Unlock()
+	subscription := ds.store.subscriptions[id]
+	subscription.ConsecutiveFailures++
+	if subscription.ConsecutiveFailures >= disableAfter {
+		subscription.Active = false
+	}
+	ds.store.subscriptions[id] = subscription
+	return nil
+}
+
+func (ds *MockWebhookSubscriptionDataSource) ResetFailures(id string) error {
+	ds.store.mu.Lock()
+	defer ds.store.mu.Unlock()
+	subscription := ds.store.subscriptions[id]
+	subscription.ConsecutiveFailures = 0
+	ds.store.subscriptions[id] = subscription
+	return nil
+}
+
+type MockWebhookDeliveryDataSource struct {
+	store *MockWebhookStore
+}
+
+func (ds *MockWebhookDeliveryDataSource) Insert(delivery daos.WebhookDeliveryDAO) error {
+	ds.store.mu.Lock()
+	defer ds.store.mu.Unlock()
+	ds.store.deliveries = append(ds.store.deliveries, delivery)
+	return nil
+}
+
+func (ds *MockWebhookDeliveryDataSource) FindBySubscriptionID(subscriptionID string, limit int) ([]daos.WebhookDeliveryDAO, error) {
+	ds.store.mu.Lock()
+	defer ds.store.mu.Unlock()
+	var result []daos.WebhookDeliveryDAO
+	for _, delivery := range ds.store.deliveries {
+		if delivery.SubscriptionID == subscriptionID && len(result) < limit {
+			result = append(result, delivery)
+		}
+	}
+	return result, nil
+}
+
+type MockWebhookOutboxDataSource struct {
+	store *MockWebhookStore
+}
+
+func (ds *MockWebhookOutboxDataSource) InsertAll(ctx context.Context, entries []daos.WebhookOutboxDAO) error {
+	ds.store.mu.Lock()
+	defer ds.store.mu.Unlock()
+	for _, entry := range entries {
+		ds.store.outbox[entry.ID] = entry
+	}
+	return nil
+}
+
+func (ds *MockWebhookOutboxDataSource) ClaimDue(now, leaseUntil time.Time, limit int) ([]daos.WebhookOutboxDAO, error) {
+	ds.store.mu.Lock()
+	defer ds.store.mu.Unlock()
+	var claimed []daos.WebhookOutboxDAO
+	for id, entry := range ds.store.outbox {
+		if len(claimed) >= limit {
+			break
+		}
+		claimable := entry.Status == constants.WEBHOOK_OUTBOX_STATUS_PENDING || entry.Status == constants.WEBHOOK_OUTBOX_STATUS_SENDING
+		if !claimable || entry.NextAttemptAt.After(now) {
+			continue
+		}
+		entry.Status = constants.WEBHOOK_OUTBOX_STATUS_SENDING
+		entry.Attempts++
+		entry.NextAttemptAt = leaseUntil
+		ds.store.outbox[id] = entry
+		claimed = append(claimed, entry)
+	}
+	return claimed, nil
+}
+
+func (ds *MockWebhookOutboxDataSource) Update(entry daos.WebhookOutboxDAO) error {
+	ds.store.mu.Lock()
+	defer ds.store.mu.Unlock()
+	if current, ok := ds.store.outbox[entry.ID]; !ok || current.Attempts != entry.Attempts {
+		return errors.New("record not found")
+	}
+	ds.store.outbox[entry.ID] = entry
+	return nil
+}
+
+// MockWebhookURLValidator aceita qualquer URL que não esteja em rejected e registra as URLs conferidas
+type MockWebhookURLValidator struct {
+	rejected  map[string]bool
+	validated []string
+}
+
+func (v *MockWebhookURLValidator) Validate(callbackURL string) error {
+	v.validated = append(v.validated, callbackURL)
+	if v.rejected[callbackURL] {
+		return &exceptions.InvalidWebhookSubscriptionDataException{Message: "Webhook URL must resolve to a public address"}
+	}
+	return nil
+}
+
+// MockWebhookSender registra as chamadas e responde com os status configurados, em ordem
+type MockWebhookSender struct {
+	mu          sync.Mutex
+	statusCodes []int
+	err         error
+	calls       []MockWebhookSenderCall
+}
+
+type MockWebhookSenderCall struct {
+	URL     string
+	Headers map[string]string
+	Body    []byte
+}
+
+func (s *MockWebhookSender) Send(url string, headers map[string]string, body []byte) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls = append(s.calls, MockWebhookSenderCall{URL: url, Headers: headers, Body: body})
+
+	if s.err != nil {
+		return 0, s.err
+	}
+
+	statusCode := s.statusCodes[0]
+	if len(s.statusCodes) > 1 {
+		s.statusCodes = s.statusCodes[1:]
+	}
+	return statusCode, nil
+}
+
+func NewMockWebhookSubscriptionGateway(store *MockWebhookStore) gateways.WebhookSubscriptionGateway {
+	return *gateways.NewWebhookSubscriptionGateway(&MockWebhookSubscriptionDataSource{store: store})
+}
+
+func NewMockWebhookDeliveryGateway(store *MockWebhookStore) gateways.WebhookDeliveryGateway {
+	return *gateways.NewWebhookDeliveryGateway(&MockWebhookDeliveryDataSource{store: store})
+}
+
+func NewMockWebhookOutboxGateway(store *MockWebhookStore) gateways.WebhookOutboxGateway {
+	return *gateways.NewWebhookOutboxGateway(&MockWebhookOutboxDataSource{store: store})
+}
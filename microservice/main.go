@@ -6,12 +6,30 @@
 // @host localhost:8082
 // @BasePath /v1
 // @schemes http
+// @securityDefinitions.apikey BearerAuth
+// @in header
+// @name Authorization
+// @description JWT access token in the format "Bearer {token}"
+// @securityDefinitions.apikey ApiKeyAuth
+// @in header
+// @name X-API-Key
+// @description API key for service-to-service calls, limited to the scopes granted on creation
 //
 //go:debug x509negativeserial=1
 package main
 
-import "tech_challenge/internal/shared/infra/api"
+import (
+	"os"
+
+	"tech_challenge/internal/shared/infra/api"
+	"tech_challenge/internal/shared/infra/cli"
+)
 
 func main() {
+	// "main migrate <up|down|status>" roda as migrations e sai, sem subir a API
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		os.Exit(cli.Migrate(os.Args[2:], os.Stdout))
+	}
+
 	api.Init()
 }